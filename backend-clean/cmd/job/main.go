@@ -1,4 +1,10 @@
 // Package main is the entry point for the job.
+// The job to run is chosen by the first argument: deactivate-inactive-users (default), purge-trash,
+// publish-scheduled-notes, export-account or import-account.
+// export-account and import-account take an account ID and an archive file path:
+//
+//	job export-account <accountID> <file>
+//	job import-account <accountID> <file>
 package main
 
 import (
@@ -12,14 +18,64 @@ import (
 func main() {
 	ctx := context.Background()
 
-	log.Println("starting job: deactivate-inactive-users")
+	name := "deactivate-inactive-users"
+	if len(os.Args) > 1 {
+		name = os.Args[1]
+	}
+
+	log.Printf("starting job: %s", name)
 
-	count, err := initializer.RunDeactivateInactiveUsers(ctx)
-	if err != nil {
-		log.Printf("job failed: %v", err)
-		os.Exit(1)
+	switch name {
+	case "deactivate-inactive-users":
+		count, err := initializer.RunDeactivateInactiveUsers(ctx)
+		if err != nil {
+			log.Printf("job failed: %v", err)
+			os.Exit(1)
+		}
+		log.Printf("job completed successfully: %d users deactivated", count)
+	case "purge-trash":
+		notes, templates, err := initializer.RunPurgeTrash(ctx)
+		if err != nil {
+			log.Printf("job failed: %v", err)
+			os.Exit(1)
+		}
+		log.Printf("job completed successfully: %d notes and %d templates purged", notes, templates)
+	case "publish-scheduled-notes":
+		published, unpublished, err := initializer.RunPublishScheduledNotes(ctx)
+		if err != nil {
+			log.Printf("job failed: %v", err)
+			os.Exit(1)
+		}
+		log.Printf("job completed successfully: %d notes published and %d notes unpublished", published, unpublished)
+	case "export-account":
+		accountID, path := archiveArgs()
+		templates, notes, err := initializer.RunExportAccount(ctx, accountID, path)
+		if err != nil {
+			log.Printf("job failed: %v", err)
+			os.Exit(1)
+		}
+		log.Printf("job completed successfully: %d templates and %d notes exported to %s", templates, notes, path)
+	case "import-account":
+		accountID, path := archiveArgs()
+		templates, notes, err := initializer.RunImportAccount(ctx, accountID, path)
+		if err != nil {
+			log.Printf("job failed: %v", err)
+			os.Exit(1)
+		}
+		log.Printf("job completed successfully: %d templates and %d notes imported from %s", templates, notes, path)
+	default:
+		log.Printf("unknown job: %s", name)
+		os.Exit(2)
 	}
 
-	log.Printf("job completed successfully: %d users deactivated", count)
 	os.Exit(0)
 }
+
+// archiveArgs returns the account ID and archive path of export-account and import-account.
+func archiveArgs() (string, string) {
+	if len(os.Args) != 4 {
+		log.Printf("usage: %s %s <accountID> <file>", os.Args[0], os.Args[1])
+		os.Exit(2)
+	}
+	return os.Args[2], os.Args[3]
+}
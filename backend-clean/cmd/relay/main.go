@@ -0,0 +1,25 @@
+// Package main is the entry point for the outbox relay worker.
+// It delivers recorded domain events to the sink chosen by RELAY_SINK and to subscribed
+// webhooks until interrupted.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	initializer "immortal-architecture-clean/backend/internal/driver/initializer/job"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := initializer.RunOutboxRelay(ctx); err != nil {
+		stop()
+		log.Fatalf("relay failed: %v", err)
+	}
+	log.Printf("relay stopped")
+}
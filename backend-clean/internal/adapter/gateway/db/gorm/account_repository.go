@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
@@ -38,6 +39,7 @@ func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input accoun
 	}
 
 	isNew := errors.Is(result.Error, gorm.ErrRecordNotFound)
+	now := time.Now()
 
 	if isNew {
 		// Create new account
@@ -48,6 +50,7 @@ func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input accoun
 			Provider:          input.Provider,
 			ProviderAccountID: input.ProviderAccountID,
 			Thumbnail:         input.Thumbnail,
+			LastLoginAt:       &now,
 		}
 
 		if err := r.db.WithContext(ctx).Create(&dbAccount).Error; err != nil {
@@ -64,6 +67,10 @@ func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input accoun
 		if input.Thumbnail != nil {
 			updates["thumbnail"] = *input.Thumbnail
 		}
+		// A refused login of a deactivated account is not a login.
+		if dbAccount.IsActive {
+			updates["last_login_at"] = now
+		}
 
 		if err := r.db.WithContext(ctx).Model(&dbAccount).Updates(updates).Error; err != nil {
 			return nil, err
@@ -106,10 +113,46 @@ func (r *AccountRepository) GetByEmail(ctx context.Context, emailAddr string) (*
 	return toDomainAccount(&dbAccount)
 }
 
+// Reactivate flips a deactivated account back to active and records the reactivation using GORM.
+func (r *AccountRepository) Reactivate(ctx context.Context, id string, at time.Time) (*account.Account, error) {
+	var dbAccount Account
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND is_active = ?", id, false).First(&dbAccount).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domainerr.ErrNotFound
+			}
+			return err
+		}
+		if err := tx.Create(&AccountReactivation{
+			AccountID:           dbAccount.ID,
+			PreviousLastLoginAt: dbAccount.LastLoginAt,
+			ReactivatedAt:       at,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&dbAccount).Updates(map[string]interface{}{
+			"is_active":     true,
+			"last_login_at": at,
+			"updated_at":    time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+		return tx.First(&dbAccount, "id = ?", id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAccount(&dbAccount)
+}
+
 // DeactivateByLastLoginBefore deactivates active users who last logged in before the given time.
-func (r *AccountRepository) DeactivateByLastLoginBefore(ctx context.Context, before time.Time) (int, error) {
+func (r *AccountRepository) DeactivateByLastLoginBefore(ctx context.Context, before time.Time) ([]string, error) {
+	var deactivated []Account
 	result := r.db.WithContext(ctx).
-		Model(&Account{}).
+		Model(&deactivated).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
 		Where("last_login_at < ? AND is_active = ?", before, true).
 		Updates(map[string]interface{}{
 			"is_active":  false,
@@ -117,10 +160,14 @@ func (r *AccountRepository) DeactivateByLastLoginBefore(ctx context.Context, bef
 		})
 
 	if result.Error != nil {
-		return 0, result.Error
+		return nil, result.Error
 	}
 
-	return int(result.RowsAffected), nil
+	ids := make([]string, 0, len(deactivated))
+	for _, a := range deactivated {
+		ids = append(ids, a.ID)
+	}
+	return ids, nil
 }
 
 // toDomainAccount converts GORM model to domain model.
@@ -24,3 +24,16 @@ type Account struct {
 func (Account) TableName() string {
 	return "accounts"
 }
+
+// AccountReactivation represents the account_reactivations table for GORM.
+type AccountReactivation struct {
+	ID                  string     `gorm:"primaryKey;column:id;type:uuid;default:gen_random_uuid()"`
+	AccountID           string     `gorm:"column:account_id;type:uuid;not null"`
+	PreviousLastLoginAt *time.Time `gorm:"column:previous_last_login_at"`
+	ReactivatedAt       time.Time  `gorm:"column:reactivated_at;not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (AccountReactivation) TableName() string {
+	return "account_reactivations"
+}
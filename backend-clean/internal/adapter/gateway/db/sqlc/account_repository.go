@@ -31,9 +31,10 @@ func NewAccountRepository(pool *pgxpool.Pool) *AccountRepository {
 	}
 }
 
-// UpsertOAuthAccount inserts or updates an OAuth account.
+// UpsertOAuthAccount inserts or updates an OAuth account and records the login time.
 func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input account.OAuthAccountInput) (*account.Account, error) {
 	q := queriesForContext(ctx, r.queries)
+	now := time.Now()
 
 	row, err := q.UpsertAccount(ctx, &generated.UpsertAccountParams{
 		Email:             input.Email,
@@ -42,7 +43,7 @@ func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input accoun
 		Provider:          input.Provider,
 		ProviderAccountID: input.ProviderAccountID,
 		Thumbnail:         pgNullableText(input.Thumbnail),
-		LastLoginAt:       pgNullableTime(nil),
+		LastLoginAt:       pgNullableTime(&now),
 	})
 	if err != nil {
 		return nil, err
@@ -59,6 +60,9 @@ func (r *AccountRepository) GetByID(ctx context.Context, id string) (*account.Ac
 	}
 	row, err := q.GetAccountByID(ctx, uuid)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
 		return nil, err
 	}
 	return toDomainAccount(row)
@@ -77,21 +81,38 @@ func (r *AccountRepository) GetByEmail(ctx context.Context, email string) (*acco
 	return toDomainAccount(row)
 }
 
-// DeactivateByLastLoginBefore deactivates active users who last logged in before the given time.
-func (r *AccountRepository) DeactivateByLastLoginBefore(ctx context.Context, before time.Time) (int, error) {
-	query := `
-		UPDATE accounts
-		SET is_active = false, updated_at = NOW()
-		WHERE last_login_at < $1
-		  AND is_active = true
-	`
-
-	result, err := r.pool.Exec(ctx, query, before)
+// Reactivate flips a deactivated account back to active and writes an account_reactivations row.
+func (r *AccountRepository) Reactivate(ctx context.Context, id string, at time.Time) (*account.Account, error) {
+	q := queriesForContext(ctx, r.queries)
+	uuid, err := toUUID(id)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	row, err := q.ReactivateAccount(ctx, &generated.ReactivateAccountParams{
+		ID:            uuid,
+		ReactivatedAt: pgNullableTime(&at),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainAccount(row)
+}
 
-	return int(result.RowsAffected()), nil
+// DeactivateByLastLoginBefore deactivates active users who last logged in before the given time.
+func (r *AccountRepository) DeactivateByLastLoginBefore(ctx context.Context, before time.Time) ([]string, error) {
+	q := queriesForContext(ctx, r.queries)
+	rows, err := q.DeactivateAccountsByLastLoginBefore(ctx, pgNullableTime(&before))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(rows))
+	for _, id := range rows {
+		ids = append(ids, uuidToString(id))
+	}
+	return ids, nil
 }
 
 func toDomainAccount(a *generated.Account) (*account.Account, error) {
@@ -90,6 +90,42 @@ func TestAccountRepository_Integration_UpsertOAuthAccount(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, thumb, created.Thumbnail)
 	})
+
+	t.Run("Upsert records last login", func(t *testing.T) {
+		before := time.Now().Add(-time.Second)
+		created, err := repo.UpsertOAuthAccount(ctx, account.OAuthAccountInput{
+			Email:             "last-login@example.com",
+			FirstName:         "Last",
+			LastName:          "Login",
+			Provider:          "google",
+			ProviderAccountID: uuid.New().String(),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, created.LastLoginAt)
+		assert.True(t, created.LastLoginAt.After(before))
+	})
+
+	t.Run("Upsert of inactive account keeps last login", func(t *testing.T) {
+		providerID := uuid.New().String()
+		lastLogin := time.Now().AddDate(0, 0, -100).Truncate(time.Microsecond)
+		_, err := pool.Exec(context.Background(), `
+			INSERT INTO accounts (email, first_name, last_name, provider, provider_account_id, is_active, last_login_at)
+			VALUES ('inactive-upsert@example.com', 'In', 'Active', 'google', $1, false, $2)
+		`, providerID, lastLogin)
+		require.NoError(t, err)
+
+		got, err := repo.UpsertOAuthAccount(ctx, account.OAuthAccountInput{
+			Email:             "inactive-upsert@example.com",
+			FirstName:         "In",
+			LastName:          "Active",
+			Provider:          "google",
+			ProviderAccountID: providerID,
+		})
+		require.NoError(t, err)
+		assert.False(t, got.IsActive)
+		require.NotNil(t, got.LastLoginAt)
+		assert.True(t, got.LastLoginAt.Equal(lastLogin))
+	})
 }
 
 func TestAccountRepository_Integration_GetByID(t *testing.T) {
@@ -120,7 +156,7 @@ func TestAccountRepository_Integration_GetByID(t *testing.T) {
 
 	t.Run("Get non-existing account returns error", func(t *testing.T) {
 		_, err := repo.GetByID(ctx, "00000000-0000-0000-0000-000000000000")
-		assert.Error(t, err)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
 	})
 
 	t.Run("Get with invalid UUID returns error", func(t *testing.T) {
@@ -230,6 +266,55 @@ func TestAccountRepository_Integration_Constraints(t *testing.T) {
 	})
 }
 
+func TestAccountRepository_Integration_Reactivate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewAccountRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	createAccount := func(t *testing.T, email string, isActive bool) string {
+		t.Helper()
+		id := uuid.New().String()
+		_, err := pool.Exec(context.Background(), `
+			INSERT INTO accounts (id, email, first_name, last_name, provider, provider_account_id, is_active, last_login_at)
+			VALUES ($1, $2, 'Test', 'User', 'google', $3, $4, NOW() - INTERVAL '100 days')
+		`, id, email, uuid.New().String(), isActive)
+		require.NoError(t, err)
+		return id
+	}
+	countReactivations := func(t *testing.T, id string) int {
+		t.Helper()
+		var n int
+		err := pool.QueryRow(context.Background(), `SELECT COUNT(*) FROM account_reactivations WHERE account_id = $1`, id).Scan(&n)
+		require.NoError(t, err)
+		return n
+	}
+
+	t.Run("Reactivates and records inactive account", func(t *testing.T) {
+		id := createAccount(t, "reactivate@example.com", false)
+		at := time.Now()
+
+		got, err := repo.Reactivate(ctx, id, at)
+		require.NoError(t, err)
+		assert.True(t, got.IsActive)
+		require.NotNil(t, got.LastLoginAt)
+		assert.WithinDuration(t, at, *got.LastLoginAt, time.Millisecond)
+		assert.Equal(t, 1, countReactivations(t, id))
+	})
+
+	t.Run("Active account returns ErrNotFound", func(t *testing.T) {
+		id := createAccount(t, "already-active@example.com", true)
+
+		_, err := repo.Reactivate(ctx, id, time.Now())
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+		assert.Equal(t, 0, countReactivations(t, id))
+	})
+}
+
 func TestAccountRepository_Integration_DeactivateByLastLoginBefore(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -272,9 +357,9 @@ func TestAccountRepository_Integration_DeactivateByLastLoginBefore(t *testing.T)
 
 		// Deactivate users who haven't logged in for 90 days
 		threshold := time.Now().AddDate(0, 0, -90)
-		count, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
+		ids, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
 		require.NoError(t, err)
-		assert.GreaterOrEqual(t, count, 1)
+		assert.Contains(t, ids, oldUserID)
 
 		// Verify old user is deactivated
 		assert.False(t, getIsActive(t, oldUserID))
@@ -291,14 +376,14 @@ func TestAccountRepository_Integration_DeactivateByLastLoginBefore(t *testing.T)
 		threshold := time.Now().AddDate(0, 0, -90)
 
 		// First deactivation
-		count1, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
+		ids1, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
 		require.NoError(t, err)
-		assert.GreaterOrEqual(t, count1, 1)
+		assert.NotEmpty(t, ids1)
 
 		// Second deactivation - should return 0 (already deactivated)
-		count2, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
+		ids2, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
 		require.NoError(t, err)
-		assert.Equal(t, 0, count2)
+		assert.Empty(t, ids2)
 	})
 
 	t.Run("Does not affect already inactive users", func(t *testing.T) {
@@ -317,9 +402,9 @@ func TestAccountRepository_Integration_DeactivateByLastLoginBefore(t *testing.T)
 	t.Run("Returns 0 when no users to deactivate", func(t *testing.T) {
 		// Use a threshold far in the past
 		threshold := time.Now().AddDate(-10, 0, 0) // 10 years ago
-		count, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
+		ids, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
 		require.NoError(t, err)
-		assert.Equal(t, 0, count)
+		assert.Empty(t, ids)
 	})
 
 	t.Run("Deactivates multiple users at once", func(t *testing.T) {
@@ -330,9 +415,9 @@ func TestAccountRepository_Integration_DeactivateByLastLoginBefore(t *testing.T)
 		id3 := createAccountWithLastLogin(t, "batch-3@example.com", oldLogin, true)
 
 		threshold := time.Now().AddDate(0, 0, -90)
-		count, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
+		ids, err := repo.DeactivateByLastLoginBefore(ctx, threshold)
 		require.NoError(t, err)
-		assert.GreaterOrEqual(t, count, 3)
+		assert.Subset(t, ids, []string{id1, id2, id3})
 
 		// All should be deactivated
 		assert.False(t, getIsActive(t, id1))
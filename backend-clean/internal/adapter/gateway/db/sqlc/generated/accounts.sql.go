@@ -11,6 +11,34 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deactivateAccountsByLastLoginBefore = `-- name: DeactivateAccountsByLastLoginBefore :many
+UPDATE accounts
+SET is_active = false, updated_at = NOW()
+WHERE last_login_at < $1
+  AND is_active = true
+RETURNING id
+`
+
+func (q *Queries) DeactivateAccountsByLastLoginBefore(ctx context.Context, lastLoginAt pgtype.Timestamptz) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, deactivateAccountsByLastLoginBefore, lastLoginAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAccountByEmail = `-- name: GetAccountByEmail :one
 SELECT id, email, first_name, last_name, is_active, provider, provider_account_id, thumbnail, last_login_at, created_at, updated_at
 FROM accounts
@@ -92,6 +120,51 @@ func (q *Queries) GetAccountByProvider(ctx context.Context, arg *GetAccountByPro
 	return &i, err
 }
 
+const reactivateAccount = `-- name: ReactivateAccount :one
+WITH target AS (
+    SELECT id, last_login_at
+    FROM accounts
+    WHERE accounts.id = $2
+      AND is_active = false
+    FOR UPDATE
+), recorded AS (
+    INSERT INTO account_reactivations (account_id, previous_last_login_at, reactivated_at)
+    SELECT id, last_login_at, $1
+    FROM target
+)
+UPDATE accounts
+SET is_active = true,
+    last_login_at = $1,
+    updated_at = NOW()
+FROM target
+WHERE accounts.id = target.id
+RETURNING accounts.id, accounts.email, accounts.first_name, accounts.last_name, accounts.is_active, accounts.provider, accounts.provider_account_id, accounts.thumbnail, accounts.last_login_at, accounts.created_at, accounts.updated_at
+`
+
+type ReactivateAccountParams struct {
+	ReactivatedAt pgtype.Timestamptz `db:"reactivated_at" json:"reactivated_at"`
+	ID            pgtype.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) ReactivateAccount(ctx context.Context, arg *ReactivateAccountParams) (*Account, error) {
+	row := q.db.QueryRow(ctx, reactivateAccount, arg.ReactivatedAt, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.IsActive,
+		&i.Provider,
+		&i.ProviderAccountID,
+		&i.Thumbnail,
+		&i.LastLoginAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const upsertAccount = `-- name: UpsertAccount :one
 INSERT INTO accounts (
     email,
@@ -109,7 +182,11 @@ DO UPDATE SET
     first_name = EXCLUDED.first_name,
     last_name = EXCLUDED.last_name,
     thumbnail = EXCLUDED.thumbnail,
-    last_login_at = EXCLUDED.last_login_at,
+    -- A refused login of a deactivated account is not a login.
+    last_login_at = CASE
+        WHEN accounts.is_active THEN EXCLUDED.last_login_at
+        ELSE accounts.last_login_at
+    END,
     updated_at = NOW()
 RETURNING id, email, first_name, last_name, is_active, provider, provider_account_id, thumbnail, last_login_at, created_at, updated_at
 `
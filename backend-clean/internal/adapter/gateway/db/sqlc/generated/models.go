@@ -22,22 +22,110 @@ type Account struct {
 	UpdatedAt         pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type AccountReactivation struct {
+	ID                  pgtype.UUID        `db:"id" json:"id"`
+	AccountID           pgtype.UUID        `db:"account_id" json:"account_id"`
+	PreviousLastLoginAt pgtype.Timestamptz `db:"previous_last_login_at" json:"previous_last_login_at"`
+	ReactivatedAt       pgtype.Timestamptz `db:"reactivated_at" json:"reactivated_at"`
+}
+
 type Field struct {
-	ID         pgtype.UUID `db:"id" json:"id"`
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
+	ID             pgtype.UUID `db:"id" json:"id"`
+	TemplateID     pgtype.UUID `db:"template_id" json:"template_id"`
+	Label          string      `db:"label" json:"label"`
+	Order          int32       `db:"order" json:"order"`
+	IsRequired     bool        `db:"is_required" json:"is_required"`
+	SchemaVersion  int32       `db:"schema_version" json:"schema_version"`
+	Key            pgtype.UUID `db:"key" json:"key"`
+	Type           string      `db:"type" json:"type"`
+	Options        []string    `db:"options" json:"options"`
+	MinLength      int32       `db:"min_length" json:"min_length"`
+	MaxLength      int32       `db:"max_length" json:"max_length"`
+	Pattern        string      `db:"pattern" json:"pattern"`
+	Placeholder    string      `db:"placeholder" json:"placeholder"`
+	DefaultContent string      `db:"default_content" json:"default_content"`
 }
 
 type Note struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	Title         string             `db:"title" json:"title"`
+	TemplateID    pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID       pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status        string             `db:"status" json:"status"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version       int32              `db:"version" json:"version"`
+	SchemaVersion int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt     pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	PublishAt     pgtype.Timestamptz `db:"publish_at" json:"publish_at"`
+	UnpublishAt   pgtype.Timestamptz `db:"unpublish_at" json:"unpublish_at"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+}
+
+type NoteReview struct {
 	ID         pgtype.UUID        `db:"id" json:"id"`
-	Title      string             `db:"title" json:"title"`
-	TemplateID pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID    pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status     string             `db:"status" json:"status"`
+	NoteID     pgtype.UUID        `db:"note_id" json:"note_id"`
+	ReviewerID pgtype.UUID        `db:"reviewer_id" json:"reviewer_id"`
+	Decision   string             `db:"decision" json:"decision"`
+	Comment    string             `db:"comment" json:"comment"`
 	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type NoteReviewer struct {
+	NoteID     pgtype.UUID        `db:"note_id" json:"note_id"`
+	ReviewerID pgtype.UUID        `db:"reviewer_id" json:"reviewer_id"`
+	AssignedAt pgtype.Timestamptz `db:"assigned_at" json:"assigned_at"`
+}
+
+type NoteRevision struct {
+	ID        pgtype.UUID        `db:"id" json:"id"`
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	Revision  int32              `db:"revision" json:"revision"`
+	Title     string             `db:"title" json:"title"`
+	Sections  []byte             `db:"sections" json:"sections"`
+	ActorID   pgtype.UUID        `db:"actor_id" json:"actor_id"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type NoteSearchDocument struct {
+	NoteID   pgtype.UUID `db:"note_id" json:"note_id"`
+	Document interface{} `db:"document" json:"document"`
+}
+
+type NoteShare struct {
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	AccountID pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role      string             `db:"role" json:"role"`
+	GrantedBy pgtype.UUID        `db:"granted_by" json:"granted_by"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type NoteShareLink struct {
+	ID        pgtype.UUID        `db:"id" json:"id"`
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	TokenHash string             `db:"token_hash" json:"token_hash"`
+	CreatedBy pgtype.UUID        `db:"created_by" json:"created_by"`
+	ExpiresAt pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	RevokedAt pgtype.Timestamptz `db:"revoked_at" json:"revoked_at"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type Outbox struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	EventType     string             `db:"event_type" json:"event_type"`
+	AggregateType string             `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string             `db:"aggregate_id" json:"aggregate_id"`
+	Payload       []byte             `db:"payload" json:"payload"`
+	OccurredAt    pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+	Attempts      int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastError     pgtype.Text        `db:"last_error" json:"last_error"`
+	DeliveredAt   pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+	FailedAt      pgtype.Timestamptz `db:"failed_at" json:"failed_at"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	TxID          int64              `db:"tx_id" json:"tx_id"`
+	Seq           int64              `db:"seq" json:"seq"`
 }
 
 type Section struct {
@@ -47,9 +135,85 @@ type Section struct {
 	Content string      `db:"content" json:"content"`
 }
 
+type Session struct {
+	ID               pgtype.UUID        `db:"id" json:"id"`
+	AccountID        pgtype.UUID        `db:"account_id" json:"account_id"`
+	RefreshTokenHash string             `db:"refresh_token_hash" json:"refresh_token_hash"`
+	ExpiresAt        pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	RevokedAt        pgtype.Timestamptz `db:"revoked_at" json:"revoked_at"`
+	CreatedAt        pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
 type Template struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	Name          string             `db:"name" json:"name"`
+	OwnerID       pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version       int32              `db:"version" json:"version"`
+	SchemaVersion int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt     pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	IsPublic      bool               `db:"is_public" json:"is_public"`
+}
+
+type TemplateShare struct {
+	TemplateID pgtype.UUID        `db:"template_id" json:"template_id"`
+	AccountID  pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role       string             `db:"role" json:"role"`
+	GrantedBy  pgtype.UUID        `db:"granted_by" json:"granted_by"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	SubscriptionID pgtype.UUID        `db:"subscription_id" json:"subscription_id"`
+	EventID        pgtype.UUID        `db:"event_id" json:"event_id"`
+	EventType      string             `db:"event_type" json:"event_type"`
+	Payload        []byte             `db:"payload" json:"payload"`
+	Status         string             `db:"status" json:"status"`
+	Attempts       int32              `db:"attempts" json:"attempts"`
+	ResponseStatus pgtype.Int4        `db:"response_status" json:"response_status"`
+	LastError      pgtype.Text        `db:"last_error" json:"last_error"`
+	NextAttemptAt  pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+	FailedAt       pgtype.Timestamptz `db:"failed_at" json:"failed_at"`
+	ReplayOf       pgtype.UUID        `db:"replay_of" json:"replay_of"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	OwnerID    pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Url        string             `db:"url" json:"url"`
+	Secret     string             `db:"secret" json:"secret"`
+	EventTypes []string           `db:"event_types" json:"event_types"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type Workspace struct {
 	ID        pgtype.UUID        `db:"id" json:"id"`
 	Name      string             `db:"name" json:"name"`
 	OwnerID   pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Personal  bool               `db:"personal" json:"personal"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
+
+type WorkspaceInvitation struct {
+	ID          pgtype.UUID        `db:"id" json:"id"`
+	WorkspaceID pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	Email       string             `db:"email" json:"email"`
+	Role        string             `db:"role" json:"role"`
+	InvitedBy   pgtype.UUID        `db:"invited_by" json:"invited_by"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	AcceptedAt  pgtype.Timestamptz `db:"accepted_at" json:"accepted_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	AccountID   pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role        string             `db:"role" json:"role"`
+	JoinedAt    pgtype.Timestamptz `db:"joined_at" json:"joined_at"`
+}
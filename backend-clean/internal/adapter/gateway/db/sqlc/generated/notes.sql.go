@@ -12,16 +12,18 @@ import (
 )
 
 const createNote = `-- name: CreateNote :one
-INSERT INTO notes (title, template_id, owner_id, status)
-VALUES ($1, $2, $3, $4)
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+INSERT INTO notes (title, template_id, owner_id, status, schema_version, workspace_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
 `
 
 type CreateNoteParams struct {
-	Title      string      `db:"title" json:"title"`
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	OwnerID    pgtype.UUID `db:"owner_id" json:"owner_id"`
-	Status     string      `db:"status" json:"status"`
+	Title         string      `db:"title" json:"title"`
+	TemplateID    pgtype.UUID `db:"template_id" json:"template_id"`
+	OwnerID       pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Status        string      `db:"status" json:"status"`
+	SchemaVersion int32       `db:"schema_version" json:"schema_version"`
+	WorkspaceID   pgtype.UUID `db:"workspace_id" json:"workspace_id"`
 }
 
 func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note, error) {
@@ -30,6 +32,8 @@ func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note,
 		arg.TemplateID,
 		arg.OwnerID,
 		arg.Status,
+		arg.SchemaVersion,
+		arg.WorkspaceID,
 	)
 	var i Note
 	err := row.Scan(
@@ -40,6 +44,53 @@ func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
+	)
+	return &i, err
+}
+
+const createNoteRevision = `-- name: CreateNoteRevision :one
+INSERT INTO note_revisions (note_id, revision, title, sections, actor_id)
+SELECT
+    $1::uuid,
+    COALESCE(MAX(r.revision), 0) + 1,
+    $2::text,
+    $3::jsonb,
+    $4::uuid
+FROM note_revisions r
+WHERE r.note_id = $1::uuid
+RETURNING id, note_id, revision, title, sections, actor_id, created_at
+`
+
+type CreateNoteRevisionParams struct {
+	NoteID   pgtype.UUID `db:"note_id" json:"note_id"`
+	Title    string      `db:"title" json:"title"`
+	Sections []byte      `db:"sections" json:"sections"`
+	ActorID  pgtype.UUID `db:"actor_id" json:"actor_id"`
+}
+
+// Callers hold the note row lock (UpdateNote) or own a fresh note, so MAX()+1 does not race.
+func (q *Queries) CreateNoteRevision(ctx context.Context, arg *CreateNoteRevisionParams) (*NoteRevision, error) {
+	row := q.db.QueryRow(ctx, createNoteRevision,
+		arg.NoteID,
+		arg.Title,
+		arg.Sections,
+		arg.ActorID,
+	)
+	var i NoteRevision
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.Revision,
+		&i.Title,
+		&i.Sections,
+		&i.ActorID,
+		&i.CreatedAt,
 	)
 	return &i, err
 }
@@ -68,51 +119,64 @@ func (q *Queries) CreateSection(ctx context.Context, arg *CreateSectionParams) (
 	return &i, err
 }
 
-const deleteNote = `-- name: DeleteNote :exec
-DELETE FROM notes
-WHERE id = $1
+const deleteSectionsByNote = `-- name: DeleteSectionsByNote :exec
+DELETE FROM sections
+WHERE note_id = $1
 `
 
-func (q *Queries) DeleteNote(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteNote, id)
+func (q *Queries) DeleteSectionsByNote(ctx context.Context, noteID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSectionsByNote, noteID)
 	return err
 }
 
-const deleteSectionsByNote = `-- name: DeleteSectionsByNote :exec
+const deleteSectionsExcept = `-- name: DeleteSectionsExcept :exec
 DELETE FROM sections
-WHERE note_id = $1
+WHERE note_id = $1 AND NOT (id = ANY($2::uuid[]))
 `
 
-func (q *Queries) DeleteSectionsByNote(ctx context.Context, noteID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteSectionsByNote, noteID)
+type DeleteSectionsExceptParams struct {
+	NoteID  pgtype.UUID   `db:"note_id" json:"note_id"`
+	KeepIds []pgtype.UUID `db:"keep_ids" json:"keep_ids"`
+}
+
+func (q *Queries) DeleteSectionsExcept(ctx context.Context, arg *DeleteSectionsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteSectionsExcept, arg.NoteID, arg.KeepIds)
 	return err
 }
 
 const getNoteByID = `-- name: GetNoteByID :one
 SELECT
-    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at,
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.schema_version, n.deleted_at, n.publish_at, n.unpublish_at, n.workspace_id,
     t.name AS template_name,
     a.first_name,
     a.last_name,
-    a.thumbnail AS owner_thumbnail
+    a.thumbnail AS owner_thumbnail,
+    t.schema_version AS latest_schema_version
 FROM notes n
 JOIN templates t ON t.id = n.template_id
 JOIN accounts a ON a.id = n.owner_id
-WHERE n.id = $1
+WHERE n.id = $1 AND n.deleted_at IS NULL
 `
 
 type GetNoteByIDRow struct {
-	ID             pgtype.UUID        `db:"id" json:"id"`
-	Title          string             `db:"title" json:"title"`
-	TemplateID     pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status         string             `db:"status" json:"status"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	TemplateName   string             `db:"template_name" json:"template_name"`
-	FirstName      string             `db:"first_name" json:"first_name"`
-	LastName       string             `db:"last_name" json:"last_name"`
-	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	ID                  pgtype.UUID        `db:"id" json:"id"`
+	Title               string             `db:"title" json:"title"`
+	TemplateID          pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID             pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status              string             `db:"status" json:"status"`
+	CreatedAt           pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version             int32              `db:"version" json:"version"`
+	SchemaVersion       int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt           pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	PublishAt           pgtype.Timestamptz `db:"publish_at" json:"publish_at"`
+	UnpublishAt         pgtype.Timestamptz `db:"unpublish_at" json:"unpublish_at"`
+	WorkspaceID         pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName        string             `db:"template_name" json:"template_name"`
+	FirstName           string             `db:"first_name" json:"first_name"`
+	LastName            string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail      pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	LatestSchemaVersion int32              `db:"latest_schema_version" json:"latest_schema_version"`
 }
 
 func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByIDRow, error) {
@@ -126,58 +190,270 @@ func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByID
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
 		&i.TemplateName,
 		&i.FirstName,
 		&i.LastName,
 		&i.OwnerThumbnail,
+		&i.LatestSchemaVersion,
 	)
 	return &i, err
 }
 
+const getNoteRevision = `-- name: GetNoteRevision :one
+SELECT id, note_id, revision, title, sections, actor_id, created_at
+FROM note_revisions
+WHERE note_id = $1 AND revision = $2
+`
+
+type GetNoteRevisionParams struct {
+	NoteID   pgtype.UUID `db:"note_id" json:"note_id"`
+	Revision int32       `db:"revision" json:"revision"`
+}
+
+func (q *Queries) GetNoteRevision(ctx context.Context, arg *GetNoteRevisionParams) (*NoteRevision, error) {
+	row := q.db.QueryRow(ctx, getNoteRevision, arg.NoteID, arg.Revision)
+	var i NoteRevision
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.Revision,
+		&i.Title,
+		&i.Sections,
+		&i.ActorID,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getTrashedNoteByID = `-- name: GetTrashedNoteByID :one
+SELECT
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.schema_version, n.deleted_at, n.publish_at, n.unpublish_at, n.workspace_id,
+    t.name AS template_name
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+WHERE n.id = $1 AND n.deleted_at IS NOT NULL
+`
+
+type GetTrashedNoteByIDRow struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	Title         string             `db:"title" json:"title"`
+	TemplateID    pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID       pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status        string             `db:"status" json:"status"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version       int32              `db:"version" json:"version"`
+	SchemaVersion int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt     pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	PublishAt     pgtype.Timestamptz `db:"publish_at" json:"publish_at"`
+	UnpublishAt   pgtype.Timestamptz `db:"unpublish_at" json:"unpublish_at"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName  string             `db:"template_name" json:"template_name"`
+}
+
+func (q *Queries) GetTrashedNoteByID(ctx context.Context, id pgtype.UUID) (*GetTrashedNoteByIDRow, error) {
+	row := q.db.QueryRow(ctx, getTrashedNoteByID, id)
+	var i GetTrashedNoteByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
+		&i.TemplateName,
+	)
+	return &i, err
+}
+
+const listNoteRevisions = `-- name: ListNoteRevisions :many
+SELECT id, note_id, revision, title, sections, actor_id, created_at
+FROM note_revisions
+WHERE note_id = $1
+ORDER BY revision DESC
+`
+
+func (q *Queries) ListNoteRevisions(ctx context.Context, noteID pgtype.UUID) ([]*NoteRevision, error) {
+	rows, err := q.db.Query(ctx, listNoteRevisions, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteRevision
+	for rows.Next() {
+		var i NoteRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.Revision,
+			&i.Title,
+			&i.Sections,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listNotes = `-- name: ListNotes :many
+WITH matched AS (
+    SELECT
+        n.id,
+        n.title,
+        n.template_id,
+        n.owner_id,
+        n.status,
+        n.created_at,
+        n.updated_at,
+        n.version,
+        n.schema_version,
+        n.publish_at,
+        n.unpublish_at,
+        n.workspace_id,
+        (CASE
+            WHEN NULLIF($1::text, '') IS NULL THEN 0
+            ELSE ts_rank(d.document, websearch_to_tsquery('simple', $1::text))
+        END)::real AS rank
+    FROM notes n
+    LEFT JOIN note_search_documents d ON d.note_id = n.id
+    WHERE n.deleted_at IS NULL
+      AND (NULLIF($2::text, '') IS NULL OR n.status = $2)
+      AND ($3::uuid IS NULL OR n.template_id = $3)
+      AND ($4::uuid IS NULL OR n.owner_id = $4)
+      AND ($5::uuid IS NULL OR n.workspace_id = $5)
+      AND (
+          $6::uuid IS NULL
+          OR EXISTS (SELECT 1 FROM workspace_members m WHERE m.workspace_id = n.workspace_id AND m.account_id = $6)
+      )
+      AND (
+          $7::uuid IS NULL
+          OR EXISTS (SELECT 1 FROM note_shares ns WHERE ns.note_id = n.id AND ns.account_id = $7)
+      )
+      AND (
+          NULLIF($1::text, '') IS NULL
+          OR d.document @@ websearch_to_tsquery('simple', $1::text)
+      )
+), page AS (
+    SELECT m.id, m.title, m.template_id, m.owner_id, m.status, m.created_at, m.updated_at, m.version, m.schema_version, m.publish_at, m.unpublish_at, m.workspace_id, m.rank
+    FROM matched m
+    WHERE $8::timestamptz IS NULL
+       OR (m.rank, m.updated_at, m.id) < ($9::real, $8::timestamptz, $10::uuid)
+    ORDER BY m.rank DESC, m.updated_at DESC, m.id DESC
+    LIMIT $11
+)
 SELECT
-    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at,
+    p.id,
+    p.title,
+    p.template_id,
+    p.owner_id,
+    p.status,
+    p.created_at,
+    p.updated_at,
+    p.version,
+    p.schema_version,
+    p.publish_at,
+    p.unpublish_at,
+    p.workspace_id,
+    p.rank,
     t.name AS template_name,
+    t.schema_version AS latest_schema_version,
     a.first_name,
     a.last_name,
-    a.thumbnail AS owner_thumbnail
-FROM notes n
-JOIN templates t ON t.id = n.template_id
-JOIN accounts a ON a.id = n.owner_id
-WHERE (NULLIF($1::text, '') IS NULL OR n.status = $1)
-  AND ($2::uuid IS NULL OR n.template_id = $2)
-  AND ($3::uuid IS NULL OR n.owner_id = $3)
-  AND (NULLIF($4::text, '') IS NULL OR n.title ILIKE '%' || $4 || '%')
-ORDER BY n.updated_at DESC
+    a.thumbnail AS owner_thumbnail,
+    (CASE
+        WHEN NULLIF($1::text, '') IS NULL THEN ''
+        ELSE ts_headline(
+            'simple',
+            translate(
+                p.title || ' ' || COALESCE((
+                    SELECT string_agg(s.content, ' ' ORDER BY f."order")
+                    FROM sections s
+                    JOIN fields f ON f.id = s.field_id
+                    WHERE s.note_id = p.id
+                ), ''),
+                chr(1) || chr(2),
+                ''
+            ),
+            websearch_to_tsquery('simple', $1::text),
+            'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', MaxFragments=2, MaxWords=20, MinWords=5'
+        )
+    END)::text AS snippet
+FROM page p
+JOIN templates t ON t.id = p.template_id
+JOIN accounts a ON a.id = p.owner_id
+ORDER BY p.rank DESC, p.updated_at DESC, p.id DESC
 `
 
 type ListNotesParams struct {
-	Column1 string      `db:"column_1" json:"column_1"`
-	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
-	Column3 pgtype.UUID `db:"column_3" json:"column_3"`
-	Column4 string      `db:"column_4" json:"column_4"`
+	Query           string             `db:"query" json:"query"`
+	Status          string             `db:"status" json:"status"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	MemberID        pgtype.UUID        `db:"member_id" json:"member_id"`
+	SharedWith      pgtype.UUID        `db:"shared_with" json:"shared_with"`
+	CursorUpdatedAt pgtype.Timestamptz `db:"cursor_updated_at" json:"cursor_updated_at"`
+	CursorRank      float32            `db:"cursor_rank" json:"cursor_rank"`
+	CursorID        pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32              `db:"page_limit" json:"page_limit"`
 }
 
 type ListNotesRow struct {
-	ID             pgtype.UUID        `db:"id" json:"id"`
-	Title          string             `db:"title" json:"title"`
-	TemplateID     pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status         string             `db:"status" json:"status"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	TemplateName   string             `db:"template_name" json:"template_name"`
-	FirstName      string             `db:"first_name" json:"first_name"`
-	LastName       string             `db:"last_name" json:"last_name"`
-	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	ID                  pgtype.UUID        `db:"id" json:"id"`
+	Title               string             `db:"title" json:"title"`
+	TemplateID          pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID             pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status              string             `db:"status" json:"status"`
+	CreatedAt           pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version             int32              `db:"version" json:"version"`
+	SchemaVersion       int32              `db:"schema_version" json:"schema_version"`
+	PublishAt           pgtype.Timestamptz `db:"publish_at" json:"publish_at"`
+	UnpublishAt         pgtype.Timestamptz `db:"unpublish_at" json:"unpublish_at"`
+	WorkspaceID         pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	Rank                float32            `db:"rank" json:"rank"`
+	TemplateName        string             `db:"template_name" json:"template_name"`
+	LatestSchemaVersion int32              `db:"latest_schema_version" json:"latest_schema_version"`
+	FirstName           string             `db:"first_name" json:"first_name"`
+	LastName            string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail      pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	Snippet             string             `db:"snippet" json:"snippet"`
 }
 
+// Without a query every rank is 0, so the order degrades to (updated_at DESC, id DESC).
+// The snippet marks matches with chr(1)...chr(2); the repository escapes it and turns them into <mark>.
 func (q *Queries) ListNotes(ctx context.Context, arg *ListNotesParams) ([]*ListNotesRow, error) {
 	rows, err := q.db.Query(ctx, listNotes,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
+		arg.Query,
+		arg.Status,
+		arg.TemplateID,
+		arg.OwnerID,
+		arg.WorkspaceID,
+		arg.MemberID,
+		arg.SharedWith,
+		arg.CursorUpdatedAt,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.PageLimit,
 	)
 	if err != nil {
 		return nil, err
@@ -194,10 +470,18 @@ func (q *Queries) ListNotes(ctx context.Context, arg *ListNotesParams) ([]*ListN
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.SchemaVersion,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.WorkspaceID,
+			&i.Rank,
 			&i.TemplateName,
+			&i.LatestSchemaVersion,
 			&i.FirstName,
 			&i.LastName,
 			&i.OwnerThumbnail,
+			&i.Snippet,
 		); err != nil {
 			return nil, err
 		}
@@ -214,7 +498,10 @@ SELECT
     s.id, s.note_id, s.field_id, s.content,
     f.label,
     f."order",
-    f.is_required
+    f.is_required,
+    f.key AS field_key,
+    f.type AS field_type,
+    f.options AS field_options
 FROM sections s
 JOIN fields f ON f.id = s.field_id
 WHERE s.note_id = $1
@@ -222,13 +509,16 @@ ORDER BY f."order" ASC
 `
 
 type ListSectionsByNoteRow struct {
-	ID         pgtype.UUID `db:"id" json:"id"`
-	NoteID     pgtype.UUID `db:"note_id" json:"note_id"`
-	FieldID    pgtype.UUID `db:"field_id" json:"field_id"`
-	Content    string      `db:"content" json:"content"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
+	ID           pgtype.UUID `db:"id" json:"id"`
+	NoteID       pgtype.UUID `db:"note_id" json:"note_id"`
+	FieldID      pgtype.UUID `db:"field_id" json:"field_id"`
+	Content      string      `db:"content" json:"content"`
+	Label        string      `db:"label" json:"label"`
+	Order        int32       `db:"order" json:"order"`
+	IsRequired   bool        `db:"is_required" json:"is_required"`
+	FieldKey     pgtype.UUID `db:"field_key" json:"field_key"`
+	FieldType    string      `db:"field_type" json:"field_type"`
+	FieldOptions []string    `db:"field_options" json:"field_options"`
 }
 
 func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([]*ListSectionsByNoteRow, error) {
@@ -248,6 +538,250 @@ func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([
 			&i.Label,
 			&i.Order,
 			&i.IsRequired,
+			&i.FieldKey,
+			&i.FieldType,
+			&i.FieldOptions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedNotes = `-- name: ListTrashedNotes :many
+SELECT
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.schema_version, n.deleted_at, n.publish_at, n.unpublish_at, n.workspace_id,
+    t.name AS template_name
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+WHERE n.owner_id = $1 AND n.deleted_at IS NOT NULL
+ORDER BY n.deleted_at DESC, n.id DESC
+`
+
+type ListTrashedNotesRow struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	Title         string             `db:"title" json:"title"`
+	TemplateID    pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID       pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status        string             `db:"status" json:"status"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version       int32              `db:"version" json:"version"`
+	SchemaVersion int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt     pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	PublishAt     pgtype.Timestamptz `db:"publish_at" json:"publish_at"`
+	UnpublishAt   pgtype.Timestamptz `db:"unpublish_at" json:"unpublish_at"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName  string             `db:"template_name" json:"template_name"`
+}
+
+func (q *Queries) ListTrashedNotes(ctx context.Context, ownerID pgtype.UUID) ([]*ListTrashedNotesRow, error) {
+	rows, err := q.db.Query(ctx, listTrashedNotes, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTrashedNotesRow
+	for rows.Next() {
+		var i ListTrashedNotesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OwnerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.SchemaVersion,
+			&i.DeletedAt,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.WorkspaceID,
+			&i.TemplateName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishDueNotes = `-- name: PublishDueNotes :many
+UPDATE notes
+SET
+    status = 'Publish',
+    publish_at = NULL,
+    version = version + 1,
+    updated_at = NOW()
+WHERE status = 'Scheduled' AND publish_at <= $1::timestamptz AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
+`
+
+// Published rows no longer match, so running the job again publishes nothing twice.
+func (q *Queries) PublishDueNotes(ctx context.Context, now pgtype.Timestamptz) ([]*Note, error) {
+	rows, err := q.db.Query(ctx, publishDueNotes, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Note
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OwnerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.SchemaVersion,
+			&i.DeletedAt,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.WorkspaceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeNotesDeletedBefore = `-- name: PurgeNotesDeletedBefore :execrows
+DELETE FROM notes
+WHERE deleted_at < $1
+`
+
+// Sections, revisions and the search document cascade away with the note.
+func (q *Queries) PurgeNotesDeletedBefore(ctx context.Context, deletedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeNotesDeletedBefore, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const refreshNoteSearchDocument = `-- name: RefreshNoteSearchDocument :exec
+INSERT INTO note_search_documents (note_id, document)
+SELECT
+    n.id,
+    setweight(to_tsvector('simple', n.title), 'A')
+        || setweight(to_tsvector('simple', COALESCE(string_agg(s.content, ' '), '')), 'B')
+FROM notes n
+LEFT JOIN sections s ON s.note_id = n.id
+WHERE n.id = $1
+GROUP BY n.id, n.title
+ON CONFLICT (note_id) DO UPDATE SET document = EXCLUDED.document
+`
+
+func (q *Queries) RefreshNoteSearchDocument(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, refreshNoteSearchDocument, id)
+	return err
+}
+
+const restoreNote = `-- name: RestoreNote :one
+UPDATE notes
+SET
+    deleted_at = NULL,
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NOT NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
+`
+
+type RestoreNoteParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Version int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) RestoreNote(ctx context.Context, arg *RestoreNoteParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, restoreNote, arg.ID, arg.Version)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
+	)
+	return &i, err
+}
+
+const trashNote = `-- name: TrashNote :execrows
+UPDATE notes
+SET
+    deleted_at = NOW(),
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+`
+
+type TrashNoteParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Version int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) TrashNote(ctx context.Context, arg *TrashNoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, trashNote, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unpublishDueNotes = `-- name: UnpublishDueNotes :many
+UPDATE notes
+SET
+    status = 'Draft',
+    unpublish_at = NULL,
+    version = version + 1,
+    updated_at = NOW()
+WHERE status = 'Publish' AND unpublish_at <= $1::timestamptz AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
+`
+
+func (q *Queries) UnpublishDueNotes(ctx context.Context, now pgtype.Timestamptz) ([]*Note, error) {
+	rows, err := q.db.Query(ctx, unpublishDueNotes, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Note
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OwnerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.SchemaVersion,
+			&i.DeletedAt,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.WorkspaceID,
 		); err != nil {
 			return nil, err
 		}
@@ -263,18 +797,58 @@ const updateNote = `-- name: UpdateNote :one
 UPDATE notes
 SET
     title = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
 `
 
 type UpdateNoteParams struct {
-	ID    pgtype.UUID `db:"id" json:"id"`
-	Title string      `db:"title" json:"title"`
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Title   string      `db:"title" json:"title"`
+	Version int32       `db:"version" json:"version"`
 }
 
+// Matches no row when the note moved past the caller's version (or was deleted).
 func (q *Queries) UpdateNote(ctx context.Context, arg *UpdateNoteParams) (*Note, error) {
-	row := q.db.QueryRow(ctx, updateNote, arg.ID, arg.Title)
+	row := q.db.QueryRow(ctx, updateNote, arg.ID, arg.Title, arg.Version)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
+	)
+	return &i, err
+}
+
+const updateNoteSchemaVersion = `-- name: UpdateNoteSchemaVersion :one
+UPDATE notes
+SET
+    schema_version = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
+`
+
+type UpdateNoteSchemaVersionParams struct {
+	ID            pgtype.UUID `db:"id" json:"id"`
+	SchemaVersion int32       `db:"schema_version" json:"schema_version"`
+	Version       int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) UpdateNoteSchemaVersion(ctx context.Context, arg *UpdateNoteSchemaVersionParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, updateNoteSchemaVersion, arg.ID, arg.SchemaVersion, arg.Version)
 	var i Note
 	err := row.Scan(
 		&i.ID,
@@ -284,6 +858,12 @@ func (q *Queries) UpdateNote(ctx context.Context, arg *UpdateNoteParams) (*Note,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
@@ -292,18 +872,31 @@ const updateNoteStatus = `-- name: UpdateNoteStatus :one
 UPDATE notes
 SET
     status = $2,
+    publish_at = $4,
+    unpublish_at = $5,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, schema_version, deleted_at, publish_at, unpublish_at, workspace_id
 `
 
 type UpdateNoteStatusParams struct {
-	ID     pgtype.UUID `db:"id" json:"id"`
-	Status string      `db:"status" json:"status"`
+	ID          pgtype.UUID        `db:"id" json:"id"`
+	Status      string             `db:"status" json:"status"`
+	Version     int32              `db:"version" json:"version"`
+	PublishAt   pgtype.Timestamptz `db:"publish_at" json:"publish_at"`
+	UnpublishAt pgtype.Timestamptz `db:"unpublish_at" json:"unpublish_at"`
 }
 
+// The schedule is replaced together with the status, so leaving Scheduled clears publish_at.
 func (q *Queries) UpdateNoteStatus(ctx context.Context, arg *UpdateNoteStatusParams) (*Note, error) {
-	row := q.db.QueryRow(ctx, updateNoteStatus, arg.ID, arg.Status)
+	row := q.db.QueryRow(ctx, updateNoteStatus,
+		arg.ID,
+		arg.Status,
+		arg.Version,
+		arg.PublishAt,
+		arg.UnpublishAt,
+	)
 	var i Note
 	err := row.Scan(
 		&i.ID,
@@ -313,24 +906,34 @@ func (q *Queries) UpdateNoteStatus(ctx context.Context, arg *UpdateNoteStatusPar
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
 
-const updateSectionContent = `-- name: UpdateSectionContent :one
+const updateSection = `-- name: UpdateSection :one
 UPDATE sections
-SET content = $2
+SET
+    field_id = $2,
+    content = $3
 WHERE id = $1
 RETURNING id, note_id, field_id, content
 `
 
-type UpdateSectionContentParams struct {
+type UpdateSectionParams struct {
 	ID      pgtype.UUID `db:"id" json:"id"`
+	FieldID pgtype.UUID `db:"field_id" json:"field_id"`
 	Content string      `db:"content" json:"content"`
 }
 
-func (q *Queries) UpdateSectionContent(ctx context.Context, arg *UpdateSectionContentParams) (*Section, error) {
-	row := q.db.QueryRow(ctx, updateSectionContent, arg.ID, arg.Content)
+// field_id changes only when a note moves to another schema version of its template.
+func (q *Queries) UpdateSection(ctx context.Context, arg *UpdateSectionParams) (*Section, error) {
+	row := q.db.QueryRow(ctx, updateSection, arg.ID, arg.FieldID, arg.Content)
 	var i Section
 	err := row.Scan(
 		&i.ID,
@@ -13,7 +13,7 @@ import (
 
 const checkTemplateInUse = `-- name: CheckTemplateInUse :one
 SELECT EXISTS (
-    SELECT 1 FROM notes WHERE template_id = $1
+    SELECT 1 FROM notes WHERE template_id = $1 AND deleted_at IS NULL
 ) AS is_used
 `
 
@@ -25,24 +25,60 @@ func (q *Queries) CheckTemplateInUse(ctx context.Context, templateID pgtype.UUID
 }
 
 const createField = `-- name: CreateField :one
-INSERT INTO fields (template_id, label, "order", is_required)
-VALUES ($1, $2, $3, $4)
-RETURNING id, template_id, label, "order", is_required
+INSERT INTO fields (
+    template_id, schema_version, key, label, "order", is_required, type, options,
+    min_length, max_length, pattern, placeholder, default_content
+)
+VALUES (
+    $1,
+    $2,
+    COALESCE($3::uuid, gen_random_uuid()),
+    $4,
+    $5,
+    $6,
+    $7,
+    $8::text[],
+    $9,
+    $10,
+    $11,
+    $12,
+    $13
+)
+RETURNING id, template_id, label, "order", is_required, schema_version, key, type, options, min_length, max_length, pattern, placeholder, default_content
 `
 
 type CreateFieldParams struct {
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
+	TemplateID     pgtype.UUID `db:"template_id" json:"template_id"`
+	SchemaVersion  int32       `db:"schema_version" json:"schema_version"`
+	Key            pgtype.UUID `db:"key" json:"key"`
+	Label          string      `db:"label" json:"label"`
+	FieldOrder     int32       `db:"field_order" json:"field_order"`
+	IsRequired     bool        `db:"is_required" json:"is_required"`
+	Type           string      `db:"type" json:"type"`
+	Options        []string    `db:"options" json:"options"`
+	MinLength      int32       `db:"min_length" json:"min_length"`
+	MaxLength      int32       `db:"max_length" json:"max_length"`
+	Pattern        string      `db:"pattern" json:"pattern"`
+	Placeholder    string      `db:"placeholder" json:"placeholder"`
+	DefaultContent string      `db:"default_content" json:"default_content"`
 }
 
+// A NULL key starts a new field identity; passing the key of an earlier version's field continues it.
 func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Field, error) {
 	row := q.db.QueryRow(ctx, createField,
 		arg.TemplateID,
+		arg.SchemaVersion,
+		arg.Key,
 		arg.Label,
-		arg.Order,
+		arg.FieldOrder,
 		arg.IsRequired,
+		arg.Type,
+		arg.Options,
+		arg.MinLength,
+		arg.MaxLength,
+		arg.Pattern,
+		arg.Placeholder,
+		arg.DefaultContent,
 	)
 	var i Field
 	err := row.Scan(
@@ -51,29 +87,44 @@ func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Fie
 		&i.Label,
 		&i.Order,
 		&i.IsRequired,
+		&i.SchemaVersion,
+		&i.Key,
+		&i.Type,
+		&i.Options,
+		&i.MinLength,
+		&i.MaxLength,
+		&i.Pattern,
+		&i.Placeholder,
+		&i.DefaultContent,
 	)
 	return &i, err
 }
 
 const createTemplate = `-- name: CreateTemplate :one
-INSERT INTO templates (name, owner_id)
-VALUES ($1, $2)
-RETURNING id, name, owner_id, updated_at
+INSERT INTO templates (name, owner_id, workspace_id)
+VALUES ($1, $2, $3)
+RETURNING id, name, owner_id, updated_at, version, schema_version, deleted_at, workspace_id, is_public
 `
 
 type CreateTemplateParams struct {
-	Name    string      `db:"name" json:"name"`
-	OwnerID pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Name        string      `db:"name" json:"name"`
+	OwnerID     pgtype.UUID `db:"owner_id" json:"owner_id"`
+	WorkspaceID pgtype.UUID `db:"workspace_id" json:"workspace_id"`
 }
 
 func (q *Queries) CreateTemplate(ctx context.Context, arg *CreateTemplateParams) (*Template, error) {
-	row := q.db.QueryRow(ctx, createTemplate, arg.Name, arg.OwnerID)
+	row := q.db.QueryRow(ctx, createTemplate, arg.Name, arg.OwnerID, arg.WorkspaceID)
 	var i Template
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.IsPublic,
 	)
 	return &i, err
 }
@@ -88,41 +139,21 @@ func (q *Queries) DeleteField(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
-const deleteFieldsByTemplate = `-- name: DeleteFieldsByTemplate :exec
-DELETE FROM fields
-WHERE template_id = $1
-`
-
-func (q *Queries) DeleteFieldsByTemplate(ctx context.Context, templateID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteFieldsByTemplate, templateID)
-	return err
-}
-
-const deleteTemplate = `-- name: DeleteTemplate :exec
-DELETE FROM templates
-WHERE id = $1
-`
-
-func (q *Queries) DeleteTemplate(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteTemplate, id)
-	return err
-}
-
 const getTemplateByID = `-- name: GetTemplateByID :one
 SELECT
-    t.id, t.name, t.owner_id, t.updated_at,
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.schema_version, t.deleted_at, t.workspace_id, t.is_public,
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
         LIMIT 1
     ) AS is_used
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE t.id = $1
+WHERE t.id = $1 AND t.deleted_at IS NULL
 `
 
 type GetTemplateByIDRow struct {
@@ -130,6 +161,11 @@ type GetTemplateByIDRow struct {
 	Name           string             `db:"name" json:"name"`
 	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
 	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	SchemaVersion  int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt      pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID    pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	IsPublic       bool               `db:"is_public" json:"is_public"`
 	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
 	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
 	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
@@ -144,6 +180,11 @@ func (q *Queries) GetTemplateByID(ctx context.Context, id pgtype.UUID) (*GetTemp
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.IsPublic,
 		&i.OwnerFirstName,
 		&i.OwnerLastName,
 		&i.OwnerThumbnail,
@@ -152,15 +193,43 @@ func (q *Queries) GetTemplateByID(ctx context.Context, id pgtype.UUID) (*GetTemp
 	return &i, err
 }
 
+const getTrashedTemplateByID = `-- name: GetTrashedTemplateByID :one
+SELECT id, name, owner_id, updated_at, version, schema_version, deleted_at, workspace_id, is_public
+FROM templates
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) GetTrashedTemplateByID(ctx context.Context, id pgtype.UUID) (*Template, error) {
+	row := q.db.QueryRow(ctx, getTrashedTemplateByID, id)
+	var i Template
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.IsPublic,
+	)
+	return &i, err
+}
+
 const listFieldsByTemplate = `-- name: ListFieldsByTemplate :many
-SELECT id, template_id, label, "order", is_required
+SELECT id, template_id, label, "order", is_required, schema_version, key, type, options, min_length, max_length, pattern, placeholder, default_content
 FROM fields
-WHERE template_id = $1
+WHERE template_id = $1 AND schema_version = $2
 ORDER BY "order" ASC
 `
 
-func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UUID) ([]*Field, error) {
-	rows, err := q.db.Query(ctx, listFieldsByTemplate, templateID)
+type ListFieldsByTemplateParams struct {
+	TemplateID    pgtype.UUID `db:"template_id" json:"template_id"`
+	SchemaVersion int32       `db:"schema_version" json:"schema_version"`
+}
+
+func (q *Queries) ListFieldsByTemplate(ctx context.Context, arg *ListFieldsByTemplateParams) ([]*Field, error) {
+	rows, err := q.db.Query(ctx, listFieldsByTemplate, arg.TemplateID, arg.SchemaVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +243,15 @@ func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UU
 			&i.Label,
 			&i.Order,
 			&i.IsRequired,
+			&i.SchemaVersion,
+			&i.Key,
+			&i.Type,
+			&i.Options,
+			&i.MinLength,
+			&i.MaxLength,
+			&i.Pattern,
+			&i.Placeholder,
+			&i.DefaultContent,
 		); err != nil {
 			return nil, err
 		}
@@ -187,26 +265,44 @@ func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UU
 
 const listTemplates = `-- name: ListTemplates :many
 SELECT
-    t.id, t.name, t.owner_id, t.updated_at,
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.schema_version, t.deleted_at, t.workspace_id, t.is_public,
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
         LIMIT 1
     ) AS is_used
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE ($1::uuid IS NULL OR t.owner_id = $1)
-  AND ($2::text IS NULL OR t.name ILIKE '%' || $2 || '%')
-ORDER BY t.updated_at DESC
+WHERE t.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR t.owner_id = $1)
+  AND ($2::uuid IS NULL OR t.workspace_id = $2)
+  AND (
+      $3::uuid IS NULL
+      OR EXISTS (SELECT 1 FROM workspace_members m WHERE m.workspace_id = t.workspace_id AND m.account_id = $3)
+  )
+  AND (NULLIF($4::text, '') IS NULL OR t.name ILIKE '%' || $4 || '%')
+  AND (
+      $5::timestamptz IS NULL
+      OR (t.updated_at, t.id) < ($5::timestamptz, $6::uuid)
+  )
+  AND (NOT $7::boolean OR t.is_public)
+ORDER BY t.updated_at DESC, t.id DESC
+LIMIT $8
 `
 
 type ListTemplatesParams struct {
-	Column1 pgtype.UUID `db:"column_1" json:"column_1"`
-	Column2 string      `db:"column_2" json:"column_2"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	MemberID        pgtype.UUID        `db:"member_id" json:"member_id"`
+	Query           string             `db:"query" json:"query"`
+	CursorUpdatedAt pgtype.Timestamptz `db:"cursor_updated_at" json:"cursor_updated_at"`
+	CursorID        pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	PublicOnly      bool               `db:"public_only" json:"public_only"`
+	PageLimit       int32              `db:"page_limit" json:"page_limit"`
 }
 
 type ListTemplatesRow struct {
@@ -214,6 +310,11 @@ type ListTemplatesRow struct {
 	Name           string             `db:"name" json:"name"`
 	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
 	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	SchemaVersion  int32              `db:"schema_version" json:"schema_version"`
+	DeletedAt      pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID    pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	IsPublic       bool               `db:"is_public" json:"is_public"`
 	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
 	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
 	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
@@ -221,7 +322,16 @@ type ListTemplatesRow struct {
 }
 
 func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) ([]*ListTemplatesRow, error) {
-	rows, err := q.db.Query(ctx, listTemplates, arg.Column1, arg.Column2)
+	rows, err := q.db.Query(ctx, listTemplates,
+		arg.OwnerID,
+		arg.WorkspaceID,
+		arg.MemberID,
+		arg.Query,
+		arg.CursorUpdatedAt,
+		arg.CursorID,
+		arg.PublicOnly,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +344,11 @@ func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) (
 			&i.Name,
 			&i.OwnerID,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.SchemaVersion,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+			&i.IsPublic,
 			&i.OwnerFirstName,
 			&i.OwnerLastName,
 			&i.OwnerThumbnail,
@@ -249,6 +364,162 @@ func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) (
 	return items, nil
 }
 
+const listTrashedTemplates = `-- name: ListTrashedTemplates :many
+SELECT id, name, owner_id, updated_at, version, schema_version, deleted_at, workspace_id, is_public
+FROM templates
+WHERE owner_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id DESC
+`
+
+func (q *Queries) ListTrashedTemplates(ctx context.Context, ownerID pgtype.UUID) ([]*Template, error) {
+	rows, err := q.db.Query(ctx, listTrashedTemplates, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Template
+	for rows.Next() {
+		var i Template
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OwnerID,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.SchemaVersion,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+			&i.IsPublic,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTemplatesDeletedBefore = `-- name: PurgeTemplatesDeletedBefore :execrows
+DELETE FROM templates t
+WHERE t.deleted_at < $1
+  AND NOT EXISTS (SELECT 1 FROM notes n WHERE n.template_id = t.id)
+`
+
+// A template still referenced by a note in the trash waits until that note is purged.
+func (q *Queries) PurgeTemplatesDeletedBefore(ctx context.Context, deletedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTemplatesDeletedBefore, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreTemplate = `-- name: RestoreTemplate :one
+UPDATE templates
+SET
+    deleted_at = NULL,
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NOT NULL
+RETURNING id, name, owner_id, updated_at, version, schema_version, deleted_at, workspace_id, is_public
+`
+
+type RestoreTemplateParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Version int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) RestoreTemplate(ctx context.Context, arg *RestoreTemplateParams) (*Template, error) {
+	row := q.db.QueryRow(ctx, restoreTemplate, arg.ID, arg.Version)
+	var i Template
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.IsPublic,
+	)
+	return &i, err
+}
+
+const setTemplatePublic = `-- name: SetTemplatePublic :one
+UPDATE templates
+SET
+    is_public = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, name, owner_id, updated_at, version, schema_version, deleted_at, workspace_id, is_public
+`
+
+type SetTemplatePublicParams struct {
+	ID       pgtype.UUID `db:"id" json:"id"`
+	IsPublic bool        `db:"is_public" json:"is_public"`
+	Version  int32       `db:"version" json:"version"`
+}
+
+// Matches no row when the template moved past the caller's version (or was deleted).
+func (q *Queries) SetTemplatePublic(ctx context.Context, arg *SetTemplatePublicParams) (*Template, error) {
+	row := q.db.QueryRow(ctx, setTemplatePublic, arg.ID, arg.IsPublic, arg.Version)
+	var i Template
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.IsPublic,
+	)
+	return &i, err
+}
+
+const setTemplateSchemaVersion = `-- name: SetTemplateSchemaVersion :exec
+UPDATE templates
+SET
+    schema_version = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type SetTemplateSchemaVersionParams struct {
+	ID            pgtype.UUID `db:"id" json:"id"`
+	SchemaVersion int32       `db:"schema_version" json:"schema_version"`
+}
+
+func (q *Queries) SetTemplateSchemaVersion(ctx context.Context, arg *SetTemplateSchemaVersionParams) error {
+	_, err := q.db.Exec(ctx, setTemplateSchemaVersion, arg.ID, arg.SchemaVersion)
+	return err
+}
+
+const trashTemplate = `-- name: TrashTemplate :execrows
+UPDATE templates
+SET
+    deleted_at = NOW(),
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+`
+
+type TrashTemplateParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Version int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) TrashTemplate(ctx context.Context, arg *TrashTemplateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, trashTemplate, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateField = `-- name: UpdateField :one
 UPDATE fields
 SET
@@ -256,7 +527,7 @@ SET
     "order" = $3,
     is_required = $4
 WHERE id = $1
-RETURNING id, template_id, label, "order", is_required
+RETURNING id, template_id, label, "order", is_required, schema_version, key, type, options, min_length, max_length, pattern, placeholder, default_content
 `
 
 type UpdateFieldParams struct {
@@ -280,6 +551,15 @@ func (q *Queries) UpdateField(ctx context.Context, arg *UpdateFieldParams) (*Fie
 		&i.Label,
 		&i.Order,
 		&i.IsRequired,
+		&i.SchemaVersion,
+		&i.Key,
+		&i.Type,
+		&i.Options,
+		&i.MinLength,
+		&i.MaxLength,
+		&i.Pattern,
+		&i.Placeholder,
+		&i.DefaultContent,
 	)
 	return &i, err
 }
@@ -288,24 +568,32 @@ const updateTemplate = `-- name: UpdateTemplate :one
 UPDATE templates
 SET
     name = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, owner_id, updated_at
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, name, owner_id, updated_at, version, schema_version, deleted_at, workspace_id, is_public
 `
 
 type UpdateTemplateParams struct {
-	ID   pgtype.UUID `db:"id" json:"id"`
-	Name string      `db:"name" json:"name"`
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Name    string      `db:"name" json:"name"`
+	Version int32       `db:"version" json:"version"`
 }
 
+// Matches no row when the template moved past the caller's version (or was deleted).
 func (q *Queries) UpdateTemplate(ctx context.Context, arg *UpdateTemplateParams) (*Template, error) {
-	row := q.db.QueryRow(ctx, updateTemplate, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateTemplate, arg.ID, arg.Name, arg.Version)
 	var i Template
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.SchemaVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.IsPublic,
 	)
 	return &i, err
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 )
 
@@ -37,6 +39,14 @@ func timestamptzToTime(t pgtype.Timestamptz) time.Time {
 	return t.Time
 }
 
+func nullableTimestamptzToTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
 func nullableTextToString(t pgtype.Text) string {
 	if !t.Valid {
 		return ""
@@ -64,3 +74,20 @@ func pgNullableTime(t *time.Time) pgtype.Timestamptz {
 	}
 	return pgtype.Timestamptz{Time: *t, Valid: true}
 }
+
+// pageLimit converts a filter limit to the LIMIT argument, defaulting unset limits.
+func pageLimit(limit int) int32 {
+	if limit <= 0 {
+		return pagination.DefaultLimit
+	}
+	return int32(limit) //nolint:gosec // bounded by pagination.MaxLimit+1 in use cases
+}
+
+// cursorParams converts a keyset cursor to query arguments.
+func cursorParams(c pagination.Cursor) (pgtype.Timestamptz, pgtype.UUID, error) {
+	id, err := toUUID(c.ID)
+	if err != nil {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, domainerr.ErrInvalidCursor
+	}
+	return pgNullableTime(&c.UpdatedAt), id, nil
+}
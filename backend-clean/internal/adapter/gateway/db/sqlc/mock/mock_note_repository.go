@@ -4,6 +4,7 @@ package mock
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -18,14 +19,22 @@ type NoteDBTX struct {
 	sectionRow *generated.Section
 	rowErr     error
 	execErr    error
+	execRows   int64
 	queryErr   error
 	listNotes  []*generated.ListNotesRow
 	sections   []*generated.Section
 }
 
 // NewNoteDBTX creates a mock DBTX that always returns the given row/err.
+// Exec reports one affected row unless WithRowsAffected says otherwise.
 func NewNoteDBTX(row *generated.Note, rowErr, execErr error) *NoteDBTX {
-	return &NoteDBTX{row: row, rowErr: rowErr, execErr: execErr}
+	return &NoteDBTX{row: row, rowErr: rowErr, execErr: execErr, execRows: 1}
+}
+
+// WithRowsAffected sets the row count reported by Exec (e.g. a stale conditional delete).
+func (m *NoteDBTX) WithRowsAffected(n int64) *NoteDBTX {
+	m.execRows = n
+	return m
 }
 
 // WithList allows configuring rows returned by ListNotes/ListSections.
@@ -36,7 +45,7 @@ func (m *NoteDBTX) WithList(notes []*generated.ListNotesRow, sections []*generat
 	return m
 }
 
-// WithGetRow sets a GetNoteByIDRow for QueryRow scans requiring 11 columns.
+// WithGetRow sets a GetNoteByIDRow for QueryRow scans requiring 15 columns.
 func (m *NoteDBTX) WithGetRow(row *generated.GetNoteByIDRow) *NoteDBTX {
 	m.getRow = row
 	return m
@@ -50,7 +59,7 @@ func (m *NoteDBTX) WithSectionRow(row *generated.Section) *NoteDBTX {
 
 // Exec implements sqlc.DBTX interface.
 func (m *NoteDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
-	return pgconn.CommandTag{}, m.execErr
+	return pgconn.NewCommandTag(fmt.Sprintf("DELETE %d", m.execRows)), m.execErr
 }
 
 // Query implements sqlc.DBTX interface.
@@ -58,8 +67,8 @@ func (m *NoteDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.
 	if m.queryErr != nil {
 		return nil, m.queryErr
 	}
-	// Heuristic: ListNotes has 4 args, ListSectionsByNote has 1 arg.
-	if len(args) == 4 {
+	// Heuristic: ListNotes has 11 args, ListSectionsByNote has 1 arg.
+	if len(args) == 11 {
 		return &noteRows{items: m.listNotes}, nil
 	}
 	return &sectionRows{items: m.sections}, nil
@@ -82,7 +91,7 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		return m.err
 	}
 	switch len(dest) {
-	case 11:
+	case 18:
 		if m.getRow == nil {
 			return errors.New("getRow is nil")
 		}
@@ -93,12 +102,19 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		setString(dest[4], m.getRow.Status)
 		setTimestamptz(dest[5], m.getRow.CreatedAt)
 		setTimestamptz(dest[6], m.getRow.UpdatedAt)
-		setString(dest[7], m.getRow.TemplateName)
-		setString(dest[8], m.getRow.FirstName)
-		setString(dest[9], m.getRow.LastName)
-		setText(dest[10], m.getRow.OwnerThumbnail)
+		setInt32(dest[7], m.getRow.Version)
+		setInt32(dest[8], m.getRow.SchemaVersion)
+		setTimestamptz(dest[9], m.getRow.DeletedAt)
+		setTimestamptz(dest[10], m.getRow.PublishAt)
+		setTimestamptz(dest[11], m.getRow.UnpublishAt)
+		setUUID(dest[12], m.getRow.WorkspaceID)
+		setString(dest[13], m.getRow.TemplateName)
+		setString(dest[14], m.getRow.FirstName)
+		setString(dest[15], m.getRow.LastName)
+		setText(dest[16], m.getRow.OwnerThumbnail)
+		setInt32(dest[17], m.getRow.LatestSchemaVersion)
 		return nil
-	case 7:
+	case 13:
 		if m.row == nil {
 			return errors.New("row is nil")
 		}
@@ -109,6 +125,12 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		setString(dest[4], m.row.Status)
 		setTimestamptz(dest[5], m.row.CreatedAt)
 		setTimestamptz(dest[6], m.row.UpdatedAt)
+		setInt32(dest[7], m.row.Version)
+		setInt32(dest[8], m.row.SchemaVersion)
+		setTimestamptz(dest[9], m.row.DeletedAt)
+		setTimestamptz(dest[10], m.row.PublishAt)
+		setTimestamptz(dest[11], m.row.UnpublishAt)
+		setUUID(dest[12], m.row.WorkspaceID)
 		return nil
 	case 4:
 		if m.secRow == nil {
@@ -147,7 +169,7 @@ func (r *noteRows) Scan(dest ...interface{}) error {
 		return errors.New("scan called out of range")
 	}
 	item := r.items[r.idx-1]
-	if len(dest) != 11 {
+	if len(dest) != 19 {
 		return errors.New("unexpected scan args")
 	}
 	setUUID(dest[0], item.ID)
@@ -157,10 +179,20 @@ func (r *noteRows) Scan(dest ...interface{}) error {
 	setString(dest[4], item.Status)
 	setTimestamptz(dest[5], item.CreatedAt)
 	setTimestamptz(dest[6], item.UpdatedAt)
-	setString(dest[7], item.TemplateName)
-	setString(dest[8], item.FirstName)
-	setString(dest[9], item.LastName)
-	setText(dest[10], item.OwnerThumbnail)
+	setInt32(dest[7], item.Version)
+	setInt32(dest[8], item.SchemaVersion)
+	setTimestamptz(dest[9], item.PublishAt)
+	setTimestamptz(dest[10], item.UnpublishAt)
+	setUUID(dest[11], item.WorkspaceID)
+	if p, ok := dest[12].(*float32); ok {
+		*p = item.Rank
+	}
+	setString(dest[13], item.TemplateName)
+	setInt32(dest[14], item.LatestSchemaVersion)
+	setString(dest[15], item.FirstName)
+	setString(dest[16], item.LastName)
+	setText(dest[17], item.OwnerThumbnail)
+	setString(dest[18], item.Snippet)
 	return nil
 }
 func (r *noteRows) Conn() *pgx.Conn { return nil }
@@ -183,20 +215,29 @@ func (r *sectionRows) Scan(dest ...interface{}) error {
 		return errors.New("scan called out of range")
 	}
 	item := r.items[r.idx-1]
-	if len(dest) != 7 {
+	if len(dest) != 10 {
 		return errors.New("unexpected scan args")
 	}
 	setUUID(dest[0], item.ID)
 	setUUID(dest[1], item.NoteID)
 	setUUID(dest[2], item.FieldID)
 	setString(dest[3], item.Content)
-	setString(dest[4], "")      // label
-	setInt32(dest[5], int32(0)) // order
-	setBool(dest[6], false)     // is_required
+	setString(dest[4], "")         // label
+	setInt32(dest[5], int32(0))    // order
+	setBool(dest[6], false)        // is_required
+	setUUID(dest[7], item.FieldID) // field_key
+	setString(dest[8], "text")     // field_type
+	setStrings(dest[9], nil)       // field_options
 	return nil
 }
 func (r *sectionRows) Conn() *pgx.Conn { return nil }
 
+func setStrings(ptr interface{}, v []string) {
+	if dest, ok := ptr.(*[]string); ok {
+		*dest = v
+	}
+}
+
 func setInt32(ptr interface{}, v int32) {
 	if dest, ok := ptr.(*int32); ok {
 		*dest = v
@@ -4,6 +4,7 @@ package mock
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -18,6 +19,7 @@ type TemplateDBTX struct {
 	FieldRow    *generated.Field
 	rowErr      error
 	execErr     error
+	execRows    int64
 	QueryErr    error
 }
 
@@ -28,12 +30,19 @@ func NewTemplateDBTX(templateRow *generated.Template, detailRow *generated.GetTe
 		detailRow:   detailRow,
 		rowErr:      rowErr,
 		execErr:     execErr,
+		execRows:    1,
 	}
 }
 
+// WithRowsAffected sets the row count reported by Exec (e.g. a stale conditional delete).
+func (m *TemplateDBTX) WithRowsAffected(n int64) *TemplateDBTX {
+	m.execRows = n
+	return m
+}
+
 // Exec implements sqlc.DBTX interface.
 func (m *TemplateDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
-	return pgconn.CommandTag{}, m.execErr
+	return pgconn.NewCommandTag(fmt.Sprintf("DELETE %d", m.execRows)), m.execErr
 }
 
 // Query implements sqlc.DBTX interface.
@@ -61,7 +70,20 @@ func (m *templateRow) Scan(dest ...interface{}) error {
 		return m.err
 	}
 	switch len(dest) {
-	case 5: // Field
+	case 9: // Template
+		if m.templateRow == nil {
+			return errors.New("templateRow is nil")
+		}
+		setUUID(dest[0], m.templateRow.ID)
+		setString(dest[1], m.templateRow.Name)
+		setUUID(dest[2], m.templateRow.OwnerID)
+		setTimestamptz(dest[3], m.templateRow.UpdatedAt)
+		setInt32Field(dest[4], m.templateRow.Version)
+		setInt32Field(dest[5], m.templateRow.SchemaVersion)
+		setTimestamptz(dest[6], m.templateRow.DeletedAt)
+		setUUID(dest[7], m.templateRow.WorkspaceID)
+		setBool(dest[8], m.templateRow.IsPublic)
+	case 14: // Field
 		if m.fieldRow == nil {
 			return errors.New("fieldRow is nil")
 		}
@@ -70,20 +92,32 @@ func (m *templateRow) Scan(dest ...interface{}) error {
 		setString(dest[2], m.fieldRow.Label)
 		setInt32Field(dest[3], m.fieldRow.Order)
 		setBool(dest[4], m.fieldRow.IsRequired)
-	case 4: // Template
-		setUUID(dest[0], m.templateRow.ID)
-		setString(dest[1], m.templateRow.Name)
-		setUUID(dest[2], m.templateRow.OwnerID)
-		setTimestamptz(dest[3], m.templateRow.UpdatedAt)
-	case 8: // GetTemplateByIDRow
+		setInt32Field(dest[5], m.fieldRow.SchemaVersion)
+		setUUID(dest[6], m.fieldRow.Key)
+		setString(dest[7], m.fieldRow.Type)
+		setStrings(dest[8], m.fieldRow.Options)
+		setInt32Field(dest[9], m.fieldRow.MinLength)
+		setInt32Field(dest[10], m.fieldRow.MaxLength)
+		setString(dest[11], m.fieldRow.Pattern)
+		setString(dest[12], m.fieldRow.Placeholder)
+		setString(dest[13], m.fieldRow.DefaultContent)
+	case 13: // GetTemplateByIDRow
+		if m.detailRow == nil {
+			return errors.New("detailRow is nil")
+		}
 		setUUID(dest[0], m.detailRow.ID)
 		setString(dest[1], m.detailRow.Name)
 		setUUID(dest[2], m.detailRow.OwnerID)
 		setTimestamptz(dest[3], m.detailRow.UpdatedAt)
-		setString(dest[4], m.detailRow.OwnerFirstName)
-		setString(dest[5], m.detailRow.OwnerLastName)
-		setText(dest[6], m.detailRow.OwnerThumbnail)
-		setBool(dest[7], m.detailRow.IsUsed)
+		setInt32Field(dest[4], m.detailRow.Version)
+		setInt32Field(dest[5], m.detailRow.SchemaVersion)
+		setTimestamptz(dest[6], m.detailRow.DeletedAt)
+		setUUID(dest[7], m.detailRow.WorkspaceID)
+		setBool(dest[8], m.detailRow.IsPublic)
+		setString(dest[9], m.detailRow.OwnerFirstName)
+		setString(dest[10], m.detailRow.OwnerLastName)
+		setText(dest[11], m.detailRow.OwnerThumbnail)
+		setBool(dest[12], m.detailRow.IsUsed)
 	default:
 		return errors.New("unexpected scan args")
 	}
@@ -3,7 +3,11 @@ package sqlc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"html"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -12,6 +16,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -31,24 +36,54 @@ func NewNoteRepository(pool *pgxpool.Pool) *NoteRepository {
 	}
 }
 
-// List returns notes by filters.
+// List returns one page of notes by filters, newest first or by relevance when searching.
 func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note.WithMeta, error) {
-	params := &generated.ListNotesParams{}
+	params := &generated.ListNotesParams{
+		PageLimit: pageLimit(filters.Limit),
+	}
 	if filters.Status != nil {
-		params.Column1 = string(*filters.Status)
+		params.Status = string(*filters.Status)
 	}
 	if filters.TemplateID != nil && *filters.TemplateID != "" {
 		if id, err := toUUID(*filters.TemplateID); err == nil {
-			params.Column2 = id
+			params.TemplateID = id
 		}
 	}
 	if filters.OwnerID != nil && *filters.OwnerID != "" {
 		if id, err := toUUID(*filters.OwnerID); err == nil {
-			params.Column3 = id
+			params.OwnerID = id
+		}
+	}
+	// A malformed workspace or member ID matches nothing instead of widening the list.
+	if filters.WorkspaceID != nil {
+		id, err := toUUID(*filters.WorkspaceID)
+		if err != nil {
+			return []note.WithMeta{}, nil
+		}
+		params.WorkspaceID = id
+	}
+	if filters.MemberID != nil {
+		id, err := toUUID(*filters.MemberID)
+		if err != nil {
+			return []note.WithMeta{}, nil
+		}
+		params.MemberID = id
+	}
+	if filters.SharedWith != nil && *filters.SharedWith != "" {
+		if id, err := toUUID(*filters.SharedWith); err == nil {
+			params.SharedWith = id
 		}
 	}
 	if filters.Query != nil && *filters.Query != "" {
-		params.Column4 = *filters.Query
+		params.Query = *filters.Query
+	}
+	if filters.Cursor != nil {
+		updatedAt, id, err := cursorParams(*filters.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.CursorUpdatedAt, params.CursorID = updatedAt, id
+		params.CursorRank = filters.Cursor.Rank
 	}
 
 	rows, err := queriesForContext(ctx, r.queries).ListNotes(ctx, params)
@@ -69,19 +104,27 @@ func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note
 		}
 		result = append(result, note.WithMeta{
 			Note: note.Note{
-				ID:         uuidToString(row.ID),
-				Title:      row.Title,
-				TemplateID: uuidToString(row.TemplateID),
-				OwnerID:    uuidToString(row.OwnerID),
-				Status:     note.NoteStatus(row.Status),
-				CreatedAt:  timestamptzToTime(row.CreatedAt),
-				UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+				ID:            uuidToString(row.ID),
+				Title:         row.Title,
+				TemplateID:    uuidToString(row.TemplateID),
+				OwnerID:       uuidToString(row.OwnerID),
+				Status:        note.NoteStatus(row.Status),
+				CreatedAt:     timestamptzToTime(row.CreatedAt),
+				UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+				Version:       int(row.Version),
+				SchemaVersion: int(row.SchemaVersion),
+				PublishAt:     nullableTimestamptzToTime(row.PublishAt),
+				UnpublishAt:   nullableTimestamptzToTime(row.UnpublishAt),
+				WorkspaceID:   uuidToString(row.WorkspaceID),
 			},
-			TemplateName:   row.TemplateName,
-			OwnerFirstName: row.FirstName,
-			OwnerLastName:  row.LastName,
-			OwnerThumbnail: thumbnail,
-			Sections:       sections,
+			TemplateName:        row.TemplateName,
+			OwnerFirstName:      row.FirstName,
+			OwnerLastName:       row.LastName,
+			OwnerThumbnail:      thumbnail,
+			Sections:            sections,
+			LatestSchemaVersion: int(row.LatestSchemaVersion),
+			Rank:                row.Rank,
+			Snippet:             highlightSnippet(row.Snippet),
 		})
 	}
 	return result, nil
@@ -111,19 +154,25 @@ func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, er
 	}
 	return &note.WithMeta{
 		Note: note.Note{
-			ID:         uuidToString(row.ID),
-			Title:      row.Title,
-			TemplateID: uuidToString(row.TemplateID),
-			OwnerID:    uuidToString(row.OwnerID),
-			Status:     note.NoteStatus(row.Status),
-			CreatedAt:  timestamptzToTime(row.CreatedAt),
-			UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+			ID:            uuidToString(row.ID),
+			Title:         row.Title,
+			TemplateID:    uuidToString(row.TemplateID),
+			OwnerID:       uuidToString(row.OwnerID),
+			Status:        note.NoteStatus(row.Status),
+			CreatedAt:     timestamptzToTime(row.CreatedAt),
+			UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+			Version:       int(row.Version),
+			SchemaVersion: int(row.SchemaVersion),
+			PublishAt:     nullableTimestamptzToTime(row.PublishAt),
+			UnpublishAt:   nullableTimestamptzToTime(row.UnpublishAt),
+			WorkspaceID:   uuidToString(row.WorkspaceID),
 		},
-		TemplateName:   row.TemplateName,
-		OwnerFirstName: row.FirstName,
-		OwnerLastName:  row.LastName,
-		OwnerThumbnail: thumbnail,
-		Sections:       sections,
+		TemplateName:        row.TemplateName,
+		OwnerFirstName:      row.FirstName,
+		OwnerLastName:       row.LastName,
+		OwnerThumbnail:      thumbnail,
+		Sections:            sections,
+		LatestSchemaVersion: int(row.LatestSchemaVersion),
 	}, nil
 }
 
@@ -137,24 +186,22 @@ func (r *NoteRepository) Create(ctx context.Context, n note.Note) (*note.Note, e
 	if err != nil {
 		return nil, err
 	}
+	workspaceID, err := toUUID(n.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
 	row, err := queriesForContext(ctx, r.queries).CreateNote(ctx, &generated.CreateNoteParams{
-		Title:      n.Title,
-		TemplateID: templateID,
-		OwnerID:    ownerID,
-		Status:     string(n.Status),
+		Title:         n.Title,
+		TemplateID:    templateID,
+		OwnerID:       ownerID,
+		Status:        string(n.Status),
+		SchemaVersion: int32(n.SchemaVersion), //nolint:gosec
+		WorkspaceID:   workspaceID,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
-	}, nil
+	return toNote(row), nil
 }
 
 // Update updates a note title.
@@ -163,64 +210,185 @@ func (r *NoteRepository) Update(ctx context.Context, n note.Note) (*note.Note, e
 	if err != nil {
 		return nil, err
 	}
-	row, err := queriesForContext(ctx, r.queries).UpdateNote(ctx, &generated.UpdateNoteParams{
-		ID:    pgID,
-		Title: n.Title,
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.UpdateNote(ctx, &generated.UpdateNoteParams{
+		ID:      pgID,
+		Title:   n.Title,
+		Version: int32(n.Version), //nolint:gosec
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domainerr.ErrNotFound
+			return nil, r.staleWriteError(ctx, pgID)
 		}
 		return nil, err
 	}
-	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
-	}, nil
+	if err := q.RefreshNoteSearchDocument(ctx, pgID); err != nil {
+		return nil, err
+	}
+	return toNote(row), nil
 }
 
-// UpdateStatus updates note status.
-func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status note.NoteStatus) (*note.Note, error) {
+// UpdateStatus updates note status and schedule.
+func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status note.NoteStatus, schedule note.Schedule, version int) (*note.Note, error) {
 	pgID, err := toUUID(id)
 	if err != nil {
 		return nil, err
 	}
 	row, err := queriesForContext(ctx, r.queries).UpdateNoteStatus(ctx, &generated.UpdateNoteStatusParams{
-		ID:     pgID,
-		Status: string(status),
+		ID:          pgID,
+		Status:      string(status),
+		Version:     int32(version), //nolint:gosec
+		PublishAt:   pgNullableTime(schedule.PublishAt),
+		UnpublishAt: pgNullableTime(schedule.UnpublishAt),
 	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.staleWriteError(ctx, pgID)
+		}
+		return nil, err
+	}
+	return toNote(row), nil
+}
+
+// UpdateSchemaVersion pins the note to another schema version of its template.
+func (r *NoteRepository) UpdateSchemaVersion(ctx context.Context, id string, schemaVersion, version int) (*note.Note, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateNoteSchemaVersion(ctx, &generated.UpdateNoteSchemaVersionParams{
+		ID:            pgID,
+		SchemaVersion: int32(schemaVersion), //nolint:gosec
+		Version:       int32(version),       //nolint:gosec
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.staleWriteError(ctx, pgID)
+		}
+		return nil, err
+	}
+	return toNote(row), nil
+}
+
+// Delete moves a note to the trash.
+func (r *NoteRepository) Delete(ctx context.Context, id string, version int) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	trashed, err := queriesForContext(ctx, r.queries).TrashNote(ctx, &generated.TrashNoteParams{
+		ID:      pgID,
+		Version: int32(version), //nolint:gosec
+	})
+	if err != nil {
+		return err
+	}
+	if trashed == 0 {
+		return r.staleWriteError(ctx, pgID)
+	}
+	return nil
+}
+
+// ListTrashed returns the owner's notes in the trash, without sections.
+func (r *NoteRepository) ListTrashed(ctx context.Context, ownerID string) ([]note.WithMeta, error) {
+	pgOwnerID, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListTrashedNotes(ctx, pgOwnerID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]note.WithMeta, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toTrashedNote(row))
+	}
+	return result, nil
+}
+
+// GetTrashed returns a note in the trash, without sections.
+func (r *NoteRepository) GetTrashed(ctx context.Context, id string) (*note.WithMeta, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetTrashedNoteByID(ctx, pgID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domainerr.ErrNotFound
 		}
 		return nil, err
 	}
-	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
-	}, nil
+	n := toTrashedNote((*generated.ListTrashedNotesRow)(row))
+	return &n, nil
 }
 
-// Delete deletes a note.
-func (r *NoteRepository) Delete(ctx context.Context, id string) error {
+// Restore takes a note out of the trash.
+func (r *NoteRepository) Restore(ctx context.Context, id string, version int) (*note.Note, error) {
 	pgID, err := toUUID(id)
 	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.RestoreNote(ctx, &generated.RestoreNoteParams{
+		ID:      pgID,
+		Version: int32(version), //nolint:gosec
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if _, err := q.GetTrashedNoteByID(ctx, pgID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, domainerr.ErrNotFound
+			}
+			return nil, err
+		}
+		return nil, domainerr.ErrVersionConflict
+	}
+	return toNote(row), nil
+}
+
+// PublishDue publishes scheduled notes whose publish time is at or before now.
+func (r *NoteRepository) PublishDue(ctx context.Context, now time.Time) ([]note.Note, error) {
+	rows, err := queriesForContext(ctx, r.queries).PublishDueNotes(ctx, pgNullableTime(&now))
+	if err != nil {
+		return nil, err
+	}
+	return toNotes(rows), nil
+}
+
+// UnpublishDue moves published notes whose unpublish time is at or before now back to draft.
+func (r *NoteRepository) UnpublishDue(ctx context.Context, now time.Time) ([]note.Note, error) {
+	rows, err := queriesForContext(ctx, r.queries).UnpublishDueNotes(ctx, pgNullableTime(&now))
+	if err != nil {
+		return nil, err
+	}
+	return toNotes(rows), nil
+}
+
+// PurgeDeletedBefore permanently deletes notes trashed before the given time.
+func (r *NoteRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	purged, err := queriesForContext(ctx, r.queries).PurgeNotesDeletedBefore(ctx, pgNullableTime(&before))
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
+
+// staleWriteError explains why a version-conditional write matched no row.
+func (r *NoteRepository) staleWriteError(ctx context.Context, id pgtype.UUID) error {
+	if _, err := queriesForContext(ctx, r.queries).GetNoteByID(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerr.ErrNotFound
+		}
 		return err
 	}
-	return queriesForContext(ctx, r.queries).DeleteNote(ctx, pgID)
+	return domainerr.ErrVersionConflict
 }
 
-// ReplaceSections replaces note sections.
+// ReplaceSections replaces note sections and re-indexes the note for search.
+// Sections left out of sections are deleted.
 func (r *NoteRepository) ReplaceSections(ctx context.Context, noteID string, sections []note.Section) error {
 	nID, err := toUUID(noteID)
 	if err != nil {
@@ -228,24 +396,40 @@ func (r *NoteRepository) ReplaceSections(ctx context.Context, noteID string, sec
 	}
 	q := queriesForContext(ctx, r.queries)
 
+	keep := make([]pgtype.UUID, 0, len(sections))
+	for _, s := range sections {
+		if s.ID == "" {
+			continue
+		}
+		secID, err := toUUID(s.ID)
+		if err != nil {
+			return err
+		}
+		keep = append(keep, secID)
+	}
+	if err := q.DeleteSectionsExcept(ctx, &generated.DeleteSectionsExceptParams{NoteID: nID, KeepIds: keep}); err != nil {
+		return err
+	}
+
 	for _, s := range sections {
+		fieldID, err := toUUID(s.FieldID)
+		if err != nil {
+			return err
+		}
 		if s.ID != "" {
 			secID, err := toUUID(s.ID)
 			if err != nil {
 				return err
 			}
-			if _, err := q.UpdateSectionContent(ctx, &generated.UpdateSectionContentParams{
+			if _, err := q.UpdateSection(ctx, &generated.UpdateSectionParams{
 				ID:      secID,
+				FieldID: fieldID,
 				Content: s.Content,
 			}); err != nil {
 				return err
 			}
 			continue
 		}
-		fieldID, err := toUUID(s.FieldID)
-		if err != nil {
-			return err
-		}
 		if _, err := q.CreateSection(ctx, &generated.CreateSectionParams{
 			NoteID:  nID,
 			FieldID: fieldID,
@@ -254,7 +438,107 @@ func (r *NoteRepository) ReplaceSections(ctx context.Context, noteID string, sec
 			return err
 		}
 	}
-	return nil
+	return q.RefreshNoteSearchDocument(ctx, nID)
+}
+
+// AppendRevision stores rev as the next revision of its note.
+func (r *NoteRepository) AppendRevision(ctx context.Context, rev note.Revision) (*note.Revision, error) {
+	noteID, err := toUUID(rev.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := toUUID(rev.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := json.Marshal(toRevisionSectionsJSON(rev.Sections))
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateNoteRevision(ctx, &generated.CreateNoteRevisionParams{
+		NoteID:   noteID,
+		Title:    rev.Title,
+		Sections: sections,
+		ActorID:  actorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRevision(row)
+}
+
+// ListRevisions returns revisions of a note, newest first.
+func (r *NoteRepository) ListRevisions(ctx context.Context, noteID string) ([]note.Revision, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNoteRevisions(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]note.Revision, 0, len(rows))
+	for _, row := range rows {
+		rev, err := toDomainRevision(row)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, *rev)
+	}
+	return revisions, nil
+}
+
+// GetRevision returns one revision of a note.
+func (r *NoteRepository) GetRevision(ctx context.Context, noteID string, number int) (*note.Revision, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetNoteRevision(ctx, &generated.GetNoteRevisionParams{
+		NoteID:   pgID,
+		Revision: int32(number), //nolint:gosec // revision numbers are small positive ints
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainRevision(row)
+}
+
+// revisionSectionJSON is the stored shape of note_revisions.sections.
+type revisionSectionJSON struct {
+	FieldID string `json:"fieldId"`
+	Content string `json:"content"`
+}
+
+func toRevisionSectionsJSON(sections []note.RevisionSection) []revisionSectionJSON {
+	out := make([]revisionSectionJSON, 0, len(sections))
+	for _, s := range sections {
+		out = append(out, revisionSectionJSON{FieldID: s.FieldID, Content: s.Content})
+	}
+	return out
+}
+
+func toDomainRevision(row *generated.NoteRevision) (*note.Revision, error) {
+	var stored []revisionSectionJSON
+	if err := json.Unmarshal(row.Sections, &stored); err != nil {
+		return nil, err
+	}
+	sections := make([]note.RevisionSection, 0, len(stored))
+	for _, s := range stored {
+		sections = append(sections, note.RevisionSection{FieldID: s.FieldID, Content: s.Content})
+	}
+	return &note.Revision{
+		ID:        uuidToString(row.ID),
+		NoteID:    uuidToString(row.NoteID),
+		Number:    int(row.Revision),
+		Title:     row.Title,
+		Sections:  sections,
+		ActorID:   uuidToString(row.ActorID),
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+	}, nil
 }
 
 func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) ([]note.SectionWithField, error) {
@@ -271,10 +555,68 @@ func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) (
 				FieldID: uuidToString(row.FieldID),
 				Content: row.Content,
 			},
-			FieldLabel: row.Label,
-			FieldOrder: int(row.Order),
-			IsRequired: row.IsRequired,
+			FieldLabel:   row.Label,
+			FieldOrder:   int(row.Order),
+			IsRequired:   row.IsRequired,
+			FieldKey:     uuidToString(row.FieldKey),
+			FieldType:    template.FieldType(row.FieldType),
+			FieldOptions: row.FieldOptions,
 		})
 	}
 	return sections, nil
 }
+
+func toNote(row *generated.Note) *note.Note {
+	return &note.Note{
+		ID:            uuidToString(row.ID),
+		Title:         row.Title,
+		TemplateID:    uuidToString(row.TemplateID),
+		OwnerID:       uuidToString(row.OwnerID),
+		Status:        note.NoteStatus(row.Status),
+		CreatedAt:     timestamptzToTime(row.CreatedAt),
+		UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+		Version:       int(row.Version),
+		SchemaVersion: int(row.SchemaVersion),
+		DeletedAt:     nullableTimestamptzToTime(row.DeletedAt),
+		PublishAt:     nullableTimestamptzToTime(row.PublishAt),
+		UnpublishAt:   nullableTimestamptzToTime(row.UnpublishAt),
+		WorkspaceID:   uuidToString(row.WorkspaceID),
+	}
+}
+
+func toNotes(rows []*generated.Note) []note.Note {
+	notes := make([]note.Note, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, *toNote(row))
+	}
+	return notes
+}
+
+func toTrashedNote(row *generated.ListTrashedNotesRow) note.WithMeta {
+	return note.WithMeta{
+		Note: note.Note{
+			ID:            uuidToString(row.ID),
+			Title:         row.Title,
+			TemplateID:    uuidToString(row.TemplateID),
+			OwnerID:       uuidToString(row.OwnerID),
+			Status:        note.NoteStatus(row.Status),
+			CreatedAt:     timestamptzToTime(row.CreatedAt),
+			UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+			Version:       int(row.Version),
+			SchemaVersion: int(row.SchemaVersion),
+			DeletedAt:     nullableTimestamptzToTime(row.DeletedAt),
+			PublishAt:     nullableTimestamptzToTime(row.PublishAt),
+			UnpublishAt:   nullableTimestamptzToTime(row.UnpublishAt),
+			WorkspaceID:   uuidToString(row.WorkspaceID),
+		},
+		TemplateName: row.TemplateName,
+	}
+}
+
+// snippetMarks turns the match markers ListNotes puts in a snippet into <mark> tags.
+var snippetMarks = strings.NewReplacer("\x01", "<mark>", "\x02", "</mark>")
+
+// highlightSnippet escapes the note text of a ListNotes snippet, so only the <mark> tags are markup.
+func highlightSnippet(s string) string {
+	return snippetMarks.Replace(html.EscapeString(s))
+}
@@ -5,7 +5,9 @@ package sqlc
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -69,13 +71,15 @@ func TestNoteRepository_Integration_CRUD(t *testing.T) {
 
 	t.Run("Update note title", func(t *testing.T) {
 		updateNote := note.Note{
-			ID:    data.Note.ID,
-			Title: "Updated Title",
+			ID:      data.Note.ID,
+			Title:   "Updated Title",
+			Version: 1,
 		}
 
 		updated, err := repo.Update(ctx, updateNote)
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Title", updated.Title)
+		assert.Equal(t, 2, updated.Version)
 
 		// Verify the update
 		got, err := repo.Get(ctx, data.Note.ID)
@@ -84,9 +88,10 @@ func TestNoteRepository_Integration_CRUD(t *testing.T) {
 	})
 
 	t.Run("Update note status to Publish", func(t *testing.T) {
-		updated, err := repo.UpdateStatus(ctx, data.Note.ID, note.StatusPublish)
+		updated, err := repo.UpdateStatus(ctx, data.Note.ID, note.StatusPublish, note.Schedule{}, 2)
 		require.NoError(t, err)
 		assert.Equal(t, note.StatusPublish, updated.Status)
+		assert.Equal(t, 3, updated.Version)
 
 		// Verify the update
 		got, err := repo.Get(ctx, data.Note.ID)
@@ -95,11 +100,27 @@ func TestNoteRepository_Integration_CRUD(t *testing.T) {
 	})
 
 	t.Run("Update note status back to Draft", func(t *testing.T) {
-		updated, err := repo.UpdateStatus(ctx, data.Note.ID, note.StatusDraft)
+		updated, err := repo.UpdateStatus(ctx, data.Note.ID, note.StatusDraft, note.Schedule{}, 3)
 		require.NoError(t, err)
 		assert.Equal(t, note.StatusDraft, updated.Status)
 	})
 
+	t.Run("Stale version is rejected", func(t *testing.T) {
+		_, err := repo.Update(ctx, note.Note{ID: data.Note.ID, Title: "Lost Update", Version: 1})
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
+
+		_, err = repo.UpdateStatus(ctx, data.Note.ID, note.StatusPublish, note.Schedule{}, 1)
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
+
+		err = repo.Delete(ctx, data.Note.ID, 1)
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
+
+		got, err := repo.Get(ctx, data.Note.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Updated Title", got.Note.Title)
+		assert.Equal(t, 4, got.Note.Version)
+	})
+
 	t.Run("Delete note", func(t *testing.T) {
 		// Create a new note to delete
 		newNote := note.Note{
@@ -112,7 +133,7 @@ func TestNoteRepository_Integration_CRUD(t *testing.T) {
 		require.NoError(t, err)
 
 		// Delete it
-		err = repo.Delete(ctx, created.ID)
+		err = repo.Delete(ctx, created.ID, created.Version)
 		require.NoError(t, err)
 
 		// Verify it's deleted
@@ -121,6 +142,159 @@ func TestNoteRepository_Integration_CRUD(t *testing.T) {
 	})
 }
 
+func TestNoteRepository_Integration_Trash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	data := testutil.CreateDefaultTestData(t, pool)
+	repo := NewNoteRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	created, err := repo.Create(ctx, note.Note{
+		Title:       "Note to Trash",
+		TemplateID:  data.Template.ID,
+		OwnerID:     data.Account.ID,
+		Status:      note.StatusDraft,
+		WorkspaceID: data.Template.WorkspaceID,
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.Delete(ctx, created.ID, created.Version))
+
+	t.Run("Trashed note is hidden from Get and List", func(t *testing.T) {
+		_, err := repo.Get(ctx, created.ID)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+
+		notes, err := repo.List(ctx, note.Filters{OwnerID: &data.Account.ID})
+		require.NoError(t, err)
+		for _, n := range notes {
+			assert.NotEqual(t, created.ID, n.Note.ID)
+		}
+	})
+
+	t.Run("Trashed note cannot be updated or trashed again", func(t *testing.T) {
+		_, err := repo.Update(ctx, note.Note{ID: created.ID, Title: "Edited", Version: created.Version + 1})
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+
+		err = repo.Delete(ctx, created.ID, created.Version+1)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+	})
+
+	t.Run("List and get trashed notes", func(t *testing.T) {
+		trashed, err := repo.ListTrashed(ctx, data.Account.ID)
+		require.NoError(t, err)
+		require.Len(t, trashed, 1)
+		assert.Equal(t, created.ID, trashed[0].Note.ID)
+		assert.Equal(t, data.Template.Name, trashed[0].TemplateName)
+		require.NotNil(t, trashed[0].Note.DeletedAt)
+
+		got, err := repo.GetTrashed(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.Version+1, got.Note.Version)
+
+		_, err = repo.GetTrashed(ctx, data.Note.ID)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+	})
+
+	t.Run("Restore note", func(t *testing.T) {
+		_, err := repo.Restore(ctx, created.ID, created.Version)
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
+
+		restored, err := repo.Restore(ctx, created.ID, created.Version+1)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, created.Version+2, restored.Version)
+
+		_, err = repo.Get(ctx, created.ID)
+		require.NoError(t, err)
+
+		_, err = repo.Restore(ctx, created.ID, restored.Version)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+	})
+
+	t.Run("Purge deletes only notes trashed before the cutoff", func(t *testing.T) {
+		got, err := repo.Get(ctx, created.ID)
+		require.NoError(t, err)
+		require.NoError(t, repo.Delete(ctx, created.ID, got.Note.Version))
+
+		purged, err := repo.PurgeDeletedBefore(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+
+		purged, err = repo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		_, err = repo.GetTrashed(ctx, created.ID)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+		_, err = repo.Get(ctx, data.Note.ID)
+		require.NoError(t, err)
+	})
+}
+
+func TestNoteRepository_Integration_Schedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	data := testutil.CreateDefaultTestData(t, pool)
+	repo := NewNoteRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	unpublishAt := publishAt.Add(time.Hour)
+	scheduled, err := repo.UpdateStatus(ctx, data.Note.ID, note.StatusScheduled, note.Schedule{PublishAt: &publishAt, UnpublishAt: &unpublishAt}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, note.StatusScheduled, scheduled.Status)
+	require.NotNil(t, scheduled.PublishAt)
+	assert.True(t, publishAt.Equal(*scheduled.PublishAt))
+
+	t.Run("Scheduled note requires a publish time", func(t *testing.T) {
+		_, err := repo.UpdateStatus(ctx, data.Note.ID, note.StatusScheduled, note.Schedule{}, scheduled.Version)
+		assert.Error(t, err)
+	})
+
+	t.Run("Nothing is due before the publish time", func(t *testing.T) {
+		published, err := repo.PublishDue(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, published)
+	})
+
+	t.Run("Due note is published once", func(t *testing.T) {
+		published, err := repo.PublishDue(ctx, publishAt)
+		require.NoError(t, err)
+		require.Len(t, published, 1)
+		assert.Equal(t, note.StatusPublish, published[0].Status)
+		assert.Nil(t, published[0].PublishAt)
+		require.NotNil(t, published[0].UnpublishAt)
+		assert.Equal(t, scheduled.Version+1, published[0].Version)
+
+		published, err = repo.PublishDue(ctx, publishAt)
+		require.NoError(t, err)
+		assert.Empty(t, published)
+	})
+
+	t.Run("Due note is unpublished once", func(t *testing.T) {
+		unpublished, err := repo.UnpublishDue(ctx, publishAt)
+		require.NoError(t, err)
+		assert.Empty(t, unpublished)
+
+		unpublished, err = repo.UnpublishDue(ctx, unpublishAt)
+		require.NoError(t, err)
+		require.Len(t, unpublished, 1)
+		assert.Equal(t, note.StatusDraft, unpublished[0].Status)
+		assert.Nil(t, unpublished[0].UnpublishAt)
+
+		unpublished, err = repo.UnpublishDue(ctx, unpublishAt)
+		require.NoError(t, err)
+		assert.Empty(t, unpublished)
+	})
+}
+
 func TestNoteRepository_Integration_List(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -193,6 +367,81 @@ func TestNoteRepository_Integration_List(t *testing.T) {
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(notes), 1)
 	})
+
+	t.Run("Search matches section content and ranks title hits first", func(t *testing.T) {
+		titleHit, err := repo.Create(ctx, note.Note{Title: "kumquat harvest", TemplateID: data.Template.ID, OwnerID: data.Account.ID, Status: note.StatusDraft, WorkspaceID: data.Template.WorkspaceID})
+		require.NoError(t, err)
+		require.NoError(t, repo.ReplaceSections(ctx, titleHit.ID, nil))
+
+		bodyHit, err := repo.Create(ctx, note.Note{Title: "Weekly notes", TemplateID: data.Template.ID, OwnerID: data.Account.ID, Status: note.StatusDraft, WorkspaceID: data.Template.WorkspaceID})
+		require.NoError(t, err)
+		require.NoError(t, repo.ReplaceSections(ctx, bodyHit.ID, []note.Section{
+			{FieldID: data.Template.Fields[0].ID, Content: "the kumquat tree needs water"},
+		}))
+
+		query := "kumquat"
+		notes, err := repo.List(ctx, note.Filters{Query: &query})
+		require.NoError(t, err)
+		require.Len(t, notes, 2)
+		assert.Equal(t, titleHit.ID, notes[0].Note.ID)
+		assert.Equal(t, bodyHit.ID, notes[1].Note.ID)
+		assert.Greater(t, notes[0].Rank, notes[1].Rank)
+		assert.Contains(t, notes[1].Snippet, "<mark>kumquat</mark>")
+
+		cursor := notes[0].PageCursor()
+		rest, err := repo.List(ctx, note.Filters{Query: &query, Cursor: &cursor})
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Equal(t, bodyHit.ID, rest[0].Note.ID)
+	})
+
+	t.Run("Snippets escape note content and follow field order", func(t *testing.T) {
+		n, err := repo.Create(ctx, note.Note{Title: "Incident review", TemplateID: data.Template.ID, OwnerID: data.Account.ID, Status: note.StatusDraft, WorkspaceID: data.Template.WorkspaceID})
+		require.NoError(t, err)
+		require.NoError(t, repo.ReplaceSections(ctx, n.ID, []note.Section{
+			{FieldID: data.Template.Fields[1].ID, Content: "second durian"},
+			{FieldID: data.Template.Fields[0].ID, Content: `first durian <script>alert("x")</script>`},
+		}))
+
+		query := "durian"
+		notes, err := repo.List(ctx, note.Filters{Query: &query})
+		require.NoError(t, err)
+		require.Len(t, notes, 1)
+		snippet := notes[0].Snippet
+		assert.NotContains(t, snippet, "<script>")
+		assert.Contains(t, snippet, "&lt;script&gt;")
+		assert.Contains(t, snippet, "<mark>durian</mark>")
+		assert.Less(t, strings.Index(snippet, "first"), strings.Index(snippet, "second"))
+	})
+
+	t.Run("Title update re-indexes the note", func(t *testing.T) {
+		n, err := repo.Create(ctx, note.Note{Title: "before", TemplateID: data.Template.ID, OwnerID: data.Account.ID, Status: note.StatusDraft, WorkspaceID: data.Template.WorkspaceID})
+		require.NoError(t, err)
+		require.NoError(t, repo.ReplaceSections(ctx, n.ID, nil))
+		_, err = repo.Update(ctx, note.Note{ID: n.ID, Title: "persimmon", Version: n.Version})
+		require.NoError(t, err)
+
+		query := "persimmon"
+		notes, err := repo.List(ctx, note.Filters{Query: &query})
+		require.NoError(t, err)
+		require.Len(t, notes, 1)
+		assert.Equal(t, n.ID, notes[0].Note.ID)
+	})
+
+	t.Run("List pages by cursor", func(t *testing.T) {
+		first, err := repo.List(ctx, note.Filters{OwnerID: &data.Account.ID, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+
+		cursor := first[0].PageCursor()
+		rest, err := repo.List(ctx, note.Filters{OwnerID: &data.Account.ID, Cursor: &cursor, Limit: 10})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(rest), 1)
+		for _, n := range rest {
+			assert.NotEqual(t, first[0].Note.ID, n.Note.ID)
+			assert.False(t, n.Note.UpdatedAt.After(first[0].Note.UpdatedAt))
+		}
+	})
 }
 
 func TestNoteRepository_Integration_Sections(t *testing.T) {
@@ -224,13 +473,9 @@ func TestNoteRepository_Integration_Sections(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, got.Sections, 3)
 
-		// Update the first section
-		sections := []note.Section{
-			{
-				ID:      got.Sections[0].Section.ID,
-				Content: "Updated content",
-			},
-		}
+		// Update the first section; sections not listed would be removed
+		sections := note.SnapshotSections(got.Sections)
+		sections[0].Content = "Updated content"
 
 		err = repo.ReplaceSections(ctx, data.Note.ID, sections)
 		require.NoError(t, err)
@@ -247,6 +492,65 @@ func TestNoteRepository_Integration_Sections(t *testing.T) {
 			}
 		}
 		assert.True(t, found, "Updated section not found")
+		assert.Len(t, updated.Sections, 3)
+	})
+
+	t.Run("Update schema version", func(t *testing.T) {
+		current, err := repo.Get(ctx, data.Note.ID)
+		require.NoError(t, err)
+
+		updated, err := repo.UpdateSchemaVersion(ctx, data.Note.ID, current.Note.SchemaVersion+1, current.Note.Version)
+		require.NoError(t, err)
+		assert.Equal(t, current.Note.SchemaVersion+1, updated.SchemaVersion)
+		assert.Equal(t, current.Note.Version+1, updated.Version)
+
+		// A stale version is a conflict
+		_, err = repo.UpdateSchemaVersion(ctx, data.Note.ID, current.Note.SchemaVersion+2, current.Note.Version)
+		assert.ErrorIs(t, err, domainerr.ErrVersionConflict)
+	})
+}
+
+func TestNoteRepository_Integration_Revisions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	data := testutil.CreateDefaultTestData(t, pool)
+	repo := NewNoteRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	sections := []note.Section{{FieldID: data.Template.Fields[0].ID, Content: "first"}}
+	first, err := repo.AppendRevision(ctx, note.NewRevision(data.Note.ID, data.Account.ID, "v1", sections))
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Number)
+
+	sections[0].Content = "second"
+	second, err := repo.AppendRevision(ctx, note.NewRevision(data.Note.ID, data.Account.ID, "v2", sections))
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Number)
+
+	t.Run("List newest first", func(t *testing.T) {
+		revisions, err := repo.ListRevisions(ctx, data.Note.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 2, revisions[0].Number)
+		assert.Equal(t, "v2", revisions[0].Title)
+		assert.Equal(t, data.Account.ID, revisions[0].ActorID)
+	})
+
+	t.Run("Get keeps section snapshot", func(t *testing.T) {
+		got, err := repo.GetRevision(ctx, data.Note.ID, 1)
+		require.NoError(t, err)
+		require.Len(t, got.Sections, 1)
+		assert.Equal(t, "first", got.Sections[0].Content)
+		assert.Equal(t, data.Template.Fields[0].ID, got.Sections[0].FieldID)
+	})
+
+	t.Run("Get unknown revision", func(t *testing.T) {
+		_, err := repo.GetRevision(ctx, data.Note.ID, 99)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
 	})
 }
 
@@ -13,6 +13,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 )
 
 func TestNoteRepository_UpdateStatus(t *testing.T) {
@@ -42,7 +43,7 @@ func TestNoteRepository_UpdateStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := mockdb.NewNoteDBTX(tt.row, tt.rowErr, nil)
 			repo := &NoteRepository{queries: generated.New(mock)}
-			_, err := repo.UpdateStatus(context.Background(), tt.id, note.StatusPublish)
+			_, err := repo.UpdateStatus(context.Background(), tt.id, note.StatusPublish, note.Schedule{}, 1)
 			if tt.wantErr == nil {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
@@ -63,25 +64,36 @@ func TestNoteRepository_UpdateStatus(t *testing.T) {
 func TestNoteRepository_Delete(t *testing.T) {
 	baseID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
 	tests := []struct {
-		name    string
-		id      string
-		execErr error
-		wantErr bool
+		name      string
+		id        string
+		execErr   error
+		stale     bool
+		getRowErr error
+		wantErr   bool
+		wantIs    error
 	}{
 		{name: "[Success] delete note", id: baseID.String()},
 		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
 		{name: "[Fail] exec error", id: baseID.String(), execErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] stale version", id: baseID.String(), stale: true, wantErr: true, wantIs: domainerr.ErrVersionConflict},
+		{name: "[Fail] already deleted", id: baseID.String(), stale: true, getRowErr: pgx.ErrNoRows, wantErr: true, wantIs: domainerr.ErrNotFound},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewNoteDBTX(nil, nil, tt.execErr)
+			mock := mockdb.NewNoteDBTX(nil, tt.getRowErr, tt.execErr).WithGetRow(&generated.GetNoteByIDRow{ID: baseID, Version: 2})
+			if tt.stale {
+				mock.WithRowsAffected(0)
+			}
 			repo := &NoteRepository{queries: generated.New(mock)}
-			err := repo.Delete(context.Background(), tt.id)
+			err := repo.Delete(context.Background(), tt.id, 1)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
 				}
+				if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+					t.Fatalf("want %v, got %v", tt.wantIs, err)
+				}
 			} else if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -89,6 +101,47 @@ func TestNoteRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestNoteRepository_Restore(t *testing.T) {
+	row := &generated.Note{
+		ID:      pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Status:  string(note.StatusDraft),
+		Version: 3,
+	}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr bool
+		wantIs  error
+	}{
+		{name: "[Success] restore note", id: row.ID.String()},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
+		{name: "[Fail] not in trash", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: true, wantIs: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &NoteRepository{queries: generated.New(mockdb.NewNoteDBTX(row, tt.rowErr, nil))}
+			got, err := repo.Restore(context.Background(), tt.id, 2)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+					t.Fatalf("want %v, got %v", tt.wantIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.DeletedAt != nil || got.Version != 3 {
+				t.Fatalf("unexpected note: %+v", got)
+			}
+		})
+	}
+}
+
 func TestNoteRepository_Create(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	row := &generated.Note{
@@ -97,6 +150,7 @@ func TestNoteRepository_Create(t *testing.T) {
 		TemplateID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
 		OwnerID:    pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
 		Status:     string(note.StatusDraft),
+		WorkspaceID: pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
 		CreatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
 		UpdatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
 	}
@@ -110,18 +164,18 @@ func TestNoteRepository_Create(t *testing.T) {
 	}{
 		{
 			name:      "[Success] create note",
-			note:      note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
+			note:      note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft, WorkspaceID: row.WorkspaceID.String()},
 			row:       row,
 			wantTitle: "t",
 		},
 		{
 			name:    "[Fail] invalid template uuid",
-			note:    note.Note{Title: "t", TemplateID: "bad-uuid", OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
+			note:    note.Note{Title: "t", TemplateID: "bad-uuid", OwnerID: row.OwnerID.String(), Status: note.StatusDraft, WorkspaceID: row.WorkspaceID.String()},
 			wantErr: true,
 		},
 		{
 			name:    "[Fail] query error",
-			note:    note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
+			note:    note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft, WorkspaceID: row.WorkspaceID.String()},
 			rowErr:  errors.New("db error"),
 			wantErr: true,
 		},
@@ -276,18 +330,23 @@ func TestNoteRepository_List(t *testing.T) {
 		FirstName:      "Taro",
 		LastName:       "Yamada",
 		OwnerThumbnail: pgtype.Text{String: "thumb", Valid: true},
+		Rank:           0.5,
+		Snippet:        "\x01t\x02",
 	}
 	sections := []*generated.Section{
 		{ID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}, NoteID: noteRow.ID, FieldID: pgtype.UUID{Bytes: [16]byte{8}, Valid: true}, Content: "c"},
 	}
 	tests := []struct {
 		name     string
+		filters  note.Filters
 		notes    []*generated.ListNotesRow
 		sections []*generated.Section
 		queryErr error
 		wantErr  bool
 	}{
 		{name: "[Success] list notes", notes: []*generated.ListNotesRow{noteRow}, sections: sections},
+		{name: "[Success] after cursor", filters: note.Filters{Cursor: &pagination.Cursor{UpdatedAt: now, ID: "00000000-0000-0000-0000-000000000001"}, Limit: 2}, notes: []*generated.ListNotesRow{noteRow}, sections: sections},
+		{name: "[Fail] cursor with malformed id", filters: note.Filters{Cursor: &pagination.Cursor{UpdatedAt: now, ID: "bad"}}, wantErr: true},
 		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
 	}
 
@@ -295,14 +354,39 @@ func TestNoteRepository_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := mockdb.NewNoteDBTX(nil, nil, nil).WithList(tt.notes, tt.sections, tt.queryErr)
 			repo := &NoteRepository{queries: generated.New(mock)}
-			_, err := repo.List(context.Background(), note.Filters{})
+			got, err := repo.List(context.Background(), tt.filters)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
 				}
-			} else if err != nil {
+				return
+			}
+			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
+			if got[0].Rank != noteRow.Rank || got[0].Snippet != "<mark>t</mark>" {
+				t.Fatalf("search metadata not mapped: %+v", got[0])
+			}
+		})
+	}
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet string
+		want    string
+	}{
+		{name: "[Success] marks matches", snippet: "the \x01kumquat\x02 tree", want: "the <mark>kumquat</mark> tree"},
+		{name: "[Success] escapes note markup", snippet: "<script>alert(\"x\")</script> & \x01img\x02 <img onerror=x>", want: "&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; <mark>img</mark> &lt;img onerror=x&gt;"},
+		{name: "[Success] empty without a query", snippet: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlightSnippet(tt.snippet); got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
 		})
 	}
 }
@@ -329,13 +413,14 @@ func TestNoteRepository_ReplaceSections(t *testing.T) {
 		sections []note.Section
 		secRow   *generated.Section
 		rowErr   error
+		execErr  error
 		wantErr  bool
 	}{
 		{
 			name:   "[Success] update existing and create new",
 			noteID: noteID.String(),
 			sections: []note.Section{
-				{ID: existingSection.ID.String(), Content: "updated"},
+				{ID: existingSection.ID.String(), FieldID: existingSection.FieldID.String(), Content: "updated"},
 				{FieldID: newSection.FieldID.String(), Content: "created"},
 			},
 			secRow: existingSection,
@@ -349,7 +434,7 @@ func TestNoteRepository_ReplaceSections(t *testing.T) {
 			name:   "[Fail] invalid section uuid",
 			noteID: noteID.String(),
 			sections: []note.Section{
-				{ID: "bad-sec", Content: "updated"},
+				{ID: "bad-sec", FieldID: existingSection.FieldID.String(), Content: "updated"},
 			},
 			secRow:  existingSection,
 			wantErr: true,
@@ -358,7 +443,7 @@ func TestNoteRepository_ReplaceSections(t *testing.T) {
 			name:   "[Fail] update error",
 			noteID: noteID.String(),
 			sections: []note.Section{
-				{ID: existingSection.ID.String(), Content: "updated"},
+				{ID: existingSection.ID.String(), FieldID: existingSection.FieldID.String(), Content: "updated"},
 			},
 			secRow:  existingSection,
 			rowErr:  errors.New("update err"),
@@ -373,11 +458,21 @@ func TestNoteRepository_ReplaceSections(t *testing.T) {
 			rowErr:  errors.New("create err"),
 			wantErr: true,
 		},
+		{
+			name:   "[Fail] search document refresh error",
+			noteID: noteID.String(),
+			sections: []note.Section{
+				{ID: existingSection.ID.String(), FieldID: existingSection.FieldID.String(), Content: "updated"},
+			},
+			secRow:  existingSection,
+			execErr: errors.New("refresh err"),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewNoteDBTX(nil, tt.rowErr, nil).WithSectionRow(tt.secRow)
+			mock := mockdb.NewNoteDBTX(nil, tt.rowErr, tt.execErr).WithSectionRow(tt.secRow)
 			repo := &NoteRepository{queries: generated.New(mock)}
 			err := repo.ReplaceSections(context.Background(), tt.noteID, tt.sections)
 			if tt.wantErr {
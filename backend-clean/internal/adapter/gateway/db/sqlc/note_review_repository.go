@@ -0,0 +1,115 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteReviewRepository stores the reviewers and review decisions of notes.
+type NoteReviewRepository struct {
+	queries *generated.Queries
+}
+
+var _ port.NoteReviewRepository = (*NoteReviewRepository)(nil)
+
+// NewNoteReviewRepository creates NoteReviewRepository.
+func NewNoteReviewRepository(pool *pgxpool.Pool) *NoteReviewRepository {
+	return &NoteReviewRepository{queries: generated.New(pool)}
+}
+
+// ListReviewers returns the reviewers assigned to a note in assignment order.
+func (r *NoteReviewRepository) ListReviewers(ctx context.Context, noteID string) ([]string, error) {
+	nid, err := toUUID(noteID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNoteReviewers(ctx, nid)
+	if err != nil {
+		return nil, err
+	}
+	reviewers := make([]string, 0, len(rows))
+	for _, id := range rows {
+		reviewers = append(reviewers, uuidToString(id))
+	}
+	return reviewers, nil
+}
+
+// ReplaceReviewers makes reviewerIDs the full set of the note's reviewers.
+// Call it inside a transaction so the note is never left without its previous reviewers on failure.
+func (r *NoteReviewRepository) ReplaceReviewers(ctx context.Context, noteID string, reviewerIDs []string) error {
+	nid, err := toUUID(noteID)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	q := queriesForContext(ctx, r.queries)
+	if err := q.DeleteNoteReviewers(ctx, nid); err != nil {
+		return err
+	}
+	for _, id := range reviewerIDs {
+		rid, err := toUUID(id)
+		if err != nil {
+			return err
+		}
+		if err := q.AddNoteReviewer(ctx, &generated.AddNoteReviewerParams{NoteID: nid, ReviewerID: rid}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppendReview stores a review decision.
+func (r *NoteReviewRepository) AppendReview(ctx context.Context, review note.Review) (*note.Review, error) {
+	nid, err := toUUID(review.NoteID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	rid, err := toUUID(review.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateNoteReview(ctx, &generated.CreateNoteReviewParams{
+		NoteID:     nid,
+		ReviewerID: rid,
+		Decision:   string(review.Decision),
+		Comment:    review.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toDomainReview(row)
+	return &created, nil
+}
+
+// ListReviews returns the review decisions of a note, newest first.
+func (r *NoteReviewRepository) ListReviews(ctx context.Context, noteID string) ([]note.Review, error) {
+	nid, err := toUUID(noteID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNoteReviews(ctx, nid)
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]note.Review, 0, len(rows))
+	for _, row := range rows {
+		reviews = append(reviews, toDomainReview(row))
+	}
+	return reviews, nil
+}
+
+func toDomainReview(row *generated.NoteReview) note.Review {
+	return note.Review{
+		ID:         uuidToString(row.ID),
+		NoteID:     uuidToString(row.NoteID),
+		ReviewerID: uuidToString(row.ReviewerID),
+		Decision:   note.ReviewDecision(row.Decision),
+		Comment:    row.Comment,
+		CreatedAt:  timestamptzToTime(row.CreatedAt),
+	}
+}
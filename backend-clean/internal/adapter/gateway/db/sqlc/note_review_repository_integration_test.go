@@ -0,0 +1,64 @@
+//go:build integration
+
+// Package sqlc implements gateway repositories using sqlc.
+package sqlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestNoteReviewRepository_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewNoteReviewRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	data := testutil.CreateDefaultTestData(t, pool)
+	first := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+	second := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+
+	t.Run("Reviewers are replaced as a set in assignment order", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceReviewers(ctx, data.Note.ID, []string{first.ID, second.ID}))
+		reviewers, err := repo.ListReviewers(ctx, data.Note.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{first.ID, second.ID}, reviewers)
+
+		require.NoError(t, repo.ReplaceReviewers(ctx, data.Note.ID, []string{second.ID}))
+		reviewers, err = repo.ListReviewers(ctx, data.Note.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{second.ID}, reviewers)
+
+		require.NoError(t, repo.ReplaceReviewers(ctx, data.Note.ID, nil))
+		reviewers, err = repo.ListReviewers(ctx, data.Note.ID)
+		require.NoError(t, err)
+		assert.Empty(t, reviewers)
+	})
+
+	t.Run("Reviews are listed newest first", func(t *testing.T) {
+		rejected, err := repo.AppendReview(ctx, note.Review{NoteID: data.Note.ID, ReviewerID: first.ID, Decision: note.ReviewRejected, Comment: "needs sources"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, rejected.ID)
+		assert.False(t, rejected.CreatedAt.IsZero())
+
+		approved, err := repo.AppendReview(ctx, note.Review{NoteID: data.Note.ID, ReviewerID: first.ID, Decision: note.ReviewApproved})
+		require.NoError(t, err)
+
+		reviews, err := repo.ListReviews(ctx, data.Note.ID)
+		require.NoError(t, err)
+		require.Len(t, reviews, 2)
+		assert.Equal(t, approved.ID, reviews[0].ID)
+		assert.Equal(t, rejected.ID, reviews[1].ID)
+		assert.Equal(t, "needs sources", reviews[1].Comment)
+		assert.Equal(t, note.ReviewRejected, reviews[1].Decision)
+	})
+}
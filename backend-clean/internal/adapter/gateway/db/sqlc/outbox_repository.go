@@ -0,0 +1,169 @@
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// OutboxRepository records domain events and settles them for the relay.
+type OutboxRepository struct {
+	queries *generated.Queries
+}
+
+var (
+	_ port.EventPublisher   = (*OutboxRepository)(nil)
+	_ port.OutboxRepository = (*OutboxRepository)(nil)
+	_ port.NoteFeedReader   = (*OutboxRepository)(nil)
+)
+
+// NewOutboxRepository creates OutboxRepository.
+func NewOutboxRepository(pool *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{queries: generated.New(pool)}
+}
+
+// Publish inserts the events using the transaction in ctx, if any.
+func (r *OutboxRepository) Publish(ctx context.Context, events ...outbox.Event) error {
+	q := queriesForContext(ctx, r.queries)
+	for _, e := range events {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return err
+		}
+		err = q.InsertOutboxEvent(ctx, &generated.InsertOutboxEventParams{
+			EventType:     e.Type,
+			AggregateType: e.AggregateType,
+			AggregateID:   e.AggregateID,
+			Payload:       payload,
+			OccurredAt:    pgNullableTime(&e.OccurredAt),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimPending leases up to limit undelivered messages due at now until leaseUntil, oldest first.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]outbox.Message, error) {
+	q := queriesForContext(ctx, r.queries)
+	rows, err := q.ClaimPendingOutbox(ctx, &generated.ClaimPendingOutboxParams{
+		LeaseUntil: pgNullableTime(&leaseUntil),
+		DueAt:      pgNullableTime(&now),
+		ClaimLimit: int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]outbox.Message, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, toDomainOutboxMessage(row))
+	}
+	// UPDATE ... RETURNING does not keep the order of the claim.
+	slices.SortFunc(messages, func(a, b outbox.Message) int {
+		if c := a.OccurredAt.Compare(b.OccurredAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return messages, nil
+}
+
+// MarkDelivered settles a message as delivered.
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id string, at time.Time) error {
+	q := queriesForContext(ctx, r.queries)
+	mid, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return q.MarkOutboxDelivered(ctx, &generated.MarkOutboxDeliveredParams{
+		ID:          mid,
+		DeliveredAt: pgNullableTime(&at),
+	})
+}
+
+// MarkRetry records a failed delivery and schedules the next attempt.
+func (r *OutboxRepository) MarkRetry(ctx context.Context, id string, attempts int, next time.Time, lastError string) error {
+	q := queriesForContext(ctx, r.queries)
+	mid, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return q.MarkOutboxRetry(ctx, &generated.MarkOutboxRetryParams{
+		ID:            mid,
+		Attempts:      int32(attempts), //nolint:gosec
+		NextAttemptAt: pgNullableTime(&next),
+		LastError:     pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+// MarkFailed records the last failed delivery and gives up on the message.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, attempts int, at time.Time, lastError string) error {
+	q := queriesForContext(ctx, r.queries)
+	mid, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return q.MarkOutboxFailed(ctx, &generated.MarkOutboxFailedParams{
+		ID:        mid,
+		Attempts:  int32(attempts), //nolint:gosec
+		FailedAt:  pgNullableTime(&at),
+		LastError: pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+// NoteFeedHead returns the position of the latest readable note event; the zero cursor when there is none.
+func (r *OutboxRepository) NoteFeedHead(ctx context.Context) (outbox.FeedCursor, error) {
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.GetNoteFeedHead(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return outbox.FeedCursor{}, nil
+		}
+		return outbox.FeedCursor{}, err
+	}
+	return outbox.FeedCursor{TxID: row.TxID, Seq: row.Seq}, nil
+}
+
+// ListNoteFeed returns up to limit note events after the given position, in feed order.
+func (r *OutboxRepository) ListNoteFeed(ctx context.Context, after outbox.FeedCursor, limit int) ([]outbox.Message, error) {
+	q := queriesForContext(ctx, r.queries)
+	rows, err := q.ListNoteFeed(ctx, &generated.ListNoteFeedParams{
+		AfterTxID: after.TxID,
+		AfterSeq:  after.Seq,
+		PageLimit: int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]outbox.Message, 0, len(rows))
+	for _, row := range rows {
+		m := toDomainOutboxMessage(row)
+		m.Position = outbox.FeedCursor{TxID: row.TxID, Seq: row.Seq}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+func toDomainOutboxMessage(row *generated.Outbox) outbox.Message {
+	return outbox.Message{
+		ID:            uuidToString(row.ID),
+		Type:          row.EventType,
+		AggregateType: row.AggregateType,
+		AggregateID:   row.AggregateID,
+		OccurredAt:    timestamptzToTime(row.OccurredAt),
+		Payload:       row.Payload,
+		Attempts:      int(row.Attempts),
+	}
+}
@@ -0,0 +1,93 @@
+//go:build integration
+
+// Package sqlc implements gateway repositories using sqlc.
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestOutboxRepository_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewOutboxRepository(pool)
+	tx := driverdb.NewTxManager(pool)
+	ctx := testutil.TestContext(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	claim := func(t *testing.T, at time.Time) []outbox.Message {
+		t.Helper()
+		messages, err := repo.ClaimPending(ctx, at, at.Add(outbox.ClaimLease), 10)
+		require.NoError(t, err)
+		return messages
+	}
+
+	t.Run("Rolled back events are not recorded", func(t *testing.T) {
+		errRollback := errors.New("rollback")
+		err := tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+			require.NoError(t, repo.Publish(txCtx, outbox.AccountEvent(outbox.AccountDeactivated, "acc-0", now)))
+			return errRollback
+		})
+		require.ErrorIs(t, err, errRollback)
+		assert.Empty(t, claim(t, now))
+	})
+
+	t.Run("Committed events are claimed, retried and settled", func(t *testing.T) {
+		err := tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+			return repo.Publish(txCtx,
+				outbox.AccountEvent(outbox.AccountDeactivated, "acc-1", now),
+				outbox.AccountEvent(outbox.AccountDeactivated, "acc-2", now.Add(time.Second)),
+			)
+		})
+		require.NoError(t, err)
+
+		messages := claim(t, now.Add(time.Second))
+		require.Len(t, messages, 2)
+		assert.Equal(t, "acc-1", messages[0].AggregateID)
+		assert.Equal(t, outbox.AccountDeactivated, messages[0].Type)
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(messages[0].Payload, &payload))
+		assert.Equal(t, "acc-1", payload["id"])
+
+		require.NoError(t, repo.MarkDelivered(ctx, messages[0].ID, now))
+		require.NoError(t, repo.MarkRetry(ctx, messages[1].ID, 1, now.Add(time.Minute), "timeout"))
+
+		assert.Empty(t, claim(t, now.Add(time.Second)))
+		retried := claim(t, now.Add(time.Minute))
+		require.Len(t, retried, 1)
+		assert.Equal(t, 1, retried[0].Attempts)
+
+		require.NoError(t, repo.MarkFailed(ctx, retried[0].ID, 2, now, "gone"))
+		assert.Empty(t, claim(t, now.Add(time.Hour)))
+	})
+
+	t.Run("Claimed messages are leased until settled or the lease runs out", func(t *testing.T) {
+		require.NoError(t, repo.Publish(ctx, outbox.AccountEvent(outbox.AccountDeactivated, "acc-3", now)))
+		// Published messages are due from the time they were recorded.
+		at := time.Now().Add(time.Second)
+		held := claim(t, at)
+		require.Len(t, held, 1)
+		assert.Empty(t, claim(t, at.Add(time.Minute)))
+
+		expired := claim(t, at.Add(outbox.ClaimLease))
+		require.Len(t, expired, 1)
+		assert.Equal(t, held[0].ID, expired[0].ID)
+		require.NoError(t, repo.MarkDelivered(ctx, expired[0].ID, now))
+		assert.Empty(t, claim(t, at.Add(time.Hour)))
+	})
+}
@@ -31,6 +31,37 @@ DO UPDATE SET
     first_name = EXCLUDED.first_name,
     last_name = EXCLUDED.last_name,
     thumbnail = EXCLUDED.thumbnail,
-    last_login_at = EXCLUDED.last_login_at,
+    -- A refused login of a deactivated account is not a login.
+    last_login_at = CASE
+        WHEN accounts.is_active THEN EXCLUDED.last_login_at
+        ELSE accounts.last_login_at
+    END,
     updated_at = NOW()
 RETURNING *;
+
+-- name: ReactivateAccount :one
+WITH target AS (
+    SELECT id, last_login_at
+    FROM accounts
+    WHERE accounts.id = sqlc.arg(id)
+      AND is_active = false
+    FOR UPDATE
+), recorded AS (
+    INSERT INTO account_reactivations (account_id, previous_last_login_at, reactivated_at)
+    SELECT id, last_login_at, sqlc.arg(reactivated_at)
+    FROM target
+)
+UPDATE accounts
+SET is_active = true,
+    last_login_at = sqlc.arg(reactivated_at),
+    updated_at = NOW()
+FROM target
+WHERE accounts.id = target.id
+RETURNING accounts.*;
+
+-- name: DeactivateAccountsByLastLoginBefore :many
+UPDATE accounts
+SET is_active = false, updated_at = NOW()
+WHERE last_login_at < $1
+  AND is_active = true
+RETURNING id;
@@ -0,0 +1,33 @@
+-- name: ListNoteReviewers :many
+SELECT reviewer_id
+FROM note_reviewers
+WHERE note_id = $1
+ORDER BY assigned_at, reviewer_id;
+
+-- name: DeleteNoteReviewers :exec
+DELETE FROM note_reviewers
+WHERE note_id = $1;
+
+-- name: AddNoteReviewer :exec
+INSERT INTO note_reviewers (
+    note_id,
+    reviewer_id
+)
+VALUES ($1, $2)
+ON CONFLICT (note_id, reviewer_id) DO NOTHING;
+
+-- name: CreateNoteReview :one
+INSERT INTO note_reviews (
+    note_id,
+    reviewer_id,
+    decision,
+    comment
+)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListNoteReviews :many
+SELECT *
+FROM note_reviews
+WHERE note_id = $1
+ORDER BY created_at DESC, id DESC;
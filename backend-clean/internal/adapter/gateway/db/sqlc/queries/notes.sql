@@ -1,18 +1,92 @@
 -- name: ListNotes :many
+-- Without a query every rank is 0, so the order degrades to (updated_at DESC, id DESC).
+-- The snippet marks matches with chr(1)...chr(2); the repository escapes it and turns them into <mark>.
+WITH matched AS (
+    SELECT
+        n.id,
+        n.title,
+        n.template_id,
+        n.owner_id,
+        n.status,
+        n.created_at,
+        n.updated_at,
+        n.version,
+        n.schema_version,
+        n.publish_at,
+        n.unpublish_at,
+        n.workspace_id,
+        (CASE
+            WHEN NULLIF(sqlc.arg(query)::text, '') IS NULL THEN 0
+            ELSE ts_rank(d.document, websearch_to_tsquery('simple', sqlc.arg(query)::text))
+        END)::real AS rank
+    FROM notes n
+    LEFT JOIN note_search_documents d ON d.note_id = n.id
+    WHERE n.deleted_at IS NULL
+      AND (NULLIF(sqlc.arg(status)::text, '') IS NULL OR n.status = sqlc.arg(status))
+      AND (sqlc.narg(template_id)::uuid IS NULL OR n.template_id = sqlc.narg(template_id))
+      AND (sqlc.narg(owner_id)::uuid IS NULL OR n.owner_id = sqlc.narg(owner_id))
+      AND (sqlc.narg(workspace_id)::uuid IS NULL OR n.workspace_id = sqlc.narg(workspace_id))
+      AND (
+          sqlc.narg(member_id)::uuid IS NULL
+          OR EXISTS (SELECT 1 FROM workspace_members m WHERE m.workspace_id = n.workspace_id AND m.account_id = sqlc.narg(member_id))
+      )
+      AND (
+          sqlc.narg(shared_with)::uuid IS NULL
+          OR EXISTS (SELECT 1 FROM note_shares ns WHERE ns.note_id = n.id AND ns.account_id = sqlc.narg(shared_with))
+      )
+      AND (
+          NULLIF(sqlc.arg(query)::text, '') IS NULL
+          OR d.document @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+      )
+), page AS (
+    SELECT m.*
+    FROM matched m
+    WHERE sqlc.narg(cursor_updated_at)::timestamptz IS NULL
+       OR (m.rank, m.updated_at, m.id) < (sqlc.arg(cursor_rank)::real, sqlc.narg(cursor_updated_at)::timestamptz, sqlc.narg(cursor_id)::uuid)
+    ORDER BY m.rank DESC, m.updated_at DESC, m.id DESC
+    LIMIT sqlc.arg(page_limit)
+)
 SELECT
-    n.*,
+    p.id,
+    p.title,
+    p.template_id,
+    p.owner_id,
+    p.status,
+    p.created_at,
+    p.updated_at,
+    p.version,
+    p.schema_version,
+    p.publish_at,
+    p.unpublish_at,
+    p.workspace_id,
+    p.rank,
     t.name AS template_name,
+    t.schema_version AS latest_schema_version,
     a.first_name,
     a.last_name,
-    a.thumbnail AS owner_thumbnail
-FROM notes n
-JOIN templates t ON t.id = n.template_id
-JOIN accounts a ON a.id = n.owner_id
-WHERE (NULLIF($1::text, '') IS NULL OR n.status = $1)
-  AND ($2::uuid IS NULL OR n.template_id = $2)
-  AND ($3::uuid IS NULL OR n.owner_id = $3)
-  AND (NULLIF($4::text, '') IS NULL OR n.title ILIKE '%' || $4 || '%')
-ORDER BY n.updated_at DESC;
+    a.thumbnail AS owner_thumbnail,
+    (CASE
+        WHEN NULLIF(sqlc.arg(query)::text, '') IS NULL THEN ''
+        ELSE ts_headline(
+            'simple',
+            translate(
+                p.title || ' ' || COALESCE((
+                    SELECT string_agg(s.content, ' ' ORDER BY f."order")
+                    FROM sections s
+                    JOIN fields f ON f.id = s.field_id
+                    WHERE s.note_id = p.id
+                ), ''),
+                chr(1) || chr(2),
+                ''
+            ),
+            websearch_to_tsquery('simple', sqlc.arg(query)::text),
+            'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', MaxFragments=2, MaxWords=20, MinWords=5'
+        )
+    END)::text AS snippet
+FROM page p
+JOIN templates t ON t.id = p.template_id
+JOIN accounts a ON a.id = p.owner_id
+ORDER BY p.rank DESC, p.updated_at DESC, p.id DESC;
 
 -- name: GetNoteByID :one
 SELECT
@@ -20,35 +94,105 @@ SELECT
     t.name AS template_name,
     a.first_name,
     a.last_name,
-    a.thumbnail AS owner_thumbnail
+    a.thumbnail AS owner_thumbnail,
+    t.schema_version AS latest_schema_version
 FROM notes n
 JOIN templates t ON t.id = n.template_id
 JOIN accounts a ON a.id = n.owner_id
-WHERE n.id = $1;
+WHERE n.id = $1 AND n.deleted_at IS NULL;
 
 -- name: CreateNote :one
-INSERT INTO notes (title, template_id, owner_id, status)
-VALUES ($1, $2, $3, $4)
+INSERT INTO notes (title, template_id, owner_id, status, schema_version, workspace_id)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateNote :one
+-- Matches no row when the note moved past the caller's version (or was deleted).
 UPDATE notes
 SET
     title = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
 RETURNING *;
 
--- name: DeleteNote :exec
+-- name: TrashNote :execrows
+UPDATE notes
+SET
+    deleted_at = NOW(),
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NULL;
+
+-- name: GetTrashedNoteByID :one
+SELECT
+    n.*,
+    t.name AS template_name
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+WHERE n.id = $1 AND n.deleted_at IS NOT NULL;
+
+-- name: ListTrashedNotes :many
+SELECT
+    n.*,
+    t.name AS template_name
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+WHERE n.owner_id = $1 AND n.deleted_at IS NOT NULL
+ORDER BY n.deleted_at DESC, n.id DESC;
+
+-- name: RestoreNote :one
+UPDATE notes
+SET
+    deleted_at = NULL,
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeNotesDeletedBefore :execrows
+-- Sections, revisions and the search document cascade away with the note.
 DELETE FROM notes
-WHERE id = $1;
+WHERE deleted_at < $1;
 
 -- name: UpdateNoteStatus :one
+-- The schedule is replaced together with the status, so leaving Scheduled clears publish_at.
 UPDATE notes
 SET
     status = $2,
+    publish_at = $4,
+    unpublish_at = $5,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: PublishDueNotes :many
+-- Published rows no longer match, so running the job again publishes nothing twice.
+UPDATE notes
+SET
+    status = 'Publish',
+    publish_at = NULL,
+    version = version + 1,
+    updated_at = NOW()
+WHERE status = 'Scheduled' AND publish_at <= sqlc.arg(now)::timestamptz AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UnpublishDueNotes :many
+UPDATE notes
+SET
+    status = 'Draft',
+    unpublish_at = NULL,
+    version = version + 1,
+    updated_at = NOW()
+WHERE status = 'Publish' AND unpublish_at <= sqlc.arg(now)::timestamptz AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateNoteSchemaVersion :one
+UPDATE notes
+SET
+    schema_version = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: ListSectionsByNote :many
@@ -56,7 +200,10 @@ SELECT
     s.*,
     f.label,
     f."order",
-    f.is_required
+    f.is_required,
+    f.key AS field_key,
+    f.type AS field_type,
+    f.options AS field_options
 FROM sections s
 JOIN fields f ON f.id = s.field_id
 WHERE s.note_id = $1
@@ -67,12 +214,55 @@ INSERT INTO sections (note_id, field_id, content)
 VALUES ($1, $2, $3)
 RETURNING *;
 
--- name: UpdateSectionContent :one
+-- name: UpdateSection :one
+-- field_id changes only when a note moves to another schema version of its template.
 UPDATE sections
-SET content = $2
+SET
+    field_id = $2,
+    content = $3
 WHERE id = $1
 RETURNING *;
 
+-- name: DeleteSectionsExcept :exec
+DELETE FROM sections
+WHERE note_id = sqlc.arg(note_id) AND NOT (id = ANY(sqlc.arg(keep_ids)::uuid[]));
+
 -- name: DeleteSectionsByNote :exec
 DELETE FROM sections
 WHERE note_id = $1;
+
+-- name: RefreshNoteSearchDocument :exec
+INSERT INTO note_search_documents (note_id, document)
+SELECT
+    n.id,
+    setweight(to_tsvector('simple', n.title), 'A')
+        || setweight(to_tsvector('simple', COALESCE(string_agg(s.content, ' '), '')), 'B')
+FROM notes n
+LEFT JOIN sections s ON s.note_id = n.id
+WHERE n.id = $1
+GROUP BY n.id, n.title
+ON CONFLICT (note_id) DO UPDATE SET document = EXCLUDED.document;
+
+-- name: CreateNoteRevision :one
+-- Callers hold the note row lock (UpdateNote) or own a fresh note, so MAX()+1 does not race.
+INSERT INTO note_revisions (note_id, revision, title, sections, actor_id)
+SELECT
+    sqlc.arg(note_id)::uuid,
+    COALESCE(MAX(r.revision), 0) + 1,
+    sqlc.arg(title)::text,
+    sqlc.arg(sections)::jsonb,
+    sqlc.arg(actor_id)::uuid
+FROM note_revisions r
+WHERE r.note_id = sqlc.arg(note_id)::uuid
+RETURNING *;
+
+-- name: ListNoteRevisions :many
+SELECT *
+FROM note_revisions
+WHERE note_id = $1
+ORDER BY revision DESC;
+
+-- name: GetNoteRevision :one
+SELECT *
+FROM note_revisions
+WHERE note_id = $1 AND revision = $2;
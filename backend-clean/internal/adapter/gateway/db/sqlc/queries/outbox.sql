@@ -0,0 +1,60 @@
+-- name: InsertOutboxEvent :exec
+INSERT INTO outbox (
+    event_type,
+    aggregate_type,
+    aggregate_id,
+    payload,
+    occurred_at
+)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ClaimPendingOutbox :many
+UPDATE outbox
+SET next_attempt_at = sqlc.arg(lease_until)
+WHERE id IN (
+    SELECT id
+    FROM outbox
+    WHERE delivered_at IS NULL
+      AND failed_at IS NULL
+      AND next_attempt_at <= sqlc.arg(due_at)
+    ORDER BY occurred_at, id
+    LIMIT sqlc.arg(claim_limit)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetNoteFeedHead :one
+SELECT tx_id, seq
+FROM outbox
+WHERE aggregate_type = 'note'
+  AND tx_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY tx_id DESC, seq DESC
+LIMIT 1;
+
+-- name: ListNoteFeed :many
+SELECT *
+FROM outbox
+WHERE aggregate_type = 'note'
+  AND (tx_id, seq) > (sqlc.arg(after_tx_id)::bigint, sqlc.arg(after_seq)::bigint)
+  AND tx_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY tx_id, seq
+LIMIT sqlc.arg(page_limit);
+
+-- name: MarkOutboxDelivered :exec
+UPDATE outbox
+SET delivered_at = $2
+WHERE id = $1;
+
+-- name: MarkOutboxRetry :exec
+UPDATE outbox
+SET attempts = $2,
+    next_attempt_at = $3,
+    last_error = $4
+WHERE id = $1;
+
+-- name: MarkOutboxFailed :exec
+UPDATE outbox
+SET attempts = $2,
+    failed_at = $3,
+    last_error = $4
+WHERE id = $1;
@@ -0,0 +1,25 @@
+-- name: CreateSession :one
+INSERT INTO sessions (
+    account_id,
+    refresh_token_hash,
+    expires_at
+)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetSessionByTokenHash :one
+SELECT *
+FROM sessions
+WHERE refresh_token_hash = $1;
+
+-- name: RevokeSession :execrows
+UPDATE sessions
+SET revoked_at = $2
+WHERE id = $1
+  AND revoked_at IS NULL;
+
+-- name: RevokeSessionsByAccount :exec
+UPDATE sessions
+SET revoked_at = $2
+WHERE account_id = $1
+  AND revoked_at IS NULL;
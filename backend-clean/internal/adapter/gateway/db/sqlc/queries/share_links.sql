@@ -0,0 +1,33 @@
+-- name: CreateNoteShareLink :one
+INSERT INTO note_share_links (
+    note_id,
+    token_hash,
+    created_by,
+    expires_at
+)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetNoteShareLinkByTokenHash :one
+SELECT *
+FROM note_share_links
+WHERE token_hash = $1;
+
+-- name: ListNoteShareLinks :many
+SELECT *
+FROM note_share_links
+WHERE note_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: RevokeNoteShareLink :execrows
+UPDATE note_share_links
+SET revoked_at = $3
+WHERE id = $1
+  AND note_id = $2
+  AND revoked_at IS NULL;
+
+-- name: RevokeNoteShareLinksByNote :execrows
+UPDATE note_share_links
+SET revoked_at = $2
+WHERE note_id = $1
+  AND revoked_at IS NULL;
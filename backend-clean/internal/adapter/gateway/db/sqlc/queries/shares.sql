@@ -0,0 +1,61 @@
+-- name: ListNoteShares :many
+SELECT *
+FROM note_shares
+WHERE note_id = $1
+ORDER BY created_at, account_id;
+
+-- name: GetNoteShare :one
+SELECT *
+FROM note_shares
+WHERE note_id = $1 AND account_id = $2;
+
+-- name: UpsertNoteShare :one
+-- Sharing again with the same account changes its role.
+INSERT INTO note_shares (
+    note_id,
+    account_id,
+    role,
+    granted_by
+)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (note_id, account_id) DO UPDATE
+SET
+    role = EXCLUDED.role,
+    granted_by = EXCLUDED.granted_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteNoteShare :execrows
+DELETE FROM note_shares
+WHERE note_id = $1 AND account_id = $2;
+
+-- name: ListTemplateShares :many
+SELECT *
+FROM template_shares
+WHERE template_id = $1
+ORDER BY created_at, account_id;
+
+-- name: GetTemplateShare :one
+SELECT *
+FROM template_shares
+WHERE template_id = $1 AND account_id = $2;
+
+-- name: UpsertTemplateShare :one
+-- Sharing again with the same account changes its role.
+INSERT INTO template_shares (
+    template_id,
+    account_id,
+    role,
+    granted_by
+)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (template_id, account_id) DO UPDATE
+SET
+    role = EXCLUDED.role,
+    granted_by = EXCLUDED.granted_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteTemplateShare :execrows
+DELETE FROM template_shares
+WHERE template_id = $1 AND account_id = $2;
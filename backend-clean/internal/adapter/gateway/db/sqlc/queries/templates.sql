@@ -7,14 +7,26 @@ SELECT
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
         LIMIT 1
     ) AS is_used
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE ($1::uuid IS NULL OR t.owner_id = $1)
-  AND ($2::text IS NULL OR t.name ILIKE '%' || $2 || '%')
-ORDER BY t.updated_at DESC;
+WHERE t.deleted_at IS NULL
+  AND (sqlc.narg(owner_id)::uuid IS NULL OR t.owner_id = sqlc.narg(owner_id))
+  AND (sqlc.narg(workspace_id)::uuid IS NULL OR t.workspace_id = sqlc.narg(workspace_id))
+  AND (
+      sqlc.narg(member_id)::uuid IS NULL
+      OR EXISTS (SELECT 1 FROM workspace_members m WHERE m.workspace_id = t.workspace_id AND m.account_id = sqlc.narg(member_id))
+  )
+  AND (NULLIF(sqlc.arg(query)::text, '') IS NULL OR t.name ILIKE '%' || sqlc.arg(query) || '%')
+  AND (
+      sqlc.narg(cursor_updated_at)::timestamptz IS NULL
+      OR (t.updated_at, t.id) < (sqlc.narg(cursor_updated_at)::timestamptz, sqlc.narg(cursor_id)::uuid)
+  )
+  AND (NOT sqlc.arg(public_only)::boolean OR t.is_public)
+ORDER BY t.updated_at DESC, t.id DESC
+LIMIT sqlc.arg(page_limit);
 
 -- name: GetTemplateByID :one
 SELECT
@@ -25,44 +37,109 @@ SELECT
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
         LIMIT 1
     ) AS is_used
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE t.id = $1;
+WHERE t.id = $1 AND t.deleted_at IS NULL;
 
 -- name: CreateTemplate :one
-INSERT INTO templates (name, owner_id)
-VALUES ($1, $2)
+INSERT INTO templates (name, owner_id, workspace_id)
+VALUES ($1, $2, $3)
 RETURNING *;
 
 -- name: UpdateTemplate :one
+-- Matches no row when the template moved past the caller's version (or was deleted).
 UPDATE templates
 SET
     name = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
 RETURNING *;
 
--- name: DeleteTemplate :exec
-DELETE FROM templates
-WHERE id = $1;
+-- name: TrashTemplate :execrows
+UPDATE templates
+SET
+    deleted_at = NOW(),
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NULL;
+
+-- name: GetTrashedTemplateByID :one
+SELECT *
+FROM templates
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: ListTrashedTemplates :many
+SELECT *
+FROM templates
+WHERE owner_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id DESC;
+
+-- name: RestoreTemplate :one
+UPDATE templates
+SET
+    deleted_at = NULL,
+    version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeTemplatesDeletedBefore :execrows
+-- A template still referenced by a note in the trash waits until that note is purged.
+DELETE FROM templates t
+WHERE t.deleted_at < $1
+  AND NOT EXISTS (SELECT 1 FROM notes n WHERE n.template_id = t.id);
 
 -- name: CheckTemplateInUse :one
 SELECT EXISTS (
-    SELECT 1 FROM notes WHERE template_id = $1
+    SELECT 1 FROM notes WHERE template_id = $1 AND deleted_at IS NULL
 ) AS is_used;
 
+-- name: SetTemplatePublic :one
+-- Matches no row when the template moved past the caller's version (or was deleted).
+UPDATE templates
+SET
+    is_public = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SetTemplateSchemaVersion :exec
+UPDATE templates
+SET
+    schema_version = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
 -- name: ListFieldsByTemplate :many
 SELECT *
 FROM fields
-WHERE template_id = $1
+WHERE template_id = $1 AND schema_version = $2
 ORDER BY "order" ASC;
 
 -- name: CreateField :one
-INSERT INTO fields (template_id, label, "order", is_required)
-VALUES ($1, $2, $3, $4)
+-- A NULL key starts a new field identity; passing the key of an earlier version's field continues it.
+INSERT INTO fields (
+    template_id, schema_version, key, label, "order", is_required, type, options,
+    min_length, max_length, pattern, placeholder, default_content
+)
+VALUES (
+    sqlc.arg(template_id),
+    sqlc.arg(schema_version),
+    COALESCE(sqlc.narg(key)::uuid, gen_random_uuid()),
+    sqlc.arg(label),
+    sqlc.arg(field_order),
+    sqlc.arg(is_required),
+    sqlc.arg(type),
+    sqlc.arg(options)::text[],
+    sqlc.arg(min_length),
+    sqlc.arg(max_length),
+    sqlc.arg(pattern),
+    sqlc.arg(placeholder),
+    sqlc.arg(default_content)
+)
 RETURNING *;
 
 -- name: UpdateField :one
@@ -74,10 +151,6 @@ SET
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteFieldsByTemplate :exec
-DELETE FROM fields
-WHERE template_id = $1;
-
 -- name: DeleteField :exec
 DELETE FROM fields
 WHERE id = $1;
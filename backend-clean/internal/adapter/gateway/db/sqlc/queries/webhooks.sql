@@ -0,0 +1,117 @@
+-- name: ListWebhookSubscriptionsByOwner :many
+SELECT *
+FROM webhook_subscriptions
+WHERE owner_id = $1
+ORDER BY created_at, id;
+
+-- name: ListWebhookSubscriptionsForEvent :many
+SELECT *
+FROM webhook_subscriptions
+WHERE owner_id = $1
+  AND sqlc.arg(event_type)::text = ANY(event_types)
+ORDER BY created_at, id;
+
+-- name: GetWebhookSubscription :one
+SELECT *
+FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    owner_id,
+    url,
+    secret,
+    event_types
+)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = $2,
+    secret = $3,
+    event_types = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: GetWebhookDelivery :one
+SELECT *
+FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: EnqueueWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_id,
+    event_type,
+    payload,
+    next_attempt_at
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (subscription_id, event_id) WHERE replay_of IS NULL DO NOTHING;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_id,
+    event_type,
+    payload,
+    next_attempt_at,
+    replay_of
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until)
+FROM webhook_subscriptions s
+WHERE s.id = d.subscription_id
+  AND d.id IN (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'pending'
+      AND next_attempt_at <= sqlc.arg(due_at)
+    ORDER BY next_attempt_at, id
+    LIMIT sqlc.arg(claim_limit)
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.response_status, d.last_error, d.next_attempt_at, d.delivered_at, d.failed_at, d.replay_of, d.created_at, s.url, s.secret;
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded',
+    attempts = $2,
+    response_status = $3,
+    delivered_at = $4,
+    last_error = NULL
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryRetry :exec
+UPDATE webhook_deliveries
+SET attempts = $2,
+    response_status = $3,
+    next_attempt_at = $4,
+    last_error = $5
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = 'failed',
+    attempts = $2,
+    response_status = $3,
+    failed_at = $4,
+    last_error = $5
+WHERE id = $1;
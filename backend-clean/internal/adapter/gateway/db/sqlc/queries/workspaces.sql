@@ -0,0 +1,82 @@
+-- name: ListWorkspacesByMember :many
+-- The personal workspace comes first, then the others in the order they were created.
+SELECT
+    w.*,
+    m.role
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.account_id = $1
+ORDER BY w.personal DESC, w.created_at, w.id;
+
+-- name: GetWorkspaceByID :one
+SELECT *
+FROM workspaces
+WHERE id = $1;
+
+-- name: GetPersonalWorkspace :one
+SELECT *
+FROM workspaces
+WHERE owner_id = $1 AND personal;
+
+-- name: CreateWorkspace :one
+INSERT INTO workspaces (name, owner_id, personal)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: CreatePersonalWorkspace :exec
+-- Concurrent first uses race to create it; the loser keeps the winner's workspace.
+INSERT INTO workspaces (name, owner_id, personal)
+VALUES ($1, $2, TRUE)
+ON CONFLICT (owner_id) WHERE personal DO NOTHING;
+
+-- name: ListWorkspaceMembers :many
+SELECT *
+FROM workspace_members
+WHERE workspace_id = $1
+ORDER BY joined_at, account_id;
+
+-- name: GetWorkspaceMember :one
+SELECT *
+FROM workspace_members
+WHERE workspace_id = $1 AND account_id = $2;
+
+-- name: AddWorkspaceMember :one
+-- Adding an existing member keeps the role they already have.
+INSERT INTO workspace_members (workspace_id, account_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (workspace_id, account_id) DO UPDATE
+SET role = workspace_members.role
+RETURNING *;
+
+-- name: DeleteWorkspaceMember :execrows
+DELETE FROM workspace_members
+WHERE workspace_id = $1 AND account_id = $2;
+
+-- name: UpsertWorkspaceInvitation :one
+-- Inviting a pending address again changes the role and restarts the invitation.
+INSERT INTO workspace_invitations (workspace_id, email, role, invited_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, lower(email)) WHERE accepted_at IS NULL DO UPDATE
+SET
+    role = EXCLUDED.role,
+    invited_by = EXCLUDED.invited_by,
+    created_at = NOW()
+RETURNING *;
+
+-- name: GetWorkspaceInvitation :one
+SELECT *
+FROM workspace_invitations
+WHERE id = $1;
+
+-- name: ListPendingInvitationsByEmail :many
+SELECT *
+FROM workspace_invitations
+WHERE lower(email) = lower(sqlc.arg(email)::text) AND accepted_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: AcceptWorkspaceInvitation :one
+-- Matches no row once accepted, so an invitation is used at most once.
+UPDATE workspace_invitations
+SET accepted_at = NOW()
+WHERE id = $1 AND accepted_at IS NULL
+RETURNING *;
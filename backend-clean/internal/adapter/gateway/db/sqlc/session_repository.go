@@ -0,0 +1,111 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/session"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SessionRepository implements refresh session persistence.
+type SessionRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.SessionRepository = (*SessionRepository)(nil)
+
+// NewSessionRepository creates SessionRepository.
+func NewSessionRepository(pool *pgxpool.Pool) *SessionRepository {
+	return &SessionRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Create inserts a new session.
+func (r *SessionRepository) Create(ctx context.Context, s session.Session) (*session.Session, error) {
+	q := queriesForContext(ctx, r.queries)
+	accountID, err := toUUID(s.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := q.CreateSession(ctx, &generated.CreateSessionParams{
+		AccountID:        accountID,
+		RefreshTokenHash: s.RefreshTokenHash,
+		ExpiresAt:        pgNullableTime(&s.ExpiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSession(row), nil
+}
+
+// GetByTokenHash fetches a session by refresh token hash.
+func (r *SessionRepository) GetByTokenHash(ctx context.Context, hash string) (*session.Session, error) {
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.GetSessionByTokenHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainSession(row), nil
+}
+
+// Revoke marks a live session revoked.
+func (r *SessionRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	q := queriesForContext(ctx, r.queries)
+	sid, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	n, err := q.RevokeSession(ctx, &generated.RevokeSessionParams{
+		ID:        sid,
+		RevokedAt: pgNullableTime(&at),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// RevokeAllByAccount revokes every live session of the account.
+func (r *SessionRepository) RevokeAllByAccount(ctx context.Context, accountID string, at time.Time) error {
+	q := queriesForContext(ctx, r.queries)
+	aid, err := toUUID(accountID)
+	if err != nil {
+		return err
+	}
+	return q.RevokeSessionsByAccount(ctx, &generated.RevokeSessionsByAccountParams{
+		AccountID: aid,
+		RevokedAt: pgNullableTime(&at),
+	})
+}
+
+func toDomainSession(s *generated.Session) *session.Session {
+	var revokedAt *time.Time
+	if s.RevokedAt.Valid {
+		t := timestamptzToTime(s.RevokedAt)
+		revokedAt = &t
+	}
+	return &session.Session{
+		ID:               uuidToString(s.ID),
+		AccountID:        uuidToString(s.AccountID),
+		RefreshTokenHash: s.RefreshTokenHash,
+		ExpiresAt:        timestamptzToTime(s.ExpiresAt),
+		RevokedAt:        revokedAt,
+		CreatedAt:        timestamptzToTime(s.CreatedAt),
+	}
+}
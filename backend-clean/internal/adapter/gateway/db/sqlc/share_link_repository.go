@@ -0,0 +1,127 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareLinkRepository stores public links to notes by the hash of their token.
+type ShareLinkRepository struct {
+	queries *generated.Queries
+}
+
+var _ port.ShareLinkRepository = (*ShareLinkRepository)(nil)
+
+// NewShareLinkRepository creates ShareLinkRepository.
+func NewShareLinkRepository(pool *pgxpool.Pool) *ShareLinkRepository {
+	return &ShareLinkRepository{queries: generated.New(pool)}
+}
+
+// Create inserts a link.
+func (r *ShareLinkRepository) Create(ctx context.Context, l sharelink.Link) (*sharelink.Link, error) {
+	noteID, err := toUUID(l.NoteID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	createdBy, err := toUUID(l.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateNoteShareLink(ctx, &generated.CreateNoteShareLinkParams{
+		NoteID:    noteID,
+		TokenHash: l.TokenHash,
+		CreatedBy: createdBy,
+		ExpiresAt: pgNullableTime(l.ExpiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainShareLink(row), nil
+}
+
+// GetByTokenHash returns a link by the hash of its token, revoked or not.
+func (r *ShareLinkRepository) GetByTokenHash(ctx context.Context, hash string) (*sharelink.Link, error) {
+	row, err := queriesForContext(ctx, r.queries).GetNoteShareLinkByTokenHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainShareLink(row), nil
+}
+
+// List returns the links of a note, newest first.
+func (r *ShareLinkRepository) List(ctx context.Context, noteID string) ([]sharelink.Link, error) {
+	nid, err := toUUID(noteID)
+	if err != nil {
+		return []sharelink.Link{}, nil
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNoteShareLinks(ctx, nid)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]sharelink.Link, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, *toDomainShareLink(row))
+	}
+	return links, nil
+}
+
+// Revoke marks a live link of a note revoked.
+func (r *ShareLinkRepository) Revoke(ctx context.Context, noteID, id string, at time.Time) error {
+	nid, err := toUUID(noteID)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	lid, err := toUUID(id)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).RevokeNoteShareLink(ctx, &generated.RevokeNoteShareLinkParams{
+		ID:        lid,
+		NoteID:    nid,
+		RevokedAt: pgNullableTime(&at),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// RevokeByNote marks every live link of a note revoked.
+func (r *ShareLinkRepository) RevokeByNote(ctx context.Context, noteID string, at time.Time) error {
+	nid, err := toUUID(noteID)
+	if err != nil {
+		return err
+	}
+	_, err = queriesForContext(ctx, r.queries).RevokeNoteShareLinksByNote(ctx, &generated.RevokeNoteShareLinksByNoteParams{
+		NoteID:    nid,
+		RevokedAt: pgNullableTime(&at),
+	})
+	return err
+}
+
+func toDomainShareLink(row *generated.NoteShareLink) *sharelink.Link {
+	return &sharelink.Link{
+		ID:        uuidToString(row.ID),
+		NoteID:    uuidToString(row.NoteID),
+		TokenHash: row.TokenHash,
+		CreatedBy: uuidToString(row.CreatedBy),
+		ExpiresAt: nullableTimestamptzToTime(row.ExpiresAt),
+		RevokedAt: nullableTimestamptzToTime(row.RevokedAt),
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+	}
+}
@@ -0,0 +1,72 @@
+//go:build integration
+
+// Package sqlc implements gateway repositories using sqlc.
+package sqlc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestShareLinkRepository_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewShareLinkRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	data := testutil.CreateDefaultTestData(t, pool)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	var first *sharelink.Link
+	t.Run("Create stores the token hash and is found by it", func(t *testing.T) {
+		var err error
+		first, err = repo.Create(ctx, sharelink.Link{NoteID: data.Note.ID, TokenHash: sharelink.HashToken("first"), CreatedBy: data.Account.ID, ExpiresAt: &expiresAt})
+		require.NoError(t, err)
+		require.NotNil(t, first.ExpiresAt)
+		assert.True(t, expiresAt.Equal(*first.ExpiresAt))
+		assert.Nil(t, first.RevokedAt)
+
+		got, err := repo.GetByTokenHash(ctx, sharelink.HashToken("first"))
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, got.ID)
+
+		_, err = repo.GetByTokenHash(ctx, sharelink.HashToken("unknown"))
+		assert.ErrorIs(t, err, domainerr.ErrNotFound)
+	})
+
+	t.Run("Revoke marks one live link revoked once", func(t *testing.T) {
+		require.NoError(t, repo.Revoke(ctx, data.Note.ID, first.ID, time.Now()))
+		assert.ErrorIs(t, repo.Revoke(ctx, data.Note.ID, first.ID, time.Now()), domainerr.ErrNotFound)
+
+		got, err := repo.GetByTokenHash(ctx, sharelink.HashToken("first"))
+		require.NoError(t, err)
+		assert.NotNil(t, got.RevokedAt)
+	})
+
+	t.Run("RevokeByNote revokes every live link and List keeps them", func(t *testing.T) {
+		second, err := repo.Create(ctx, sharelink.Link{NoteID: data.Note.ID, TokenHash: sharelink.HashToken("second"), CreatedBy: data.Account.ID})
+		require.NoError(t, err)
+		assert.Nil(t, second.ExpiresAt)
+
+		require.NoError(t, repo.RevokeByNote(ctx, data.Note.ID, time.Now()))
+
+		links, err := repo.List(ctx, data.Note.ID)
+		require.NoError(t, err)
+		require.Len(t, links, 2)
+		assert.Equal(t, second.ID, links[0].ID)
+		for _, l := range links {
+			assert.NotNil(t, l.RevokedAt)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareRepository stores the grants on notes and templates, one table per resource type.
+type ShareRepository struct {
+	queries *generated.Queries
+}
+
+var _ port.ShareRepository = (*ShareRepository)(nil)
+
+// NewShareRepository creates ShareRepository.
+func NewShareRepository(pool *pgxpool.Pool) *ShareRepository {
+	return &ShareRepository{queries: generated.New(pool)}
+}
+
+// List returns the grants on a resource, oldest first.
+func (r *ShareRepository) List(ctx context.Context, resourceType share.ResourceType, resourceID string) ([]share.Grant, error) {
+	rid, err := toUUID(resourceID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	q := queriesForContext(ctx, r.queries)
+	var grants []share.Grant
+	switch resourceType {
+	case share.ResourceNote:
+		rows, err := q.ListNoteShares(ctx, rid)
+		if err != nil {
+			return nil, err
+		}
+		grants = make([]share.Grant, 0, len(rows))
+		for _, row := range rows {
+			grants = append(grants, toDomainNoteShare(row))
+		}
+	case share.ResourceTemplate:
+		rows, err := q.ListTemplateShares(ctx, rid)
+		if err != nil {
+			return nil, err
+		}
+		grants = make([]share.Grant, 0, len(rows))
+		for _, row := range rows {
+			grants = append(grants, toDomainTemplateShare(row))
+		}
+	default:
+		return nil, domainerr.ErrNotFound
+	}
+	return grants, nil
+}
+
+// Get returns the grant of accountID on a resource.
+func (r *ShareRepository) Get(ctx context.Context, resourceType share.ResourceType, resourceID, accountID string) (*share.Grant, error) {
+	rid, aid, err := shareKey(resourceID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	var grant share.Grant
+	switch resourceType {
+	case share.ResourceNote:
+		row, err := q.GetNoteShare(ctx, &generated.GetNoteShareParams{NoteID: rid, AccountID: aid})
+		if err != nil {
+			return nil, shareNotFound(err)
+		}
+		grant = toDomainNoteShare(row)
+	case share.ResourceTemplate:
+		row, err := q.GetTemplateShare(ctx, &generated.GetTemplateShareParams{TemplateID: rid, AccountID: aid})
+		if err != nil {
+			return nil, shareNotFound(err)
+		}
+		grant = toDomainTemplateShare(row)
+	default:
+		return nil, domainerr.ErrNotFound
+	}
+	return &grant, nil
+}
+
+// Upsert creates the grant or changes the role of an existing one.
+func (r *ShareRepository) Upsert(ctx context.Context, g share.Grant) (*share.Grant, error) {
+	rid, aid, err := shareKey(g.ResourceID, g.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	grantedBy, err := toUUID(g.GrantedBy)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	var saved share.Grant
+	switch g.ResourceType {
+	case share.ResourceNote:
+		row, err := q.UpsertNoteShare(ctx, &generated.UpsertNoteShareParams{NoteID: rid, AccountID: aid, Role: string(g.Role), GrantedBy: grantedBy})
+		if err != nil {
+			return nil, err
+		}
+		saved = toDomainNoteShare(row)
+	case share.ResourceTemplate:
+		row, err := q.UpsertTemplateShare(ctx, &generated.UpsertTemplateShareParams{TemplateID: rid, AccountID: aid, Role: string(g.Role), GrantedBy: grantedBy})
+		if err != nil {
+			return nil, err
+		}
+		saved = toDomainTemplateShare(row)
+	default:
+		return nil, domainerr.ErrNotFound
+	}
+	return &saved, nil
+}
+
+// Delete removes the grant of accountID on a resource.
+func (r *ShareRepository) Delete(ctx context.Context, resourceType share.ResourceType, resourceID, accountID string) error {
+	rid, aid, err := shareKey(resourceID, accountID)
+	if err != nil {
+		return err
+	}
+	q := queriesForContext(ctx, r.queries)
+	var n int64
+	switch resourceType {
+	case share.ResourceNote:
+		n, err = q.DeleteNoteShare(ctx, &generated.DeleteNoteShareParams{NoteID: rid, AccountID: aid})
+	case share.ResourceTemplate:
+		n, err = q.DeleteTemplateShare(ctx, &generated.DeleteTemplateShareParams{TemplateID: rid, AccountID: aid})
+	}
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// shareKey parses the IDs of a grant; a malformed ID cannot match any grant.
+func shareKey(resourceID, accountID string) (pgtype.UUID, pgtype.UUID, error) {
+	rid, err := toUUID(resourceID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, domainerr.ErrNotFound
+	}
+	aid, err := toUUID(accountID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, domainerr.ErrNotFound
+	}
+	return rid, aid, nil
+}
+
+func shareNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainerr.ErrNotFound
+	}
+	return err
+}
+
+func toDomainNoteShare(row *generated.NoteShare) share.Grant {
+	return share.Grant{
+		ResourceType: share.ResourceNote,
+		ResourceID:   uuidToString(row.NoteID),
+		AccountID:    uuidToString(row.AccountID),
+		Role:         share.Role(row.Role),
+		GrantedBy:    uuidToString(row.GrantedBy),
+		CreatedAt:    timestamptzToTime(row.CreatedAt),
+		UpdatedAt:    timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func toDomainTemplateShare(row *generated.TemplateShare) share.Grant {
+	return share.Grant{
+		ResourceType: share.ResourceTemplate,
+		ResourceID:   uuidToString(row.TemplateID),
+		AccountID:    uuidToString(row.AccountID),
+		Role:         share.Role(row.Role),
+		GrantedBy:    uuidToString(row.GrantedBy),
+		CreatedAt:    timestamptzToTime(row.CreatedAt),
+		UpdatedAt:    timestamptzToTime(row.UpdatedAt),
+	}
+}
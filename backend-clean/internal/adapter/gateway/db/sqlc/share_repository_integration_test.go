@@ -0,0 +1,77 @@
+//go:build integration
+
+// Package sqlc implements gateway repositories using sqlc.
+package sqlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestShareRepository_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewShareRepository(pool)
+	notes := NewNoteRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	data := testutil.CreateDefaultTestData(t, pool)
+	viewer := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+	editor := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+
+	t.Run("Upsert creates a grant and changes its role", func(t *testing.T) {
+		created, err := repo.Upsert(ctx, share.Grant{ResourceType: share.ResourceNote, ResourceID: data.Note.ID, AccountID: viewer.ID, Role: share.RoleEditor, GrantedBy: data.Account.ID})
+		require.NoError(t, err)
+		assert.Equal(t, share.RoleEditor, created.Role)
+		assert.False(t, created.CreatedAt.IsZero())
+
+		updated, err := repo.Upsert(ctx, share.Grant{ResourceType: share.ResourceNote, ResourceID: data.Note.ID, AccountID: viewer.ID, Role: share.RoleViewer, GrantedBy: data.Account.ID})
+		require.NoError(t, err)
+		assert.Equal(t, share.RoleViewer, updated.Role)
+		assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+		got, err := repo.Get(ctx, share.ResourceNote, data.Note.ID, viewer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, share.RoleViewer, got.Role)
+	})
+
+	t.Run("Grants are kept per resource type", func(t *testing.T) {
+		_, err := repo.Upsert(ctx, share.Grant{ResourceType: share.ResourceTemplate, ResourceID: data.Template.ID, AccountID: editor.ID, Role: share.RoleEditor, GrantedBy: data.Account.ID})
+		require.NoError(t, err)
+
+		grants, err := repo.List(ctx, share.ResourceTemplate, data.Template.ID)
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, editor.ID, grants[0].AccountID)
+
+		_, err = repo.Get(ctx, share.ResourceNote, data.Note.ID, editor.ID)
+		assert.ErrorIs(t, err, domainerr.ErrNotFound)
+	})
+
+	t.Run("Shared with me lists notes shared with the account", func(t *testing.T) {
+		shared, err := notes.List(ctx, note.Filters{SharedWith: &viewer.ID})
+		require.NoError(t, err)
+		require.Len(t, shared, 1)
+		assert.Equal(t, data.Note.ID, shared[0].Note.ID)
+
+		none, err := notes.List(ctx, note.Filters{SharedWith: &editor.ID})
+		require.NoError(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("Delete removes the grant", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, share.ResourceNote, data.Note.ID, viewer.ID))
+		assert.ErrorIs(t, repo.Delete(ctx, share.ResourceNote, data.Note.ID, viewer.ID), domainerr.ErrNotFound)
+	})
+}
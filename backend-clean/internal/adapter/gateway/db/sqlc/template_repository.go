@@ -4,6 +4,7 @@ package sqlc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -45,16 +46,41 @@ func NewTemplateRepository(pool *pgxpool.Pool) *TemplateRepository {
 	}
 }
 
-// List returns templates by filters.
+// List returns one page of templates by filters, newest first.
 func (r *TemplateRepository) List(ctx context.Context, filters template.Filters) ([]template.WithUsage, error) {
-	params := &generated.ListTemplatesParams{}
+	params := &generated.ListTemplatesParams{
+		PublicOnly: filters.PublicOnly,
+		PageLimit:  pageLimit(filters.Limit),
+	}
 	if filters.OwnerID != nil && *filters.OwnerID != "" {
 		if id, err := toUUID(*filters.OwnerID); err == nil {
-			params.Column1 = id
+			params.OwnerID = id
+		}
+	}
+	// A malformed workspace or member ID matches nothing instead of widening the list.
+	if filters.WorkspaceID != nil {
+		id, err := toUUID(*filters.WorkspaceID)
+		if err != nil {
+			return []template.WithUsage{}, nil
+		}
+		params.WorkspaceID = id
+	}
+	if filters.MemberID != nil {
+		id, err := toUUID(*filters.MemberID)
+		if err != nil {
+			return []template.WithUsage{}, nil
 		}
+		params.MemberID = id
 	}
 	if filters.Query != nil && *filters.Query != "" {
-		params.Column2 = *filters.Query
+		params.Query = *filters.Query
+	}
+	if filters.Cursor != nil {
+		updatedAt, id, err := cursorParams(*filters.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.CursorUpdatedAt, params.CursorID = updatedAt, id
 	}
 
 	rows, err := queriesForContext(ctx, r.queries).ListTemplates(ctx, params)
@@ -64,18 +90,22 @@ func (r *TemplateRepository) List(ctx context.Context, filters template.Filters)
 
 	result := make([]template.WithUsage, 0, len(rows))
 	for _, row := range rows {
-		fields, err := r.listFields(ctx, row.ID)
+		fields, err := r.listFields(ctx, row.ID, row.SchemaVersion)
 		if err != nil {
 			return nil, err
 		}
 		owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
 		result = append(result, template.WithUsage{
 			Template: template.Template{
-				ID:        uuidToString(row.ID),
-				Name:      row.Name,
-				OwnerID:   uuidToString(row.OwnerID),
-				UpdatedAt: timestamptzToTime(row.UpdatedAt),
-				Fields:    fields,
+				ID:            uuidToString(row.ID),
+				Name:          row.Name,
+				OwnerID:       uuidToString(row.OwnerID),
+				UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+				Version:       int(row.Version),
+				Fields:        fields,
+				SchemaVersion: int(row.SchemaVersion),
+				WorkspaceID:   uuidToString(row.WorkspaceID),
+				IsPublic:      row.IsPublic,
 			},
 			IsUsed: row.IsUsed,
 			Owner:  owner,
@@ -97,18 +127,22 @@ func (r *TemplateRepository) Get(ctx context.Context, id string) (*template.With
 		}
 		return nil, err
 	}
-	fields, err := r.listFields(ctx, row.ID)
+	fields, err := r.listFields(ctx, row.ID, row.SchemaVersion)
 	if err != nil {
 		return nil, err
 	}
 	owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
 	return &template.WithUsage{
 		Template: template.Template{
-			ID:        uuidToString(row.ID),
-			Name:      row.Name,
-			OwnerID:   uuidToString(row.OwnerID),
-			UpdatedAt: timestamptzToTime(row.UpdatedAt),
-			Fields:    fields,
+			ID:            uuidToString(row.ID),
+			Name:          row.Name,
+			OwnerID:       uuidToString(row.OwnerID),
+			UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+			Version:       int(row.Version),
+			Fields:        fields,
+			SchemaVersion: int(row.SchemaVersion),
+			WorkspaceID:   uuidToString(row.WorkspaceID),
+			IsPublic:      row.IsPublic,
 		},
 		IsUsed: row.IsUsed,
 		Owner:  owner,
@@ -121,19 +155,19 @@ func (r *TemplateRepository) Create(ctx context.Context, tpl template.Template)
 	if err != nil {
 		return nil, err
 	}
+	workspace, err := toUUID(tpl.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
 	row, err := queriesForContext(ctx, r.queries).CreateTemplate(ctx, &generated.CreateTemplateParams{
-		Name:    tpl.Name,
-		OwnerID: owner,
+		Name:        tpl.Name,
+		OwnerID:     owner,
+		WorkspaceID: workspace,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &template.Template{
-		ID:        uuidToString(row.ID),
-		Name:      row.Name,
-		OwnerID:   uuidToString(row.OwnerID),
-		UpdatedAt: timestamptzToTime(row.UpdatedAt),
-	}, nil
+	return toTemplate(row), nil
 }
 
 // Update updates template name.
@@ -143,72 +177,235 @@ func (r *TemplateRepository) Update(ctx context.Context, tpl template.Template)
 		return nil, err
 	}
 	row, err := queriesForContext(ctx, r.queries).UpdateTemplate(ctx, &generated.UpdateTemplateParams{
-		ID:   pgID,
-		Name: tpl.Name,
+		ID:      pgID,
+		Name:    tpl.Name,
+		Version: int32(tpl.Version), //nolint:gosec
 	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.staleWriteError(ctx, pgID)
+		}
+		return nil, err
+	}
+	return toTemplate(row), nil
+}
+
+// Delete moves a template to the trash.
+func (r *TemplateRepository) Delete(ctx context.Context, id string, version int) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	trashed, err := queriesForContext(ctx, r.queries).TrashTemplate(ctx, &generated.TrashTemplateParams{
+		ID:      pgID,
+		Version: int32(version), //nolint:gosec
+	})
+	if err != nil {
+		return err
+	}
+	if trashed == 0 {
+		return r.staleWriteError(ctx, pgID)
+	}
+	return nil
+}
+
+// ListTrashed returns the owner's templates in the trash, without fields.
+func (r *TemplateRepository) ListTrashed(ctx context.Context, ownerID string) ([]template.Template, error) {
+	pgOwnerID, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListTrashedTemplates(ctx, pgOwnerID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]template.Template, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toTemplate(row))
+	}
+	return result, nil
+}
+
+// GetTrashed returns a template in the trash, without fields.
+func (r *TemplateRepository) GetTrashed(ctx context.Context, id string) (*template.Template, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetTrashedTemplateByID(ctx, pgID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domainerr.ErrNotFound
 		}
 		return nil, err
 	}
-	return &template.Template{
-		ID:        uuidToString(row.ID),
-		Name:      row.Name,
-		OwnerID:   uuidToString(row.OwnerID),
-		UpdatedAt: timestamptzToTime(row.UpdatedAt),
-	}, nil
+	return toTemplate(row), nil
+}
+
+// Restore takes a template out of the trash.
+func (r *TemplateRepository) Restore(ctx context.Context, id string, version int) (*template.Template, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.RestoreTemplate(ctx, &generated.RestoreTemplateParams{
+		ID:      pgID,
+		Version: int32(version), //nolint:gosec
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if _, err := q.GetTrashedTemplateByID(ctx, pgID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, domainerr.ErrNotFound
+			}
+			return nil, err
+		}
+		return nil, domainerr.ErrVersionConflict
+	}
+	return toTemplate(row), nil
 }
 
-// Delete deletes a template.
-func (r *TemplateRepository) Delete(ctx context.Context, id string) error {
+// SetPublic lists the template in the gallery or takes it out.
+func (r *TemplateRepository) SetPublic(ctx context.Context, id string, isPublic bool, version int) (*template.Template, error) {
 	pgID, err := toUUID(id)
 	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).SetTemplatePublic(ctx, &generated.SetTemplatePublicParams{
+		ID:       pgID,
+		IsPublic: isPublic,
+		Version:  int32(version), //nolint:gosec
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.staleWriteError(ctx, pgID)
+		}
+		return nil, err
+	}
+	return toTemplate(row), nil
+}
+
+// PurgeDeletedBefore permanently deletes templates trashed before the given time that no note refers to.
+func (r *TemplateRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	purged, err := queriesForContext(ctx, r.queries).PurgeTemplatesDeletedBefore(ctx, pgNullableTime(&before))
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
+
+// staleWriteError explains why a version-conditional write matched no row.
+func (r *TemplateRepository) staleWriteError(ctx context.Context, id pgtype.UUID) error {
+	if _, err := queriesForContext(ctx, r.queries).GetTemplateByID(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerr.ErrNotFound
+		}
 		return err
 	}
-	return queriesForContext(ctx, r.queries).DeleteTemplate(ctx, pgID)
+	return domainerr.ErrVersionConflict
 }
 
-// ReplaceFields replaces template fields.
-func (r *TemplateRepository) ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error {
+// ReplaceFields writes fields as a new schema version and points the template at it.
+// Earlier versions are left in place; a field with a Key continues that field's identity.
+func (r *TemplateRepository) ReplaceFields(ctx context.Context, templateID string, schemaVersion int, fields []template.Field) error {
 	pgID, err := toUUID(templateID)
 	if err != nil {
 		return err
 	}
 	q := queriesForContext(ctx, r.queries)
-	if err := q.DeleteFieldsByTemplate(ctx, pgID); err != nil {
-		return err
-	}
 	for idx, f := range fields {
 		order := f.Order
 		if order == 0 {
 			order = idx + 1
 		}
+		fieldType := f.Type
+		if fieldType == "" {
+			fieldType = template.FieldTypeText
+		}
+		options := f.Options
+		if options == nil {
+			options = []string{}
+		}
+		var key pgtype.UUID
+		if f.Key != "" {
+			if key, err = toUUID(f.Key); err != nil {
+				return err
+			}
+		}
 		if _, err := q.CreateField(ctx, &generated.CreateFieldParams{
-			TemplateID: pgID,
-			Label:      f.Label,
-			Order:      int32(order), //nolint:gosec
-			IsRequired: f.IsRequired,
+			TemplateID:     pgID,
+			SchemaVersion:  int32(schemaVersion), //nolint:gosec
+			Key:            key,
+			Label:          f.Label,
+			FieldOrder:     int32(order), //nolint:gosec
+			IsRequired:     f.IsRequired,
+			Type:           string(fieldType),
+			Options:        options,
+			MinLength:      int32(f.MinLength), //nolint:gosec
+			MaxLength:      int32(f.MaxLength), //nolint:gosec
+			Pattern:        f.Pattern,
+			Placeholder:    f.Placeholder,
+			DefaultContent: f.DefaultContent,
 		}); err != nil {
 			return err
 		}
 	}
-	return nil
+	return q.SetTemplateSchemaVersion(ctx, &generated.SetTemplateSchemaVersionParams{
+		ID:            pgID,
+		SchemaVersion: int32(schemaVersion), //nolint:gosec
+	})
+}
+
+// ListFields returns the fields of one schema version of a template.
+func (r *TemplateRepository) ListFields(ctx context.Context, templateID string, schemaVersion int) ([]template.Field, error) {
+	pgID, err := toUUID(templateID)
+	if err != nil {
+		return nil, err
+	}
+	return r.listFields(ctx, pgID, int32(schemaVersion)) //nolint:gosec
 }
 
-func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.UUID) ([]template.Field, error) {
-	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplate(ctx, templateID)
+func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.UUID, schemaVersion int32) ([]template.Field, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplate(ctx, &generated.ListFieldsByTemplateParams{
+		TemplateID:    templateID,
+		SchemaVersion: schemaVersion,
+	})
 	if err != nil {
 		return nil, err
 	}
 	fields := make([]template.Field, 0, len(rows))
 	for _, f := range rows {
 		fields = append(fields, template.Field{
-			ID:         uuidToString(f.ID),
-			Label:      f.Label,
-			Order:      int(f.Order),
-			IsRequired: f.IsRequired,
+			ID:             uuidToString(f.ID),
+			Key:            uuidToString(f.Key),
+			Label:          f.Label,
+			Order:          int(f.Order),
+			IsRequired:     f.IsRequired,
+			Type:           template.FieldType(f.Type),
+			Options:        f.Options,
+			MinLength:      int(f.MinLength),
+			MaxLength:      int(f.MaxLength),
+			Pattern:        f.Pattern,
+			Placeholder:    f.Placeholder,
+			DefaultContent: f.DefaultContent,
 		})
 	}
 	return fields, nil
 }
+
+func toTemplate(row *generated.Template) *template.Template {
+	return &template.Template{
+		ID:            uuidToString(row.ID),
+		Name:          row.Name,
+		OwnerID:       uuidToString(row.OwnerID),
+		UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+		Version:       int(row.Version),
+		SchemaVersion: int(row.SchemaVersion),
+		DeletedAt:     nullableTimestamptzToTime(row.DeletedAt),
+		WorkspaceID:   uuidToString(row.WorkspaceID),
+		IsPublic:      row.IsPublic,
+	}
+}
@@ -6,6 +6,7 @@ package sqlc
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,8 +37,9 @@ func TestTemplateRepository_Integration_CRUD(t *testing.T) {
 
 	t.Run("Create template", func(t *testing.T) {
 		tpl := template.Template{
-			Name:    "Design Document",
-			OwnerID: account.ID,
+			Name:        "Design Document",
+			OwnerID:     account.ID,
+			WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account.ID),
 		}
 
 		created, err := repo.Create(ctx, tpl)
@@ -65,13 +67,18 @@ func TestTemplateRepository_Integration_CRUD(t *testing.T) {
 
 	t.Run("Update template", func(t *testing.T) {
 		tpl := template.Template{
-			ID:   createdID,
-			Name: "Updated Design Document",
+			ID:      createdID,
+			Name:    "Updated Design Document",
+			Version: 1,
 		}
 
 		updated, err := repo.Update(ctx, tpl)
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Design Document", updated.Name)
+		assert.Equal(t, 2, updated.Version)
+
+		_, err = repo.Update(ctx, template.Template{ID: createdID, Name: "Lost Update", Version: 1})
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
 
 		// Verify the update
 		got, err := repo.Get(ctx, createdID)
@@ -82,14 +89,18 @@ func TestTemplateRepository_Integration_CRUD(t *testing.T) {
 	t.Run("Delete template", func(t *testing.T) {
 		// Create a template to delete
 		tpl := template.Template{
-			Name:    "Template to Delete",
-			OwnerID: account.ID,
+			Name:        "Template to Delete",
+			OwnerID:     account.ID,
+			WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account.ID),
 		}
 		created, err := repo.Create(ctx, tpl)
 		require.NoError(t, err)
 
 		// Delete it
-		err = repo.Delete(ctx, created.ID)
+		err = repo.Delete(ctx, created.ID, created.Version+1)
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
+
+		err = repo.Delete(ctx, created.ID, created.Version)
 		require.NoError(t, err)
 
 		// Verify it's deleted
@@ -112,8 +123,9 @@ func TestTemplateRepository_Integration_Fields(t *testing.T) {
 
 	// Create template
 	tpl := template.Template{
-		Name:    "Template with Fields",
-		OwnerID: account.ID,
+		Name:        "Template with Fields",
+		OwnerID:     account.ID,
+		WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account.ID),
 	}
 	created, err := repo.Create(ctx, tpl)
 	require.NoError(t, err)
@@ -125,7 +137,7 @@ func TestTemplateRepository_Integration_Fields(t *testing.T) {
 			{Label: "Notes", Order: 3, IsRequired: false},
 		}
 
-		err := repo.ReplaceFields(ctx, created.ID, fields)
+		err := repo.ReplaceFields(ctx, created.ID, created.SchemaVersion, fields)
 		require.NoError(t, err)
 
 		// Verify fields were added
@@ -145,21 +157,66 @@ func TestTemplateRepository_Integration_Fields(t *testing.T) {
 		assert.False(t, got.Template.Fields[2].IsRequired)
 	})
 
-	t.Run("Replace fields (update existing)", func(t *testing.T) {
+	t.Run("Replace fields writes a new schema version", func(t *testing.T) {
+		before, err := repo.Get(ctx, created.ID)
+		require.NoError(t, err)
+		kept := before.Template.Fields[0]
+
 		newFields := []template.Field{
-			{Label: "Problem Statement", Order: 1, IsRequired: true},
+			{Key: kept.Key, Label: "Problem Statement", Order: 1, IsRequired: true},
 			{Label: "Proposed Solution", Order: 2, IsRequired: true},
 		}
 
-		err := repo.ReplaceFields(ctx, created.ID, newFields)
+		err = repo.ReplaceFields(ctx, created.ID, before.Template.SchemaVersion+1, newFields)
 		require.NoError(t, err)
 
 		// Verify fields were replaced
 		got, err := repo.Get(ctx, created.ID)
 		require.NoError(t, err)
+		assert.Equal(t, before.Template.SchemaVersion+1, got.Template.SchemaVersion)
 		assert.Len(t, got.Template.Fields, 2)
 		assert.Equal(t, "Problem Statement", got.Template.Fields[0].Label)
 		assert.Equal(t, "Proposed Solution", got.Template.Fields[1].Label)
+
+		// The edited field keeps its key, the added one gets a new key
+		assert.NotEqual(t, kept.ID, got.Template.Fields[0].ID)
+		assert.Equal(t, kept.Key, got.Template.Fields[0].Key)
+		assert.NotEqual(t, kept.Key, got.Template.Fields[1].Key)
+
+		// The previous schema version is still readable for notes pinned to it
+		old, err := repo.ListFields(ctx, created.ID, before.Template.SchemaVersion)
+		require.NoError(t, err)
+		assert.Len(t, old, 3)
+		assert.Equal(t, "Background", old[0].Label)
+	})
+
+	t.Run("Field type, options and constraints round trip", func(t *testing.T) {
+		before, err := repo.Get(ctx, created.ID)
+		require.NoError(t, err)
+
+		typed := []template.Field{
+			{Label: "Summary", Order: 1},
+			{Label: "Priority", Order: 2, Type: template.FieldTypeSingleSelect, Options: []string{"low", "high"}},
+			{Label: "Due", Order: 3, Type: template.FieldTypeDate},
+			{Label: "Code", Order: 4, MinLength: 3, MaxLength: 8, Pattern: `[A-Z]+`, Placeholder: "ABC", DefaultContent: "NEW"},
+		}
+		err = repo.ReplaceFields(ctx, created.ID, before.Template.SchemaVersion+1, typed)
+		require.NoError(t, err)
+
+		got, err := repo.Get(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, got.Template.Fields, 4)
+		assert.Equal(t, template.FieldTypeText, got.Template.Fields[0].Type)
+		assert.Empty(t, got.Template.Fields[0].Options)
+		assert.Equal(t, template.FieldTypeSingleSelect, got.Template.Fields[1].Type)
+		assert.Equal(t, []string{"low", "high"}, got.Template.Fields[1].Options)
+		assert.Equal(t, template.FieldTypeDate, got.Template.Fields[2].Type)
+		code := got.Template.Fields[3]
+		assert.Equal(t, 3, code.MinLength)
+		assert.Equal(t, 8, code.MaxLength)
+		assert.Equal(t, `[A-Z]+`, code.Pattern)
+		assert.Equal(t, "ABC", code.Placeholder)
+		assert.Equal(t, "NEW", code.DefaultContent)
 	})
 }
 
@@ -179,13 +236,13 @@ func TestTemplateRepository_Integration_List(t *testing.T) {
 	ctx := testutil.TestContext(t)
 
 	// Create templates for account1
-	tpl1, err := repo.Create(ctx, template.Template{Name: "Design Doc", OwnerID: account1.ID})
+	tpl1, err := repo.Create(ctx, template.Template{Name: "Design Doc", OwnerID: account1.ID, WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account1.ID)})
 	require.NoError(t, err)
-	_, err = repo.Create(ctx, template.Template{Name: "Meeting Notes", OwnerID: account1.ID})
+	_, err = repo.Create(ctx, template.Template{Name: "Meeting Notes", OwnerID: account1.ID, WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account1.ID)})
 	require.NoError(t, err)
 
 	// Create template for account2
-	_, err = repo.Create(ctx, template.Template{Name: "Project Plan", OwnerID: account2.ID})
+	_, err = repo.Create(ctx, template.Template{Name: "Project Plan", OwnerID: account2.ID, WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account2.ID)})
 	require.NoError(t, err)
 
 	t.Run("List all templates", func(t *testing.T) {
@@ -211,6 +268,18 @@ func TestTemplateRepository_Integration_List(t *testing.T) {
 		assert.GreaterOrEqual(t, len(templates), 1)
 	})
 
+	t.Run("List pages by cursor", func(t *testing.T) {
+		first, err := repo.List(ctx, template.Filters{OwnerID: &account1.ID, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+
+		cursor := first[0].PageCursor()
+		second, err := repo.List(ctx, template.Filters{OwnerID: &account1.ID, Cursor: &cursor, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+		assert.NotEqual(t, first[0].Template.ID, second[0].Template.ID)
+	})
+
 	t.Run("IsUsed is true when note exists", func(t *testing.T) {
 		// Create a note using tpl1
 		testutil.CreateTestNote(t, pool, testutil.TestNote{
@@ -247,15 +316,15 @@ func TestTemplateRepository_Integration_Constraints(t *testing.T) {
 		assert.Error(t, err) // Foreign key violation
 	})
 
-	t.Run("Delete template with notes fails (FK constraint)", func(t *testing.T) {
+	t.Run("Purge keeps a trashed template that notes still refer to", func(t *testing.T) {
 		account := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
 
 		// Create template
-		tpl, err := repo.Create(ctx, template.Template{Name: "Used Template", OwnerID: account.ID})
+		tpl, err := repo.Create(ctx, template.Template{Name: "Used Template", OwnerID: account.ID, WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account.ID)})
 		require.NoError(t, err)
 
 		// Add field
-		err = repo.ReplaceFields(ctx, tpl.ID, []template.Field{{Label: "Field1", Order: 1}})
+		err = repo.ReplaceFields(ctx, tpl.ID, tpl.SchemaVersion, []template.Field{{Label: "Field1", Order: 1}})
 		require.NoError(t, err)
 
 		// Get field ID
@@ -273,8 +342,79 @@ func TestTemplateRepository_Integration_Constraints(t *testing.T) {
 			},
 		})
 
-		// Try to delete template - should fail due to FK constraint
-		err = repo.Delete(ctx, tpl.ID)
-		assert.Error(t, err)
+		// Trashing succeeds, but the row stays until no note refers to it
+		require.NoError(t, repo.Delete(ctx, tpl.ID, got.Template.Version))
+		purged, err := repo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+
+		_, err = repo.GetTrashed(ctx, tpl.ID)
+		require.NoError(t, err)
+	})
+}
+
+func TestTemplateRepository_Integration_Trash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	account := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+	repo := NewTemplateRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	t.Run("IsUsed ignores trashed notes", func(t *testing.T) {
+		tpl := testutil.CreateTestTemplate(t, pool, testutil.TestTemplate{Name: "Usage", OwnerID: account.ID})
+		n := testutil.CreateTestNote(t, pool, testutil.TestNote{TemplateID: tpl.ID, OwnerID: account.ID})
+
+		got, err := repo.Get(ctx, tpl.ID)
+		require.NoError(t, err)
+		assert.True(t, got.IsUsed)
+
+		_, err = pool.Exec(ctx, `UPDATE notes SET deleted_at = NOW() WHERE id = $1`, n.ID)
+		require.NoError(t, err)
+
+		got, err = repo.Get(ctx, tpl.ID)
+		require.NoError(t, err)
+		assert.False(t, got.IsUsed)
+	})
+
+	t.Run("Trash, list and restore template", func(t *testing.T) {
+		created, err := repo.Create(ctx, template.Template{Name: "Trash Me", OwnerID: account.ID, WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account.ID)})
+		require.NoError(t, err)
+		require.NoError(t, repo.Delete(ctx, created.ID, created.Version))
+
+		_, err = repo.Get(ctx, created.ID)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
+
+		trashed, err := repo.ListTrashed(ctx, account.ID)
+		require.NoError(t, err)
+		require.Len(t, trashed, 1)
+		assert.Equal(t, created.ID, trashed[0].ID)
+		require.NotNil(t, trashed[0].DeletedAt)
+
+		_, err = repo.Restore(ctx, created.ID, created.Version)
+		assert.True(t, errors.Is(err, domainerr.ErrVersionConflict))
+
+		restored, err := repo.Restore(ctx, created.ID, trashed[0].Version)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		_, err = repo.Get(ctx, created.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Purge deletes unused trashed templates", func(t *testing.T) {
+		created, err := repo.Create(ctx, template.Template{Name: "Purge Me", OwnerID: account.ID, WorkspaceID: testutil.PersonalWorkspaceID(t, pool, account.ID)})
+		require.NoError(t, err)
+		require.NoError(t, repo.Delete(ctx, created.ID, created.Version))
+
+		purged, err := repo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		_, err = repo.GetTrashed(ctx, created.ID)
+		assert.True(t, errors.Is(err, domainerr.ErrNotFound))
 	})
 }
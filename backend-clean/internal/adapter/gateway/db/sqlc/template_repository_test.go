@@ -22,6 +22,7 @@ func TestTemplateRepository_Create(t *testing.T) {
 		Name:      "tpl",
 		OwnerID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
 		UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		WorkspaceID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
 	}
 	tests := []struct {
 		name    string
@@ -30,9 +31,9 @@ func TestTemplateRepository_Create(t *testing.T) {
 		rowErr  error
 		wantErr bool
 	}{
-		{name: "[Success] create template", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String()}, row: row},
+		{name: "[Success] create template", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String(), WorkspaceID: row.WorkspaceID.String()}, row: row},
 		{name: "[Fail] invalid owner uuid", tpl: template.Template{Name: "tpl", OwnerID: "bad-uuid"}, wantErr: true},
-		{name: "[Fail] query error", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String()}, rowErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] query error", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String(), WorkspaceID: row.WorkspaceID.String()}, rowErr: errors.New("db error"), wantErr: true},
 	}
 
 	for _, tt := range tests {
@@ -154,22 +155,31 @@ func TestTemplateRepository_Delete(t *testing.T) {
 		name    string
 		id      string
 		execErr error
+		stale   bool
 		wantErr bool
+		wantIs  error
 	}{
 		{name: "[Success] delete template", id: baseID.String()},
 		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
 		{name: "[Fail] exec error", id: baseID.String(), execErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] stale version", id: baseID.String(), stale: true, wantErr: true, wantIs: domainerr.ErrVersionConflict},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewTemplateDBTX(nil, nil, nil, tt.execErr)
+			mock := mockdb.NewTemplateDBTX(nil, &generated.GetTemplateByIDRow{ID: baseID, Version: 2}, nil, tt.execErr)
+			if tt.stale {
+				mock.WithRowsAffected(0)
+			}
 			repo := &TemplateRepository{queries: generated.New(mock)}
-			err := repo.Delete(context.Background(), tt.id)
+			err := repo.Delete(context.Background(), tt.id, 1)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
 				}
+				if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+					t.Fatalf("want %v, got %v", tt.wantIs, err)
+				}
 			} else if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -177,6 +187,80 @@ func TestTemplateRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestTemplateRepository_SetPublic(t *testing.T) {
+	row := &generated.Template{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Name: "tpl", Version: 4, IsPublic: true}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] publish template", id: row.ID.String()},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: errors.New("invalid")},
+		{name: "[Fail] not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(row, nil, tt.rowErr, nil)
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			got, err := repo.SetPublic(context.Background(), tt.id, true, 3)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !got.IsPublic || got.Version != 4 {
+					t.Fatalf("got %+v", got)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_Restore(t *testing.T) {
+	row := &generated.Template{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Name: "tpl", Version: 3}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr bool
+		wantIs  error
+	}{
+		{name: "[Success] restore template", id: row.ID.String()},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
+		{name: "[Fail] not in trash", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: true, wantIs: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &TemplateRepository{queries: generated.New(mockdb.NewTemplateDBTX(row, nil, tt.rowErr, nil))}
+			got, err := repo.Restore(context.Background(), tt.id, 2)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+					t.Fatalf("want %v, got %v", tt.wantIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.DeletedAt != nil || got.Name != "tpl" {
+				t.Fatalf("unexpected template: %+v", got)
+			}
+		})
+	}
+}
+
 func TestTemplateRepository_List(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	// list returns emptyRows; we assert success and error path
@@ -226,7 +310,9 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 	}{
 		{name: "[Success] replace fields", tplID: tplID.String(), field: template.Field{Label: "lbl", Order: 1, IsRequired: true}},
 		{name: "[Fail] invalid tpl uuid", tplID: "bad-uuid", field: template.Field{Label: "lbl"}, wantErr: true},
-		{name: "[Fail] delete error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, execErr: errors.New("del error"), wantErr: true},
+		{name: "[Success] carry field key", tplID: tplID.String(), field: template.Field{Key: tplID.String(), Label: "lbl", Order: 1}},
+		{name: "[Fail] invalid field key", tplID: tplID.String(), field: template.Field{Key: "bad-uuid", Label: "lbl"}, wantErr: true},
+		{name: "[Fail] schema version update error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, execErr: errors.New("update error"), wantErr: true},
 		{name: "[Fail] create error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, rowErr: errors.New("create error"), wantErr: true},
 	}
 
@@ -235,7 +321,7 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 			mock := mockdb.NewTemplateDBTX(nil, nil, tt.rowErr, tt.execErr)
 			mock.FieldRow = fieldRow
 			repo := &TemplateRepository{queries: generated.New(mock)}
-			err := repo.ReplaceFields(context.Background(), tt.tplID, []template.Field{tt.field})
+			err := repo.ReplaceFields(context.Background(), tt.tplID, 2, []template.Field{tt.field})
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
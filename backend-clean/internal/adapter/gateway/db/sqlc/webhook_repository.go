@@ -0,0 +1,348 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookRepository stores webhook subscriptions and their delivery log.
+type WebhookRepository struct {
+	queries *generated.Queries
+}
+
+var (
+	_ port.WebhookRepository         = (*WebhookRepository)(nil)
+	_ port.WebhookDeliveryRepository = (*WebhookRepository)(nil)
+)
+
+// NewWebhookRepository creates WebhookRepository.
+func NewWebhookRepository(pool *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{queries: generated.New(pool)}
+}
+
+// ListByOwner returns the owner's subscriptions, oldest first.
+func (r *WebhookRepository) ListByOwner(ctx context.Context, ownerID string) ([]webhook.Subscription, error) {
+	oid, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhookSubscriptionsByOwner(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSubscriptions(rows), nil
+}
+
+// ListForEvent returns the owner's subscriptions to eventType.
+func (r *WebhookRepository) ListForEvent(ctx context.Context, ownerID, eventType string) ([]webhook.Subscription, error) {
+	oid, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhookSubscriptionsForEvent(ctx, &generated.ListWebhookSubscriptionsForEventParams{
+		OwnerID:   oid,
+		EventType: eventType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSubscriptions(rows), nil
+}
+
+// Get returns a subscription by ID.
+func (r *WebhookRepository) Get(ctx context.Context, id string) (*webhook.Subscription, error) {
+	sid, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWebhookSubscription(ctx, sid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	sub := toDomainSubscription(row)
+	return &sub, nil
+}
+
+// Create stores a new subscription.
+func (r *WebhookRepository) Create(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	oid, err := toUUID(sub.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateWebhookSubscription(ctx, &generated.CreateWebhookSubscriptionParams{
+		OwnerID:    oid,
+		Url:        sub.URL,
+		Secret:     sub.Secret,
+		EventTypes: sub.EventTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toDomainSubscription(row)
+	return &created, nil
+}
+
+// Update replaces the URL, secret and event types of a subscription.
+func (r *WebhookRepository) Update(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	sid, err := toUUID(sub.ID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateWebhookSubscription(ctx, &generated.UpdateWebhookSubscriptionParams{
+		ID:         sid,
+		Url:        sub.URL,
+		Secret:     sub.Secret,
+		EventTypes: sub.EventTypes,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	updated := toDomainSubscription(row)
+	return &updated, nil
+}
+
+// Delete removes a subscription; its deliveries go with it.
+func (r *WebhookRepository) Delete(ctx context.Context, id string) error {
+	sid, err := toUUID(id)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteWebhookSubscription(ctx, sid)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// ListDeliveries returns the latest limit deliveries of a subscription, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhook.Delivery, error) {
+	sid, err := toUUID(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhookDeliveries(ctx, &generated.ListWebhookDeliveriesParams{
+		SubscriptionID: sid,
+		Limit:          int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]webhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toDomainDelivery(row))
+	}
+	return deliveries, nil
+}
+
+// GetDelivery returns a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	did, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWebhookDelivery(ctx, did)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	d := toDomainDelivery(row)
+	return &d, nil
+}
+
+// EnqueueDelivery records a pending delivery unless the subscription already has one for the event.
+func (r *WebhookRepository) EnqueueDelivery(ctx context.Context, d webhook.Delivery) error {
+	sid, err := toUUID(d.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	eid, err := toUUID(d.EventID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).EnqueueWebhookDelivery(ctx, &generated.EnqueueWebhookDeliveryParams{
+		SubscriptionID: sid,
+		EventID:        eid,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		NextAttemptAt:  pgNullableTime(&d.NextAttemptAt),
+	})
+}
+
+// CreateDelivery records a pending delivery unconditionally.
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+	sid, err := toUUID(d.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	eid, err := toUUID(d.EventID)
+	if err != nil {
+		return nil, err
+	}
+	var replayOf pgtype.UUID
+	if d.ReplayOf != "" {
+		if replayOf, err = toUUID(d.ReplayOf); err != nil {
+			return nil, err
+		}
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateWebhookDelivery(ctx, &generated.CreateWebhookDeliveryParams{
+		SubscriptionID: sid,
+		EventID:        eid,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		NextAttemptAt:  pgNullableTime(&d.NextAttemptAt),
+		ReplayOf:       replayOf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toDomainDelivery(row)
+	return &created, nil
+}
+
+// ClaimDue leases up to limit pending deliveries due at now until leaseUntil, together with their target.
+func (r *WebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhook.DueDelivery, error) {
+	rows, err := queriesForContext(ctx, r.queries).ClaimDueWebhookDeliveries(ctx, &generated.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgNullableTime(&leaseUntil),
+		DueAt:      pgNullableTime(&now),
+		ClaimLimit: int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	due := make([]webhook.DueDelivery, 0, len(rows))
+	for _, row := range rows {
+		due = append(due, webhook.DueDelivery{
+			Delivery: toDomainDelivery(&generated.WebhookDelivery{
+				ID:             row.ID,
+				SubscriptionID: row.SubscriptionID,
+				EventID:        row.EventID,
+				EventType:      row.EventType,
+				Payload:        row.Payload,
+				Status:         row.Status,
+				Attempts:       row.Attempts,
+				ResponseStatus: row.ResponseStatus,
+				LastError:      row.LastError,
+				NextAttemptAt:  row.NextAttemptAt,
+				DeliveredAt:    row.DeliveredAt,
+				FailedAt:       row.FailedAt,
+				ReplayOf:       row.ReplayOf,
+				CreatedAt:      row.CreatedAt,
+			}),
+			URL:    row.Url,
+			Secret: row.Secret,
+		})
+	}
+	return due, nil
+}
+
+// MarkSucceeded settles a delivery the receiver accepted.
+func (r *WebhookRepository) MarkSucceeded(ctx context.Context, id string, attempts, responseStatus int, at time.Time) error {
+	did, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).MarkWebhookDeliverySucceeded(ctx, &generated.MarkWebhookDeliverySucceededParams{
+		ID:             did,
+		Attempts:       int32(attempts), //nolint:gosec
+		ResponseStatus: pgNullableStatus(responseStatus),
+		DeliveredAt:    pgNullableTime(&at),
+	})
+}
+
+// MarkRetry records a failed attempt and schedules the next one.
+func (r *WebhookRepository) MarkRetry(ctx context.Context, id string, attempts, responseStatus int, next time.Time, lastError string) error {
+	did, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).MarkWebhookDeliveryRetry(ctx, &generated.MarkWebhookDeliveryRetryParams{
+		ID:             did,
+		Attempts:       int32(attempts), //nolint:gosec
+		ResponseStatus: pgNullableStatus(responseStatus),
+		NextAttemptAt:  pgNullableTime(&next),
+		LastError:      pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+// MarkFailed records the last failed attempt and gives up on the delivery.
+func (r *WebhookRepository) MarkFailed(ctx context.Context, id string, attempts, responseStatus int, at time.Time, lastError string) error {
+	did, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).MarkWebhookDeliveryFailed(ctx, &generated.MarkWebhookDeliveryFailedParams{
+		ID:             did,
+		Attempts:       int32(attempts), //nolint:gosec
+		ResponseStatus: pgNullableStatus(responseStatus),
+		FailedAt:       pgNullableTime(&at),
+		LastError:      pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+// pgNullableStatus stores a missing HTTP response (status 0) as NULL.
+func pgNullableStatus(status int) pgtype.Int4 {
+	if status == 0 {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(status), Valid: true} //nolint:gosec
+}
+
+func toDomainSubscriptions(rows []*generated.WebhookSubscription) []webhook.Subscription {
+	subs := make([]webhook.Subscription, 0, len(rows))
+	for _, row := range rows {
+		subs = append(subs, toDomainSubscription(row))
+	}
+	return subs
+}
+
+func toDomainSubscription(row *generated.WebhookSubscription) webhook.Subscription {
+	return webhook.Subscription{
+		ID:         uuidToString(row.ID),
+		OwnerID:    uuidToString(row.OwnerID),
+		URL:        row.Url,
+		Secret:     row.Secret,
+		EventTypes: row.EventTypes,
+		CreatedAt:  timestamptzToTime(row.CreatedAt),
+		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func toDomainDelivery(row *generated.WebhookDelivery) webhook.Delivery {
+	return webhook.Delivery{
+		ID:             uuidToString(row.ID),
+		SubscriptionID: uuidToString(row.SubscriptionID),
+		EventID:        uuidToString(row.EventID),
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         webhook.DeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		ResponseStatus: int(row.ResponseStatus.Int32),
+		LastError:      nullableTextToString(row.LastError),
+		NextAttemptAt:  timestamptzToTime(row.NextAttemptAt),
+		DeliveredAt:    nullableTimestamptzToTime(row.DeliveredAt),
+		FailedAt:       nullableTimestamptzToTime(row.FailedAt),
+		ReplayOf:       uuidToString(row.ReplayOf),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+	}
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+// Package sqlc implements gateway repositories using sqlc.
+package sqlc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestWebhookRepository_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewWebhookRepository(pool)
+	ctx := testutil.TestContext(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	owner := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+	sub, err := repo.Create(ctx, webhook.Subscription{
+		OwnerID:    owner.ID,
+		URL:        "https://example.com/hooks",
+		Secret:     strings.Repeat("s", webhook.MinSecretLength),
+		EventTypes: []string{outbox.NotePublished},
+	})
+	require.NoError(t, err)
+
+	claim := func(t *testing.T, at time.Time) []webhook.DueDelivery {
+		t.Helper()
+		due, err := repo.ClaimDue(ctx, at, at.Add(webhook.ClaimLease), 10)
+		require.NoError(t, err)
+		return due
+	}
+
+	t.Run("Subscriptions are matched by owner and event type", func(t *testing.T) {
+		subs, err := repo.ListForEvent(ctx, owner.ID, outbox.NotePublished)
+		require.NoError(t, err)
+		require.Len(t, subs, 1)
+		assert.Equal(t, sub.ID, subs[0].ID)
+
+		subs, err = repo.ListForEvent(ctx, owner.ID, outbox.NoteUnpublished)
+		require.NoError(t, err)
+		assert.Empty(t, subs)
+
+		sub.EventTypes = []string{outbox.NotePublished, outbox.NoteUnpublished}
+		updated, err := repo.Update(ctx, *sub)
+		require.NoError(t, err)
+		assert.Equal(t, sub.EventTypes, updated.EventTypes)
+	})
+
+	t.Run("An event is enqueued once and settled after retries", func(t *testing.T) {
+		d := webhook.Delivery{
+			SubscriptionID: sub.ID,
+			EventID:        uuid.New().String(),
+			EventType:      outbox.NotePublished,
+			Payload:        []byte(`{"id":"event"}`),
+			NextAttemptAt:  now,
+		}
+		require.NoError(t, repo.EnqueueDelivery(ctx, d))
+		require.NoError(t, repo.EnqueueDelivery(ctx, d))
+
+		due := claim(t, now)
+		require.Len(t, due, 1)
+		assert.Equal(t, "https://example.com/hooks", due[0].URL)
+		assert.Equal(t, sub.Secret, due[0].Secret)
+		assert.Empty(t, claim(t, now.Add(time.Minute)), "a claimed delivery is leased")
+
+		require.NoError(t, repo.MarkRetry(ctx, due[0].ID, 1, 503, now.Add(time.Minute), "receiver responded 503"))
+		assert.Empty(t, claim(t, now))
+		retried := claim(t, now.Add(time.Minute))
+		require.Len(t, retried, 1)
+		assert.Equal(t, 1, retried[0].Attempts)
+
+		require.NoError(t, repo.MarkSucceeded(ctx, retried[0].ID, 2, 200, now))
+		got, err := repo.GetDelivery(ctx, retried[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, webhook.DeliverySucceeded, got.Status)
+		assert.Equal(t, 200, got.ResponseStatus)
+		assert.Empty(t, got.LastError)
+
+		replay, err := repo.CreateDelivery(ctx, got.Replay(now))
+		require.NoError(t, err)
+		assert.Equal(t, got.ID, replay.ReplayOf)
+		assert.Equal(t, webhook.DeliveryPending, replay.Status)
+
+		deliveries, err := repo.ListDeliveries(ctx, sub.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		assert.Equal(t, replay.ID, deliveries[0].ID)
+	})
+
+	t.Run("Deleting a subscription removes its deliveries", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, sub.ID))
+		_, err := repo.Get(ctx, sub.ID)
+		require.ErrorIs(t, err, domainerr.ErrNotFound)
+		assert.Empty(t, claim(t, now.Add(time.Hour)))
+		require.ErrorIs(t, repo.Delete(ctx, sub.ID), domainerr.ErrNotFound)
+	})
+}
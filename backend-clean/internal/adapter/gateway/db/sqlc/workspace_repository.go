@@ -0,0 +1,310 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceRepository stores workspaces, their members and pending invitations.
+type WorkspaceRepository struct {
+	queries *generated.Queries
+}
+
+var _ port.WorkspaceRepository = (*WorkspaceRepository)(nil)
+
+// NewWorkspaceRepository creates WorkspaceRepository.
+func NewWorkspaceRepository(pool *pgxpool.Pool) *WorkspaceRepository {
+	return &WorkspaceRepository{queries: generated.New(pool)}
+}
+
+// ListByMember returns the workspaces of accountID with its role, personal workspace first.
+func (r *WorkspaceRepository) ListByMember(ctx context.Context, accountID string) ([]workspace.WithRole, error) {
+	aid, err := toUUID(accountID)
+	if err != nil {
+		return []workspace.WithRole{}, nil
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWorkspacesByMember(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]workspace.WithRole, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, workspace.WithRole{
+			Workspace: workspace.Workspace{
+				ID:        uuidToString(row.ID),
+				Name:      row.Name,
+				OwnerID:   uuidToString(row.OwnerID),
+				Personal:  row.Personal,
+				CreatedAt: timestamptzToTime(row.CreatedAt),
+				UpdatedAt: timestamptzToTime(row.UpdatedAt),
+			},
+			Role: workspace.Role(row.Role),
+		})
+	}
+	return result, nil
+}
+
+// Get returns a workspace by ID.
+func (r *WorkspaceRepository) Get(ctx context.Context, id string) (*workspace.Workspace, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWorkspaceByID(ctx, pgID)
+	if err != nil {
+		return nil, workspaceNotFound(err)
+	}
+	return toDomainWorkspace(row), nil
+}
+
+// EnsurePersonal returns the personal workspace of ownerID, creating it on first use.
+func (r *WorkspaceRepository) EnsurePersonal(ctx context.Context, ownerID string) (*workspace.Workspace, error) {
+	oid, err := toUUID(ownerID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	q := queriesForContext(ctx, r.queries)
+	if err := q.CreatePersonalWorkspace(ctx, &generated.CreatePersonalWorkspaceParams{
+		Name:    workspace.PersonalName,
+		OwnerID: oid,
+	}); err != nil {
+		return nil, err
+	}
+	row, err := q.GetPersonalWorkspace(ctx, oid)
+	if err != nil {
+		return nil, workspaceNotFound(err)
+	}
+	if _, err := q.AddWorkspaceMember(ctx, &generated.AddWorkspaceMemberParams{
+		WorkspaceID: row.ID,
+		AccountID:   oid,
+		Role:        string(workspace.RoleOwner),
+	}); err != nil {
+		return nil, err
+	}
+	return toDomainWorkspace(row), nil
+}
+
+// Create inserts a workspace and makes its owner a member; run it in a transaction.
+func (r *WorkspaceRepository) Create(ctx context.Context, ws workspace.Workspace) (*workspace.Workspace, error) {
+	oid, err := toUUID(ws.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.CreateWorkspace(ctx, &generated.CreateWorkspaceParams{
+		Name:     ws.Name,
+		OwnerID:  oid,
+		Personal: ws.Personal,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := q.AddWorkspaceMember(ctx, &generated.AddWorkspaceMemberParams{
+		WorkspaceID: row.ID,
+		AccountID:   oid,
+		Role:        string(workspace.RoleOwner),
+	}); err != nil {
+		return nil, err
+	}
+	return toDomainWorkspace(row), nil
+}
+
+// GetMember returns the membership of accountID in a workspace.
+func (r *WorkspaceRepository) GetMember(ctx context.Context, workspaceID, accountID string) (*workspace.Member, error) {
+	wid, aid, err := memberKey(workspaceID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWorkspaceMember(ctx, &generated.GetWorkspaceMemberParams{
+		WorkspaceID: wid,
+		AccountID:   aid,
+	})
+	if err != nil {
+		return nil, workspaceNotFound(err)
+	}
+	m := toDomainMember(row)
+	return &m, nil
+}
+
+// ListMembers returns the members of a workspace in the order they joined.
+func (r *WorkspaceRepository) ListMembers(ctx context.Context, workspaceID string) ([]workspace.Member, error) {
+	wid, err := toUUID(workspaceID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWorkspaceMembers(ctx, wid)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]workspace.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, toDomainMember(row))
+	}
+	return members, nil
+}
+
+// AddMember adds a member; an existing member keeps its role.
+func (r *WorkspaceRepository) AddMember(ctx context.Context, m workspace.Member) (*workspace.Member, error) {
+	wid, aid, err := memberKey(m.WorkspaceID, m.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).AddWorkspaceMember(ctx, &generated.AddWorkspaceMemberParams{
+		WorkspaceID: wid,
+		AccountID:   aid,
+		Role:        string(m.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+	saved := toDomainMember(row)
+	return &saved, nil
+}
+
+// RemoveMember deletes a membership.
+func (r *WorkspaceRepository) RemoveMember(ctx context.Context, workspaceID, accountID string) error {
+	wid, aid, err := memberKey(workspaceID, accountID)
+	if err != nil {
+		return err
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteWorkspaceMember(ctx, &generated.DeleteWorkspaceMemberParams{
+		WorkspaceID: wid,
+		AccountID:   aid,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// UpsertInvitation creates an invitation or renews the pending one for the same email.
+func (r *WorkspaceRepository) UpsertInvitation(ctx context.Context, inv workspace.Invitation) (*workspace.Invitation, error) {
+	wid, err := toUUID(inv.WorkspaceID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	invitedBy, err := toUUID(inv.InvitedBy)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpsertWorkspaceInvitation(ctx, &generated.UpsertWorkspaceInvitationParams{
+		WorkspaceID: wid,
+		Email:       inv.Email,
+		Role:        string(inv.Role),
+		InvitedBy:   invitedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainInvitation(row), nil
+}
+
+// GetInvitation returns an invitation by ID, accepted or not.
+func (r *WorkspaceRepository) GetInvitation(ctx context.Context, id string) (*workspace.Invitation, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWorkspaceInvitation(ctx, pgID)
+	if err != nil {
+		return nil, workspaceNotFound(err)
+	}
+	return toDomainInvitation(row), nil
+}
+
+// ListPendingInvitations returns the unaccepted invitations for email, newest first.
+func (r *WorkspaceRepository) ListPendingInvitations(ctx context.Context, email string) ([]workspace.Invitation, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListPendingInvitationsByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	invs := make([]workspace.Invitation, 0, len(rows))
+	for _, row := range rows {
+		invs = append(invs, *toDomainInvitation(row))
+	}
+	return invs, nil
+}
+
+// AcceptInvitation marks an invitation used.
+func (r *WorkspaceRepository) AcceptInvitation(ctx context.Context, id string) (*workspace.Invitation, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.AcceptWorkspaceInvitation(ctx, pgID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if _, err := q.GetWorkspaceInvitation(ctx, pgID); err != nil {
+			return nil, workspaceNotFound(err)
+		}
+		return nil, domainerr.ErrInvitationAccepted
+	}
+	return toDomainInvitation(row), nil
+}
+
+// memberKey parses the IDs of a membership; a malformed ID cannot match any member.
+func memberKey(workspaceID, accountID string) (pgtype.UUID, pgtype.UUID, error) {
+	wid, err := toUUID(workspaceID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, domainerr.ErrNotFound
+	}
+	aid, err := toUUID(accountID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, domainerr.ErrNotFound
+	}
+	return wid, aid, nil
+}
+
+func workspaceNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainerr.ErrNotFound
+	}
+	return err
+}
+
+func toDomainWorkspace(row *generated.Workspace) *workspace.Workspace {
+	return &workspace.Workspace{
+		ID:        uuidToString(row.ID),
+		Name:      row.Name,
+		OwnerID:   uuidToString(row.OwnerID),
+		Personal:  row.Personal,
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+		UpdatedAt: timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func toDomainMember(row *generated.WorkspaceMember) workspace.Member {
+	return workspace.Member{
+		WorkspaceID: uuidToString(row.WorkspaceID),
+		AccountID:   uuidToString(row.AccountID),
+		Role:        workspace.Role(row.Role),
+		JoinedAt:    timestamptzToTime(row.JoinedAt),
+	}
+}
+
+func toDomainInvitation(row *generated.WorkspaceInvitation) *workspace.Invitation {
+	return &workspace.Invitation{
+		ID:          uuidToString(row.ID),
+		WorkspaceID: uuidToString(row.WorkspaceID),
+		Email:       row.Email,
+		Role:        workspace.Role(row.Role),
+		InvitedBy:   uuidToString(row.InvitedBy),
+		CreatedAt:   timestamptzToTime(row.CreatedAt),
+		AcceptedAt:  nullableTimestamptzToTime(row.AcceptedAt),
+	}
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+// Package sqlc implements gateway repositories using sqlc.
+package sqlc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestWorkspaceRepository_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pg := testutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	repo := NewWorkspaceRepository(pool)
+	notes := NewNoteRepository(pool)
+	templates := NewTemplateRepository(pool)
+	ctx := testutil.TestContext(t)
+
+	owner := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+	invitee := testutil.CreateTestAccount(t, pool, testutil.TestAccount{})
+
+	t.Run("EnsurePersonal creates the personal workspace once", func(t *testing.T) {
+		first, err := repo.EnsurePersonal(ctx, owner.ID)
+		require.NoError(t, err)
+		assert.True(t, first.Personal)
+		assert.Equal(t, workspace.PersonalName, first.Name)
+
+		second, err := repo.EnsurePersonal(ctx, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+
+		member, err := repo.GetMember(ctx, first.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, workspace.RoleOwner, member.Role)
+	})
+
+	var team *workspace.Workspace
+	t.Run("Create adds the owner as member and lists personal first", func(t *testing.T) {
+		var err error
+		team, err = repo.Create(ctx, workspace.Workspace{Name: "Team", OwnerID: owner.ID})
+		require.NoError(t, err)
+		assert.False(t, team.Personal)
+
+		list, err := repo.ListByMember(ctx, owner.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.True(t, list[0].Workspace.Personal)
+		assert.Equal(t, team.ID, list[1].Workspace.ID)
+		assert.Equal(t, workspace.RoleOwner, list[1].Role)
+	})
+
+	t.Run("An invitation is renewed while pending and accepted once", func(t *testing.T) {
+		inv, err := repo.UpsertInvitation(ctx, workspace.Invitation{WorkspaceID: team.ID, Email: invitee.Email, Role: workspace.RoleMember, InvitedBy: owner.ID})
+		require.NoError(t, err)
+		renewed, err := repo.UpsertInvitation(ctx, workspace.Invitation{WorkspaceID: team.ID, Email: strings.ToUpper(invitee.Email), Role: workspace.RoleAdmin, InvitedBy: owner.ID})
+		require.NoError(t, err)
+		assert.Equal(t, inv.ID, renewed.ID)
+		assert.Equal(t, workspace.RoleAdmin, renewed.Role)
+
+		pending, err := repo.ListPendingInvitations(ctx, invitee.Email)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		accepted, err := repo.AcceptInvitation(ctx, inv.ID)
+		require.NoError(t, err)
+		require.NotNil(t, accepted.AcceptedAt)
+		_, err = repo.AcceptInvitation(ctx, inv.ID)
+		assert.ErrorIs(t, err, domainerr.ErrInvitationAccepted)
+
+		pending, err = repo.ListPendingInvitations(ctx, invitee.Email)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("Members are added without changing an existing role and removed once", func(t *testing.T) {
+		_, err := repo.AddMember(ctx, workspace.Member{WorkspaceID: team.ID, AccountID: invitee.ID, Role: workspace.RoleAdmin})
+		require.NoError(t, err)
+		again, err := repo.AddMember(ctx, workspace.Member{WorkspaceID: team.ID, AccountID: invitee.ID, Role: workspace.RoleMember})
+		require.NoError(t, err)
+		assert.Equal(t, workspace.RoleAdmin, again.Role)
+
+		members, err := repo.ListMembers(ctx, team.ID)
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		assert.Equal(t, owner.ID, members[0].AccountID)
+
+		require.NoError(t, repo.RemoveMember(ctx, team.ID, invitee.ID))
+		assert.ErrorIs(t, repo.RemoveMember(ctx, team.ID, invitee.ID), domainerr.ErrNotFound)
+		_, err = repo.GetMember(ctx, team.ID, invitee.ID)
+		assert.ErrorIs(t, err, domainerr.ErrNotFound)
+	})
+
+	t.Run("Templates and notes are listed per workspace and member", func(t *testing.T) {
+		tpl := testutil.CreateTestTemplate(t, pool, testutil.TestTemplate{OwnerID: owner.ID, WorkspaceID: team.ID})
+		n := testutil.CreateTestNote(t, pool, testutil.TestNote{TemplateID: tpl.ID, OwnerID: owner.ID})
+
+		got, err := notes.Get(ctx, n.ID)
+		require.NoError(t, err)
+		assert.Equal(t, team.ID, got.Note.WorkspaceID)
+
+		teamTemplates, err := templates.List(ctx, template.Filters{WorkspaceID: &team.ID})
+		require.NoError(t, err)
+		require.Len(t, teamTemplates, 1)
+		assert.Equal(t, tpl.ID, teamTemplates[0].Template.ID)
+
+		teamNotes, err := notes.List(ctx, note.Filters{WorkspaceID: &team.ID, MemberID: &owner.ID})
+		require.NoError(t, err)
+		require.Len(t, teamNotes, 1)
+
+		outsider, err := notes.List(ctx, note.Filters{MemberID: &invitee.ID})
+		require.NoError(t, err)
+		assert.Empty(t, outsider)
+		outsiderTemplates, err := templates.List(ctx, template.Filters{MemberID: &invitee.ID})
+		require.NoError(t, err)
+		assert.Empty(t, outsiderTemplates)
+	})
+}
@@ -0,0 +1,35 @@
+// Package eventsink delivers outbox messages to external destinations.
+package eventsink
+
+import (
+	"encoding/json"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+// envelope is the JSON representation of a delivered message shared by all sinks.
+// Consumers deduplicate redeliveries by ID.
+type envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+func encode(msg outbox.Message) ([]byte, error) {
+	payload := json.RawMessage(msg.Payload)
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+	return json.Marshal(envelope{
+		ID:            msg.ID,
+		Type:          msg.Type,
+		AggregateType: msg.AggregateType,
+		AggregateID:   msg.AggregateID,
+		OccurredAt:    msg.OccurredAt.UTC(),
+		Payload:       payload,
+	})
+}
@@ -0,0 +1,151 @@
+package eventsink_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/eventsink"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+func sampleMessage() outbox.Message {
+	return outbox.Message{
+		ID:            "msg-1",
+		Type:          outbox.NotePublished,
+		AggregateType: outbox.AggregateNote,
+		AggregateID:   "note-1",
+		OccurredAt:    time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+		Payload:       []byte(`{"id":"note-1","status":"Publish"}`),
+	}
+}
+
+func decodeEnvelope(t *testing.T, line []byte) map[string]any {
+	t.Helper()
+	var got map[string]any
+	if err := json.Unmarshal(line, &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", line, err)
+	}
+	if got["id"] != "msg-1" || got["type"] != outbox.NotePublished || got["aggregate_id"] != "note-1" {
+		t.Fatalf("unexpected envelope: %v", got)
+	}
+	if payload, ok := got["payload"].(map[string]any); !ok || payload["status"] != "Publish" {
+		t.Fatalf("unexpected payload: %v", got["payload"])
+	}
+	return got
+}
+
+func TestWebhookSink_Deliver(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "[Success] 2xx acknowledges", status: http.StatusNoContent},
+		{name: "[Fail] 5xx is retried", status: http.StatusServiceUnavailable, wantErr: true},
+		{name: "[Fail] 4xx is retried", status: http.StatusBadRequest, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("unexpected request %s %s", r.Method, r.Header.Get("Content-Type"))
+				}
+				if r.Header.Get("X-Event-ID") != "msg-1" || r.Header.Get("X-Event-Type") != outbox.NotePublished {
+					t.Errorf("unexpected event headers: %v", r.Header)
+				}
+				body, _ := io.ReadAll(r.Body)
+				decodeEnvelope(t, body)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := eventsink.NewWebhookSink(srv.URL, 0).Deliver(context.Background(), sampleMessage())
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWebhookSink_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	err := eventsink.NewWebhookSink(srv.URL, 50*time.Millisecond).Deliver(context.Background(), sampleMessage())
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+}
+
+func TestWriterSink_Deliver(t *testing.T) {
+	var buf bytes.Buffer
+	sink := eventsink.NewWriterSink(&buf)
+
+	if err := sink.Deliver(context.Background(), sampleMessage()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(buf.String(), "\n") || strings.Count(buf.String(), "\n") != 1 {
+		t.Fatalf("want one JSON line, got %q", buf.String())
+	}
+	decodeEnvelope(t, bytes.TrimSpace(buf.Bytes()))
+}
+
+func TestFileSink_Deliver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := eventsink.OpenFileSink(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	for range 2 {
+		if err := sink.Deliver(context.Background(), sampleMessage()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("want 2 appended lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		decodeEnvelope(t, []byte(line))
+	}
+}
+
+func TestFanout_Deliver(t *testing.T) {
+	var first, second bytes.Buffer
+	failing := eventsink.NewWebhookSink("http://127.0.0.1:0", time.Second)
+
+	if err := eventsink.NewFanout(eventsink.NewWriterSink(&first), eventsink.NewWriterSink(&second)).Deliver(context.Background(), sampleMessage()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decodeEnvelope(t, bytes.TrimSpace(first.Bytes()))
+	decodeEnvelope(t, bytes.TrimSpace(second.Bytes()))
+
+	var after bytes.Buffer
+	if err := eventsink.NewFanout(failing, eventsink.NewWriterSink(&after)).Deliver(context.Background(), sampleMessage()); err == nil {
+		t.Fatal("expected the failing sink's error")
+	}
+	if after.Len() != 0 {
+		t.Fatal("sinks after a failure must not receive the message")
+	}
+}
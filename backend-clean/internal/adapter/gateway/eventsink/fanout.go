@@ -0,0 +1,32 @@
+package eventsink
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Fanout hands every message to several sinks in order.
+// The first failure stops the fan-out and the relay retries the whole message,
+// so every sink must tolerate receiving it again.
+type Fanout struct {
+	sinks []port.EventSink
+}
+
+var _ port.EventSink = (*Fanout)(nil)
+
+// NewFanout creates a sink delivering to each of sinks.
+func NewFanout(sinks ...port.EventSink) *Fanout {
+	return &Fanout{sinks: sinks}
+}
+
+// Deliver passes msg to every sink and returns the first error.
+func (f *Fanout) Deliver(ctx context.Context, msg outbox.Message) error {
+	for _, s := range f.sinks {
+		if err := s.Deliver(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DefaultWebhookTimeout bounds a webhook request when no timeout is configured.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// WebhookSink POSTs each message as JSON to a fixed URL.
+// Any 2xx response acknowledges the message; everything else is retried by the relay.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+var _ port.EventSink = (*WebhookSink)(nil)
+
+// NewWebhookSink creates a sink posting to url; timeout <= 0 uses DefaultWebhookTimeout.
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	return &WebhookSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// Deliver posts the message and reports non-2xx responses as errors.
+func (s *WebhookSink) Deliver(ctx context.Context, msg outbox.Message) error {
+	body, err := encode(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-ID", msg.ID)
+	req.Header.Set("X-Event-Type", msg.Type)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %d", resp.StatusCode)
+	}
+	return nil
+}
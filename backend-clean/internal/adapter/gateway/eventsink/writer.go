@@ -0,0 +1,73 @@
+package eventsink
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WriterSink writes each message as one JSON line, e.g. to stdout.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var _ port.EventSink = (*WriterSink)(nil)
+
+// NewWriterSink creates a sink writing to w.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Deliver writes the message followed by a newline.
+func (s *WriterSink) Deliver(_ context.Context, msg outbox.Message) error {
+	line, err := encode(msg)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// FileSink appends each message as one JSON line to a local file.
+// Lines are synced to disk before the message counts as delivered.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ port.EventSink = (*FileSink)(nil)
+
+// OpenFileSink opens path for appending, creating it if needed.
+func OpenFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // path comes from operator configuration
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f}, nil
+}
+
+// Deliver appends the message and syncs the file.
+func (s *FileSink) Deliver(_ context.Context, msg outbox.Message) error {
+	line, err := encode(msg)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
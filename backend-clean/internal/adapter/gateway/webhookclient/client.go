@@ -0,0 +1,89 @@
+// Package webhookclient posts signed webhook deliveries over HTTP.
+package webhookclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DefaultTimeout bounds a delivery request when no timeout is configured.
+const DefaultTimeout = 10 * time.Second
+
+// ErrAddressNotAllowed is returned when a receiver resolves to an address webhooks may not reach.
+var ErrAddressNotAllowed = errors.New("webhook receiver address is not allowed")
+
+// Client implements port.WebhookSender with net/http.
+type Client struct {
+	client *http.Client
+}
+
+var _ port.WebhookSender = (*Client)(nil)
+
+// New creates a client; timeout <= 0 uses DefaultTimeout.
+// Redirects are not followed so a receiver cannot bounce a signed payload elsewhere, and
+// every connection is checked after name resolution so a subscription whose host resolves
+// to a loopback, private or metadata address never reaches it.
+func New(timeout time.Duration) *Client {
+	return newClient(timeout, webhook.IsPublicAddr)
+}
+
+// newClient creates a client that only connects to addresses allow accepts.
+func newClient(timeout time.Duration, allow func(netip.Addr) bool) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Control runs for each address the name resolved to, right before connecting.
+		Control: func(_, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil || !allow(ap.Addr()) {
+				return fmt.Errorf("%w: %s", ErrAddressNotAllowed, address)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the receiver, bypassing the address check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &Client{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send posts the request and reports non-2xx responses as errors.
+func (c *Client) Send(ctx context.Context, r webhook.Request) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(r.Body))
+	if err != nil {
+		return 0, err
+	}
+	for k, v := range r.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver responded %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,95 @@
+package webhookclient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/webhookclient"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+func TestClient_Send(t *testing.T) {
+	const secret = "0123456789abcdef"
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "[Success] 2xx acknowledges", status: http.StatusAccepted},
+		{name: "[Fail] 5xx is an error", status: http.StatusInternalServerError, wantErr: true},
+		{name: "[Fail] redirect is not followed", status: http.StatusFound, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The receiver verifies the signature the way a subscriber would.
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				ts, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+				if err != nil || !webhook.Verify(secret, ts, body, r.Header.Get(webhook.HeaderSignature)) {
+					t.Errorf("signature does not verify: %s", r.Header.Get(webhook.HeaderSignature))
+				}
+				if r.Header.Get(webhook.HeaderEvent) != outbox.NotePublished || r.Header.Get(webhook.HeaderDeliveryID) != "d1" {
+					t.Errorf("unexpected headers: %v", r.Header)
+				}
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			req := webhook.NewRequest(webhook.DueDelivery{
+				Delivery: webhook.Delivery{ID: "d1", EventType: outbox.NotePublished, Payload: []byte(`{"id":"m1"}`)},
+				URL:      srv.URL,
+				Secret:   secret,
+			}, now)
+			status, err := webhookclient.NewAllowingLoopback(time.Second).Send(context.Background(), req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d", status, tt.status)
+			}
+		})
+	}
+}
+
+func TestClient_SendUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	status, err := webhookclient.NewAllowingLoopback(time.Second).Send(context.Background(), webhook.Request{URL: url, Body: []byte(`{}`)})
+	if err == nil || status != 0 {
+		t.Fatalf("status = %d, err = %v; want 0 and an error", status, err)
+	}
+}
+
+func TestClient_SendBlockedAddress(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	// Reach the loopback server through a name, as a subscription that passed validation would.
+	url := strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)
+
+	status, err := webhookclient.New(time.Second).Send(context.Background(), webhook.Request{URL: url, Body: []byte(`{}`)})
+	if !errors.Is(err, webhookclient.ErrAddressNotAllowed) || status != 0 {
+		t.Fatalf("status = %d, err = %v; want 0 and ErrAddressNotAllowed", status, err)
+	}
+	if called {
+		t.Fatal("receiver on a loopback address must not be reached")
+	}
+}
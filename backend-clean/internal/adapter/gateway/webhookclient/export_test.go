@@ -0,0 +1,11 @@
+package webhookclient
+
+import (
+	"net/netip"
+	"time"
+)
+
+// NewAllowingLoopback creates a client that may reach test servers on the loopback interface.
+func NewAllowingLoopback(timeout time.Duration) *Client {
+	return newClient(timeout, func(ip netip.Addr) bool { return ip.IsLoopback() })
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// invalidArgumentErrors are domain errors caused by the request content itself.
+var invalidArgumentErrors = []error{
+	account.ErrInvalidEmail, account.ErrInvalidName,
+	domainerr.ErrInvalidStatus, domainerr.ErrInvalidStatusChange, domainerr.ErrTemplateTrashed,
+	domainerr.ErrInvalidTemplateField, domainerr.ErrTemplateNameRequired, domainerr.ErrTemplateOwnerRequired,
+	domainerr.ErrFieldRequired, domainerr.ErrFieldOrderInvalid, domainerr.ErrFieldLabelRequired,
+	domainerr.ErrInvalidFieldType, domainerr.ErrFieldOptionsInvalid, domainerr.ErrFieldConstraintInvalid,
+	domainerr.ErrSectionsMissing, domainerr.ErrRequiredFieldEmpty, domainerr.ErrInvalidSectionContent,
+	domainerr.ErrSectionTooShort, domainerr.ErrSectionTooLong, domainerr.ErrSectionPatternMismatch,
+	domainerr.ErrProviderRequired, domainerr.ErrProviderAccountRequired,
+	domainerr.ErrTitleRequired, domainerr.ErrOwnerRequired,
+	domainerr.ErrInvalidCursor, domainerr.ErrInvalidPageLimit,
+	domainerr.ErrPublishAtRequired, domainerr.ErrScheduleInPast, domainerr.ErrUnpublishBeforePublish,
+	domainerr.ErrScheduleNotAllowed, domainerr.ErrReviewRequired, domainerr.ErrNoteLockedForReview,
+}
+
+// handleError converts domain errors to gRPC status codes.
+// Validation errors carry a google.rpc.BadRequest detail with one field violation per broken rule.
+func handleError(err error) error {
+	var verr *domainerr.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		return badRequest(verr)
+	case errors.Is(err, domainerr.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domainerr.ErrSessionInvalid), errors.Is(err, domainerr.ErrSessionExpired):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domainerr.ErrUnauthorized), errors.Is(err, domainerr.ErrAccountInactive):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case isAny(err, invalidArgumentErrors):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrTemplateInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domainerr.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, domainerr.ErrWatchInterrupted):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func badRequest(verr *domainerr.ValidationError) error {
+	st := status.New(codes.InvalidArgument, verr.Error())
+	detail := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		detail.FieldViolations = append(detail.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Path,
+			Reason:      v.Code,
+			Description: v.Err.Error(),
+		})
+	}
+	withDetail, err := st.WithDetails(detail)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetail.Err()
+}
+
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// currentAccountID returns the account authenticated by the auth interceptor.
+func currentAccountID(ctx context.Context) (string, error) {
+	id, ok := interceptor.AccountIDFromContext(ctx)
+	if !ok {
+		return "", domainerr.ErrUnauthorized
+	}
+	return id, nil
+}
+
+// optionalTime converts an unset timestamp to nil.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
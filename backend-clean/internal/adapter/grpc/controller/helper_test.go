@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestHandleError(t *testing.T) {
+	verr := &domainerr.ValidationError{}
+	verr.Add("sections[f-1].content", domainerr.CodeTooLong, domainerr.ErrSectionTooLong)
+	verr.Add("sections[f-2]", domainerr.CodeRequired, domainerr.ErrSectionsMissing)
+
+	tests := []struct {
+		name           string
+		err            error
+		wantCode       codes.Code
+		wantViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:     "[Success] validation error carries field violations",
+			err:      verr,
+			wantCode: codes.InvalidArgument,
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "sections[f-1].content", Reason: domainerr.CodeTooLong, Description: domainerr.ErrSectionTooLong.Error()},
+				{Field: "sections[f-2]", Reason: domainerr.CodeRequired, Description: domainerr.ErrSectionsMissing.Error()},
+			},
+		},
+		{name: "[Success] wrapped sentinel", err: fmt.Errorf("create: %w", domainerr.ErrTitleRequired), wantCode: codes.InvalidArgument},
+		{name: "[Success] not found", err: domainerr.ErrNotFound, wantCode: codes.NotFound},
+		{name: "[Success] invalid session", err: domainerr.ErrSessionInvalid, wantCode: codes.Unauthenticated},
+		{name: "[Success] not the owner", err: domainerr.ErrUnauthorized, wantCode: codes.PermissionDenied},
+		{name: "[Success] template in use", err: domainerr.ErrTemplateInUse, wantCode: codes.FailedPrecondition},
+		{name: "[Success] stale version", err: domainerr.ErrVersionConflict, wantCode: codes.Aborted},
+		{name: "[Success] unknown error", err: errors.New("boom"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(handleError(tt.err))
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					violations = append(violations, br.GetFieldViolations()...)
+				}
+			}
+			if len(violations) != len(tt.wantViolations) {
+				t.Fatalf("violations = %v, want %v", violations, tt.wantViolations)
+			}
+			for i, v := range violations {
+				want := tt.wantViolations[i]
+				if v.GetField() != want.Field || v.GetReason() != want.Reason || v.GetDescription() != want.Description {
+					t.Fatalf("violation[%d] = %v, want %v", i, v, want)
+				}
+			}
+		})
+	}
+}
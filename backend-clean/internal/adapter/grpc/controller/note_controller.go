@@ -0,0 +1,251 @@
+package controller
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteController implements notepb.NoteServiceServer.
+type NoteController struct {
+	notepb.UnimplementedNoteServiceServer
+	inputFactory       func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort
+	outputFactory      func() *grpcpresenter.NotePresenter
+	noteRepoFactory    func() port.NoteRepository
+	tplRepoFactory     func() port.TemplateRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+	watchInputFactory  func(output port.NoteWatchOutputPort) port.NoteWatchInputPort
+	watchOutputFactory func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteEventPresenter
+}
+
+// NewNoteController creates a new gRPC note controller.
+func NewNoteController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort,
+	outputFactory func() *grpcpresenter.NotePresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+	watchInputFactory func(output port.NoteWatchOutputPort) port.NoteWatchInputPort,
+	watchOutputFactory func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteEventPresenter,
+) *NoteController {
+	return &NoteController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		noteRepoFactory:    noteRepoFactory,
+		tplRepoFactory:     tplRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+		watchInputFactory:  watchInputFactory,
+		watchOutputFactory: watchOutputFactory,
+	}
+}
+
+// ListNotes returns one page of notes.
+func (s *NoteController) ListNotes(ctx context.Context, req *notepb.ListNotesRequest) (*notepb.ListNotesResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	cursor, err := pagination.ParseCursor(req.GetCursor())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	var status *note.NoteStatus
+	if req.Status != nil {
+		st := note.NoteStatus(req.GetStatus())
+		if err := st.Validate(); err != nil {
+			return nil, handleError(err)
+		}
+		status = &st
+	}
+	input, presenter := s.newIO()
+	err = input.List(ctx, note.Filters{
+		Status:     status,
+		TemplateID: req.TemplateId,
+		OwnerID:    req.OwnerId,
+		MemberID:   &actorID,
+		Query:      req.Q,
+		Cursor:     cursor,
+		Limit:      int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.ListResponse(), nil
+}
+
+// GetNote retrieves a note by ID.
+func (s *NoteController) GetNote(ctx context.Context, req *notepb.GetNoteRequest) (*notepb.NoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	if err := input.Get(ctx, req.GetNoteId(), actorID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// CreateNote creates a note.
+func (s *NoteController) CreateNote(ctx context.Context, req *notepb.CreateNoteRequest) (*notepb.NoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	sections := make([]port.SectionInput, 0, len(req.GetSections()))
+	for _, sec := range req.GetSections() {
+		sections = append(sections, port.SectionInput{
+			FieldID: sec.GetFieldId(),
+			Content: sec.GetContent(),
+		})
+	}
+	input, presenter := s.newIO()
+	err = input.Create(ctx, port.NoteCreateInput{
+		Title:      req.GetTitle(),
+		TemplateID: req.GetTemplateId(),
+		OwnerID:    actorID,
+		Sections:   sections,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// UpdateNote updates a note; sections are left unchanged when the request has none.
+func (s *NoteController) UpdateNote(ctx context.Context, req *notepb.UpdateNoteRequest) (*notepb.NoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	var sections []port.SectionUpdateInput
+	for _, sec := range req.GetSections() {
+		sections = append(sections, port.SectionUpdateInput{
+			SectionID: sec.GetSectionId(),
+			Content:   sec.GetContent(),
+		})
+	}
+	input, presenter := s.newIO()
+	err = input.Update(ctx, port.NoteUpdateInput{
+		ID:       req.GetNoteId(),
+		Title:    req.GetTitle(),
+		OwnerID:  actorID,
+		Version:  int(req.GetVersion()),
+		Sections: sections,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// PublishNote publishes a note.
+func (s *NoteController) PublishNote(ctx context.Context, req *notepb.PublishNoteRequest) (*notepb.NoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return s.changeStatus(ctx, port.NoteStatusChangeInput{
+		ID:      req.GetNoteId(),
+		OwnerID: actorID,
+		Status:  note.StatusPublish,
+		Version: int(req.GetVersion()),
+	})
+}
+
+// UnpublishNote moves a note back to draft.
+func (s *NoteController) UnpublishNote(ctx context.Context, req *notepb.UnpublishNoteRequest) (*notepb.NoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return s.changeStatus(ctx, port.NoteStatusChangeInput{
+		ID:      req.GetNoteId(),
+		OwnerID: actorID,
+		Status:  note.StatusDraft,
+		Version: int(req.GetVersion()),
+	})
+}
+
+// ScheduleNote schedules a note to publish, and optionally unpublish, at a future time.
+func (s *NoteController) ScheduleNote(ctx context.Context, req *notepb.ScheduleNoteRequest) (*notepb.NoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return s.changeStatus(ctx, port.NoteStatusChangeInput{
+		ID:      req.GetNoteId(),
+		OwnerID: actorID,
+		Status:  note.StatusScheduled,
+		Version: int(req.GetVersion()),
+		Schedule: note.Schedule{
+			PublishAt:   optionalTime(req.GetPublishAt()),
+			UnpublishAt: optionalTime(req.GetUnpublishAt()),
+		},
+	})
+}
+
+// DeleteNote moves a note to the trash.
+func (s *NoteController) DeleteNote(ctx context.Context, req *notepb.DeleteNoteRequest) (*notepb.DeleteNoteResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.Delete(ctx, port.NoteDeleteInput{
+		ID:      req.GetNoteId(),
+		OwnerID: actorID,
+		Version: int(req.GetVersion()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.DeleteResponse(), nil
+}
+
+// WatchNotes streams committed note changes matching the request until the client cancels.
+func (s *NoteController) WatchNotes(req *notepb.WatchNotesRequest, stream notepb.NoteService_WatchNotesServer) error {
+	actorID, err := currentAccountID(stream.Context())
+	if err != nil {
+		return handleError(err)
+	}
+	filter := note.EventFilter{
+		OwnerID:    req.OwnerId,
+		TemplateID: req.TemplateId,
+	}
+	if req.Status != nil {
+		st := note.NoteStatus(req.GetStatus())
+		filter.Status = &st
+	}
+	input := s.watchInputFactory(s.watchOutputFactory(stream))
+	err = input.Watch(stream.Context(), port.NoteWatchInput{
+		ActorID: actorID,
+		Filter:  filter,
+		Cursor:  req.GetCursor(),
+	})
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (s *NoteController) changeStatus(ctx context.Context, in port.NoteStatusChangeInput) (*notepb.NoteResponse, error) {
+	input, presenter := s.newIO()
+	if err := input.ChangeStatus(ctx, in); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+func (s *NoteController) newIO() (port.NoteInputPort, *grpcpresenter.NotePresenter) {
+	output := s.outputFactory()
+	input := s.inputFactory(s.noteRepoFactory(), s.tplRepoFactory(), s.accountRepoFactory(), s.txFactory(), output)
+	return input, output
+}
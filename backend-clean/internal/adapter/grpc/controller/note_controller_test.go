@@ -0,0 +1,260 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTestNoteController(input *ctrlmock.NoteInputStub) *NoteController {
+	return newTestNoteWatchController(input, &ctrlmock.NoteWatchInputStub{})
+}
+
+func newTestNoteWatchController(input *ctrlmock.NoteInputStub, watch *ctrlmock.NoteWatchInputStub) *NoteController {
+	return NewNoteController(
+		func(_ port.NoteRepository, _ port.TemplateRepository, _ port.AccountRepository, _ port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+			input.Output = output
+			return input
+		},
+		grpcpresenter.NewNotePresenter,
+		func() port.NoteRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+		func(output port.NoteWatchOutputPort) port.NoteWatchInputPort {
+			watch.Output = output
+			return watch
+		},
+		grpcpresenter.NewNoteEventPresenter,
+	)
+}
+
+// ownerContext is the context of a call authenticated as owner-1.
+func ownerContext() context.Context {
+	return interceptor.WithAccountID(context.Background(), "owner-1")
+}
+
+func TestNoteController_ListNotes(t *testing.T) {
+	draft := "Draft"
+	invalid := "Archived"
+	next := pagination.Cursor{ID: "note-2"}
+
+	tests := []struct {
+		name       string
+		req        *notepb.ListNotesRequest
+		inErr      error
+		wantCode   codes.Code
+		wantStatus *note.NoteStatus
+	}{
+		{
+			name:     "[Success] list with status filter",
+			req:      &notepb.ListNotesRequest{Status: &draft, Limit: 1},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "[Fail] unknown status",
+			req:      &notepb.ListNotesRequest{Status: &invalid},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] malformed cursor",
+			req:      &notepb.ListNotesRequest{Cursor: "not-a-cursor"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] usecase error",
+			req:      &notepb.ListNotesRequest{},
+			inErr:    domainerr.ErrInvalidPageLimit,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{
+				Err:   tt.inErr,
+				Notes: []note.WithMeta{{Note: note.Note{ID: "note-1", Status: note.StatusDraft}}},
+				Page:  pagination.Info{NextCursor: &next, HasMore: true},
+			}
+			res, err := newTestNoteController(input).ListNotes(ownerContext(), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if input.Filters.Status == nil || *input.Filters.Status != note.StatusDraft || input.Filters.Limit != 1 ||
+				input.Filters.MemberID == nil || *input.Filters.MemberID != "owner-1" {
+				t.Fatalf("filters = %+v", input.Filters)
+			}
+			if len(res.GetItems()) != 1 || !res.GetHasMore() || res.GetNextCursor() != next.Encode() {
+				t.Fatalf("unexpected response: %v", res)
+			}
+		})
+	}
+}
+
+func TestNoteController_PublishNote(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		inErr       error
+		wantCode    codes.Code
+		wantVersion int
+	}{
+		{name: "[Success] publish", ctx: ownerContext(), wantCode: codes.OK, wantVersion: 3},
+		{name: "[Fail] not the owner", ctx: ownerContext(), inErr: domainerr.ErrUnauthorized, wantCode: codes.PermissionDenied, wantVersion: 3},
+		{name: "[Fail] stale version", ctx: ownerContext(), inErr: domainerr.ErrVersionConflict, wantCode: codes.Aborted, wantVersion: 3},
+		{name: "[Fail] no authenticated account", ctx: context.Background(), wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			res, err := newTestNoteController(input).PublishNote(tt.ctx, &notepb.PublishNoteRequest{
+				NoteId:  "note-1",
+				Version: 3,
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if input.Version != tt.wantVersion {
+				t.Fatalf("version = %d, want %d", input.Version, tt.wantVersion)
+			}
+			if tt.wantCode == codes.OK && (res.GetStatus() != string(note.StatusPublish) || res.GetVersion() != 4 || res.GetOwnerId() != "owner-1") {
+				t.Fatalf("unexpected response: %v", res)
+			}
+		})
+	}
+}
+
+func TestNoteController_ScheduleNote(t *testing.T) {
+	publishAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	unpublishAt := publishAt.Add(24 * time.Hour)
+	tests := []struct {
+		name        string
+		unpublishAt *timestamppb.Timestamp
+		inErr       error
+		wantCode    codes.Code
+	}{
+		{name: "[Success] schedule publish only", wantCode: codes.OK},
+		{name: "[Success] schedule publish and unpublish", unpublishAt: timestamppb.New(unpublishAt), wantCode: codes.OK},
+		{name: "[Fail] schedule in the past", inErr: &domainerr.ValidationError{Violations: []domainerr.Violation{{Path: "publishAt", Code: domainerr.CodeInvalid, Err: domainerr.ErrScheduleInPast}}}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			res, err := newTestNoteController(input).ScheduleNote(ownerContext(), &notepb.ScheduleNoteRequest{
+				NoteId:      "note-1",
+				Version:     3,
+				PublishAt:   timestamppb.New(publishAt),
+				UnpublishAt: tt.unpublishAt,
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if res.GetStatus() != string(note.StatusScheduled) || !res.GetPublishAt().AsTime().Equal(publishAt) {
+				t.Fatalf("unexpected response: %v", res)
+			}
+			if (tt.unpublishAt == nil) != (res.GetUnpublishAt() == nil) {
+				t.Fatalf("unpublishAt = %v, want %v", res.GetUnpublishAt(), tt.unpublishAt)
+			}
+		})
+	}
+}
+
+func TestNoteController_DeleteNote(t *testing.T) {
+	input := &ctrlmock.NoteInputStub{}
+	res, err := newTestNoteController(input).DeleteNote(ownerContext(), &notepb.DeleteNoteRequest{NoteId: "note-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.GetSuccess() {
+		t.Fatalf("want success")
+	}
+}
+
+// watchStream collects the events sent on a WatchNotes stream.
+type watchStream struct {
+	grpc.ServerStream
+	sent []*notepb.NoteEvent
+}
+
+func (s *watchStream) Context() context.Context { return ownerContext() }
+
+func (s *watchStream) Send(e *notepb.NoteEvent) error {
+	s.sent = append(s.sent, e)
+	return nil
+}
+
+func TestNoteController_WatchNotes(t *testing.T) {
+	owner := "owner-1"
+	publish := "Publish"
+
+	tests := []struct {
+		name     string
+		req      *notepb.WatchNotesRequest
+		inErr    error
+		wantCode codes.Code
+	}{
+		{
+			name:     "[Success] stream filtered events",
+			req:      &notepb.WatchNotesRequest{OwnerId: &owner, Status: &publish, Cursor: "c-1"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "[Fail] malformed cursor",
+			req:      &notepb.WatchNotesRequest{Cursor: "%%%"},
+			inErr:    domainerr.ErrInvalidCursor,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] feed interrupted",
+			req:      &notepb.WatchNotesRequest{},
+			inErr:    domainerr.ErrWatchInterrupted,
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watch := &ctrlmock.NoteWatchInputStub{
+				Err:    tt.inErr,
+				Events: []note.Event{{Cursor: "c-2", Type: note.EventStatusChanged, NoteID: "note-1", OwnerID: owner, Status: note.StatusPublish, Version: 2}},
+			}
+			stream := &watchStream{}
+			err := newTestNoteWatchController(&ctrlmock.NoteInputStub{}, watch).WatchNotes(tt.req, stream)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			f := watch.Input.Filter
+			if f.OwnerID == nil || *f.OwnerID != owner || f.Status == nil || *f.Status != note.StatusPublish || f.TemplateID != nil || watch.Input.Cursor != "c-1" || watch.Input.ActorID != "owner-1" {
+				t.Fatalf("input = %+v", watch.Input)
+			}
+			if len(stream.sent) != 1 || stream.sent[0].GetCursor() != "c-2" || stream.sent[0].GetType() != "status_changed" || stream.sent[0].GetVersion() != 2 {
+				t.Fatalf("sent = %v", stream.sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateController implements templatepb.TemplateServiceServer.
+type TemplateController struct {
+	templatepb.UnimplementedTemplateServiceServer
+	inputFactory       func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort
+	outputFactory      func() *grpcpresenter.TemplatePresenter
+	repoFactory        func() port.TemplateRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+}
+
+// NewTemplateController creates a new gRPC template controller.
+func NewTemplateController(
+	inputFactory func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort,
+	outputFactory func() *grpcpresenter.TemplatePresenter,
+	repoFactory func() port.TemplateRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *TemplateController {
+	return &TemplateController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		repoFactory:        repoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// ListTemplates returns one page of templates.
+func (s *TemplateController) ListTemplates(ctx context.Context, req *templatepb.ListTemplatesRequest) (*templatepb.ListTemplatesResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	cursor, err := pagination.ParseCursor(req.GetCursor())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.List(ctx, template.Filters{
+		Query:    req.Q,
+		OwnerID:  req.OwnerId,
+		MemberID: &actorID,
+		Cursor:   cursor,
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.ListResponse(), nil
+}
+
+// GetTemplate retrieves a template by ID.
+func (s *TemplateController) GetTemplate(ctx context.Context, req *templatepb.GetTemplateRequest) (*templatepb.TemplateResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	if err := input.Get(ctx, req.GetTemplateId(), actorID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// CreateTemplate creates a template.
+func (s *TemplateController) CreateTemplate(ctx context.Context, req *templatepb.CreateTemplateRequest) (*templatepb.TemplateResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.Create(ctx, port.TemplateCreateInput{
+		Name:    req.GetName(),
+		OwnerID: actorID,
+		Fields:  toFields(req.GetFields()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// UpdateTemplate updates a template.
+func (s *TemplateController) UpdateTemplate(ctx context.Context, req *templatepb.UpdateTemplateRequest) (*templatepb.TemplateResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.Update(ctx, port.TemplateUpdateInput{
+		ID:      req.GetTemplateId(),
+		Name:    req.GetName(),
+		Fields:  toFields(req.GetFields()),
+		OwnerID: actorID,
+		Version: int(req.GetVersion()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// DeleteTemplate moves a template to the trash.
+func (s *TemplateController) DeleteTemplate(ctx context.Context, req *templatepb.DeleteTemplateRequest) (*templatepb.DeleteTemplateResponse, error) {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.Delete(ctx, port.TemplateDeleteInput{
+		ID:      req.GetTemplateId(),
+		OwnerID: actorID,
+		Version: int(req.GetVersion()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.DeleteResponse(), nil
+}
+
+func (s *TemplateController) newIO() (port.TemplateInputPort, *grpcpresenter.TemplatePresenter) {
+	output := s.outputFactory()
+	input := s.inputFactory(s.repoFactory(), s.accountRepoFactory(), s.txFactory(), output)
+	return input, output
+}
+
+func toFields(inputs []*templatepb.FieldInput) []template.Field {
+	fields := make([]template.Field, 0, len(inputs))
+	for _, f := range inputs {
+		fields = append(fields, template.Field{
+			ID:             f.GetId(),
+			Label:          f.GetLabel(),
+			Order:          int(f.GetOrder()),
+			IsRequired:     f.GetIsRequired(),
+			Type:           template.FieldType(f.GetType()),
+			Options:        f.GetOptions(),
+			MinLength:      int(f.GetMinLength()),
+			MaxLength:      int(f.GetMaxLength()),
+			Pattern:        f.GetPattern(),
+			Placeholder:    f.GetPlaceholder(),
+			DefaultContent: f.GetDefaultContent(),
+		})
+	}
+	return fields
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestTemplateController_CreateTemplate(t *testing.T) {
+	verr := &domainerr.ValidationError{}
+	verr.Add("name", domainerr.CodeRequired, domainerr.ErrTemplateNameRequired)
+
+	tests := []struct {
+		name       string
+		req        *templatepb.CreateTemplateRequest
+		inErr      error
+		wantCode   codes.Code
+		wantFields []template.Field
+	}{
+		{
+			name: "[Success] create with typed fields",
+			req: &templatepb.CreateTemplateRequest{
+				Name: "Daily",
+				Fields: []*templatepb.FieldInput{
+					{Label: "Mood", Order: 1, Type: "single_select", Options: []string{"good", "bad"}},
+					{Label: "Notes", Order: 2, IsRequired: true, MaxLength: 200, DefaultContent: "-"},
+				},
+			},
+			wantCode: codes.OK,
+			wantFields: []template.Field{
+				{Label: "Mood", Order: 1, Type: template.FieldTypeSingleSelect, Options: []string{"good", "bad"}},
+				{Label: "Notes", Order: 2, IsRequired: true, MaxLength: 200, DefaultContent: "-"},
+			},
+		},
+		{
+			name:       "[Fail] validation error",
+			req:        &templatepb.CreateTemplateRequest{},
+			inErr:      verr,
+			wantCode:   codes.InvalidArgument,
+			wantFields: []template.Field{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := NewTemplateController(
+				func(_ port.TemplateRepository, _ port.AccountRepository, _ port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				grpcpresenter.NewTemplatePresenter,
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			res, err := ctrl.CreateTemplate(ownerContext(), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if !reflect.DeepEqual(input.Fields, tt.wantFields) {
+				t.Fatalf("fields = %+v, want %+v", input.Fields, tt.wantFields)
+			}
+			if tt.wantCode == codes.OK && (res.GetId() != "tpl-1" || res.GetOwnerId() != "owner-1") {
+				t.Fatalf("unexpected response: %v", res)
+			}
+		})
+	}
+}
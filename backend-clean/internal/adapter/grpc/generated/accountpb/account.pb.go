@@ -118,8 +118,10 @@ type CreateOrGetAccountRequest struct {
 	Provider          string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
 	ProviderAccountId string                 `protobuf:"bytes,5,opt,name=provider_account_id,json=providerAccountId,proto3" json:"provider_account_id,omitempty"`
 	Thumbnail         *string                `protobuf:"bytes,6,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// reactivate lets a deactivated account log in again; the reactivation is recorded
+	Reactivate    bool `protobuf:"varint,7,opt,name=reactivate,proto3" json:"reactivate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrGetAccountRequest) Reset() {
@@ -194,6 +196,13 @@ func (x *CreateOrGetAccountRequest) GetThumbnail() string {
 	return ""
 }
 
+func (x *CreateOrGetAccountRequest) GetReactivate() bool {
+	if x != nil {
+		return x.Reactivate
+	}
+	return false
+}
+
 type AccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -312,7 +321,7 @@ const file_proto_account_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\"0\n" +
 	"\x18GetAccountByEmailRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"\xea\x01\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x8a\x02\n" +
 	"\x19CreateOrGetAccountRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
 	"\n" +
@@ -320,7 +329,10 @@ const file_proto_account_proto_rawDesc = "" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x1a\n" +
 	"\bprovider\x18\x04 \x01(\tR\bprovider\x12.\n" +
 	"\x13provider_account_id\x18\x05 \x01(\tR\x11providerAccountId\x12!\n" +
-	"\tthumbnail\x18\x06 \x01(\tH\x00R\tthumbnail\x88\x01\x01B\f\n" +
+	"\tthumbnail\x18\x06 \x01(\tH\x00R\tthumbnail\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"reactivate\x18\a \x01(\bR\n" +
+	"reactivateB\f\n" +
 	"\n" +
 	"_thumbnail\"\xf7\x02\n" +
 	"\x0fAccountResponse\x12\x0e\n" +
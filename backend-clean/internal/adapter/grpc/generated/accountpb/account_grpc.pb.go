@@ -28,13 +28,15 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// AccountService provides account-related operations
+// AccountService provides account-related operations.
+// Every method except CreateOrGetAccount requires an authorization bearer token in the request metadata.
 type AccountServiceClient interface {
 	// GetAccountById retrieves an account by ID
 	GetAccountById(ctx context.Context, in *GetAccountByIdRequest, opts ...grpc.CallOption) (*AccountResponse, error)
 	// GetAccountByEmail retrieves an account by email
 	GetAccountByEmail(ctx context.Context, in *GetAccountByEmailRequest, opts ...grpc.CallOption) (*AccountResponse, error)
-	// CreateOrGetAccount creates or retrieves an OAuth account
+	// CreateOrGetAccount creates or retrieves an OAuth account.
+	// Only the frontend server may call it; it requires the x-login-secret metadata.
 	CreateOrGetAccount(ctx context.Context, in *CreateOrGetAccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
 }
 
@@ -80,13 +82,15 @@ func (c *accountServiceClient) CreateOrGetAccount(ctx context.Context, in *Creat
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility.
 //
-// AccountService provides account-related operations
+// AccountService provides account-related operations.
+// Every method except CreateOrGetAccount requires an authorization bearer token in the request metadata.
 type AccountServiceServer interface {
 	// GetAccountById retrieves an account by ID
 	GetAccountById(context.Context, *GetAccountByIdRequest) (*AccountResponse, error)
 	// GetAccountByEmail retrieves an account by email
 	GetAccountByEmail(context.Context, *GetAccountByEmailRequest) (*AccountResponse, error)
-	// CreateOrGetAccount creates or retrieves an OAuth account
+	// CreateOrGetAccount creates or retrieves an OAuth account.
+	// Only the frontend server may call it; it requires the x-login-secret metadata.
 	CreateOrGetAccount(context.Context, *CreateOrGetAccountRequest) (*AccountResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
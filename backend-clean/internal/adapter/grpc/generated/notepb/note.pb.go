@@ -0,0 +1,1453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: proto/note.proto
+
+package notepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is Draft, InReview, Approved, Publish or Scheduled
+	Status     *string `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	TemplateId *string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	OwnerId    *string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	Q          *string `protobuf:"bytes,4,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	// cursor is the next_cursor of the previous page; empty starts from the newest note
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// limit is the page size; 0 uses the server default
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_proto_note_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListNotesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NoteResponse        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_proto_note_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotesResponse) GetItems() []*NoteResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListNotesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListNotesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type GetNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRequest) Reset() {
+	*x = GetNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRequest) ProtoMessage() {}
+
+func (x *GetNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TemplateId string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// sections may omit fields that have a default content
+	Sections      []*SectionInput `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetSections() []*SectionInput {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type SectionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionInput) Reset() {
+	*x = SectionInput{}
+	mi := &file_proto_note_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionInput) ProtoMessage() {}
+
+func (x *SectionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionInput.ProtoReflect.Descriptor instead.
+func (*SectionInput) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SectionInput) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *SectionInput) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateNoteRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Title  string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// sections replace the content of existing sections; empty leaves the sections unchanged
+	Sections []*SectionUpdate `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	// version is the version the caller last read; 0 skips the check
+	Version       int32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetSections() []*SectionUpdate {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SectionUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionUpdate) Reset() {
+	*x = SectionUpdate{}
+	mi := &file_proto_note_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionUpdate) ProtoMessage() {}
+
+func (x *SectionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionUpdate.ProtoReflect.Descriptor instead.
+func (*SectionUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SectionUpdate) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SectionUpdate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type PublishNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishNoteRequest) Reset() {
+	*x = PublishNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishNoteRequest) ProtoMessage() {}
+
+func (x *PublishNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishNoteRequest.ProtoReflect.Descriptor instead.
+func (*PublishNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PublishNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *PublishNoteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UnpublishNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishNoteRequest) Reset() {
+	*x = UnpublishNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishNoteRequest) ProtoMessage() {}
+
+func (x *UnpublishNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishNoteRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnpublishNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *UnpublishNoteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ScheduleNoteRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	NoteId    string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version   int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// unpublish_at is optional; when set it must be after publish_at
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleNoteRequest) Reset() {
+	*x = ScheduleNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleNoteRequest) ProtoMessage() {}
+
+func (x *ScheduleNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleNoteRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScheduleNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *ScheduleNoteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ScheduleNoteRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ScheduleNoteRequest) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+type DeleteNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *DeleteNoteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_proto_note_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Section struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       string                 `protobuf:"bytes,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	FieldLabel    string                 `protobuf:"bytes,3,opt,name=field_label,json=fieldLabel,proto3" json:"field_label,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	IsRequired    bool                   `protobuf:"varint,5,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	FieldType     string                 `protobuf:"bytes,6,opt,name=field_type,json=fieldType,proto3" json:"field_type,omitempty"`
+	FieldOptions  []string               `protobuf:"bytes,7,rep,name=field_options,json=fieldOptions,proto3" json:"field_options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_proto_note_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Section) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Section) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *Section) GetFieldLabel() string {
+	if x != nil {
+		return x.FieldLabel
+	}
+	return ""
+}
+
+func (x *Section) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Section) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *Section) GetFieldType() string {
+	if x != nil {
+		return x.FieldType
+	}
+	return ""
+}
+
+func (x *Section) GetFieldOptions() []string {
+	if x != nil {
+		return x.FieldOptions
+	}
+	return nil
+}
+
+type Owner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Thumbnail     *string                `protobuf:"bytes,4,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Owner) Reset() {
+	*x = Owner{}
+	mi := &file_proto_note_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Owner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Owner) ProtoMessage() {}
+
+func (x *Owner) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Owner.ProtoReflect.Descriptor instead.
+func (*Owner) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Owner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Owner) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Owner) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Owner) GetThumbnail() string {
+	if x != nil && x.Thumbnail != nil {
+		return *x.Thumbnail
+	}
+	return ""
+}
+
+type NoteResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TemplateId   string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateName string                 `protobuf:"bytes,4,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	OwnerId      string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Owner        *Owner                 `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// status is Draft, InReview, Approved, Publish or Scheduled
+	Status                      string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Sections                    []*Section             `protobuf:"bytes,8,rep,name=sections,proto3" json:"sections,omitempty"`
+	CreatedAt                   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version                     int32                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	TemplateSchemaVersion       int32                  `protobuf:"varint,12,opt,name=template_schema_version,json=templateSchemaVersion,proto3" json:"template_schema_version,omitempty"`
+	LatestTemplateSchemaVersion int32                  `protobuf:"varint,13,opt,name=latest_template_schema_version,json=latestTemplateSchemaVersion,proto3" json:"latest_template_schema_version,omitempty"`
+	// snippet is set only when listing with q; HTML-escaped, with matches wrapped in <mark>...</mark>
+	Snippet *string `protobuf:"bytes,14,opt,name=snippet,proto3,oneof" json:"snippet,omitempty"`
+	// publish_at is set only while the note is Scheduled
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// unpublish_at is set when the note will return to draft at that time
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteResponse) Reset() {
+	*x = NoteResponse{}
+	mi := &file_proto_note_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteResponse) ProtoMessage() {}
+
+func (x *NoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteResponse.ProtoReflect.Descriptor instead.
+func (*NoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NoteResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetOwner() *Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetTemplateSchemaVersion() int32 {
+	if x != nil {
+		return x.TemplateSchemaVersion
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetLatestTemplateSchemaVersion() int32 {
+	if x != nil {
+		return x.LatestTemplateSchemaVersion
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetSnippet() string {
+	if x != nil && x.Snippet != nil {
+		return *x.Snippet
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+type WatchNotesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId    *string                `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	TemplateId *string                `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	// status is Draft, InReview, Approved, Publish or Scheduled; it matches the status the change left the note in
+	Status *string `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// cursor is the cursor of the last event received; empty starts with the next change
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotesRequest) Reset() {
+	*x = WatchNotesRequest{}
+	mi := &file_proto_note_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotesRequest) ProtoMessage() {}
+
+func (x *WatchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotesRequest.ProtoReflect.Descriptor instead.
+func (*WatchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchNotesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *WatchNotesRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *WatchNotesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *WatchNotesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type NoteEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cursor resumes the watch after this event
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// type is created, updated, status_changed or deleted
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	NoteId        string                 `protobuf:"bytes,3,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Version       int32                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteEvent) Reset() {
+	*x = NoteEvent{}
+	mi := &file_proto_note_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteEvent) ProtoMessage() {}
+
+func (x *NoteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteEvent.ProtoReflect.Descriptor instead.
+func (*NoteEvent) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NoteEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NoteEvent) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NoteEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_proto_note_proto protoreflect.FileDescriptor
+
+const file_proto_note_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/note.proto\x12\anote.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x01\n" +
+	"\x10ListNotesRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x02 \x01(\tH\x01R\n" +
+	"templateId\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x02R\aownerId\x88\x01\x01\x12\x11\n" +
+	"\x01q\x18\x04 \x01(\tH\x03R\x01q\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limitB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_template_idB\v\n" +
+	"\t_owner_idB\x04\n" +
+	"\x02_q\"|\n" +
+	"\x11ListNotesResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.note.v1.NoteResponseR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\")\n" +
+	"\x0eGetNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\"\x8d\x01\n" +
+	"\x11CreateNoteRequest\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x121\n" +
+	"\bsections\x18\x04 \x03(\v2\x15.note.v1.SectionInputR\bsectionsJ\x04\b\x01\x10\x02R\bactor_id\"C\n" +
+	"\fSectionInput\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xa0\x01\n" +
+	"\x11UpdateNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x122\n" +
+	"\bsections\x18\x04 \x03(\v2\x16.note.v1.SectionUpdateR\bsections\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversionJ\x04\b\x02\x10\x03R\bactor_id\"H\n" +
+	"\rSectionUpdate\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"W\n" +
+	"\x12PublishNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversionJ\x04\b\x02\x10\x03R\bactor_id\"Y\n" +
+	"\x14UnpublishNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversionJ\x04\b\x02\x10\x03R\bactor_id\"\xd2\x01\n" +
+	"\x13ScheduleNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"publish_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAtJ\x04\b\x02\x10\x03R\bactor_id\"V\n" +
+	"\x11DeleteNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversionJ\x04\b\x02\x10\x03R\bactor_id\".\n" +
+	"\x12DeleteNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd4\x01\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\tR\afieldId\x12\x1f\n" +
+	"\vfield_label\x18\x03 \x01(\tR\n" +
+	"fieldLabel\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1f\n" +
+	"\vis_required\x18\x05 \x01(\bR\n" +
+	"isRequired\x12\x1d\n" +
+	"\n" +
+	"field_type\x18\x06 \x01(\tR\tfieldType\x12#\n" +
+	"\rfield_options\x18\a \x03(\tR\ffieldOptions\"\x84\x01\n" +
+	"\x05Owner\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\tthumbnail\x18\x04 \x01(\tH\x00R\tthumbnail\x88\x01\x01B\f\n" +
+	"\n" +
+	"_thumbnail\"\xb3\x05\n" +
+	"\fNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x12#\n" +
+	"\rtemplate_name\x18\x04 \x01(\tR\ftemplateName\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12$\n" +
+	"\x05owner\x18\x06 \x01(\v2\x0e.note.v1.OwnerR\x05owner\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12,\n" +
+	"\bsections\x18\b \x03(\v2\x10.note.v1.SectionR\bsections\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x126\n" +
+	"\x17template_schema_version\x18\f \x01(\x05R\x15templateSchemaVersion\x12C\n" +
+	"\x1elatest_template_schema_version\x18\r \x01(\x05R\x1blatestTemplateSchemaVersion\x12\x1d\n" +
+	"\asnippet\x18\x0e \x01(\tH\x00R\asnippet\x88\x01\x01\x129\n" +
+	"\n" +
+	"publish_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAtB\n" +
+	"\n" +
+	"\b_snippet\"\xb6\x01\n" +
+	"\x11WatchNotesRequest\x12\x1e\n" +
+	"\bowner_id\x18\x01 \x01(\tH\x00R\aownerId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x02 \x01(\tH\x01R\n" +
+	"templateId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursorB\v\n" +
+	"\t_owner_idB\x0e\n" +
+	"\f_template_idB\t\n" +
+	"\a_status\"\xfb\x01\n" +
+	"\tNoteEvent\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x17\n" +
+	"\anote_id\x18\x03 \x01(\tR\x06noteId\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt2\xe4\x04\n" +
+	"\vNoteService\x12B\n" +
+	"\tListNotes\x12\x19.note.v1.ListNotesRequest\x1a\x1a.note.v1.ListNotesResponse\x129\n" +
+	"\aGetNote\x12\x17.note.v1.GetNoteRequest\x1a\x15.note.v1.NoteResponse\x12?\n" +
+	"\n" +
+	"CreateNote\x12\x1a.note.v1.CreateNoteRequest\x1a\x15.note.v1.NoteResponse\x12?\n" +
+	"\n" +
+	"UpdateNote\x12\x1a.note.v1.UpdateNoteRequest\x1a\x15.note.v1.NoteResponse\x12A\n" +
+	"\vPublishNote\x12\x1b.note.v1.PublishNoteRequest\x1a\x15.note.v1.NoteResponse\x12E\n" +
+	"\rUnpublishNote\x12\x1d.note.v1.UnpublishNoteRequest\x1a\x15.note.v1.NoteResponse\x12C\n" +
+	"\fScheduleNote\x12\x1c.note.v1.ScheduleNoteRequest\x1a\x15.note.v1.NoteResponse\x12E\n" +
+	"\n" +
+	"DeleteNote\x12\x1a.note.v1.DeleteNoteRequest\x1a\x1b.note.v1.DeleteNoteResponse\x12>\n" +
+	"\n" +
+	"WatchNotes\x12\x1a.note.v1.WatchNotesRequest\x1a\x12.note.v1.NoteEvent0\x01BLZJimmortal-architecture-clean/backend/internal/adapter/grpc/generated/notepbb\x06proto3"
+
+var (
+	file_proto_note_proto_rawDescOnce sync.Once
+	file_proto_note_proto_rawDescData []byte
+)
+
+func file_proto_note_proto_rawDescGZIP() []byte {
+	file_proto_note_proto_rawDescOnce.Do(func() {
+		file_proto_note_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_note_proto_rawDesc), len(file_proto_note_proto_rawDesc)))
+	})
+	return file_proto_note_proto_rawDescData
+}
+
+var file_proto_note_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_note_proto_goTypes = []any{
+	(*ListNotesRequest)(nil),      // 0: note.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),     // 1: note.v1.ListNotesResponse
+	(*GetNoteRequest)(nil),        // 2: note.v1.GetNoteRequest
+	(*CreateNoteRequest)(nil),     // 3: note.v1.CreateNoteRequest
+	(*SectionInput)(nil),          // 4: note.v1.SectionInput
+	(*UpdateNoteRequest)(nil),     // 5: note.v1.UpdateNoteRequest
+	(*SectionUpdate)(nil),         // 6: note.v1.SectionUpdate
+	(*PublishNoteRequest)(nil),    // 7: note.v1.PublishNoteRequest
+	(*UnpublishNoteRequest)(nil),  // 8: note.v1.UnpublishNoteRequest
+	(*ScheduleNoteRequest)(nil),   // 9: note.v1.ScheduleNoteRequest
+	(*DeleteNoteRequest)(nil),     // 10: note.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),    // 11: note.v1.DeleteNoteResponse
+	(*Section)(nil),               // 12: note.v1.Section
+	(*Owner)(nil),                 // 13: note.v1.Owner
+	(*NoteResponse)(nil),          // 14: note.v1.NoteResponse
+	(*WatchNotesRequest)(nil),     // 15: note.v1.WatchNotesRequest
+	(*NoteEvent)(nil),             // 16: note.v1.NoteEvent
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+}
+var file_proto_note_proto_depIdxs = []int32{
+	14, // 0: note.v1.ListNotesResponse.items:type_name -> note.v1.NoteResponse
+	4,  // 1: note.v1.CreateNoteRequest.sections:type_name -> note.v1.SectionInput
+	6,  // 2: note.v1.UpdateNoteRequest.sections:type_name -> note.v1.SectionUpdate
+	17, // 3: note.v1.ScheduleNoteRequest.publish_at:type_name -> google.protobuf.Timestamp
+	17, // 4: note.v1.ScheduleNoteRequest.unpublish_at:type_name -> google.protobuf.Timestamp
+	13, // 5: note.v1.NoteResponse.owner:type_name -> note.v1.Owner
+	12, // 6: note.v1.NoteResponse.sections:type_name -> note.v1.Section
+	17, // 7: note.v1.NoteResponse.created_at:type_name -> google.protobuf.Timestamp
+	17, // 8: note.v1.NoteResponse.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 9: note.v1.NoteResponse.publish_at:type_name -> google.protobuf.Timestamp
+	17, // 10: note.v1.NoteResponse.unpublish_at:type_name -> google.protobuf.Timestamp
+	17, // 11: note.v1.NoteEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: note.v1.NoteService.ListNotes:input_type -> note.v1.ListNotesRequest
+	2,  // 13: note.v1.NoteService.GetNote:input_type -> note.v1.GetNoteRequest
+	3,  // 14: note.v1.NoteService.CreateNote:input_type -> note.v1.CreateNoteRequest
+	5,  // 15: note.v1.NoteService.UpdateNote:input_type -> note.v1.UpdateNoteRequest
+	7,  // 16: note.v1.NoteService.PublishNote:input_type -> note.v1.PublishNoteRequest
+	8,  // 17: note.v1.NoteService.UnpublishNote:input_type -> note.v1.UnpublishNoteRequest
+	9,  // 18: note.v1.NoteService.ScheduleNote:input_type -> note.v1.ScheduleNoteRequest
+	10, // 19: note.v1.NoteService.DeleteNote:input_type -> note.v1.DeleteNoteRequest
+	15, // 20: note.v1.NoteService.WatchNotes:input_type -> note.v1.WatchNotesRequest
+	1,  // 21: note.v1.NoteService.ListNotes:output_type -> note.v1.ListNotesResponse
+	14, // 22: note.v1.NoteService.GetNote:output_type -> note.v1.NoteResponse
+	14, // 23: note.v1.NoteService.CreateNote:output_type -> note.v1.NoteResponse
+	14, // 24: note.v1.NoteService.UpdateNote:output_type -> note.v1.NoteResponse
+	14, // 25: note.v1.NoteService.PublishNote:output_type -> note.v1.NoteResponse
+	14, // 26: note.v1.NoteService.UnpublishNote:output_type -> note.v1.NoteResponse
+	14, // 27: note.v1.NoteService.ScheduleNote:output_type -> note.v1.NoteResponse
+	11, // 28: note.v1.NoteService.DeleteNote:output_type -> note.v1.DeleteNoteResponse
+	16, // 29: note.v1.NoteService.WatchNotes:output_type -> note.v1.NoteEvent
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_proto_note_proto_init() }
+func file_proto_note_proto_init() {
+	if File_proto_note_proto != nil {
+		return
+	}
+	file_proto_note_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[13].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_note_proto_rawDesc), len(file_proto_note_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_note_proto_goTypes,
+		DependencyIndexes: file_proto_note_proto_depIdxs,
+		MessageInfos:      file_proto_note_proto_msgTypes,
+	}.Build()
+	File_proto_note_proto = out.File
+	file_proto_note_proto_goTypes = nil
+	file_proto_note_proto_depIdxs = nil
+}
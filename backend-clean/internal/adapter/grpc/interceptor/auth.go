@@ -0,0 +1,106 @@
+// Package interceptor contains gRPC server interceptors for the gRPC adapter.
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type accountIDKey struct{}
+
+// UnaryAuth verifies the authorization bearer token on every unary method except publicMethods
+// and stores the authenticated account ID on the context.
+// publicMethods are full method names (e.g. "/account.v1.AccountService/CreateOrGetAccount").
+func UnaryAuth(verifier port.TokenVerifier, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := methodSet(publicMethods)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+		authed, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		return handler(authed, req)
+	}
+}
+
+// StreamAuth is UnaryAuth for streaming methods.
+func StreamAuth(verifier port.TokenVerifier, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := methodSet(publicMethods)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+		authed, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: authed})
+	}
+}
+
+// WithAccountID returns a context carrying the authenticated account ID.
+func WithAccountID(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, accountIDKey{}, accountID)
+}
+
+// AccountIDFromContext returns the authenticated account ID, if any.
+func AccountIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(accountIDKey{}).(string)
+	if !ok || strings.TrimSpace(id) == "" {
+		return "", false
+	}
+	return id, true
+}
+
+func authenticate(ctx context.Context, verifier port.TokenVerifier) (context.Context, error) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	accountID, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return WithAccountID(ctx, accountID), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func methodSet(methods []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		set[m] = struct{}{}
+	}
+	return set
+}
+
+// contextStream overrides the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type verifierStub struct{}
+
+func (verifierStub) Verify(_ context.Context, token string) (string, error) {
+	if token == "good" {
+		return "acc-1", nil
+	}
+	return "", errors.New("bad token")
+}
+
+type streamStub struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s streamStub) Context() context.Context { return s.ctx }
+
+const (
+	privateMethod = "/note.v1.NoteService/ListNotes"
+	publicMethod  = "/account.v1.AccountService/CreateOrGetAccount"
+)
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		header   string
+		wantCode codes.Code
+		wantID   string
+	}{
+		{name: "[Success] valid bearer token", method: privateMethod, header: "Bearer good", wantCode: codes.OK, wantID: "acc-1"},
+		{name: "[Success] scheme is case-insensitive", method: privateMethod, header: "bearer good", wantCode: codes.OK, wantID: "acc-1"},
+		{name: "[Success] public method skips verification", method: publicMethod, wantCode: codes.OK},
+		{name: "[Fail] missing metadata", method: privateMethod, wantCode: codes.Unauthenticated},
+		{name: "[Fail] wrong scheme", method: privateMethod, header: "Basic good", wantCode: codes.Unauthenticated},
+		{name: "[Fail] invalid token", method: privateMethod, header: "Bearer bad", wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+
+			var gotID string
+			unary := UnaryAuth(verifierStub{}, publicMethod)
+			_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, _ any) (any, error) {
+				gotID, _ = AccountIDFromContext(ctx)
+				return nil, nil
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("unary code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+			if gotID != tt.wantID {
+				t.Fatalf("unary account id = %q, want %q", gotID, tt.wantID)
+			}
+
+			gotID = ""
+			stream := StreamAuth(verifierStub{}, publicMethod)
+			err = stream(nil, streamStub{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, func(_ any, ss grpc.ServerStream) error {
+				gotID, _ = AccountIDFromContext(ss.Context())
+				return nil
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("stream code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+			if gotID != tt.wantID {
+				t.Fatalf("stream account id = %q, want %q", gotID, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// LoginSecretKey is the metadata key carrying the secret shared with the frontend server.
+const LoginSecretKey = "x-login-secret"
+
+// UnaryLoginSecret refuses calls to methods that do not carry secret in LoginSecretKey.
+// An empty secret refuses every call to methods.
+func UnaryLoginSecret(secret string, methods ...string) grpc.UnaryServerInterceptor {
+	guarded := methodSet(methods)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := guarded[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		var given string
+		if values := md.Get(LoginSecretKey); len(values) > 0 {
+			given = values[0]
+		}
+		if secret == "" || subtle.ConstantTimeCompare([]byte(given), []byte(secret)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid login secret")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,47 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryLoginSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		secret   string
+		method   string
+		given    string
+		wantCode codes.Code
+	}{
+		{name: "[Success] login with the shared secret", secret: "s3cret", method: publicMethod, given: "s3cret", wantCode: codes.OK},
+		{name: "[Success] other methods need no secret", secret: "s3cret", method: privateMethod, wantCode: codes.OK},
+		{name: "[Fail] unverified login without secret", secret: "s3cret", method: publicMethod, wantCode: codes.Unauthenticated},
+		{name: "[Fail] wrong secret", secret: "s3cret", method: publicMethod, given: "guess", wantCode: codes.Unauthenticated},
+		{name: "[Fail] no secret configured", method: publicMethod, wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.given != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(LoginSecretKey, tt.given))
+			}
+			reached := false
+			_, err := UnaryLoginSecret(tt.secret, publicMethod)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, any) (any, error) {
+				reached = true
+				return nil, nil
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+			if reached != (tt.wantCode == codes.OK) {
+				t.Fatalf("handler reached = %v", reached)
+			}
+		})
+	}
+}
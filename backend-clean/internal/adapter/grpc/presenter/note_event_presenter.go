@@ -0,0 +1,37 @@
+package presenter
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteEventPresenter implements port.NoteWatchOutputPort by sending each event on a gRPC stream.
+type NoteEventPresenter struct {
+	stream notepb.NoteService_WatchNotesServer
+}
+
+var _ port.NoteWatchOutputPort = (*NoteEventPresenter)(nil)
+
+// NewNoteEventPresenter creates a presenter writing to stream.
+func NewNoteEventPresenter(stream notepb.NoteService_WatchNotesServer) *NoteEventPresenter {
+	return &NoteEventPresenter{stream: stream}
+}
+
+// PresentNoteEvent sends the event to the watcher.
+func (p *NoteEventPresenter) PresentNoteEvent(_ context.Context, e note.Event) error {
+	return p.stream.Send(&notepb.NoteEvent{
+		Cursor:     e.Cursor,
+		Type:       string(e.Type),
+		NoteId:     e.NoteID,
+		OwnerId:    e.OwnerID,
+		TemplateId: e.TemplateID,
+		Status:     string(e.Status),
+		Version:    int32(e.Version), //nolint:gosec
+		OccurredAt: timestamppb.New(e.OccurredAt),
+	})
+}
@@ -0,0 +1,147 @@
+package presenter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotePresenter implements port.NoteOutputPort for gRPC.
+type NotePresenter struct {
+	mu      sync.RWMutex
+	note    *notepb.NoteResponse
+	list    *notepb.ListNotesResponse
+	deleted bool
+}
+
+var _ port.NoteOutputPort = (*NotePresenter)(nil)
+
+// NewNotePresenter creates a new gRPC note presenter.
+func NewNotePresenter() *NotePresenter {
+	return &NotePresenter{}
+}
+
+// PresentNoteList converts one page of notes to a gRPC response and stores it.
+func (p *NotePresenter) PresentNoteList(_ context.Context, notes []note.WithMeta, page pagination.Info) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	items := make([]*notepb.NoteResponse, 0, len(notes))
+	for _, n := range notes {
+		items = append(items, toNoteResponse(n))
+	}
+	p.list = &notepb.ListNotesResponse{
+		Items:      items,
+		NextCursor: nextCursor(page),
+		HasMore:    page.HasMore,
+	}
+	return nil
+}
+
+// PresentNote converts a domain note to a gRPC response and stores it.
+func (p *NotePresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.note = toNoteResponse(*n)
+	return nil
+}
+
+// PresentNoteDeleted marks delete success.
+func (p *NotePresenter) PresentNoteDeleted(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deleted = true
+	return nil
+}
+
+// PresentNoteRevisions is a no-op: revision history is not served over gRPC.
+func (p *NotePresenter) PresentNoteRevisions(_ context.Context, _ []note.Revision) error {
+	return nil
+}
+
+// PresentNoteRevisionDiff is a no-op: revision diffs are not served over gRPC.
+func (p *NotePresenter) PresentNoteRevisionDiff(_ context.Context, _ note.RevisionDiff) error {
+	return nil
+}
+
+// PresentNoteDuplicated is a no-op: notes are not duplicated over gRPC.
+func (p *NotePresenter) PresentNoteDuplicated(_ context.Context, _ *note.WithMeta, _ []note.UnmappedSection) error {
+	return nil
+}
+
+// Response returns the stored note response.
+func (p *NotePresenter) Response() *notepb.NoteResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.note
+}
+
+// ListResponse returns the stored note list response.
+func (p *NotePresenter) ListResponse() *notepb.ListNotesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.list
+}
+
+// DeleteResponse returns the delete response.
+func (p *NotePresenter) DeleteResponse() *notepb.DeleteNoteResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &notepb.DeleteNoteResponse{Success: p.deleted}
+}
+
+func toNoteResponse(n note.WithMeta) *notepb.NoteResponse {
+	sections := make([]*notepb.Section, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		sections = append(sections, &notepb.Section{
+			Id:           s.Section.ID,
+			FieldId:      s.Section.FieldID,
+			FieldLabel:   s.FieldLabel,
+			Content:      s.Section.Content,
+			IsRequired:   s.IsRequired,
+			FieldType:    string(s.FieldType),
+			FieldOptions: s.FieldOptions,
+		})
+	}
+	var snippet *string
+	if n.Snippet != "" {
+		snippet = &n.Snippet
+	}
+	return &notepb.NoteResponse{
+		Id:           n.Note.ID,
+		Title:        n.Note.Title,
+		TemplateId:   n.Note.TemplateID,
+		TemplateName: n.TemplateName,
+		OwnerId:      n.Note.OwnerID,
+		Owner: &notepb.Owner{
+			Id:        n.Note.OwnerID,
+			FirstName: n.OwnerFirstName,
+			LastName:  n.OwnerLastName,
+			Thumbnail: n.OwnerThumbnail,
+		},
+		Status:                      string(n.Note.Status),
+		Sections:                    sections,
+		CreatedAt:                   timestamppb.New(n.Note.CreatedAt),
+		UpdatedAt:                   timestamppb.New(n.Note.UpdatedAt),
+		Version:                     int32(n.Note.Version),        //nolint:gosec
+		TemplateSchemaVersion:       int32(n.Note.SchemaVersion),  //nolint:gosec
+		LatestTemplateSchemaVersion: int32(n.LatestSchemaVersion), //nolint:gosec
+		Snippet:                     snippet,
+		PublishAt:                   optionalTimestamp(n.Note.PublishAt),
+		UnpublishAt:                 optionalTimestamp(n.Note.UnpublishAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
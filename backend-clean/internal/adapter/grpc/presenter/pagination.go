@@ -0,0 +1,11 @@
+package presenter
+
+import "immortal-architecture-clean/backend/internal/domain/pagination"
+
+// nextCursor encodes the cursor of the following page, or "" on the last page.
+func nextCursor(page pagination.Info) string {
+	if page.NextCursor == nil {
+		return ""
+	}
+	return page.NextCursor.Encode()
+}
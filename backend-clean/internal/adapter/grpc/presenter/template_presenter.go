@@ -0,0 +1,118 @@
+package presenter
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplatePresenter implements port.TemplateOutputPort for gRPC.
+type TemplatePresenter struct {
+	mu       sync.RWMutex
+	template *templatepb.TemplateResponse
+	list     *templatepb.ListTemplatesResponse
+	deleted  bool
+}
+
+var _ port.TemplateOutputPort = (*TemplatePresenter)(nil)
+
+// NewTemplatePresenter creates a new gRPC template presenter.
+func NewTemplatePresenter() *TemplatePresenter {
+	return &TemplatePresenter{}
+}
+
+// PresentTemplateList converts one page of templates to a gRPC response and stores it.
+func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates []template.WithUsage, page pagination.Info) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	items := make([]*templatepb.TemplateResponse, 0, len(templates))
+	for _, t := range templates {
+		items = append(items, toTemplateResponse(t))
+	}
+	p.list = &templatepb.ListTemplatesResponse{
+		Items:      items,
+		NextCursor: nextCursor(page),
+		HasMore:    page.HasMore,
+	}
+	return nil
+}
+
+// PresentTemplate converts a domain template to a gRPC response and stores it.
+func (p *TemplatePresenter) PresentTemplate(_ context.Context, tpl *template.WithUsage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.template = toTemplateResponse(*tpl)
+	return nil
+}
+
+// PresentTemplateDeleted marks delete success.
+func (p *TemplatePresenter) PresentTemplateDeleted(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deleted = true
+	return nil
+}
+
+// Response returns the stored template response.
+func (p *TemplatePresenter) Response() *templatepb.TemplateResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.template
+}
+
+// ListResponse returns the stored template list response.
+func (p *TemplatePresenter) ListResponse() *templatepb.ListTemplatesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.list
+}
+
+// DeleteResponse returns the delete response.
+func (p *TemplatePresenter) DeleteResponse() *templatepb.DeleteTemplateResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &templatepb.DeleteTemplateResponse{Success: p.deleted}
+}
+
+func toTemplateResponse(t template.WithUsage) *templatepb.TemplateResponse {
+	fields := make([]*templatepb.Field, 0, len(t.Template.Fields))
+	for _, f := range t.Template.Fields {
+		fields = append(fields, &templatepb.Field{
+			Id:             f.ID,
+			Key:            f.Key,
+			Label:          f.Label,
+			Order:          int32(f.Order), //nolint:gosec
+			IsRequired:     f.IsRequired,
+			Type:           string(f.Type),
+			Options:        f.Options,
+			MinLength:      int32(f.MinLength), //nolint:gosec
+			MaxLength:      int32(f.MaxLength), //nolint:gosec
+			Pattern:        f.Pattern,
+			Placeholder:    f.Placeholder,
+			DefaultContent: f.DefaultContent,
+		})
+	}
+	return &templatepb.TemplateResponse{
+		Id:      t.Template.ID,
+		Name:    t.Template.Name,
+		OwnerId: t.Template.OwnerID,
+		Owner: &templatepb.Owner{
+			Id:        t.Owner.ID,
+			FirstName: t.Owner.FirstName,
+			LastName:  t.Owner.LastName,
+			Thumbnail: t.Owner.Thumbnail,
+		},
+		Fields:        fields,
+		IsUsed:        t.IsUsed,
+		UpdatedAt:     timestamppb.New(t.Template.UpdatedAt),
+		Version:       int32(t.Template.Version),       //nolint:gosec
+		SchemaVersion: int32(t.Template.SchemaVersion), //nolint:gosec
+	}
+}
@@ -8,7 +8,6 @@ import (
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
-	"immortal-architecture-clean/backend/internal/domain/account"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -32,27 +31,6 @@ func NewAccountController(
 	}
 }
 
-// CreateOrGet handles account upsert via OAuth.
-func (c *AccountController) CreateOrGet(ctx echo.Context) error {
-	var body openapi.ModelsCreateOrGetAccountRequest
-	if err := ctx.Bind(&body); err != nil {
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
-	}
-	input, p := c.newIO()
-	err := input.CreateOrGet(ctx.Request().Context(), account.OAuthAccountInput{
-		Email:             body.Email,
-		FirstName:         body.Name,
-		LastName:          "",
-		Provider:          body.Provider,
-		ProviderAccountID: body.ProviderAccountId,
-		Thumbnail:         body.Thumbnail,
-	})
-	if err != nil {
-		return handleError(ctx, err)
-	}
-	return ctx.JSON(http.StatusOK, p.Response())
-}
-
 // GetByID handles GET /accounts/:id.
 func (c *AccountController) GetByID(ctx echo.Context, accountID string) error {
 	input, p := c.newIO()
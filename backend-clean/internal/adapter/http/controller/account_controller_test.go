@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"bytes"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -15,73 +14,17 @@ import (
 	"immortal-architecture-clean/backend/internal/port"
 )
 
-func TestAccountController_CreateOrGet(t *testing.T) {
-	tests := []struct {
-		name       string
-		body       string
-		createErr  error
-		wantStatus int
-		wantBody   string
-	}{
-		{
-			name:       "[Success] create or get",
-			body:       `{"email":"user@example.com","name":"Taro","provider":"google","providerAccountId":"pid"}`,
-			wantStatus: http.StatusOK,
-		},
-		{
-			name:       "[Fail] usecase error",
-			body:       `{"email":"user@example.com","name":"Taro","provider":"google","providerAccountId":"pid"}`,
-			createErr:  domainerr.ErrNotFound,
-			wantStatus: http.StatusNotFound,
-			wantBody:   domainerr.ErrNotFound.Error(),
-		},
-		{
-			name:       "[Fail] bind error",
-			body:       `not-json`,
-			wantStatus: http.StatusBadRequest,
-			wantBody:   "invalid body",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			p := presenter.NewAccountPresenter()
-			input := &ctrlmock.AccountInputStub{CreateErr: tt.createErr}
-			ctrl := NewAccountController(
-				func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
-					input.Output = output
-					return input
-				},
-				func() *presenter.AccountPresenter { return p },
-				func() port.AccountRepository { return nil },
-			)
-
-			e := echo.New()
-			req := httptest.NewRequest(http.MethodPost, "/api/accounts/auth", bytes.NewBufferString(tt.body))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-			rec := httptest.NewRecorder()
-			c := e.NewContext(req, rec)
-
-			_ = ctrl.CreateOrGet(c)
-			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
-			if tt.wantStatus == http.StatusOK && (p.Response() == nil || p.Response().Email != "user@example.com") {
-				t.Fatalf("presenter response not set: %+v", p.Response())
-			}
-		})
-	}
-}
-
 func TestAccountController_GetCurrent(t *testing.T) {
 	tests := []struct {
 		name       string
-		headerID   string
+		accountID  string
 		getErr     error
 		wantStatus int
 		wantBody   string
 	}{
-		{name: "[Success] with header", headerID: "acc-1", wantStatus: http.StatusOK},
-		{name: "[Fail] missing header", headerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
-		{name: "[Fail] usecase not found", headerID: "acc-1", getErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Success] authenticated", accountID: "acc-1", wantStatus: http.StatusOK},
+		{name: "[Fail] unauthenticated", accountID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] usecase not found", accountID: "acc-1", getErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
 
 	for _, tt := range tests {
@@ -97,10 +40,7 @@ func TestAccountController_GetCurrent(t *testing.T) {
 				func() port.AccountRepository { return nil },
 			)
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodGet, "/api/accounts/me", nil)
-			if tt.headerID != "" {
-				req.Header.Set("X-Account-ID", tt.headerID)
-			}
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/accounts/me", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DefaultImportMaxBytes is the largest import body accepted when no limit is configured.
+const DefaultImportMaxBytes = 32 << 20
+
+// ArchiveController handles exporting and importing all data of the current account.
+type ArchiveController struct {
+	inputFactory       func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort
+	outputFactory      func() *presenter.ArchivePresenter
+	tplRepoFactory     func() port.TemplateRepository
+	noteRepoFactory    func() port.NoteRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+	importMaxBytes     int64
+}
+
+// NewArchiveController creates ArchiveController.
+// Import bodies above importMaxBytes are refused; importMaxBytes <= 0 uses DefaultImportMaxBytes.
+func NewArchiveController(
+	inputFactory func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort,
+	outputFactory func() *presenter.ArchivePresenter,
+	tplRepoFactory func() port.TemplateRepository,
+	noteRepoFactory func() port.NoteRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+	importMaxBytes int64,
+) *ArchiveController {
+	if importMaxBytes <= 0 {
+		importMaxBytes = DefaultImportMaxBytes
+	}
+	return &ArchiveController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		tplRepoFactory:     tplRepoFactory,
+		noteRepoFactory:    noteRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+		importMaxBytes:     importMaxBytes,
+	}
+}
+
+// Export handles GET /accounts/me/export.
+func (c *ArchiveController) Export(ctx echo.Context) error {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Export(ctx.Request().Context(), accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", p.Filename()))
+	return ctx.Blob(http.StatusOK, archive.MediaType, p.Body())
+}
+
+// Import handles POST /accounts/me/import. The request body is an archive as written by Export;
+// bodies above the configured limit are refused with 413.
+func (c *ArchiveController) Import(ctx echo.Context) error {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	body := http.MaxBytesReader(ctx.Response(), ctx.Request().Body, c.importMaxBytes)
+	a, err := archive.Decode(body)
+	if err != nil {
+		// The decoder may report the cut-off line before the read error, so ask the body itself.
+		var tooLarge *http.MaxBytesError
+		if _, rerr := body.Read(make([]byte, 1)); errors.As(rerr, &tooLarge) {
+			return ctx.JSON(http.StatusRequestEntityTooLarge, openapi.ModelsErrorResponse{Code: "PAYLOAD_TOO_LARGE", Message: fmt.Sprintf("archive must be at most %d bytes", tooLarge.Limit)})
+		}
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Import(ctx.Request().Context(), port.ArchiveImportInput{Archive: *a, OwnerID: accountID}); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Imported())
+}
+
+func (c *ArchiveController) newIO() (port.ArchiveInputPort, *presenter.ArchivePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.tplRepoFactory(), c.noteRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newArchiveTestController(input *ctrlmock.ArchiveInputStub, importMaxBytes int64) *ArchiveController {
+	return NewArchiveController(
+		func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewArchivePresenter,
+		func() port.TemplateRepository { return nil },
+		func() port.NoteRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+		importMaxBytes,
+	)
+}
+
+func TestArchiveController_Export(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] archive download", accountID: "acc-1", wantStatus: http.StatusOK, wantBody: `{"type":"archive","version":1,"accountId":"acc-1"`},
+		{name: "[Fail] account missing", wantStatus: http.StatusForbidden},
+		{name: "[Fail] use case error", accountID: "acc-1", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ArchiveInputStub{Err: tt.inErr}
+			ctrl := newArchiveTestController(input, 0)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/accounts/me/export", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Export(echo.New().NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get(echo.HeaderContentType); got != archive.MediaType {
+				t.Fatalf("content type = %q", got)
+			}
+			if got := rec.Header().Get(echo.HeaderContentDisposition); got != `attachment; filename="account-acc-1.jsonl"` {
+				t.Fatalf("content disposition = %q", got)
+			}
+		})
+	}
+}
+
+func TestArchiveController_Import(t *testing.T) {
+	valid := `{"type":"archive","version":1,"accountId":"acc-1","exportedAt":"2026-10-16T09:00:00Z"}
+{"type":"template","id":"tpl-1","name":"Weekly"}
+{"type":"field","templateId":"tpl-1","id":"f-1","key":"k-1","label":"Summary","order":1,"isRequired":true,"fieldType":"text"}
+{"type":"note","id":"note-1","templateId":"tpl-1","title":"Week 42","status":"Draft"}
+{"type":"section","noteId":"note-1","id":"s-1","fieldId":"f-1","fieldKey":"k-1","content":"shipped"}
+`
+	tests := []struct {
+		name       string
+		body       string
+		accountID  string
+		maxBytes   int64
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantCalled bool
+	}{
+		{name: "[Success] import archive", body: valid, accountID: "acc-2", wantStatus: http.StatusOK, wantBody: `{"notes":1,"templates":1}`, wantCalled: true},
+		{name: "[Fail] account missing", body: valid, wantStatus: http.StatusForbidden},
+		{name: "[Fail] malformed archive", body: "{", accountID: "acc-2", wantStatus: http.StatusBadRequest, wantBody: "line 1"},
+		{name: "[Fail] other archive version", body: `{"type":"archive","version":9}`, accountID: "acc-2", wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrArchiveVersionUnsupported.Error()},
+		{name: "[Fail] body above the limit", body: valid, accountID: "acc-2", maxBytes: 64, wantStatus: http.StatusRequestEntityTooLarge, wantBody: "PAYLOAD_TOO_LARGE"},
+		{name: "[Fail] invalid note", body: valid, accountID: "acc-2", inErr: domainerr.ErrTitleRequired, wantStatus: http.StatusBadRequest, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ArchiveInputStub{Err: tt.inErr}
+			ctrl := newArchiveTestController(input, tt.maxBytes)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/accounts/me/import", strings.NewReader(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, archive.MediaType)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Import(echo.New().NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if called := input.Imported.OwnerID != ""; called != tt.wantCalled {
+				t.Fatalf("input = %+v", input.Imported)
+			}
+			if tt.wantCalled && (input.Imported.OwnerID != "acc-2" || len(input.Imported.Archive.Notes[0].Sections) != 1) {
+				t.Fatalf("input = %+v", input.Imported)
+			}
+		})
+	}
+}
@@ -3,41 +3,152 @@ package controller
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/middleware"
 	"immortal-architecture-clean/backend/internal/domain/account"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 )
 
+// badRequestErrors are domain errors caused by the request content itself.
+var badRequestErrors = []error{
+	account.ErrInvalidEmail, account.ErrInvalidName,
+	domainerr.ErrInvalidStatus, domainerr.ErrInvalidStatusChange, domainerr.ErrTemplateTrashed,
+	domainerr.ErrInvalidTemplateField, domainerr.ErrTemplateNameRequired, domainerr.ErrTemplateOwnerRequired,
+	domainerr.ErrFieldRequired, domainerr.ErrFieldOrderInvalid, domainerr.ErrFieldLabelRequired,
+	domainerr.ErrInvalidFieldType, domainerr.ErrFieldOptionsInvalid, domainerr.ErrFieldConstraintInvalid,
+	domainerr.ErrSectionsMissing, domainerr.ErrRequiredFieldEmpty, domainerr.ErrInvalidSectionContent,
+	domainerr.ErrSectionTooShort, domainerr.ErrSectionTooLong, domainerr.ErrSectionPatternMismatch,
+	domainerr.ErrProviderRequired, domainerr.ErrProviderAccountRequired,
+	domainerr.ErrTitleRequired, domainerr.ErrOwnerRequired,
+	domainerr.ErrInvalidCursor, domainerr.ErrInvalidPageLimit,
+	domainerr.ErrInvalidWebhookURL, domainerr.ErrWebhookHostNotAllowed, domainerr.ErrWebhookSecretTooShort, domainerr.ErrWebhookEventRequired,
+	domainerr.ErrUnsupportedWebhookEvent, domainerr.ErrDuplicateWebhookEvent,
+	domainerr.ErrPublishAtRequired, domainerr.ErrScheduleInPast, domainerr.ErrUnpublishBeforePublish,
+	domainerr.ErrScheduleNotAllowed,
+	domainerr.ErrReviewRequired, domainerr.ErrReviewerRequired, domainerr.ErrDuplicateReviewer,
+	domainerr.ErrSelfReview, domainerr.ErrUnknownReviewer, domainerr.ErrReviewersLocked, domainerr.ErrNoteLockedForReview,
+	domainerr.ErrReviewCommentRequired, domainerr.ErrReviewCommentTooLong,
+	domainerr.ErrInvalidShareRole, domainerr.ErrShareWithOwner, domainerr.ErrUnknownShareAccount,
+	domainerr.ErrPersonalWorkspace, domainerr.ErrInvitationAccepted, domainerr.ErrRemoveWorkspaceOwner,
+	domainerr.ErrShareLinkNotPublished,
+	domainerr.ErrArchiveMalformed, domainerr.ErrArchiveVersionUnsupported, domainerr.ErrArchiveTemplateMissing,
+	domainerr.ErrTemplateDocumentVersionUnsupported,
+}
+
 func handleError(ctx echo.Context, err error) error {
+	var verr *domainerr.ValidationError
 	switch {
+	case errors.As(err, &verr):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error(), Details: errorDetails(verr)})
 	case errors.Is(err, domainerr.ErrNotFound):
 		return ctx.JSON(http.StatusNotFound, openapi.ModelsNotFoundError{Code: openapi.ModelsNotFoundErrorCodeNOTFOUND, Message: err.Error()})
-	case errors.Is(err, domainerr.ErrUnauthorized):
+	case errors.Is(err, domainerr.ErrSessionInvalid), errors.Is(err, domainerr.ErrSessionExpired):
+		return ctx.JSON(http.StatusUnauthorized, openapi.ModelsUnauthorizedError{Code: openapi.ModelsUnauthorizedErrorCodeUNAUTHORIZED, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrUnauthorized), errors.Is(err, domainerr.ErrAccountInactive):
 		return ctx.JSON(http.StatusForbidden, openapi.ModelsForbiddenError{Code: openapi.ModelsForbiddenErrorCodeFORBIDDEN, Message: err.Error()})
-	case errors.Is(err, account.ErrInvalidEmail), errors.Is(err, account.ErrInvalidName):
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
-	case errors.Is(err, domainerr.ErrInvalidStatus) || errors.Is(err, domainerr.ErrInvalidStatusChange) || errors.Is(err, domainerr.ErrInvalidTemplateField):
+	case isAny(err, badRequestErrors):
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrTemplateInUse):
+		return ctx.JSON(http.StatusConflict, openapi.ModelsErrorResponse{Code: "CONFLICT", Message: err.Error()})
+	case errors.Is(err, domainerr.ErrVersionConflict):
+		return ctx.JSON(http.StatusPreconditionFailed, openapi.ModelsPreconditionFailedError{Code: openapi.ModelsPreconditionFailedErrorCodePRECONDITIONFAILED, Message: err.Error()})
 	default:
 		return ctx.JSON(http.StatusInternalServerError, openapi.ModelsErrorResponse{Code: "INTERNAL_ERROR", Message: err.Error()})
 	}
 }
 
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorDetails lists each violation so clients can point at the offending input.
+func errorDetails(verr *domainerr.ValidationError) *[]openapi.ModelsErrorDetail {
+	details := make([]openapi.ModelsErrorDetail, 0, len(verr.Violations))
+	for _, v := range verr.Violations {
+		details = append(details, openapi.ModelsErrorDetail{Field: v.Path, Code: v.Code, Message: v.Err.Error()})
+	}
+	return &details
+}
+
+// currentAccountID returns the actor authenticated by middleware.Auth.
 func currentAccountID(ctx echo.Context) (string, error) {
-	id := ctx.Request().Header.Get("X-Account-ID")
-	if strings.TrimSpace(id) == "" {
+	id, ok := middleware.AccountIDFromContext(ctx.Request().Context())
+	if !ok {
 		return "", domainerr.ErrUnauthorized
 	}
 	return id, nil
 }
 
+// pageParams parses the cursor/limit query parameters shared by list endpoints.
+// An omitted limit is returned as 0 so the use case applies its default.
+func pageParams(cursor *string, limit *int32) (*pagination.Cursor, int, error) {
+	c, err := pagination.ParseCursor(valueOrEmpty(cursor))
+	if err != nil {
+		return nil, 0, err
+	}
+	if limit == nil {
+		return c, 0, nil
+	}
+	if *limit < 1 {
+		return nil, 0, domainerr.ErrInvalidPageLimit
+	}
+	return c, int(*limit), nil
+}
+
+// ifMatchVersion parses an If-Match header carrying an ETag issued by the presenters.
+// A missing header or "*" returns 0, meaning the write is unconditional. Any tag this
+// service could not have issued cannot match the current version, so it is a conflict.
+func ifMatchVersion(header *string) (int, error) {
+	v := strings.TrimSpace(valueOrEmpty(header))
+	if v == "" || v == "*" {
+		return 0, nil
+	}
+	unquoted, err := strconv.Unquote(v)
+	if err != nil {
+		return 0, domainerr.ErrVersionConflict
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, domainerr.ErrVersionConflict
+	}
+	return version, nil
+}
+
+// setETag exposes the presented resource version to the client.
+func setETag(ctx echo.Context, etag string) {
+	if etag != "" {
+		ctx.Response().Header().Set("ETag", etag)
+	}
+}
+
 func valueOrEmpty(s *string) string {
 	if s == nil {
 		return ""
 	}
 	return *s
 }
+
+func intOrZero(v *int32) int {
+	if v == nil {
+		return 0
+	}
+	return int(*v)
+}
+
+func valueOrNil[T any](s *[]T) []T {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
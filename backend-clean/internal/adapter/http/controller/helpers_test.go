@@ -1,11 +1,30 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/middleware"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
 
+// withAccount simulates middleware.Auth by attaching an authenticated account ID.
+func withAccount(req *http.Request, accountID string) *http.Request {
+	if accountID == "" {
+		return req
+	}
+	return req.WithContext(middleware.WithAccountID(req.Context(), accountID))
+}
+
 // assertStatusBody checks HTTP status and optional body substring.
 func assertStatusBody(t *testing.T, rec *httptest.ResponseRecorder, wantStatus int, wantBody string) {
 	t.Helper()
@@ -16,3 +35,58 @@ func assertStatusBody(t *testing.T, rec *httptest.ResponseRecorder, wantStatus i
 		t.Fatalf("body = %q, want to contain %q", rec.Body.String(), wantBody)
 	}
 }
+
+func strPtr(s string) *string { return &s }
+
+func TestHandleError(t *testing.T) {
+	verr := &domainerr.ValidationError{}
+	verr.Add("name", domainerr.CodeRequired, domainerr.ErrTemplateNameRequired)
+	verr.Add("fields[1].order", domainerr.CodeDuplicate, domainerr.ErrFieldOrderInvalid)
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantDetails []openapi.ModelsErrorDetail
+	}{
+		{
+			name:       "[Success] validation error lists every violation",
+			err:        verr,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []openapi.ModelsErrorDetail{
+				{Field: "name", Code: domainerr.CodeRequired, Message: domainerr.ErrTemplateNameRequired.Error()},
+				{Field: "fields[1].order", Code: domainerr.CodeDuplicate, Message: domainerr.ErrFieldOrderInvalid.Error()},
+			},
+		},
+		{name: "[Success] bare validation sentinel", err: domainerr.ErrTitleRequired, wantStatus: http.StatusBadRequest},
+		{name: "[Success] wrapped sentinel", err: fmt.Errorf("create: %w", domainerr.ErrSectionsMissing), wantStatus: http.StatusBadRequest},
+		{name: "[Success] not found", err: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Success] expired session", err: domainerr.ErrSessionExpired, wantStatus: http.StatusUnauthorized},
+		{name: "[Success] inactive account", err: domainerr.ErrAccountInactive, wantStatus: http.StatusForbidden},
+		{name: "[Success] template in use", err: domainerr.ErrTemplateInUse, wantStatus: http.StatusConflict},
+		{name: "[Success] stale version", err: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed},
+		{name: "[Success] unknown error", err: errors.New("boom"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			_ = handleError(ctx, tt.err)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var body openapi.ModelsErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if tt.wantDetails == nil && body.Details != nil {
+				t.Fatalf("details must be omitted: %+v", *body.Details)
+			}
+			if tt.wantDetails != nil && (body.Details == nil || !reflect.DeepEqual(*body.Details, tt.wantDetails)) {
+				t.Fatalf("details = %v, want %+v", body.Details, tt.wantDetails)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchiveInputStub is a lightweight stub for account archive use case input.
+type ArchiveInputStub struct {
+	Err    error
+	Output port.ArchiveOutputPort
+	// AccountID records the account passed to the last Export.
+	AccountID string
+	// Imported records the last input passed to Import.
+	Imported port.ArchiveImportInput
+}
+
+func (s *ArchiveInputStub) Export(ctx context.Context, accountID string) error {
+	s.AccountID = accountID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentArchive(ctx, &archive.Archive{Version: archive.Version, AccountID: accountID, ExportedAt: time.Now()})
+	}
+	return s.Err
+}
+
+func (s *ArchiveInputStub) Import(ctx context.Context, input port.ArchiveImportInput) error {
+	s.Imported = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentArchiveImported(ctx, len(input.Archive.Templates), len(input.Archive.Notes))
+	}
+	return s.Err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -12,21 +13,35 @@ type NoteInputStub struct {
 	Err      error
 	Output   port.NoteOutputPort
 	Notes    []note.WithMeta
+	Page     pagination.Info
 	NoteResp *note.WithMeta
+	Filters  note.Filters
+	// Version records the expected version passed to the last mutation.
+	Version int
+	// Upgraded records the input of the last Upgrade call.
+	Upgraded port.NoteUpgradeInput
+	// ActorID records the actor passed to the last read.
+	ActorID string
+	// Duplicated records the input of the last Duplicate call.
+	Duplicated port.NoteDuplicateInput
+	// Unmapped is presented by Duplicate as the content the copy could not take over.
+	Unmapped []note.UnmappedSection
 }
 
 func (s *NoteInputStub) List(ctx context.Context, filters note.Filters) error {
+	s.Filters = filters
 	if s.Output != nil && s.Err == nil {
-		_ = s.Output.PresentNoteList(ctx, s.Notes)
+		_ = s.Output.PresentNoteList(ctx, s.Notes, s.Page)
 	}
 	return s.Err
 }
 
-func (s *NoteInputStub) Get(ctx context.Context, id string) error {
+func (s *NoteInputStub) Get(ctx context.Context, id, actorID string) error {
+	s.ActorID = actorID
 	if s.Output != nil && s.Err == nil {
 		resp := s.NoteResp
 		if resp == nil {
-			resp = &note.WithMeta{Note: note.Note{ID: id}}
+			resp = &note.WithMeta{Note: note.Note{ID: id, Version: 1}}
 		}
 		_ = s.Output.PresentNote(ctx, resp)
 	}
@@ -41,22 +56,98 @@ func (s *NoteInputStub) Create(ctx context.Context, input port.NoteCreateInput)
 }
 
 func (s *NoteInputStub) Update(ctx context.Context, input port.NoteUpdateInput) error {
+	s.Version = input.Version
 	if s.Output != nil && s.Err == nil {
-		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID}})
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID, Version: input.Version + 1}})
 	}
 	return s.Err
 }
 
 func (s *NoteInputStub) ChangeStatus(ctx context.Context, input port.NoteStatusChangeInput) error {
+	s.Version = input.Version
 	if s.Output != nil && s.Err == nil {
-		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID, Status: input.Status}})
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{
+			ID: input.ID, OwnerID: input.OwnerID, Status: input.Status, Version: input.Version + 1,
+			PublishAt: input.Schedule.PublishAt, UnpublishAt: input.Schedule.UnpublishAt,
+		}})
 	}
 	return s.Err
 }
 
-func (s *NoteInputStub) Delete(ctx context.Context, id, ownerID string) error {
+func (s *NoteInputStub) Delete(ctx context.Context, input port.NoteDeleteInput) error {
+	s.Version = input.Version
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentNoteDeleted(ctx)
 	}
 	return s.Err
 }
+
+func (s *NoteInputStub) ListRevisions(ctx context.Context, noteID, actorID string) error {
+	s.ActorID = actorID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteRevisions(ctx, []note.Revision{{NoteID: noteID, Number: 1}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) DiffRevisions(ctx context.Context, input port.NoteRevisionDiffInput) error {
+	s.ActorID = input.ActorID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteRevisionDiff(ctx, note.RevisionDiff{From: input.From, To: input.To})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) RestoreRevision(ctx context.Context, input port.NoteRevisionRestoreInput) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.NoteID, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Upgrade(ctx context.Context, input port.NoteUpgradeInput) error {
+	s.Version = input.Version
+	s.Upgraded = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{
+			Note:                note.Note{ID: input.ID, OwnerID: input.OwnerID, Version: input.Version + 1, SchemaVersion: 2},
+			LatestSchemaVersion: 2,
+		})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Restore(ctx context.Context, input port.NoteRestoreInput) error {
+	s.Version = input.Version
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID, Version: input.Version + 1}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Duplicate(ctx context.Context, input port.NoteDuplicateInput) error {
+	s.Duplicated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteDuplicated(ctx, &note.WithMeta{Note: note.Note{ID: "note-2", OwnerID: input.OwnerID, TemplateID: input.TemplateID, Version: 1}}, s.Unmapped)
+	}
+	return s.Err
+}
+
+// NoteWatchInputStub is a lightweight stub for the note watch use case.
+type NoteWatchInputStub struct {
+	Err    error
+	Output port.NoteWatchOutputPort
+	Events []note.Event
+	// Input records the last Watch input.
+	Input port.NoteWatchInput
+}
+
+func (s *NoteWatchInputStub) Watch(ctx context.Context, input port.NoteWatchInput) error {
+	s.Input = input
+	for _, e := range s.Events {
+		if err := s.Output.PresentNoteEvent(ctx, e); err != nil {
+			return err
+		}
+	}
+	return s.Err
+}
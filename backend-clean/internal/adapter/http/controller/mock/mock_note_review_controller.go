@@ -0,0 +1,59 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteReviewInputStub is a lightweight stub for note review use case input.
+type NoteReviewInputStub struct {
+	Err    error
+	Output port.NoteReviewOutputPort
+	State  note.ReviewState
+	Note   note.WithMeta
+	// Assigned, Submitted and Decided record the last inputs passed to the use case.
+	Assigned  port.NoteReviewersInput
+	Submitted port.NoteReviewSubmitInput
+	Decided   port.NoteReviewDecisionInput
+}
+
+func (s *NoteReviewInputStub) Get(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentReviewState(ctx, s.State)
+	}
+	return s.Err
+}
+
+func (s *NoteReviewInputStub) AssignReviewers(ctx context.Context, input port.NoteReviewersInput) error {
+	s.Assigned = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentReviewState(ctx, s.State)
+	}
+	return s.Err
+}
+
+func (s *NoteReviewInputStub) Submit(ctx context.Context, input port.NoteReviewSubmitInput) error {
+	s.Submitted = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &s.Note)
+	}
+	return s.Err
+}
+
+func (s *NoteReviewInputStub) Approve(ctx context.Context, input port.NoteReviewDecisionInput) error {
+	s.Decided = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &s.Note)
+	}
+	return s.Err
+}
+
+func (s *NoteReviewInputStub) Reject(ctx context.Context, input port.NoteReviewDecisionInput) error {
+	s.Decided = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &s.Note)
+	}
+	return s.Err
+}
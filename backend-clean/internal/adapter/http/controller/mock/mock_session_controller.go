@@ -0,0 +1,59 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/session"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SessionInputStub is a lightweight stub for session use case input.
+type SessionInputStub struct {
+	Err        error
+	Output     port.SessionOutputPort
+	LoginInput account.OAuthAccountInput
+}
+
+func (s *SessionInputStub) Login(ctx context.Context, input account.OAuthAccountInput) error {
+	s.LoginInput = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentSession(ctx, &account.Account{
+			ID:        "acc-1",
+			Email:     account.Email(input.Email),
+			FirstName: input.FirstName,
+			Provider:  input.Provider,
+		}, stubTokens())
+	}
+	return s.Err
+}
+
+func (s *SessionInputStub) Refresh(ctx context.Context, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentSession(ctx, &account.Account{
+			ID:        "acc-1",
+			Email:     "user@example.com",
+			FirstName: "Taro",
+			Provider:  "google",
+		}, stubTokens())
+	}
+	return s.Err
+}
+
+func (s *SessionInputStub) Logout(ctx context.Context, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentLogout(ctx)
+	}
+	return s.Err
+}
+
+func stubTokens() *session.Tokens {
+	now := time.Now()
+	return &session.Tokens{
+		AccessToken:           "access-token",
+		AccessTokenExpiresAt:  now.Add(15 * time.Minute),
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: now.Add(24 * time.Hour),
+	}
+}
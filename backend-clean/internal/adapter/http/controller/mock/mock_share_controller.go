@@ -0,0 +1,43 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareInputStub is a lightweight stub for share use case input.
+type ShareInputStub struct {
+	Err    error
+	Output port.ShareOutputPort
+	Grants []share.Grant
+	// Listed, Granted and Revoked record the last inputs passed to the use case.
+	Listed  port.ShareListInput
+	Granted port.ShareGrantInput
+	Revoked port.ShareRevokeInput
+}
+
+func (s *ShareInputStub) List(ctx context.Context, input port.ShareListInput) error {
+	s.Listed = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentShares(ctx, s.Grants)
+	}
+	return s.Err
+}
+
+func (s *ShareInputStub) Grant(ctx context.Context, input port.ShareGrantInput) error {
+	s.Granted = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentShare(ctx, &share.Grant{ResourceType: input.ResourceType, ResourceID: input.ResourceID, AccountID: input.AccountID, Role: input.Role, GrantedBy: input.ActorID})
+	}
+	return s.Err
+}
+
+func (s *ShareInputStub) Revoke(ctx context.Context, input port.ShareRevokeInput) error {
+	s.Revoked = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentShareRevoked(ctx)
+	}
+	return s.Err
+}
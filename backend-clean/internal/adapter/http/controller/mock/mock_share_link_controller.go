@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareLinkInputStub is a lightweight stub for public link use case input.
+type ShareLinkInputStub struct {
+	Err    error
+	Output port.ShareLinkOutputPort
+	// Note is presented by View.
+	Note *note.WithMeta
+	// ActorID and Token record the actor and token passed to the last call.
+	ActorID string
+	Token   string
+	// Created and Revoked record the last inputs passed to the use case.
+	Created port.ShareLinkCreateInput
+	Revoked port.ShareLinkRevokeInput
+}
+
+func (s *ShareLinkInputStub) Create(ctx context.Context, input port.ShareLinkCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentShareLink(ctx, &sharelink.Link{ID: "link-1", NoteID: input.NoteID, CreatedBy: input.ActorID, ExpiresAt: input.ExpiresAt, CreatedAt: time.Now()}, "raw-token")
+	}
+	return s.Err
+}
+
+func (s *ShareLinkInputStub) List(ctx context.Context, noteID, actorID string) error {
+	s.ActorID = actorID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentShareLinks(ctx, []sharelink.Link{{ID: "link-1", NoteID: noteID, CreatedBy: actorID}})
+	}
+	return s.Err
+}
+
+func (s *ShareLinkInputStub) Revoke(ctx context.Context, input port.ShareLinkRevokeInput) error {
+	s.Revoked = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentShareLinkRevoked(ctx)
+	}
+	return s.Err
+}
+
+func (s *ShareLinkInputStub) View(ctx context.Context, token string) error {
+	s.Token = token
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentPublicNote(ctx, s.Note)
+	}
+	return s.Err
+}
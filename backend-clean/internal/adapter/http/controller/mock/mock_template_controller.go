@@ -11,18 +11,46 @@ import (
 type TemplateInputStub struct {
 	Err    error
 	Output port.TemplateOutputPort
+	// Version records the expected version passed to the last mutation.
+	Version int
+	// Fields records the fields passed to the last create.
+	Fields []template.Field
+	// Filters records the filters passed to the last list.
+	Filters template.Filters
+	// ActorID records the actor passed to the last read.
+	ActorID string
+	// WorkspaceID records the workspace passed to the last create, import or clone.
+	WorkspaceID string
+	// Found is presented by reads instead of a template with only an ID when set.
+	Found *template.WithUsage
+	// Document records the document passed to the last import.
+	Document template.Document
+	// ClonedID records the source template passed to the last clone.
+	ClonedID string
+	// IsPublic records the visibility passed to the last visibility change.
+	IsPublic bool
 }
 
-func (s *TemplateInputStub) List(ctx context.Context, filters template.Filters) error { return s.Err }
+func (s *TemplateInputStub) List(ctx context.Context, filters template.Filters) error {
+	s.Filters = filters
+	return s.Err
+}
 
-func (s *TemplateInputStub) Get(ctx context.Context, id string) error {
+func (s *TemplateInputStub) Get(ctx context.Context, id, actorID string) error {
+	s.ActorID = actorID
 	if s.Output != nil && s.Err == nil {
-		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: id}})
+		found := s.Found
+		if found == nil {
+			found = &template.WithUsage{Template: template.Template{ID: id, Version: 1}}
+		}
+		_ = s.Output.PresentTemplate(ctx, found)
 	}
 	return s.Err
 }
 
 func (s *TemplateInputStub) Create(ctx context.Context, input port.TemplateCreateInput) error {
+	s.Fields = input.Fields
+	s.WorkspaceID = input.WorkspaceID
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: input.Name, OwnerID: input.OwnerID}})
 	}
@@ -30,15 +58,52 @@ func (s *TemplateInputStub) Create(ctx context.Context, input port.TemplateCreat
 }
 
 func (s *TemplateInputStub) Update(ctx context.Context, input port.TemplateUpdateInput) error {
+	s.Version = input.Version
 	if s.Output != nil && s.Err == nil {
-		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: input.ID, Name: input.Name, OwnerID: input.OwnerID}})
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: input.ID, Name: input.Name, OwnerID: input.OwnerID, Version: input.Version + 1}})
 	}
 	return s.Err
 }
 
-func (s *TemplateInputStub) Delete(ctx context.Context, id, ownerID string) error {
+func (s *TemplateInputStub) Delete(ctx context.Context, input port.TemplateDeleteInput) error {
+	s.Version = input.Version
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplateDeleted(ctx)
 	}
 	return s.Err
 }
+
+func (s *TemplateInputStub) Restore(ctx context.Context, input port.TemplateRestoreInput) error {
+	s.Version = input.Version
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: input.ID, OwnerID: input.OwnerID, Version: input.Version + 1}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) Import(ctx context.Context, input port.TemplateImportInput) error {
+	s.Document = input.Document
+	s.WorkspaceID = input.WorkspaceID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: input.Document.Name, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) Clone(ctx context.Context, input port.TemplateCloneInput) error {
+	s.ClonedID = input.ID
+	s.WorkspaceID = input.WorkspaceID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: "tpl-2", OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) SetVisibility(ctx context.Context, input port.TemplateVisibilityInput) error {
+	s.IsPublic = input.IsPublic
+	s.Version = input.Version
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: input.ID, OwnerID: input.OwnerID, Version: input.Version + 1, IsPublic: input.IsPublic}})
+	}
+	return s.Err
+}
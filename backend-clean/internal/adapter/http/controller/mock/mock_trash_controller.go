@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TrashInputStub is a lightweight stub for trash use case input.
+type TrashInputStub struct {
+	Err       error
+	Output    port.TrashOutputPort
+	Notes     []note.WithMeta
+	Templates []template.Template
+	// OwnerID records the owner passed to the last List call.
+	OwnerID string
+}
+
+func (s *TrashInputStub) List(ctx context.Context, ownerID string) error {
+	s.OwnerID = ownerID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTrash(ctx, s.Notes, s.Templates)
+	}
+	return s.Err
+}
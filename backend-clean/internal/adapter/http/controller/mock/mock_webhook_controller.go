@@ -0,0 +1,72 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookInputStub is a lightweight stub for webhook use case input.
+type WebhookInputStub struct {
+	Err          error
+	Output       port.WebhookOutputPort
+	Subscription webhook.Subscription
+	Delivery     webhook.Delivery
+	// Created and Updated record the last inputs passed to Create and Update.
+	Created  port.WebhookCreateInput
+	Updated  port.WebhookUpdateInput
+	Replayed port.WebhookReplayInput
+}
+
+func (s *WebhookInputStub) List(ctx context.Context, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookList(ctx, []webhook.Subscription{s.Subscription})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Get(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &s.Subscription)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &s.Subscription)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	s.Updated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &s.Subscription)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Delete(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) ListDeliveries(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDeliveryList(ctx, []webhook.Delivery{s.Delivery})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Replay(ctx context.Context, input port.WebhookReplayInput) error {
+	s.Replayed = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDelivery(ctx, &s.Delivery)
+	}
+	return s.Err
+}
@@ -0,0 +1,78 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceInputStub is a lightweight stub for workspace use case input.
+type WorkspaceInputStub struct {
+	Err        error
+	Output     port.WorkspaceOutputPort
+	Workspaces []workspace.WithRole
+	// ActorID records the actor passed to the last call.
+	ActorID string
+	// Created, Invited, Accepted and Removed record the last inputs passed to the use case.
+	Created  port.WorkspaceCreateInput
+	Invited  port.WorkspaceInviteInput
+	Accepted port.WorkspaceAcceptInput
+	Removed  port.WorkspaceRemoveMemberInput
+}
+
+func (s *WorkspaceInputStub) List(ctx context.Context, actorID string) error {
+	s.ActorID = actorID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaces(ctx, s.Workspaces)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Create(ctx context.Context, input port.WorkspaceCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspace(ctx, workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1", Name: input.Name, OwnerID: input.ActorID}, Role: workspace.RoleOwner})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) ListMembers(ctx context.Context, workspaceID, actorID string) error {
+	s.ActorID = actorID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentMembers(ctx, []workspace.Member{{WorkspaceID: workspaceID, AccountID: actorID, Role: workspace.RoleOwner}})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Invite(ctx context.Context, input port.WorkspaceInviteInput) error {
+	s.Invited = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentInvitation(ctx, &workspace.Invitation{ID: "inv-1", WorkspaceID: input.WorkspaceID, Email: input.Email, Role: input.Role, InvitedBy: input.ActorID})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) ListInvitations(ctx context.Context, actorID string) error {
+	s.ActorID = actorID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentInvitations(ctx, []workspace.Invitation{})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Accept(ctx context.Context, input port.WorkspaceAcceptInput) error {
+	s.Accepted = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspace(ctx, workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1"}, Role: workspace.RoleMember})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) RemoveMember(ctx context.Context, input port.WorkspaceRemoveMemberInput) error {
+	s.Removed = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentMemberRemoved(ctx)
+	}
+	return s.Err
+}
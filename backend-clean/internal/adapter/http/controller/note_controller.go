@@ -2,41 +2,47 @@
 package controller
 
 import (
+	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // NoteController handles note HTTP endpoints.
 type NoteController struct {
-	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort
-	outputFactory   func() *presenter.NotePresenter
-	noteRepoFactory func() port.NoteRepository
-	tplRepoFactory  func() port.TemplateRepository
-	txFactory       func() port.TxManager
+	inputFactory       func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort
+	outputFactory      func() *presenter.NotePresenter
+	exportFactory      func(format openapi.ModelsNoteExportFormat) *presenter.NoteExportPresenter
+	noteRepoFactory    func() port.NoteRepository
+	tplRepoFactory     func() port.TemplateRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
 }
 
 // NewNoteController creates NoteController.
 func NewNoteController(
-	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort,
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort,
 	outputFactory func() *presenter.NotePresenter,
+	exportFactory func(format openapi.ModelsNoteExportFormat) *presenter.NoteExportPresenter,
 	noteRepoFactory func() port.NoteRepository,
 	tplRepoFactory func() port.TemplateRepository,
+	accountRepoFactory func() port.AccountRepository,
 	txFactory func() port.TxManager,
 ) *NoteController {
 	return &NoteController{
-		inputFactory:    inputFactory,
-		outputFactory:   outputFactory,
-		noteRepoFactory: noteRepoFactory,
-		tplRepoFactory:  tplRepoFactory,
-		txFactory:       txFactory,
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		exportFactory:      exportFactory,
+		noteRepoFactory:    noteRepoFactory,
+		tplRepoFactory:     tplRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
 	}
 }
 
@@ -48,11 +54,26 @@ func (c *NoteController) List(ctx echo.Context, params openapi.NotesListNotesPar
 		s := note.NoteStatus(*params.Status)
 		status = &s
 	}
+	cursor, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	filters := note.Filters{
-		Status:     status,
-		TemplateID: params.TemplateId,
-		OwnerID:    params.OwnerId,
-		Query:      params.Q,
+		Status:      status,
+		TemplateID:  params.TemplateId,
+		OwnerID:     params.OwnerId,
+		WorkspaceID: params.WorkspaceId,
+		MemberID:    &accountID,
+		Query:       params.Q,
+		Cursor:      cursor,
+		Limit:       limit,
+	}
+	if params.SharedWithMe != nil && *params.SharedWithMe {
+		filters.SharedWith = &accountID
 	}
 	input, p := c.newIO()
 	if err := input.List(ctx.Request().Context(), filters); err != nil {
@@ -63,13 +84,40 @@ func (c *NoteController) List(ctx echo.Context, params openapi.NotesListNotesPar
 
 // GetByID handles GET /notes/:id.
 func (c *NoteController) GetByID(ctx echo.Context, noteID string) error {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	input, p := c.newIO()
-	if err := input.Get(ctx.Request().Context(), noteID); err != nil {
+	if err := input.Get(ctx.Request().Context(), noteID, accountID); err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
+// Export handles GET /notes/:noteId/export, rendering the note as a download in the requested format.
+func (c *NoteController) Export(ctx echo.Context, noteID string, params openapi.NotesExportNoteParams) error {
+	format := openapi.ModelsNoteExportFormatMarkdown
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if !presenter.IsExportFormat(format) {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid format"})
+	}
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	p := c.exportFactory(format)
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.accountRepoFactory(), c.txFactory(), p)
+	if err := input.Get(ctx.Request().Context(), noteID, accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", p.Filename()))
+	return ctx.Blob(http.StatusOK, p.ContentType(), p.Body())
+}
+
 // Create handles creating a new note.
 // Create handles POST /notes.
 func (c *NoteController) Create(ctx echo.Context) error {
@@ -77,7 +125,10 @@ func (c *NoteController) Create(ctx echo.Context) error {
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
 	}
-	ownerID := body.OwnerId.String()
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	sections := []port.SectionInput{}
 	if body.Sections != nil {
 		for _, s := range *body.Sections {
@@ -88,7 +139,7 @@ func (c *NoteController) Create(ctx echo.Context) error {
 		}
 	}
 	input, p := c.newIO()
-	err := input.Create(ctx.Request().Context(), port.NoteCreateInput{
+	err = input.Create(ctx.Request().Context(), port.NoteCreateInput{
 		Title:      body.Title,
 		TemplateID: body.TemplateId.String(),
 		OwnerID:    ownerID,
@@ -97,6 +148,7 @@ func (c *NoteController) Create(ctx echo.Context) error {
 	if err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
@@ -107,9 +159,13 @@ func (c *NoteController) Update(ctx echo.Context, noteID string, params openapi.
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
 	}
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	sections := make([]port.SectionUpdateInput, 0, len(body.Sections))
 	for _, s := range body.Sections {
@@ -119,27 +175,38 @@ func (c *NoteController) Update(ctx echo.Context, noteID string, params openapi.
 		})
 	}
 	input, p := c.newIO()
-	err := input.Update(ctx.Request().Context(), port.NoteUpdateInput{
+	err = input.Update(ctx.Request().Context(), port.NoteUpdateInput{
 		ID:       noteID,
 		Title:    body.Title,
 		OwnerID:  ownerID,
+		Version:  version,
 		Sections: sections,
 	})
 	if err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
 // Delete handles deleting a note.
 // Delete handles DELETE /notes/:id.
 func (c *NoteController) Delete(ctx echo.Context, noteID string, params openapi.NotesDeleteNoteParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
-	if err := input.Delete(ctx.Request().Context(), noteID, ownerID); err != nil {
+	err = input.Delete(ctx.Request().Context(), port.NoteDeleteInput{
+		ID:      noteID,
+		OwnerID: ownerID,
+		Version: version,
+	})
+	if err != nil {
 		return handleError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, p.DeleteResponse())
@@ -148,43 +215,220 @@ func (c *NoteController) Delete(ctx echo.Context, noteID string, params openapi.
 // Publish handles publishing a note.
 // Publish handles POST /notes/:id/publish.
 func (c *NoteController) Publish(ctx echo.Context, noteID string, params openapi.NotesPublishNoteParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrOwnerRequired)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
-	err := input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
+	err = input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
 		ID:      noteID,
 		Status:  note.StatusPublish,
 		OwnerID: ownerID,
+		Version: version,
 	})
 	if err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
 // Unpublish handles unpublishing a note.
 // Unpublish handles POST /notes/:id/unpublish.
 func (c *NoteController) Unpublish(ctx echo.Context, noteID string, params openapi.NotesUnpublishNoteParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrOwnerRequired)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
-	err := input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
+	err = input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
 		ID:      noteID,
 		Status:  note.StatusDraft,
 		OwnerID: ownerID,
+		Version: version,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// Schedule handles POST /notes/:id/schedule.
+func (c *NoteController) Schedule(ctx echo.Context, noteID string, params openapi.NotesScheduleNoteParams) error {
+	var body openapi.ModelsScheduleNoteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	var publishAt *time.Time
+	if !body.PublishAt.IsZero() {
+		publishAt = &body.PublishAt
+	}
+	input, p := c.newIO()
+	err = input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
+		ID:       noteID,
+		Status:   note.StatusScheduled,
+		OwnerID:  ownerID,
+		Version:  version,
+		Schedule: note.Schedule{PublishAt: publishAt, UnpublishAt: body.UnpublishAt},
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// ListRevisions handles GET /notes/:id/revisions.
+func (c *NoteController) ListRevisions(ctx echo.Context, noteID string) error {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListRevisions(ctx.Request().Context(), noteID, accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Revisions())
+}
+
+// DiffRevisions handles GET /notes/:id/revisions/diff.
+func (c *NoteController) DiffRevisions(ctx echo.Context, noteID string, params openapi.NotesDiffNoteRevisionsParams) error {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.DiffRevisions(ctx.Request().Context(), port.NoteRevisionDiffInput{
+		NoteID:  noteID,
+		From:    int(params.From),
+		To:      int(params.To),
+		ActorID: accountID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.RevisionDiff())
+}
+
+// RestoreRevision handles POST /notes/:id/revisions/:revision/restore.
+func (c *NoteController) RestoreRevision(ctx echo.Context, noteID string, revision int32) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.RestoreRevision(ctx.Request().Context(), port.NoteRevisionRestoreInput{
+		NoteID:   noteID,
+		Revision: int(revision),
+		OwnerID:  ownerID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// Restore handles POST /notes/:id/restore.
+func (c *NoteController) Restore(ctx echo.Context, noteID string, params openapi.NotesRestoreNoteParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Restore(ctx.Request().Context(), port.NoteRestoreInput{
+		ID:      noteID,
+		OwnerID: ownerID,
+		Version: version,
 	})
 	if err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
+// Upgrade handles POST /notes/:id/upgrade.
+func (c *NoteController) Upgrade(ctx echo.Context, noteID string, params openapi.NotesUpgradeNoteParams) error {
+	var body openapi.ModelsUpgradeNoteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	var sections []port.SectionInput
+	if body.Sections != nil {
+		sections = make([]port.SectionInput, 0, len(*body.Sections))
+		for _, s := range *body.Sections {
+			sections = append(sections, port.SectionInput{FieldID: s.FieldId, Content: s.Content})
+		}
+	}
+	input, p := c.newIO()
+	err = input.Upgrade(ctx.Request().Context(), port.NoteUpgradeInput{
+		ID:       noteID,
+		OwnerID:  ownerID,
+		Version:  version,
+		Sections: sections,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// Duplicate handles POST /notes/:noteId/duplicate. The body is optional; without a template ID the copy keeps the note's template.
+func (c *NoteController) Duplicate(ctx echo.Context, noteID string) error {
+	var body openapi.ModelsDuplicateNoteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Duplicate(ctx.Request().Context(), port.NoteDuplicateInput{
+		ID:         noteID,
+		OwnerID:    ownerID,
+		TemplateID: valueOrEmpty(body.TemplateId),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Duplicated())
+}
+
 func (c *NoteController) newIO() (port.NoteInputPort, *presenter.NotePresenter) {
 	output := c.outputFactory()
-	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), output)
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
 	return input, output
 }
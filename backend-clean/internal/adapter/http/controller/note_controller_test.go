@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -13,6 +14,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -20,20 +22,29 @@ func TestNoteController_Create(t *testing.T) {
 	tests := []struct {
 		name       string
 		body       string
+		ownerID    string
 		wantStatus int
 		wantBody   string
 	}{
 		{
 			name:       "[Success] create note",
-			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001","ownerId":"00000000-0000-0000-0000-000000000002","sections":[{"fieldId":"f1","content":"c1"}]}`,
+			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001","sections":[{"fieldId":"f1","content":"c1"}]}`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
 			wantStatus: http.StatusOK,
 		},
 		{
 			name:       "[Fail] bind error",
 			body:       `not-json`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
 			wantStatus: http.StatusBadRequest,
 			wantBody:   "invalid body",
 		},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001"}`,
+			wantStatus: http.StatusForbidden,
+			wantBody:   domainerr.ErrUnauthorized.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -41,18 +52,20 @@ func TestNoteController_Create(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPost, "/api/notes", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes", bytes.NewBufferString(tt.body)), tt.ownerID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -64,15 +77,28 @@ func TestNoteController_Create(t *testing.T) {
 }
 
 func TestNoteController_List(t *testing.T) {
+	cursor := pagination.Cursor{UpdatedAt: time.Now(), ID: "n0"}.Encode()
+	limit, zero := int32(5), int32(0)
+	shared := true
 	tests := []struct {
-		name       string
-		filters    openapi.NotesListNotesParams
-		inErr      error
-		wantStatus int
-		wantBody   string
+		name           string
+		filters        openapi.NotesListNotesParams
+		accountID      string
+		inErr          error
+		wantStatus     int
+		wantBody       string
+		wantLimit      int
+		wantSharedWith string
+		wantWorkspace  string
 	}{
-		{name: "[Success] list notes", filters: openapi.NotesListNotesParams{}, wantStatus: http.StatusOK},
-		{name: "[Fail] repo error", filters: openapi.NotesListNotesParams{}, inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Success] list notes", filters: openapi.NotesListNotesParams{}, accountID: "owner", wantStatus: http.StatusOK, wantBody: `"hasMore":false`},
+		{name: "[Success] cursor and limit forwarded", filters: openapi.NotesListNotesParams{Cursor: &cursor, Limit: &limit}, accountID: "owner", wantStatus: http.StatusOK, wantLimit: 5},
+		{name: "[Success] shared with me filters by the current account", filters: openapi.NotesListNotesParams{SharedWithMe: &shared}, accountID: "viewer-1", wantStatus: http.StatusOK, wantSharedWith: "viewer-1"},
+		{name: "[Success] workspace forwarded", filters: openapi.NotesListNotesParams{WorkspaceId: strPtr("ws-1")}, accountID: "owner", wantStatus: http.StatusOK, wantWorkspace: "ws-1"},
+		{name: "[Fail] without account", filters: openapi.NotesListNotesParams{}, wantStatus: http.StatusForbidden},
+		{name: "[Fail] malformed cursor", filters: openapi.NotesListNotesParams{Cursor: strPtr("not-a-cursor")}, accountID: "owner", wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidCursor.Error()},
+		{name: "[Fail] zero limit", filters: openapi.NotesListNotesParams{Limit: &zero}, accountID: "owner", wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidPageLimit.Error()},
+		{name: "[Fail] repo error", filters: openapi.NotesListNotesParams{}, accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
 
 	for _, tt := range tests {
@@ -81,20 +107,37 @@ func TestNoteController_List(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Notes: []note.WithMeta{{Note: note.Note{ID: "n1"}}}, Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodGet, "/api/notes", nil)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			_ = ctrl.List(c, tt.filters)
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.filters.Cursor != nil && tt.wantStatus == http.StatusOK && (input.Filters.Cursor == nil || input.Filters.Cursor.ID != "n0") {
+				t.Fatalf("cursor not forwarded: %+v", input.Filters.Cursor)
+			}
+			if input.Filters.Limit != tt.wantLimit {
+				t.Fatalf("limit = %d, want %d", input.Filters.Limit, tt.wantLimit)
+			}
+			if got := valueOrEmpty(input.Filters.SharedWith); got != tt.wantSharedWith {
+				t.Fatalf("shared with = %q, want %q", got, tt.wantSharedWith)
+			}
+			if got := valueOrEmpty(input.Filters.WorkspaceID); got != tt.wantWorkspace {
+				t.Fatalf("workspace = %q, want %q", got, tt.wantWorkspace)
+			}
+			if tt.wantStatus == http.StatusOK && valueOrEmpty(input.Filters.MemberID) != tt.accountID {
+				t.Fatalf("member = %q, want %q", valueOrEmpty(input.Filters.MemberID), tt.accountID)
+			}
 		})
 	}
 }
@@ -102,12 +145,14 @@ func TestNoteController_List(t *testing.T) {
 func TestNoteController_Get(t *testing.T) {
 	tests := []struct {
 		name       string
+		accountID  string
 		inErr      error
 		wantStatus int
 		wantBody   string
 	}{
-		{name: "[Success] get note", wantStatus: http.StatusOK},
-		{name: "[Fail] not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Success] get note", accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] not found", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Fail] without account", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 	}
 
 	for _, tt := range tests {
@@ -116,35 +161,47 @@ func TestNoteController_Get(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodGet, "/api/notes/n1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			_ = ctrl.GetByID(c, "n1")
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.ActorID != tt.accountID {
+				t.Fatalf("actor = %q, want %q", input.ActorID, tt.accountID)
+			}
 		})
 	}
 }
 
 func TestNoteController_Update(t *testing.T) {
 	tests := []struct {
-		name       string
-		body       string
-		params     openapi.NotesUpdateNoteParams
-		inErr      error
-		wantStatus int
-		wantBody   string
+		name        string
+		body        string
+		ownerID     string
+		ifMatch     *string
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantVersion int
+		wantETag    string
 	}{
-		{name: "[Success] update note", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, params: openapi.NotesUpdateNoteParams{OwnerId: "owner"}, wantStatus: http.StatusOK},
-		{name: "[Fail] missing owner", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, params: openapi.NotesUpdateNoteParams{OwnerId: ""}, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Success] update note", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, ownerID: "owner", wantStatus: http.StatusOK, wantETag: `"1"`},
+		{name: "[Success] If-Match is forwarded as version", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, ownerID: "owner", ifMatch: strPtr(`"3"`), wantStatus: http.StatusOK, wantVersion: 3, wantETag: `"4"`},
+		{name: "[Success] wildcard If-Match", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, ownerID: "owner", ifMatch: strPtr("*"), wantStatus: http.StatusOK, wantETag: `"1"`},
+		{name: "[Fail] missing owner", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] malformed If-Match", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, ownerID: "owner", ifMatch: strPtr(`W/"abc"`), wantStatus: http.StatusPreconditionFailed, wantBody: domainerr.ErrVersionConflict.Error()},
+		{name: "[Fail] stale version", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, ownerID: "owner", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed, wantBody: domainerr.ErrVersionConflict.Error(), wantVersion: 1},
 	}
 
 	for _, tt := range tests {
@@ -153,21 +210,29 @@ func TestNoteController_Update(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodPut, "/api/notes/n1", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/notes/n1", bytes.NewBufferString(tt.body)), tt.ownerID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Update(c, "n1", tt.params)
+			_ = ctrl.Update(c, "n1", openapi.NotesUpdateNoteParams{IfMatch: tt.ifMatch})
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Version != tt.wantVersion {
+				t.Fatalf("version = %d, want %d", input.Version, tt.wantVersion)
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
 		})
 	}
 }
@@ -181,7 +246,7 @@ func TestNoteController_Publish(t *testing.T) {
 		wantBody   string
 	}{
 		{name: "[Success] publish note", ownerID: "owner", wantStatus: http.StatusOK},
-		{name: "[Fail] publish missing owner", ownerID: "", wantStatus: http.StatusInternalServerError, wantBody: domainerr.ErrOwnerRequired.Error()},
+		{name: "[Fail] publish missing owner", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 		{name: "[Fail] publish forbidden", ownerID: "owner", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 	}
 
@@ -191,19 +256,63 @@ func TestNoteController_Publish(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/publish", nil)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/publish", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Publish(c, "n1", openapi.NotesPublishNoteParams{OwnerId: tt.ownerID})
+			_ = ctrl.Publish(c, "n1", openapi.NotesPublishNoteParams{})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteController_Schedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] schedule note", body: `{"publishAt":"2030-01-01T09:00:00Z","unpublishAt":"2030-02-01T09:00:00Z"}`, ownerID: "owner", wantStatus: http.StatusOK, wantBody: `"unpublishAt":"2030-02-01T09:00:00Z"`},
+		{name: "[Fail] schedule invalid body", body: `{"publishAt":"tomorrow"}`, ownerID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] schedule missing owner", body: `{"publishAt":"2030-01-01T09:00:00Z"}`, ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] schedule in the past", body: `{"publishAt":"2000-01-01T09:00:00Z"}`, ownerID: "owner", inErr: &domainerr.ValidationError{Violations: []domainerr.Violation{{Path: "publishAt", Code: domainerr.CodeInvalid, Err: domainerr.ErrScheduleInPast}}}, wantStatus: http.StatusBadRequest, wantBody: `"field":"publishAt"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/schedule", bytes.NewBufferString(tt.body)), tt.ownerID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Schedule(c, "n1", openapi.NotesScheduleNoteParams{})
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
 		})
 	}
@@ -218,7 +327,7 @@ func TestNoteController_Unpublish(t *testing.T) {
 		wantBody   string
 	}{
 		{name: "[Success] unpublish note", ownerID: "owner", wantStatus: http.StatusOK},
-		{name: "[Fail] unpublish missing owner", ownerID: "", wantStatus: http.StatusInternalServerError, wantBody: domainerr.ErrOwnerRequired.Error()},
+		{name: "[Fail] unpublish missing owner", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 		{name: "[Fail] unpublish forbidden", ownerID: "owner", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 		{name: "[Fail] unpublish not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
@@ -229,19 +338,21 @@ func TestNoteController_Unpublish(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/unpublish", nil)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/unpublish", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Unpublish(c, "n1", openapi.NotesUnpublishNoteParams{OwnerId: tt.ownerID})
+			_ = ctrl.Unpublish(c, "n1", openapi.NotesUnpublishNoteParams{})
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
 		})
 	}
@@ -251,6 +362,7 @@ func TestNoteController_Delete(t *testing.T) {
 	tests := []struct {
 		name       string
 		ownerID    string
+		ifMatch    *string
 		inErr      error
 		wantStatus int
 		wantBody   string
@@ -258,6 +370,238 @@ func TestNoteController_Delete(t *testing.T) {
 		{name: "[Success] delete", ownerID: "owner", wantStatus: http.StatusOK},
 		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 		{name: "[Fail] not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Fail] stale version", ownerID: "owner", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed, wantBody: domainerr.ErrVersionConflict.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/n1", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Delete(c, "n1", openapi.NotesDeleteNoteParams{IfMatch: tt.ifMatch})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteController_Revisions(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(ctrl *NoteController, c echo.Context) error
+		ownerID    string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] list revisions",
+			call:       func(ctrl *NoteController, c echo.Context) error { return ctrl.ListRevisions(c, "n1") },
+			ownerID:    "owner",
+			wantStatus: http.StatusOK,
+			wantBody:   `"revision":1`,
+		},
+		{
+			name: "[Success] diff revisions",
+			call: func(ctrl *NoteController, c echo.Context) error {
+				return ctrl.DiffRevisions(c, "n1", openapi.NotesDiffNoteRevisionsParams{From: 1, To: 2})
+			},
+			ownerID:    "owner",
+			wantStatus: http.StatusOK,
+			wantBody:   `"to":2`,
+		},
+		{
+			name: "[Fail] diff unknown revision",
+			call: func(ctrl *NoteController, c echo.Context) error {
+				return ctrl.DiffRevisions(c, "n1", openapi.NotesDiffNoteRevisionsParams{From: 1, To: 9})
+			},
+			ownerID:    "owner",
+			inErr:      domainerr.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+			wantBody:   domainerr.ErrNotFound.Error(),
+		},
+		{
+			name:       "[Success] restore revision",
+			call:       func(ctrl *NoteController, c echo.Context) error { return ctrl.RestoreRevision(c, "n1", 1) },
+			ownerID:    "owner",
+			wantStatus: http.StatusOK,
+			wantBody:   `"id":"n1"`,
+		},
+		{
+			name:       "[Fail] restore missing owner",
+			call:       func(ctrl *NoteController, c echo.Context) error { return ctrl.RestoreRevision(c, "n1", 1) },
+			wantStatus: http.StatusForbidden,
+			wantBody:   domainerr.ErrUnauthorized.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/revisions", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = tt.call(ctrl, c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteController_Upgrade(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		ownerID      string
+		ifMatch      *string
+		inErr        error
+		wantStatus   int
+		wantBody     string
+		wantSections int
+		wantETag     string
+	}{
+		{name: "[Success] upgrade with content for new fields", body: `{"sections":[{"fieldId":"f3","content":"c"}]}`, ownerID: "owner", ifMatch: strPtr(`"3"`), wantStatus: http.StatusOK, wantBody: `"templateSchemaVersion":2`, wantSections: 1, wantETag: `"4"`},
+		{name: "[Success] upgrade without body sections", body: `{}`, ownerID: "owner", wantStatus: http.StatusOK, wantBody: `"latestTemplateSchemaVersion":2`, wantETag: `"1"`},
+		{name: "[Fail] missing owner", body: `{}`, ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] stale version", body: `{}`, ownerID: "owner", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed, wantBody: domainerr.ErrVersionConflict.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/upgrade", bytes.NewBufferString(tt.body)), tt.ownerID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Upgrade(c, "n1", openapi.NotesUpgradeNoteParams{IfMatch: tt.ifMatch})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if got := len(input.Upgraded.Sections); got != tt.wantSections {
+				t.Fatalf("sections = %d, want %d", got, tt.wantSections)
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+		})
+	}
+}
+
+func TestNoteController_Duplicate(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		ownerID      string
+		unmapped     []note.UnmappedSection
+		inErr        error
+		wantStatus   int
+		wantBody     string
+		wantTemplate string
+		wantETag     string
+	}{
+		{name: "[Success] duplicate on the same template", ownerID: "owner", wantStatus: http.StatusOK, wantBody: `"unmapped":[]`, wantETag: `"1"`},
+		{
+			name:         "[Success] duplicate into another template",
+			body:         `{"templateId":"tpl-2"}`,
+			ownerID:      "owner",
+			unmapped:     []note.UnmappedSection{{FieldLabel: "Risks", Content: "none"}},
+			wantStatus:   http.StatusOK,
+			wantBody:     `"unmapped":[{"content":"none","fieldLabel":"Risks"}]`,
+			wantTemplate: "tpl-2",
+			wantETag:     `"1"`,
+		},
+		{name: "[Fail] bind error", body: `not-json`, ownerID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] missing owner", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] required field left empty", body: `{"templateId":"tpl-2"}`, ownerID: "owner", inErr: domainerr.ErrRequiredFieldEmpty, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrRequiredFieldEmpty.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr, Unmapped: tt.unmapped}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				presenter.NewNotePresenter,
+				presenter.NewNoteExportPresenter,
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/duplicate", bytes.NewBufferString(tt.body)), tt.ownerID)
+			if tt.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+			_ = ctrl.Duplicate(e.NewContext(req, rec), "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.Duplicated.ID != "n1" || input.Duplicated.TemplateID != tt.wantTemplate) {
+				t.Fatalf("input = %+v", input.Duplicated)
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+		})
+	}
+}
+
+func TestNoteController_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		ifMatch    *string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantETag   string
+	}{
+		{name: "[Success] restore", ownerID: "owner", ifMatch: strPtr(`"3"`), wantStatus: http.StatusOK, wantBody: `"id":"n1"`, wantETag: `"4"`},
+		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] not in trash", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Fail] template in trash", ownerID: "owner", inErr: domainerr.ErrTemplateTrashed, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrTemplateTrashed.Error()},
+		{name: "[Fail] stale version", ownerID: "owner", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed, wantBody: domainerr.ErrVersionConflict.Error()},
 	}
 
 	for _, tt := range tests {
@@ -266,20 +610,75 @@ func TestNoteController_Delete(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				presenter.NewNoteExportPresenter,
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodDelete, "/api/notes/n1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/restore", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Delete(c, "n1", openapi.NotesDeleteNoteParams{OwnerId: tt.ownerID})
+			_ = ctrl.Restore(c, "n1", openapi.NotesRestoreNoteParams{IfMatch: tt.ifMatch})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+		})
+	}
+}
+
+func TestNoteController_Export(t *testing.T) {
+	html := openapi.ModelsNoteExportFormatHtml
+	pdf := openapi.ModelsNoteExportFormat("pdf")
+	tests := []struct {
+		name            string
+		params          openapi.NotesExportNoteParams
+		accountID       string
+		inErr           error
+		wantStatus      int
+		wantBody        string
+		wantContentType string
+		wantFilename    string
+	}{
+		{name: "[Success] markdown by default", accountID: "owner", wantStatus: http.StatusOK, wantBody: "# Hello\n", wantContentType: "text/markdown; charset=UTF-8", wantFilename: `attachment; filename="note-note-1.md"`},
+		{name: "[Success] html", params: openapi.NotesExportNoteParams{Format: &html}, accountID: "owner", wantStatus: http.StatusOK, wantBody: "<h1>Hello</h1>", wantContentType: "text/html; charset=UTF-8", wantFilename: `attachment; filename="note-note-1.html"`},
+		{name: "[Fail] unknown format", params: openapi.NotesExportNoteParams{Format: &pdf}, accountID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid format"},
+		{name: "[Fail] without account", wantStatus: http.StatusForbidden},
+		{name: "[Fail] outside the workspace", accountID: "outsider", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr, NoteResp: &note.WithMeta{Note: note.Note{ID: "note-1", Title: "Hello", Status: note.StatusDraft}}}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				presenter.NewNotePresenter,
+				presenter.NewNoteExportPresenter,
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/note-1/export", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Export(echo.New().NewContext(req, rec), "note-1", tt.params)
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantContentType != "" && rec.Header().Get(echo.HeaderContentType) != tt.wantContentType {
+				t.Fatalf("content type = %q, want %q", rec.Header().Get(echo.HeaderContentType), tt.wantContentType)
+			}
+			if rec.Header().Get(echo.HeaderContentDisposition) != tt.wantFilename {
+				t.Fatalf("content disposition = %q, want %q", rec.Header().Get(echo.HeaderContentDisposition), tt.wantFilename)
+			}
 		})
 	}
 }
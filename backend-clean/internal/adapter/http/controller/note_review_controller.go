@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteReviewController handles the note review workflow endpoints.
+type NoteReviewController struct {
+	inputFactory       func(noteRepo port.NoteRepository, reviewRepo port.NoteReviewRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteReviewOutputPort) port.NoteReviewInputPort
+	outputFactory      func() *presenter.NoteReviewPresenter
+	noteRepoFactory    func() port.NoteRepository
+	reviewRepoFactory  func() port.NoteReviewRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+}
+
+// NewNoteReviewController creates NoteReviewController.
+func NewNoteReviewController(
+	inputFactory func(noteRepo port.NoteRepository, reviewRepo port.NoteReviewRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteReviewOutputPort) port.NoteReviewInputPort,
+	outputFactory func() *presenter.NoteReviewPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	reviewRepoFactory func() port.NoteReviewRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *NoteReviewController {
+	return &NoteReviewController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		noteRepoFactory:    noteRepoFactory,
+		reviewRepoFactory:  reviewRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// Get handles GET /notes/:id/reviews.
+func (c *NoteReviewController) Get(ctx echo.Context, noteID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Get(ctx.Request().Context(), noteID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.State())
+}
+
+// AssignReviewers handles PUT /notes/:id/reviewers.
+func (c *NoteReviewController) AssignReviewers(ctx echo.Context, noteID string) error {
+	var body openapi.ModelsAssignNoteReviewersRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.AssignReviewers(ctx.Request().Context(), port.NoteReviewersInput{
+		NoteID:      noteID,
+		OwnerID:     ownerID,
+		ReviewerIDs: body.ReviewerIds,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.State())
+}
+
+// Submit handles POST /notes/:id/submit.
+func (c *NoteReviewController) Submit(ctx echo.Context, noteID string, params openapi.NotesSubmitNoteForReviewParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Submit(ctx.Request().Context(), port.NoteReviewSubmitInput{
+		NoteID:  noteID,
+		OwnerID: ownerID,
+		Version: version,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// Approve handles POST /notes/:id/approve.
+func (c *NoteReviewController) Approve(ctx echo.Context, noteID string, params openapi.NotesApproveNoteParams) error {
+	return c.decide(ctx, noteID, params.IfMatch, port.NoteReviewInputPort.Approve)
+}
+
+// Reject handles POST /notes/:id/reject.
+func (c *NoteReviewController) Reject(ctx echo.Context, noteID string, params openapi.NotesRejectNoteParams) error {
+	return c.decide(ctx, noteID, params.IfMatch, port.NoteReviewInputPort.Reject)
+}
+
+func (c *NoteReviewController) decide(
+	ctx echo.Context,
+	noteID string,
+	ifMatch *string,
+	action func(port.NoteReviewInputPort, context.Context, port.NoteReviewDecisionInput) error,
+) error {
+	var body openapi.ModelsReviewDecisionRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	reviewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(ifMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	var comment string
+	if body.Comment != nil {
+		comment = *body.Comment
+	}
+	input, p := c.newIO()
+	err = action(input, ctx.Request().Context(), port.NoteReviewDecisionInput{
+		NoteID:     noteID,
+		ReviewerID: reviewerID,
+		Comment:    comment,
+		Version:    version,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+func (c *NoteReviewController) newIO() (port.NoteReviewInputPort, *presenter.NoteReviewPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.reviewRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteReviewTestController(input *ctrlmock.NoteReviewInputStub) *NoteReviewController {
+	return NewNoteReviewController(
+		func(noteRepo port.NoteRepository, reviewRepo port.NoteReviewRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteReviewOutputPort) port.NoteReviewInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewNoteReviewPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.NoteReviewRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestNoteReviewController_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] reviewers and history", wantStatus: http.StatusOK, wantBody: `"reviewerIds":["reviewer-1"]`},
+		{name: "[Fail] not owner or reviewer", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteReviewInputStub{
+				Err: tt.inErr,
+				State: note.ReviewState{
+					Note:      note.Note{ID: "n1", Status: note.StatusInReview},
+					Reviewers: []string{"reviewer-1"},
+					Reviews:   []note.Review{{ID: "r1", ReviewerID: "reviewer-1", Decision: note.ReviewRejected, Comment: "fix"}},
+				},
+			}
+			ctrl := newNoteReviewTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/reviews", nil), "owner")
+			rec := httptest.NewRecorder()
+			_ = ctrl.Get(echo.New().NewContext(req, rec), "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && !strings.Contains(rec.Body.String(), `"decision":"Rejected"`) {
+				t.Fatalf("body = %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestNoteReviewController_AssignReviewers(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantIDs    []string
+	}{
+		{name: "[Success] assign", ownerID: "owner", body: `{"reviewerIds":["reviewer-1","reviewer-2"]}`, wantStatus: http.StatusOK, wantBody: `"noteId":"n1"`, wantIDs: []string{"reviewer-1", "reviewer-2"}},
+		{name: "[Fail] invalid body", ownerID: "owner", body: `{`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] owner missing", ownerID: "", body: `{}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] reviewers locked", ownerID: "owner", body: `{"reviewerIds":[]}`, inErr: domainerr.ErrReviewersLocked, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrReviewersLocked.Error(), wantIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteReviewInputStub{Err: tt.inErr, State: note.ReviewState{Note: note.Note{ID: "n1", Status: note.StatusDraft}}}
+			ctrl := newNoteReviewTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/notes/n1/reviewers", strings.NewReader(tt.body)), tt.ownerID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.AssignReviewers(echo.New().NewContext(req, rec), "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantIDs != nil && !reflect.DeepEqual(input.Assigned.ReviewerIDs, tt.wantIDs) {
+				t.Fatalf("input = %+v", input.Assigned)
+			}
+		})
+	}
+}
+
+func TestNoteReviewController_Reject(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		ifMatch     *string
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantComment string
+		wantVersion int
+		wantETag    string
+	}{
+		{name: "[Success] reject with comment", body: `{"comment":"needs sources"}`, ifMatch: strPtr(`"2"`), wantStatus: http.StatusOK, wantBody: `"status":"Draft"`, wantComment: "needs sources", wantVersion: 2, wantETag: `"3"`},
+		{name: "[Fail] comment required", body: `{}`, inErr: &domainerr.ValidationError{Violations: []domainerr.Violation{{Path: "comment", Code: domainerr.CodeRequired, Err: domainerr.ErrReviewCommentRequired}}}, wantStatus: http.StatusBadRequest, wantBody: `"field":"comment"`},
+		{name: "[Fail] not assigned reviewer", body: `{"comment":"no"}`, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantComment: "no"},
+		{name: "[Fail] stale If-Match", body: `{"comment":"no"}`, ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed, wantComment: "no", wantVersion: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteReviewInputStub{
+				Err:  tt.inErr,
+				Note: note.WithMeta{Note: note.Note{ID: "n1", Status: note.StatusDraft, Version: 3}},
+			}
+			ctrl := newNoteReviewTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/reject", strings.NewReader(tt.body)), "reviewer-1")
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Reject(echo.New().NewContext(req, rec), "n1", openapi.NotesRejectNoteParams{IfMatch: tt.ifMatch})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Decided.Comment != tt.wantComment || input.Decided.Version != tt.wantVersion || input.Decided.ReviewerID != "reviewer-1" {
+				t.Fatalf("input = %+v", input.Decided)
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+		})
+	}
+}
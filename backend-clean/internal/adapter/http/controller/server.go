@@ -4,23 +4,42 @@ import (
 	"github.com/labstack/echo/v4"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/share"
 )
 
 // Server implements the OpenAPI ServerInterface by delegating to domain-specific controllers.
 type Server struct {
-	account  *AccountController
-	note     *NoteController
-	template *TemplateController
+	account   *AccountController
+	note      *NoteController
+	template  *TemplateController
+	session   *SessionController
+	trash     *TrashController
+	webhook   *WebhookController
+	review    *NoteReviewController
+	share     *ShareController
+	workspace *WorkspaceController
+	link      *ShareLinkController
+	archive   *ArchiveController
 }
 
 // NewServer wires controller dependencies to generated ServerInterface.
-func NewServer(ac *AccountController, nc *NoteController, tc *TemplateController) *Server {
-	return &Server{account: ac, note: nc, template: tc}
+func NewServer(ac *AccountController, nc *NoteController, tc *TemplateController, sc *SessionController, trc *TrashController, wc *WebhookController, rc *NoteReviewController, shc *ShareController, wsc *WorkspaceController, slc *ShareLinkController, arc *ArchiveController) *Server {
+	return &Server{account: ac, note: nc, template: tc, session: sc, trash: trc, webhook: wc, review: rc, share: shc, workspace: wsc, link: slc, archive: arc}
 }
 
 // AccountsCreateOrGetAccount handles POST /api/accounts/auth.
 func (s *Server) AccountsCreateOrGetAccount(ctx echo.Context) error {
-	return s.account.CreateOrGet(ctx)
+	return s.session.Login(ctx)
+}
+
+// AccountsRefreshSession handles POST /api/accounts/refresh.
+func (s *Server) AccountsRefreshSession(ctx echo.Context) error {
+	return s.session.Refresh(ctx)
+}
+
+// AccountsLogout handles POST /api/accounts/logout.
+func (s *Server) AccountsLogout(ctx echo.Context) error {
+	return s.session.Logout(ctx)
 }
 
 // AccountsGetCurrentAccount handles GET /api/accounts/me.
@@ -28,6 +47,16 @@ func (s *Server) AccountsGetCurrentAccount(ctx echo.Context) error {
 	return s.account.GetCurrent(ctx)
 }
 
+// AccountsExportAccountData handles GET /api/accounts/me/export.
+func (s *Server) AccountsExportAccountData(ctx echo.Context) error {
+	return s.archive.Export(ctx)
+}
+
+// AccountsImportAccountData handles POST /api/accounts/me/import.
+func (s *Server) AccountsImportAccountData(ctx echo.Context) error {
+	return s.archive.Import(ctx)
+}
+
 // AccountsGetAccountById handles GET /api/accounts/:id.
 func (s *Server) AccountsGetAccountById(ctx echo.Context, accountId string) error { //nolint:revive
 	return s.account.GetByID(ctx, accountId)
@@ -64,6 +93,26 @@ func (s *Server) NotesUpdateNote(ctx echo.Context, noteId string, params openapi
 	return s.note.Update(ctx, noteId, params)
 }
 
+// NotesExportNote handles GET /api/notes/:noteId/export.
+func (s *Server) NotesExportNote(ctx echo.Context, noteId string, params openapi.NotesExportNoteParams) error { //nolint:revive
+	return s.note.Export(ctx, noteId, params)
+}
+
+// NotesListNoteLinks handles GET /api/notes/:noteId/links.
+func (s *Server) NotesListNoteLinks(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.link.List(ctx, noteId)
+}
+
+// NotesCreateNoteLink handles POST /api/notes/:noteId/links.
+func (s *Server) NotesCreateNoteLink(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.link.Create(ctx, noteId)
+}
+
+// NotesRevokeNoteLink handles DELETE /api/notes/:noteId/links/:linkId.
+func (s *Server) NotesRevokeNoteLink(ctx echo.Context, noteId string, linkId string) error { //nolint:revive
+	return s.link.Revoke(ctx, noteId, linkId)
+}
+
 // NotesPublishNote handles POST /api/notes/:noteId/publish.
 // NotesPublishNote handles POST /api/notes/:id/publish.
 func (s *Server) NotesPublishNote(ctx echo.Context, noteId string, params openapi.NotesPublishNoteParams) error { //nolint:revive
@@ -76,6 +125,81 @@ func (s *Server) NotesUnpublishNote(ctx echo.Context, noteId string, params open
 	return s.note.Unpublish(ctx, noteId, params)
 }
 
+// NotesListNoteRevisions handles GET /api/notes/:noteId/revisions.
+func (s *Server) NotesListNoteRevisions(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.ListRevisions(ctx, noteId)
+}
+
+// NotesDiffNoteRevisions handles GET /api/notes/:noteId/revisions/diff.
+func (s *Server) NotesDiffNoteRevisions(ctx echo.Context, noteId string, params openapi.NotesDiffNoteRevisionsParams) error { //nolint:revive
+	return s.note.DiffRevisions(ctx, noteId, params)
+}
+
+// NotesRestoreNoteRevision handles POST /api/notes/:noteId/revisions/:revision/restore.
+func (s *Server) NotesRestoreNoteRevision(ctx echo.Context, noteId string, revision int32) error { //nolint:revive
+	return s.note.RestoreRevision(ctx, noteId, revision)
+}
+
+// NotesScheduleNote handles POST /api/notes/:noteId/schedule.
+func (s *Server) NotesScheduleNote(ctx echo.Context, noteId string, params openapi.NotesScheduleNoteParams) error { //nolint:revive
+	return s.note.Schedule(ctx, noteId, params)
+}
+
+// NotesGetNoteReviews handles GET /api/notes/:noteId/reviews.
+func (s *Server) NotesGetNoteReviews(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.review.Get(ctx, noteId)
+}
+
+// NotesAssignNoteReviewers handles PUT /api/notes/:noteId/reviewers.
+func (s *Server) NotesAssignNoteReviewers(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.review.AssignReviewers(ctx, noteId)
+}
+
+// NotesSubmitNoteForReview handles POST /api/notes/:noteId/submit.
+func (s *Server) NotesSubmitNoteForReview(ctx echo.Context, noteId string, params openapi.NotesSubmitNoteForReviewParams) error { //nolint:revive
+	return s.review.Submit(ctx, noteId, params)
+}
+
+// NotesApproveNote handles POST /api/notes/:noteId/approve.
+func (s *Server) NotesApproveNote(ctx echo.Context, noteId string, params openapi.NotesApproveNoteParams) error { //nolint:revive
+	return s.review.Approve(ctx, noteId, params)
+}
+
+// NotesRejectNote handles POST /api/notes/:noteId/reject.
+func (s *Server) NotesRejectNote(ctx echo.Context, noteId string, params openapi.NotesRejectNoteParams) error { //nolint:revive
+	return s.review.Reject(ctx, noteId, params)
+}
+
+// NotesListNoteShares handles GET /api/notes/:noteId/shares.
+func (s *Server) NotesListNoteShares(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.share.List(ctx, share.ResourceNote, noteId)
+}
+
+// NotesShareNote handles PUT /api/notes/:noteId/shares/:accountId.
+func (s *Server) NotesShareNote(ctx echo.Context, noteId string, accountId string) error { //nolint:revive
+	return s.share.Grant(ctx, share.ResourceNote, noteId, accountId)
+}
+
+// NotesUnshareNote handles DELETE /api/notes/:noteId/shares/:accountId.
+func (s *Server) NotesUnshareNote(ctx echo.Context, noteId string, accountId string) error { //nolint:revive
+	return s.share.Revoke(ctx, share.ResourceNote, noteId, accountId)
+}
+
+// NotesUpgradeNote handles POST /api/notes/:noteId/upgrade.
+func (s *Server) NotesUpgradeNote(ctx echo.Context, noteId string, params openapi.NotesUpgradeNoteParams) error { //nolint:revive
+	return s.note.Upgrade(ctx, noteId, params)
+}
+
+// NotesDuplicateNote handles POST /api/notes/:noteId/duplicate.
+func (s *Server) NotesDuplicateNote(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.Duplicate(ctx, noteId)
+}
+
+// NotesRestoreNote handles POST /api/notes/:noteId/restore.
+func (s *Server) NotesRestoreNote(ctx echo.Context, noteId string, params openapi.NotesRestoreNoteParams) error { //nolint:revive
+	return s.note.Restore(ctx, noteId, params)
+}
+
 // TemplatesListTemplates handles GET /api/templates.
 func (s *Server) TemplatesListTemplates(ctx echo.Context, params openapi.TemplatesListTemplatesParams) error {
 	return s.template.List(ctx, params)
@@ -101,3 +225,128 @@ func (s *Server) TemplatesGetTemplateById(ctx echo.Context, templateId string) e
 func (s *Server) TemplatesUpdateTemplate(ctx echo.Context, templateId string, params openapi.TemplatesUpdateTemplateParams) error { //nolint:revive
 	return s.template.Update(ctx, templateId, params)
 }
+
+// TemplatesRestoreTemplate handles POST /api/templates/:templateId/restore.
+func (s *Server) TemplatesRestoreTemplate(ctx echo.Context, templateId string, params openapi.TemplatesRestoreTemplateParams) error { //nolint:revive
+	return s.template.Restore(ctx, templateId, params)
+}
+
+// TemplatesListTemplateGallery handles GET /api/templates/gallery.
+func (s *Server) TemplatesListTemplateGallery(ctx echo.Context, params openapi.TemplatesListTemplateGalleryParams) error {
+	return s.template.Gallery(ctx, params)
+}
+
+// TemplatesImportTemplate handles POST /api/templates/import.
+func (s *Server) TemplatesImportTemplate(ctx echo.Context, params openapi.TemplatesImportTemplateParams) error {
+	return s.template.Import(ctx, params)
+}
+
+// TemplatesCloneTemplate handles POST /api/templates/:templateId/clone.
+func (s *Server) TemplatesCloneTemplate(ctx echo.Context, templateId string, params openapi.TemplatesCloneTemplateParams) error { //nolint:revive
+	return s.template.Clone(ctx, templateId, params)
+}
+
+// TemplatesExportTemplate handles GET /api/templates/:templateId/export.
+func (s *Server) TemplatesExportTemplate(ctx echo.Context, templateId string, params openapi.TemplatesExportTemplateParams) error { //nolint:revive
+	return s.template.Export(ctx, templateId, params)
+}
+
+// TemplatesSetTemplateVisibility handles PUT /api/templates/:templateId/visibility.
+func (s *Server) TemplatesSetTemplateVisibility(ctx echo.Context, templateId string, params openapi.TemplatesSetTemplateVisibilityParams) error { //nolint:revive
+	return s.template.SetVisibility(ctx, templateId, params)
+}
+
+// TemplatesListTemplateShares handles GET /api/templates/:templateId/shares.
+func (s *Server) TemplatesListTemplateShares(ctx echo.Context, templateId string) error { //nolint:revive
+	return s.share.List(ctx, share.ResourceTemplate, templateId)
+}
+
+// TemplatesShareTemplate handles PUT /api/templates/:templateId/shares/:accountId.
+func (s *Server) TemplatesShareTemplate(ctx echo.Context, templateId string, accountId string) error { //nolint:revive
+	return s.share.Grant(ctx, share.ResourceTemplate, templateId, accountId)
+}
+
+// TemplatesUnshareTemplate handles DELETE /api/templates/:templateId/shares/:accountId.
+func (s *Server) TemplatesUnshareTemplate(ctx echo.Context, templateId string, accountId string) error { //nolint:revive
+	return s.share.Revoke(ctx, share.ResourceTemplate, templateId, accountId)
+}
+
+// TrashListTrash handles GET /api/trash.
+func (s *Server) TrashListTrash(ctx echo.Context) error {
+	return s.trash.List(ctx)
+}
+
+// WebhooksListWebhooks handles GET /api/webhooks.
+func (s *Server) WebhooksListWebhooks(ctx echo.Context) error {
+	return s.webhook.List(ctx)
+}
+
+// WebhooksCreateWebhook handles POST /api/webhooks.
+func (s *Server) WebhooksCreateWebhook(ctx echo.Context) error {
+	return s.webhook.Create(ctx)
+}
+
+// WebhooksGetWebhook handles GET /api/webhooks/:webhookId.
+func (s *Server) WebhooksGetWebhook(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.GetByID(ctx, webhookId)
+}
+
+// WebhooksUpdateWebhook handles PUT /api/webhooks/:webhookId.
+func (s *Server) WebhooksUpdateWebhook(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.Update(ctx, webhookId)
+}
+
+// WebhooksDeleteWebhook handles DELETE /api/webhooks/:webhookId.
+func (s *Server) WebhooksDeleteWebhook(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.Delete(ctx, webhookId)
+}
+
+// WebhooksListWebhookDeliveries handles GET /api/webhooks/:webhookId/deliveries.
+func (s *Server) WebhooksListWebhookDeliveries(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.ListDeliveries(ctx, webhookId)
+}
+
+// WebhooksReplayWebhookDelivery handles POST /api/webhooks/:webhookId/deliveries/:deliveryId/replay.
+func (s *Server) WebhooksReplayWebhookDelivery(ctx echo.Context, webhookId string, deliveryId string) error { //nolint:revive
+	return s.webhook.Replay(ctx, webhookId, deliveryId)
+}
+
+// WorkspacesListWorkspaces handles GET /api/workspaces.
+func (s *Server) WorkspacesListWorkspaces(ctx echo.Context) error {
+	return s.workspace.List(ctx)
+}
+
+// WorkspacesCreateWorkspace handles POST /api/workspaces.
+func (s *Server) WorkspacesCreateWorkspace(ctx echo.Context) error {
+	return s.workspace.Create(ctx)
+}
+
+// WorkspacesListWorkspaceInvitations handles GET /api/workspaces/invitations.
+func (s *Server) WorkspacesListWorkspaceInvitations(ctx echo.Context) error {
+	return s.workspace.ListInvitations(ctx)
+}
+
+// WorkspacesAcceptWorkspaceInvitation handles POST /api/workspaces/invitations/:invitationId/accept.
+func (s *Server) WorkspacesAcceptWorkspaceInvitation(ctx echo.Context, invitationId string) error { //nolint:revive
+	return s.workspace.Accept(ctx, invitationId)
+}
+
+// WorkspacesInviteWorkspaceMember handles POST /api/workspaces/:workspaceId/invitations.
+func (s *Server) WorkspacesInviteWorkspaceMember(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.Invite(ctx, workspaceId)
+}
+
+// WorkspacesListWorkspaceMembers handles GET /api/workspaces/:workspaceId/members.
+func (s *Server) WorkspacesListWorkspaceMembers(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.ListMembers(ctx, workspaceId)
+}
+
+// WorkspacesRemoveWorkspaceMember handles DELETE /api/workspaces/:workspaceId/members/:accountId.
+func (s *Server) WorkspacesRemoveWorkspaceMember(ctx echo.Context, workspaceId string, accountId string) error { //nolint:revive
+	return s.workspace.RemoveMember(ctx, workspaceId, accountId)
+}
+
+// PublicViewPublicNote handles GET /public/notes/:token.
+func (s *Server) PublicViewPublicNote(ctx echo.Context, token string) error {
+	return s.link.View(ctx, token)
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SessionController handles login, refresh and logout endpoints.
+type SessionController struct {
+	inputFactory       func(accounts port.AccountRepository, sessions port.SessionRepository, tokens port.TokenIssuer, tx port.TxManager, output port.SessionOutputPort) port.SessionInputPort
+	outputFactory      func() *presenter.SessionPresenter
+	accountRepoFactory func() port.AccountRepository
+	sessionRepoFactory func() port.SessionRepository
+	tokenFactory       func() port.TokenIssuer
+	txFactory          func() port.TxManager
+}
+
+// NewSessionController creates SessionController.
+func NewSessionController(
+	inputFactory func(accounts port.AccountRepository, sessions port.SessionRepository, tokens port.TokenIssuer, tx port.TxManager, output port.SessionOutputPort) port.SessionInputPort,
+	outputFactory func() *presenter.SessionPresenter,
+	accountRepoFactory func() port.AccountRepository,
+	sessionRepoFactory func() port.SessionRepository,
+	tokenFactory func() port.TokenIssuer,
+	txFactory func() port.TxManager,
+) *SessionController {
+	return &SessionController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		accountRepoFactory: accountRepoFactory,
+		sessionRepoFactory: sessionRepoFactory,
+		tokenFactory:       tokenFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// Login handles POST /accounts/auth: OAuth account upsert plus session issuance.
+func (c *SessionController) Login(ctx echo.Context) error {
+	var body openapi.ModelsCreateOrGetAccountRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	input, p := c.newIO()
+	err := input.Login(ctx.Request().Context(), account.OAuthAccountInput{
+		Email:             body.Email,
+		FirstName:         body.Name,
+		LastName:          "",
+		Provider:          body.Provider,
+		ProviderAccountID: body.ProviderAccountId,
+		Thumbnail:         body.Thumbnail,
+		Reactivate:        body.Reactivate != nil && *body.Reactivate,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Session())
+}
+
+// Refresh handles POST /accounts/refresh.
+func (c *SessionController) Refresh(ctx echo.Context) error {
+	var body openapi.ModelsRefreshSessionRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	input, p := c.newIO()
+	if err := input.Refresh(ctx.Request().Context(), body.RefreshToken); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Session())
+}
+
+// Logout handles POST /accounts/logout.
+func (c *SessionController) Logout(ctx echo.Context) error {
+	var body openapi.ModelsLogoutRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	input, p := c.newIO()
+	if err := input.Logout(ctx.Request().Context(), body.RefreshToken); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.LogoutResponse())
+}
+
+func (c *SessionController) newIO() (port.SessionInputPort, *presenter.SessionPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.accountRepoFactory(), c.sessionRepoFactory(), c.tokenFactory(), c.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTestSessionController(p *presenter.SessionPresenter, input *ctrlmock.SessionInputStub) *SessionController {
+	return NewSessionController(
+		func(accounts port.AccountRepository, sessions port.SessionRepository, tokens port.TokenIssuer, tx port.TxManager, output port.SessionOutputPort) port.SessionInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.SessionPresenter { return p },
+		func() port.AccountRepository { return nil },
+		func() port.SessionRepository { return nil },
+		func() port.TokenIssuer { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestSessionController_Login(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		err            error
+		wantStatus     int
+		wantBody       string
+		wantReactivate bool
+	}{
+		{
+			name:       "[Success] login issues session",
+			body:       `{"email":"user@example.com","name":"Taro","provider":"google","providerAccountId":"pid"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   "refresh-token",
+		},
+		{
+			name:           "[Success] login forwards reactivation request",
+			body:           `{"email":"user@example.com","name":"Taro","provider":"google","providerAccountId":"pid","reactivate":true}`,
+			wantStatus:     http.StatusOK,
+			wantReactivate: true,
+		},
+		{
+			name:       "[Fail] inactive account",
+			body:       `{"email":"user@example.com","name":"Taro","provider":"google","providerAccountId":"pid"}`,
+			err:        domainerr.ErrAccountInactive,
+			wantStatus: http.StatusForbidden,
+			wantBody:   domainerr.ErrAccountInactive.Error(),
+		},
+		{
+			name:       "[Fail] bind error",
+			body:       `not-json`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := presenter.NewSessionPresenter()
+			input := &ctrlmock.SessionInputStub{Err: tt.err}
+			ctrl := newTestSessionController(p, input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/accounts/auth", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Login(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (p.Session() == nil || p.Session().Account.Email != "user@example.com") {
+				t.Fatalf("presenter session not set: %+v", p.Session())
+			}
+			if input.LoginInput.Reactivate != tt.wantReactivate {
+				t.Fatalf("Reactivate = %v, want %v", input.LoginInput.Reactivate, tt.wantReactivate)
+			}
+		})
+	}
+}
+
+func TestSessionController_Refresh(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] refresh rotates tokens", body: `{"refreshToken":"old"}`, wantStatus: http.StatusOK, wantBody: "access-token"},
+		{name: "[Fail] invalid session", body: `{"refreshToken":"old"}`, err: domainerr.ErrSessionInvalid, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrSessionInvalid.Error()},
+		{name: "[Fail] expired session", body: `{"refreshToken":"old"}`, err: domainerr.ErrSessionExpired, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrSessionExpired.Error()},
+		{name: "[Fail] inactive account", body: `{"refreshToken":"old"}`, err: domainerr.ErrAccountInactive, wantStatus: http.StatusForbidden},
+		{name: "[Fail] bind error", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newTestSessionController(presenter.NewSessionPresenter(), &ctrlmock.SessionInputStub{Err: tt.err})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/accounts/refresh", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Refresh(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestSessionController_Logout(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] logout", body: `{"refreshToken":"old"}`, wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] bind error", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newTestSessionController(presenter.NewSessionPresenter(), &ctrlmock.SessionInputStub{Err: tt.err})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/accounts/logout", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Logout(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
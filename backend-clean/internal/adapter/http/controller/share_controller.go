@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareController handles the share endpoints of notes and templates.
+type ShareController struct {
+	inputFactory       func(shareRepo port.ShareRepository, noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ShareOutputPort) port.ShareInputPort
+	outputFactory      func() *presenter.SharePresenter
+	shareRepoFactory   func() port.ShareRepository
+	noteRepoFactory    func() port.NoteRepository
+	tplRepoFactory     func() port.TemplateRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+}
+
+// NewShareController creates ShareController.
+func NewShareController(
+	inputFactory func(shareRepo port.ShareRepository, noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ShareOutputPort) port.ShareInputPort,
+	outputFactory func() *presenter.SharePresenter,
+	shareRepoFactory func() port.ShareRepository,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *ShareController {
+	return &ShareController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		shareRepoFactory:   shareRepoFactory,
+		noteRepoFactory:    noteRepoFactory,
+		tplRepoFactory:     tplRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// List handles GET /notes/:id/shares and GET /templates/:id/shares.
+func (c *ShareController) List(ctx echo.Context, resourceType share.ResourceType, resourceID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.List(ctx.Request().Context(), port.ShareListInput{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		ActorID:      actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Shares())
+}
+
+// Grant handles PUT /notes/:id/shares/:accountId and PUT /templates/:id/shares/:accountId.
+func (c *ShareController) Grant(ctx echo.Context, resourceType share.ResourceType, resourceID, accountID string) error {
+	var body openapi.ModelsShareRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Grant(ctx.Request().Context(), port.ShareGrantInput{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		AccountID:    accountID,
+		Role:         share.Role(body.Role),
+		ActorID:      actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Share())
+}
+
+// Revoke handles DELETE /notes/:id/shares/:accountId and DELETE /templates/:id/shares/:accountId.
+func (c *ShareController) Revoke(ctx echo.Context, resourceType share.ResourceType, resourceID, accountID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Revoke(ctx.Request().Context(), port.ShareRevokeInput{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		AccountID:    accountID,
+		ActorID:      actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.RevokeResponse())
+}
+
+func (c *ShareController) newIO() (port.ShareInputPort, *presenter.SharePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.shareRepoFactory(), c.noteRepoFactory(), c.tplRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
+	return input, output
+}
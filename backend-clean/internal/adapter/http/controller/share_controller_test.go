@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newShareTestController(input *ctrlmock.ShareInputStub) *ShareController {
+	return NewShareController(
+		func(shareRepo port.ShareRepository, noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ShareOutputPort) port.ShareInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewSharePresenter,
+		func() port.ShareRepository { return nil },
+		func() port.NoteRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestShareController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list grants", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"role":"viewer"`},
+		{name: "[Fail] not shared", accountID: "other", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] account missing", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ShareInputStub{Err: tt.inErr, Grants: []share.Grant{{AccountID: "viewer-1", Role: share.RoleViewer, GrantedBy: "owner"}}}
+			ctrl := newShareTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/shares", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.List(echo.New().NewContext(req, rec), share.ResourceNote, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.accountID != "" && (input.Listed.ResourceType != share.ResourceNote || input.Listed.ResourceID != "n1" || input.Listed.ActorID != tt.accountID) {
+				t.Fatalf("input = %+v", input.Listed)
+			}
+		})
+	}
+}
+
+func TestShareController_Grant(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantRole   share.Role
+	}{
+		{name: "[Success] share as editor", body: `{"role":"editor"}`, wantStatus: http.StatusOK, wantBody: `"accountId":"acc-2"`, wantRole: share.RoleEditor},
+		{name: "[Fail] invalid body", body: `{`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] share with owner", body: `{"role":"viewer"}`, inErr: domainerr.ErrShareWithOwner, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrShareWithOwner.Error(), wantRole: share.RoleViewer},
+		{name: "[Fail] not owner", body: `{"role":"viewer"}`, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantRole: share.RoleViewer},
+		{name: "[Fail] template not found", body: `{"role":"viewer"}`, inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantRole: share.RoleViewer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ShareInputStub{Err: tt.inErr}
+			ctrl := newShareTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/templates/t1/shares/acc-2", strings.NewReader(tt.body)), "owner")
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Grant(echo.New().NewContext(req, rec), share.ResourceTemplate, "t1", "acc-2")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Granted.Role != tt.wantRole {
+				t.Fatalf("input = %+v", input.Granted)
+			}
+			if tt.wantRole != "" && (input.Granted.ResourceType != share.ResourceTemplate || input.Granted.AccountID != "acc-2" || input.Granted.ActorID != "owner") {
+				t.Fatalf("input = %+v", input.Granted)
+			}
+		})
+	}
+}
+
+func TestShareController_Revoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] revoke", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] grant not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ShareInputStub{Err: tt.inErr}
+			ctrl := newShareTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/n1/shares/acc-2", nil), "owner")
+			rec := httptest.NewRecorder()
+			_ = ctrl.Revoke(echo.New().NewContext(req, rec), share.ResourceNote, "n1", "acc-2")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Revoked.AccountID != "acc-2" || input.Revoked.ActorID != "owner" {
+				t.Fatalf("input = %+v", input.Revoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareLinkController handles the public link endpoints of notes and the public note page.
+type ShareLinkController struct {
+	inputFactory       func(linkRepo port.ShareLinkRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tokens port.LinkTokenGenerator, tx port.TxManager, output port.ShareLinkOutputPort) port.ShareLinkInputPort
+	outputFactory      func() *presenter.ShareLinkPresenter
+	linkRepoFactory    func() port.ShareLinkRepository
+	noteRepoFactory    func() port.NoteRepository
+	accountRepoFactory func() port.AccountRepository
+	tokenFactory       func() port.LinkTokenGenerator
+	txFactory          func() port.TxManager
+}
+
+// NewShareLinkController creates ShareLinkController.
+func NewShareLinkController(
+	inputFactory func(linkRepo port.ShareLinkRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tokens port.LinkTokenGenerator, tx port.TxManager, output port.ShareLinkOutputPort) port.ShareLinkInputPort,
+	outputFactory func() *presenter.ShareLinkPresenter,
+	linkRepoFactory func() port.ShareLinkRepository,
+	noteRepoFactory func() port.NoteRepository,
+	accountRepoFactory func() port.AccountRepository,
+	tokenFactory func() port.LinkTokenGenerator,
+	txFactory func() port.TxManager,
+) *ShareLinkController {
+	return &ShareLinkController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		linkRepoFactory:    linkRepoFactory,
+		noteRepoFactory:    noteRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		tokenFactory:       tokenFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// List handles GET /notes/:noteId/links.
+func (c *ShareLinkController) List(ctx echo.Context, noteID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), noteID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Links())
+}
+
+// Create handles POST /notes/:noteId/links.
+func (c *ShareLinkController) Create(ctx echo.Context, noteID string) error {
+	var body openapi.ModelsCreateShareLinkRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Create(ctx.Request().Context(), port.ShareLinkCreateInput{
+		NoteID:    noteID,
+		ExpiresAt: body.ExpiresAt,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Link())
+}
+
+// Revoke handles DELETE /notes/:noteId/links/:linkId.
+func (c *ShareLinkController) Revoke(ctx echo.Context, noteID, linkID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Revoke(ctx.Request().Context(), port.ShareLinkRevokeInput{
+		NoteID:  noteID,
+		LinkID:  linkID,
+		ActorID: actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.RevokeResponse())
+}
+
+// View handles GET /public/notes/:token without authentication.
+// Browsers asking for text/html get a rendered page; other clients get JSON.
+func (c *ShareLinkController) View(ctx echo.Context, token string) error {
+	input, p := c.newIO()
+	if err := input.View(ctx.Request().Context(), token); err != nil {
+		return handleError(ctx, err)
+	}
+	if !wantsHTML(ctx) {
+		return ctx.JSON(http.StatusOK, p.PublicNote())
+	}
+	page, err := p.PublicNoteHTML()
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.HTML(http.StatusOK, page)
+}
+
+func (c *ShareLinkController) newIO() (port.ShareLinkInputPort, *presenter.ShareLinkPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.linkRepoFactory(), c.noteRepoFactory(), c.accountRepoFactory(), c.tokenFactory(), c.txFactory(), output)
+	return input, output
+}
+
+// wantsHTML reports whether the client prefers an HTML page, as browsers following a link do.
+func wantsHTML(ctx echo.Context) bool {
+	return strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), echo.MIMETextHTML)
+}
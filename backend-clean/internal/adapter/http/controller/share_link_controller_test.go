@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newShareLinkTestController(input *ctrlmock.ShareLinkInputStub) *ShareLinkController {
+	return NewShareLinkController(
+		func(linkRepo port.ShareLinkRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tokens port.LinkTokenGenerator, tx port.TxManager, output port.ShareLinkOutputPort) port.ShareLinkInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewShareLinkPresenter,
+		func() port.ShareLinkRepository { return nil },
+		func() port.NoteRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.LinkTokenGenerator { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestShareLinkController_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantExpiry bool
+	}{
+		{name: "[Success] link without expiry", body: `{}`, accountID: "owner", wantStatus: http.StatusOK, wantBody: `"token":"raw-token"`},
+		{name: "[Success] link with expiry", body: `{"expiresAt":"2099-01-01T00:00:00Z"}`, accountID: "owner", wantStatus: http.StatusOK, wantBody: `"expiresAt":"2099-01-01T00:00:00Z"`, wantExpiry: true},
+		{name: "[Fail] invalid body", body: `{`, accountID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] account missing", body: `{}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] draft note", body: `{}`, accountID: "owner", inErr: domainerr.ErrShareLinkNotPublished, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrShareLinkNotPublished.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ShareLinkInputStub{Err: tt.inErr}
+			ctrl := newShareLinkTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/note-1/links", strings.NewReader(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Create(echo.New().NewContext(req, rec), "note-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if (input.Created.ExpiresAt != nil) != tt.wantExpiry {
+				t.Fatalf("input = %+v", input.Created)
+			}
+		})
+	}
+}
+
+func TestShareLinkController_Revoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] revoke link", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] not the owner", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] already revoked", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ShareLinkInputStub{Err: tt.inErr}
+			ctrl := newShareLinkTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/note-1/links/link-1", nil), "owner")
+			rec := httptest.NewRecorder()
+			_ = ctrl.Revoke(echo.New().NewContext(req, rec), "note-1", "link-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Revoked != (port.ShareLinkRevokeInput{NoteID: "note-1", LinkID: "link-1", ActorID: "owner"}) {
+				t.Fatalf("input = %+v", input.Revoked)
+			}
+		})
+	}
+}
+
+func TestShareLinkController_View(t *testing.T) {
+	published := &note.WithMeta{
+		Note:           note.Note{ID: "note-1", Title: "<b>Launch</b>", Status: note.StatusPublish},
+		TemplateName:   "Weekly",
+		OwnerFirstName: "Taro",
+		OwnerLastName:  "Yamada",
+		Sections:       []note.SectionWithField{{Section: note.Section{Content: "Shipped"}, FieldLabel: "Summary"}},
+	}
+	tests := []struct {
+		name        string
+		accept      string
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantContent string
+	}{
+		{name: "[Success] JSON by default", wantStatus: http.StatusOK, wantBody: `"ownerName":"Taro Yamada"`, wantContent: echo.MIMEApplicationJSON},
+		{name: "[Success] HTML for browsers", accept: "text/html,application/xhtml+xml", wantStatus: http.StatusOK, wantBody: "<h2>Summary</h2>", wantContent: echo.MIMETextHTML},
+		{name: "[Success] HTML escapes note content", accept: "text/html", wantStatus: http.StatusOK, wantBody: "&lt;b&gt;Launch&lt;/b&gt;", wantContent: echo.MIMETextHTML},
+		{name: "[Fail] link not working", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.ShareLinkInputStub{Err: tt.inErr, Note: published}
+			ctrl := newShareLinkTestController(input)
+			req := httptest.NewRequest(http.MethodGet, "/public/notes/raw-token", nil)
+			if tt.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			_ = ctrl.View(echo.New().NewContext(req, rec), "raw-token")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantContent != "" && !strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), tt.wantContent) {
+				t.Fatalf("content type = %q, want %q", rec.Header().Get(echo.HeaderContentType), tt.wantContent)
+			}
+			if input.Token != "raw-token" {
+				t.Fatalf("token = %q", input.Token)
+			}
+		})
+	}
+}
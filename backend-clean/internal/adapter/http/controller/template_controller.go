@@ -2,46 +2,67 @@
 package controller
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
-	"strings"
 
 	"github.com/labstack/echo/v4"
+	"gopkg.in/yaml.v3"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // TemplateController handles template HTTP endpoints.
 type TemplateController struct {
-	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort
-	outputFactory func() *presenter.TemplatePresenter
-	repoFactory   func() port.TemplateRepository
-	txFactory     func() port.TxManager
+	inputFactory       func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort
+	outputFactory      func() *presenter.TemplatePresenter
+	exportFactory      func(format openapi.ModelsTemplateExportFormat) *presenter.TemplateExportPresenter
+	repoFactory        func() port.TemplateRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
 }
 
 // NewTemplateController creates TemplateController.
 func NewTemplateController(
-	inputFactory func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort,
+	inputFactory func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort,
 	outputFactory func() *presenter.TemplatePresenter,
+	exportFactory func(format openapi.ModelsTemplateExportFormat) *presenter.TemplateExportPresenter,
 	repoFactory func() port.TemplateRepository,
+	accountRepoFactory func() port.AccountRepository,
 	txFactory func() port.TxManager,
 ) *TemplateController {
 	return &TemplateController{
-		inputFactory:  inputFactory,
-		outputFactory: outputFactory,
-		repoFactory:   repoFactory,
-		txFactory:     txFactory,
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		exportFactory:      exportFactory,
+		repoFactory:        repoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
 	}
 }
 
 // List handles GET /templates.
 func (c *TemplateController) List(ctx echo.Context, params openapi.TemplatesListTemplatesParams) error {
+	cursor, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	filters := template.Filters{
-		Query:   params.Q,
-		OwnerID: params.OwnerId,
+		Query:       params.Q,
+		OwnerID:     params.OwnerId,
+		WorkspaceID: params.WorkspaceId,
+		MemberID:    &accountID,
+		Cursor:      cursor,
+		Limit:       limit,
 	}
 	input, p := c.newIO()
 	if err := input.List(ctx.Request().Context(), filters); err != nil {
@@ -52,10 +73,15 @@ func (c *TemplateController) List(ctx echo.Context, params openapi.TemplatesList
 
 // GetByID handles GET /templates/:id.
 func (c *TemplateController) GetByID(ctx echo.Context, templateID string) error {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	input, p := c.newIO()
-	if err := input.Get(ctx.Request().Context(), templateID); err != nil {
+	if err := input.Get(ctx.Request().Context(), templateID, accountID); err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Template())
 }
 
@@ -65,24 +91,36 @@ func (c *TemplateController) Create(ctx echo.Context) error {
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
 	}
-	ownerID := body.OwnerId.String()
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	fields := make([]template.Field, 0, len(body.Fields))
 	for _, f := range body.Fields {
 		fields = append(fields, template.Field{
-			Label:      f.Label,
-			Order:      int(f.Order),
-			IsRequired: f.IsRequired,
+			Label:          f.Label,
+			Order:          int(f.Order),
+			IsRequired:     f.IsRequired,
+			Type:           fieldType(f.Type),
+			Options:        valueOrNil(f.Options),
+			MinLength:      intOrZero(f.MinLength),
+			MaxLength:      intOrZero(f.MaxLength),
+			Pattern:        valueOrEmpty(f.Pattern),
+			Placeholder:    valueOrEmpty(f.Placeholder),
+			DefaultContent: valueOrEmpty(f.DefaultContent),
 		})
 	}
 	input, p := c.newIO()
-	err := input.Create(ctx.Request().Context(), port.TemplateCreateInput{
-		Name:    body.Name,
-		OwnerID: ownerID,
-		Fields:  fields,
+	err = input.Create(ctx.Request().Context(), port.TemplateCreateInput{
+		Name:        body.Name,
+		OwnerID:     ownerID,
+		WorkspaceID: valueOrEmpty(body.WorkspaceId),
+		Fields:      fields,
 	})
 	if err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Template())
 }
 
@@ -92,47 +130,253 @@ func (c *TemplateController) Update(ctx echo.Context, templateID string, params
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
 	}
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	fields := make([]template.Field, 0, len(body.Fields))
 	for _, f := range body.Fields {
 		fields = append(fields, template.Field{
-			ID:         valueOrEmpty(f.Id),
-			Label:      f.Label,
-			Order:      int(f.Order),
-			IsRequired: f.IsRequired,
+			ID:             valueOrEmpty(f.Id),
+			Label:          f.Label,
+			Order:          int(f.Order),
+			IsRequired:     f.IsRequired,
+			Type:           fieldType(f.Type),
+			Options:        valueOrNil(f.Options),
+			MinLength:      intOrZero(f.MinLength),
+			MaxLength:      intOrZero(f.MaxLength),
+			Pattern:        valueOrEmpty(f.Pattern),
+			Placeholder:    valueOrEmpty(f.Placeholder),
+			DefaultContent: valueOrEmpty(f.DefaultContent),
 		})
 	}
 	input, p := c.newIO()
-	err := input.Update(ctx.Request().Context(), port.TemplateUpdateInput{
+	err = input.Update(ctx.Request().Context(), port.TemplateUpdateInput{
 		ID:      templateID,
 		Name:    body.Name,
 		Fields:  fields,
 		OwnerID: ownerID,
+		Version: version,
 	})
 	if err != nil {
 		return handleError(ctx, err)
 	}
+	setETag(ctx, p.ETag())
 	return ctx.JSON(http.StatusOK, p.Template())
 }
 
 // Delete handles DELETE /templates/:id.
 func (c *TemplateController) Delete(ctx echo.Context, templateID string, params openapi.TemplatesDeleteTemplateParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
-	if err := input.Delete(ctx.Request().Context(), templateID, ownerID); err != nil {
+	err = input.Delete(ctx.Request().Context(), port.TemplateDeleteInput{
+		ID:      templateID,
+		OwnerID: ownerID,
+		Version: version,
+	})
+	if err != nil {
 		return handleError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, p.DeleteResponse())
 }
 
+// Restore handles POST /templates/:id/restore.
+func (c *TemplateController) Restore(ctx echo.Context, templateID string, params openapi.TemplatesRestoreTemplateParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Restore(ctx.Request().Context(), port.TemplateRestoreInput{
+		ID:      templateID,
+		OwnerID: ownerID,
+		Version: version,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// Gallery handles GET /templates/gallery, listing public templates of every workspace.
+func (c *TemplateController) Gallery(ctx echo.Context, params openapi.TemplatesListTemplateGalleryParams) error {
+	cursor, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	if _, err := currentAccountID(ctx); err != nil {
+		return handleError(ctx, err)
+	}
+	filters := template.Filters{
+		Query:      params.Q,
+		PublicOnly: true,
+		Cursor:     cursor,
+		Limit:      limit,
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), filters); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Templates())
+}
+
+// Export handles GET /templates/:id/export, rendering the template as a portable document.
+func (c *TemplateController) Export(ctx echo.Context, templateID string, params openapi.TemplatesExportTemplateParams) error {
+	format := openapi.ModelsTemplateExportFormatJson
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if !presenter.IsTemplateExportFormat(format) {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid format"})
+	}
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	p := c.exportFactory(format)
+	input := c.inputFactory(c.repoFactory(), c.accountRepoFactory(), c.txFactory(), p)
+	if err := input.Get(ctx.Request().Context(), templateID, accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", p.Filename()))
+	return ctx.Blob(http.StatusOK, p.ContentType(), p.Body())
+}
+
+// Import handles POST /templates/import. The body is a document as written by Export, in JSON or YAML.
+func (c *TemplateController) Import(ctx echo.Context, params openapi.TemplatesImportTemplateParams) error {
+	body, err := bindTemplateDocument(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	fields := make([]template.Field, 0, len(body.Fields))
+	for _, f := range body.Fields {
+		fields = append(fields, template.Field{
+			Label:          f.Label,
+			IsRequired:     f.IsRequired,
+			Type:           fieldType(f.Type),
+			Options:        valueOrNil(f.Options),
+			MinLength:      intOrZero(f.MinLength),
+			MaxLength:      intOrZero(f.MaxLength),
+			Pattern:        valueOrEmpty(f.Pattern),
+			Placeholder:    valueOrEmpty(f.Placeholder),
+			DefaultContent: valueOrEmpty(f.DefaultContent),
+		})
+	}
+	input, p := c.newIO()
+	err = input.Import(ctx.Request().Context(), port.TemplateImportInput{
+		Document:    template.Document{Version: int(body.Version), Name: body.Name, Fields: fields},
+		OwnerID:     ownerID,
+		WorkspaceID: valueOrEmpty(params.WorkspaceId),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// Clone handles POST /templates/:id/clone.
+func (c *TemplateController) Clone(ctx echo.Context, templateID string, params openapi.TemplatesCloneTemplateParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Clone(ctx.Request().Context(), port.TemplateCloneInput{
+		ID:          templateID,
+		OwnerID:     ownerID,
+		WorkspaceID: valueOrEmpty(params.WorkspaceId),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// SetVisibility handles PUT /templates/:id/visibility.
+func (c *TemplateController) SetVisibility(ctx echo.Context, templateID string, params openapi.TemplatesSetTemplateVisibilityParams) error {
+	var body openapi.ModelsTemplateVisibilityRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.SetVisibility(ctx.Request().Context(), port.TemplateVisibilityInput{
+		ID:       templateID,
+		IsPublic: body.IsPublic,
+		OwnerID:  ownerID,
+		Version:  version,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	setETag(ctx, p.ETag())
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
 func (c *TemplateController) newIO() (port.TemplateInputPort, *presenter.TemplatePresenter) {
 	output := c.outputFactory()
-	input := c.inputFactory(c.repoFactory(), c.txFactory(), output)
+	input := c.inputFactory(c.repoFactory(), c.accountRepoFactory(), c.txFactory(), output)
 	return input, output
 }
+
+// fieldType maps an optional field type; an omitted type is left for the domain to default.
+func fieldType(t *openapi.ModelsFieldType) template.FieldType {
+	if t == nil {
+		return ""
+	}
+	return template.FieldType(*t)
+}
+
+// bindTemplateDocument decodes a template document body. YAML bodies are converted to JSON
+// first so that both formats are read with the same field names and types.
+func bindTemplateDocument(ctx echo.Context) (openapi.ModelsTemplateDocument, error) {
+	var doc openapi.ModelsTemplateDocument
+	mediaType, _, _ := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != "application/yaml" {
+		err := ctx.Bind(&doc)
+		return doc, err
+	}
+	raw, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return doc, err
+	}
+	var v any
+	if err := yaml.Unmarshal(raw, &v); err != nil {
+		return doc, err
+	}
+	asJSON, err := json.Marshal(v)
+	if err != nil {
+		return doc, err
+	}
+	err = json.Unmarshal(asJSON, &doc)
+	return doc, err
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -12,6 +14,7 @@ import (
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -19,36 +22,70 @@ func TestTemplateController_Create(t *testing.T) {
 	tests := []struct {
 		name       string
 		body       string
+		ownerID    string
+		inErr      error
 		wantStatus int
+		wantFields []template.Field
 	}{
 		{
 			name:       "[Success] create template",
-			body:       `{"name":"Template","ownerId":"00000000-0000-0000-0000-000000000002","fields":[{"label":"Title","order":1,"isRequired":true}]}`,
+			body:       `{"name":"Template","fields":[{"label":"Title","order":1,"isRequired":true}]}`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
+			wantStatus: http.StatusOK,
+			wantFields: []template.Field{{Label: "Title", Order: 1, IsRequired: true}},
+		},
+		{
+			name:       "[Success] typed field",
+			body:       `{"name":"Template","fields":[{"label":"Priority","order":1,"isRequired":false,"type":"single_select","options":["low","high"]}]}`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
 			wantStatus: http.StatusOK,
+			wantFields: []template.Field{{Label: "Priority", Order: 1, Type: template.FieldTypeSingleSelect, Options: []string{"low", "high"}}},
+		},
+		{
+			name:       "[Success] field constraints",
+			body:       `{"name":"Template","fields":[{"label":"Code","order":1,"isRequired":true,"minLength":3,"maxLength":8,"pattern":"[A-Z]+","placeholder":"ABC","defaultContent":"NEW"}]}`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
+			wantStatus: http.StatusOK,
+			wantFields: []template.Field{{Label: "Code", Order: 1, IsRequired: true, MinLength: 3, MaxLength: 8, Pattern: "[A-Z]+", Placeholder: "ABC", DefaultContent: "NEW"}},
+		},
+		{
+			name:       "[Fail] invalid field type",
+			body:       `{"name":"Template","fields":[{"label":"Title","order":1,"isRequired":true,"type":"color"}]}`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
+			inErr:      domainerr.ErrInvalidFieldType,
+			wantStatus: http.StatusBadRequest,
 		},
 		{
 			name:       "[Fail] bind error",
 			body:       `not-json`,
+			ownerID:    "00000000-0000-0000-0000-000000000002",
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"name":"Template","fields":[{"label":"Title","order":1,"isRequired":true}]}`,
+			wantStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
-			input := &ctrlmock.TemplateInputStub{}
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
+				presenter.NewTemplateExportPresenter,
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPost, "/api/templates", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/templates", bytes.NewBufferString(tt.body)), tt.ownerID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -57,18 +94,25 @@ func TestTemplateController_Create(t *testing.T) {
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
+			if tt.wantFields != nil && !reflect.DeepEqual(input.Fields, tt.wantFields) {
+				t.Fatalf("fields = %+v, want %+v", input.Fields, tt.wantFields)
+			}
 		})
 	}
 }
 
 func TestTemplateController_List(t *testing.T) {
 	tests := []struct {
-		name       string
-		inErr      error
-		wantStatus int
+		name        string
+		accountID   string
+		workspaceID *string
+		inErr       error
+		wantStatus  int
 	}{
-		{name: "[Success] list templates", wantStatus: http.StatusOK},
-		{name: "[Fail] repo error", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Success] list templates", accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Success] workspace forwarded", accountID: "owner", workspaceID: strPtr("ws-1"), wantStatus: http.StatusOK},
+		{name: "[Fail] repo error", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] without account", wantStatus: http.StatusForbidden},
 	}
 
 	for _, tt := range tests {
@@ -77,22 +121,33 @@ func TestTemplateController_List(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
+				presenter.NewTemplateExportPresenter,
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodGet, "/api/templates", nil)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/templates", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.List(c, openapi.TemplatesListTemplatesParams{})
+			_ = ctrl.List(c, openapi.TemplatesListTemplatesParams{WorkspaceId: tt.workspaceID})
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := valueOrEmpty(input.Filters.MemberID); got != tt.accountID {
+				t.Fatalf("member = %q, want %q", got, tt.accountID)
+			}
+			if got, want := valueOrEmpty(input.Filters.WorkspaceID), valueOrEmpty(tt.workspaceID); got != want {
+				t.Fatalf("workspace = %q, want %q", got, want)
+			}
 		})
 	}
 }
@@ -100,11 +155,13 @@ func TestTemplateController_List(t *testing.T) {
 func TestTemplateController_Get(t *testing.T) {
 	tests := []struct {
 		name       string
+		accountID  string
 		inErr      error
 		wantStatus int
 	}{
-		{name: "[Success] get template", wantStatus: http.StatusOK},
-		{name: "[Fail] not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Success] get template", accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] not found", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] without account", wantStatus: http.StatusForbidden},
 	}
 
 	for _, tt := range tests {
@@ -113,15 +170,17 @@ func TestTemplateController_Get(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
+				presenter.NewTemplateExportPresenter,
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
-			req := httptest.NewRequest(http.MethodGet, "/api/templates/t1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/templates/t1", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
@@ -138,55 +197,76 @@ func TestTemplateController_Update(t *testing.T) {
 	p := presenter.NewTemplatePresenter()
 	input := &ctrlmock.TemplateInputStub{}
 	ctrl := NewTemplateController(
-		func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+		func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
 			input.Output = output
 			return input
 		},
 		func() *presenter.TemplatePresenter { return p },
+		presenter.NewTemplateExportPresenter,
 		func() port.TemplateRepository { return nil },
+		func() port.AccountRepository { return nil },
 		func() port.TxManager { return nil },
 	)
 
 	tests := []struct {
 		name       string
 		body       string
-		params     openapi.TemplatesUpdateTemplateParams
+		ownerID    string
+		ifMatch    *string
 		inErr      error
 		wantStatus int
 	}{
 		{
 			name:       "[Success] update template",
 			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
-			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: "owner"},
+			ownerID:    "owner",
 			wantStatus: http.StatusOK,
 		},
 		{
 			name:       "[Fail] missing owner",
 			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
-			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: ""},
+			ownerID:    "",
 			wantStatus: http.StatusForbidden,
 		},
 		{
 			name:       "[Fail] usecase error",
 			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
-			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: "owner"},
+			ownerID:    "owner",
 			inErr:      domainerr.ErrNotFound,
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name:       "[Fail] stale If-Match",
+			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
+			ownerID:    "owner",
+			ifMatch:    strPtr(`"2"`),
+			inErr:      domainerr.ErrVersionConflict,
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "[Fail] malformed If-Match",
+			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
+			ownerID:    "owner",
+			ifMatch:    strPtr("2"),
+			wantStatus: http.StatusPreconditionFailed,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			input.Err = tt.inErr
-			req := httptest.NewRequest(http.MethodPut, "/api/templates/t1", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/templates/t1", bytes.NewBufferString(tt.body)), tt.ownerID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.Update(c, "t1", tt.params)
+			_ = ctrl.Update(c, "t1", openapi.TemplatesUpdateTemplateParams{IfMatch: tt.ifMatch})
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
+			if tt.wantStatus == http.StatusOK && rec.Header().Get("ETag") == "" {
+				t.Fatalf("missing ETag header")
+			}
 		})
 	}
 }
@@ -195,12 +275,14 @@ func TestTemplateController_Delete(t *testing.T) {
 	tests := []struct {
 		name       string
 		ownerID    string
+		ifMatch    *string
 		inErr      error
 		wantStatus int
 	}{
 		{name: "[Success] delete template", ownerID: "owner", wantStatus: http.StatusOK},
 		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden},
 		{name: "[Fail] not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] stale If-Match", ownerID: "owner", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed},
 	}
 
 	for _, tt := range tests {
@@ -209,23 +291,279 @@ func TestTemplateController_Delete(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
+				presenter.NewTemplateExportPresenter,
 				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
 				func() port.TxManager { return nil },
 			)
 
-			req := httptest.NewRequest(http.MethodDelete, "/api/templates/t1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/templates/t1", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.Delete(c, "t1", openapi.TemplatesDeleteTemplateParams{OwnerId: tt.ownerID})
+			_ = ctrl.Delete(c, "t1", openapi.TemplatesDeleteTemplateParams{IfMatch: tt.ifMatch})
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
 		})
 	}
 }
+
+func TestTemplateController_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		ifMatch    *string
+		inErr      error
+		wantStatus int
+		wantETag   string
+	}{
+		{name: "[Success] restore template", ownerID: "owner", ifMatch: strPtr(`"2"`), wantStatus: http.StatusOK, wantETag: `"3"`},
+		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] not in trash", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] stale If-Match", ownerID: "owner", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTemplatePresenter()
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := NewTemplateController(
+				func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TemplatePresenter { return p },
+				presenter.NewTemplateExportPresenter,
+				func() port.TemplateRepository { return nil },
+				func() port.AccountRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/templates/t1/restore", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Restore(c, "t1", openapi.TemplatesRestoreTemplateParams{IfMatch: tt.ifMatch})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+		})
+	}
+}
+
+func newTemplateTestController(input *ctrlmock.TemplateInputStub) *TemplateController {
+	return NewTemplateController(
+		func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewTemplatePresenter,
+		presenter.NewTemplateExportPresenter,
+		func() port.TemplateRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestTemplateController_Gallery(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] public templates", accountID: "acc-1", wantStatus: http.StatusOK},
+		{name: "[Fail] account missing", wantStatus: http.StatusForbidden},
+		{name: "[Fail] use case error", accountID: "acc-1", inErr: domainerr.ErrInvalidCursor, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := newTemplateTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/templates/gallery", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Gallery(echo.New().NewContext(req, rec), openapi.TemplatesListTemplateGalleryParams{})
+			assertStatusBody(t, rec, tt.wantStatus, "")
+			if tt.wantStatus == http.StatusOK && (!input.Filters.PublicOnly || input.Filters.MemberID != nil) {
+				t.Fatalf("filters = %+v", input.Filters)
+			}
+		})
+	}
+}
+
+func TestTemplateController_Export(t *testing.T) {
+	found := &template.WithUsage{Template: template.Template{ID: "t1", Name: "Weekly", Version: 1, Fields: []template.Field{
+		{ID: "f1", Key: "k1", Label: "Summary", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+	}}}
+	tests := []struct {
+		name            string
+		accountID       string
+		format          *openapi.ModelsTemplateExportFormat
+		inErr           error
+		wantStatus      int
+		wantBody        string
+		wantContentType string
+		wantFilename    string
+	}{
+		{name: "[Success] json by default", accountID: "acc-1", wantStatus: http.StatusOK, wantBody: `"label": "Summary"`, wantContentType: "application/json", wantFilename: "template-t1.json"},
+		{name: "[Success] yaml", accountID: "acc-1", format: templateFormatPtr(openapi.ModelsTemplateExportFormatYaml), wantStatus: http.StatusOK, wantBody: "name: Weekly", wantContentType: "application/yaml", wantFilename: "template-t1.yaml"},
+		{name: "[Fail] unknown format", accountID: "acc-1", format: templateFormatPtr("xml"), wantStatus: http.StatusBadRequest, wantBody: "invalid format"},
+		{name: "[Fail] account missing", wantStatus: http.StatusForbidden},
+		{name: "[Fail] not readable", accountID: "acc-1", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr, Found: found}
+			ctrl := newTemplateTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/templates/t1/export", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Export(echo.New().NewContext(req, rec), "t1", openapi.TemplatesExportTemplateParams{Format: tt.format})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get(echo.HeaderContentType); got != tt.wantContentType {
+				t.Fatalf("content type = %q", got)
+			}
+			if got := rec.Header().Get(echo.HeaderContentDisposition); got != `attachment; filename="`+tt.wantFilename+`"` {
+				t.Fatalf("content disposition = %q", got)
+			}
+		})
+	}
+}
+
+func TestTemplateController_Import(t *testing.T) {
+	wantFields := []template.Field{
+		{Label: "Summary", IsRequired: true, Type: template.FieldTypeText},
+		{Label: "Mood", Type: template.FieldTypeSingleSelect, Options: []string{"good", "bad"}},
+	}
+	tests := []struct {
+		name          string
+		contentType   string
+		body          string
+		accountID     string
+		workspaceID   *string
+		inErr         error
+		wantStatus    int
+		wantWorkspace string
+	}{
+		{
+			name:        "[Success] json document",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"version":1,"name":"Weekly","fields":[{"label":"Summary","isRequired":true,"type":"text"},{"label":"Mood","isRequired":false,"type":"single_select","options":["good","bad"]}]}`,
+			accountID:   "acc-1",
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:          "[Success] yaml document into a workspace",
+			contentType:   "application/yaml; charset=utf-8",
+			body:          "version: 1\nname: Weekly\nfields:\n  - label: Summary\n    isRequired: true\n    type: text\n  - label: Mood\n    isRequired: false\n    type: single_select\n    options: [good, bad]\n",
+			accountID:     "acc-1",
+			workspaceID:   strPtr("ws-team"),
+			wantStatus:    http.StatusOK,
+			wantWorkspace: "ws-team",
+		},
+		{name: "[Fail] malformed yaml", contentType: "application/yaml", body: "fields: [", accountID: "acc-1", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] malformed json", contentType: echo.MIMEApplicationJSON, body: "{", accountID: "acc-1", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] account missing", contentType: echo.MIMEApplicationJSON, body: `{"version":1,"name":"Weekly","fields":[]}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] other document version", contentType: echo.MIMEApplicationJSON, body: `{"version":2,"name":"Weekly","fields":[]}`, accountID: "acc-1", inErr: domainerr.ErrTemplateDocumentVersionUnsupported, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := newTemplateTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/templates/import", strings.NewReader(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Import(echo.New().NewContext(req, rec), openapi.TemplatesImportTemplateParams{WorkspaceId: tt.workspaceID})
+			assertStatusBody(t, rec, tt.wantStatus, "")
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if input.Document.Version != 1 || input.Document.Name != "Weekly" || !reflect.DeepEqual(input.Document.Fields, wantFields) {
+				t.Fatalf("document = %+v", input.Document)
+			}
+			if input.WorkspaceID != tt.wantWorkspace {
+				t.Fatalf("workspace = %q, want %q", input.WorkspaceID, tt.wantWorkspace)
+			}
+		})
+	}
+}
+
+func TestTemplateController_Clone(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] clone template", accountID: "acc-1", wantStatus: http.StatusOK},
+		{name: "[Fail] account missing", wantStatus: http.StatusForbidden},
+		{name: "[Fail] not readable", accountID: "acc-1", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := newTemplateTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/templates/t1/clone", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Clone(echo.New().NewContext(req, rec), "t1", openapi.TemplatesCloneTemplateParams{WorkspaceId: strPtr("ws-team")})
+			assertStatusBody(t, rec, tt.wantStatus, "")
+			if tt.wantStatus == http.StatusOK && (input.ClonedID != "t1" || input.WorkspaceID != "ws-team") {
+				t.Fatalf("input = %+v", input)
+			}
+		})
+	}
+}
+
+func TestTemplateController_SetVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		accountID  string
+		ifMatch    *string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantETag   string
+	}{
+		{name: "[Success] publish to gallery", body: `{"isPublic":true}`, accountID: "acc-1", ifMatch: strPtr(`"2"`), wantStatus: http.StatusOK, wantBody: `"isPublic":true`, wantETag: `"3"`},
+		{name: "[Fail] bind error", body: "not-json", accountID: "acc-1", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] account missing", body: `{"isPublic":true}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] not the owner", body: `{"isPublic":true}`, accountID: "acc-2", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] stale If-Match", body: `{"isPublic":false}`, accountID: "acc-1", ifMatch: strPtr(`"1"`), inErr: domainerr.ErrVersionConflict, wantStatus: http.StatusPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := newTemplateTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/templates/t1/visibility", strings.NewReader(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.SetVisibility(echo.New().NewContext(req, rec), "t1", openapi.TemplatesSetTemplateVisibilityParams{IfMatch: tt.ifMatch})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if got := rec.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+		})
+	}
+}
+
+func templateFormatPtr(f openapi.ModelsTemplateExportFormat) *openapi.ModelsTemplateExportFormat {
+	return &f
+}
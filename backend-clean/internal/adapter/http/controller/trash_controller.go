@@ -0,0 +1,53 @@
+// Package controller contains HTTP controllers.
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TrashController handles trash HTTP endpoints.
+type TrashController struct {
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.TrashOutputPort) port.TrashInputPort
+	outputFactory   func() *presenter.TrashPresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+}
+
+// NewTrashController creates TrashController.
+func NewTrashController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.TrashOutputPort) port.TrashInputPort,
+	outputFactory func() *presenter.TrashPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+) *TrashController {
+	return &TrashController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		tplRepoFactory:  tplRepoFactory,
+	}
+}
+
+// List handles GET /trash.
+func (c *TrashController) List(ctx echo.Context) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Trash())
+}
+
+func (c *TrashController) newIO() (port.TrashInputPort, *presenter.TrashPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestTrashController_List(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list trash", ownerID: "owner", wantStatus: http.StatusOK, wantBody: `"deletedAt":"2026-10-01T09:00:00Z"`},
+		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] usecase error", ownerID: "owner", inErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantBody: "db down"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTrashPresenter()
+			input := &ctrlmock.TrashInputStub{
+				Err:       tt.inErr,
+				Notes:     []note.WithMeta{{Note: note.Note{ID: "n1", DeletedAt: &deletedAt}, TemplateName: "tpl"}},
+				Templates: []template.Template{{ID: "t1", DeletedAt: &deletedAt}},
+			}
+			ctrl := NewTrashController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.TrashOutputPort) port.TrashInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TrashPresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/trash", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.OwnerID != tt.ownerID {
+				t.Fatalf("owner = %q, want %q", input.OwnerID, tt.ownerID)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package controller contains HTTP controllers.
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookController handles webhook HTTP endpoints.
+type WebhookController struct {
+	inputFactory       func(repo port.WebhookRepository, accountRepo port.AccountRepository, output port.WebhookOutputPort) port.WebhookInputPort
+	outputFactory      func() *presenter.WebhookPresenter
+	repoFactory        func() port.WebhookRepository
+	accountRepoFactory func() port.AccountRepository
+}
+
+// NewWebhookController creates WebhookController.
+func NewWebhookController(
+	inputFactory func(repo port.WebhookRepository, accountRepo port.AccountRepository, output port.WebhookOutputPort) port.WebhookInputPort,
+	outputFactory func() *presenter.WebhookPresenter,
+	repoFactory func() port.WebhookRepository,
+	accountRepoFactory func() port.AccountRepository,
+) *WebhookController {
+	return &WebhookController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		repoFactory:        repoFactory,
+		accountRepoFactory: accountRepoFactory,
+	}
+}
+
+// List handles GET /webhooks.
+func (c *WebhookController) List(ctx echo.Context) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhooks())
+}
+
+// GetByID handles GET /webhooks/:id.
+func (c *WebhookController) GetByID(ctx echo.Context, webhookID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Get(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Create handles POST /webhooks.
+func (c *WebhookController) Create(ctx echo.Context) error {
+	var body openapi.ModelsCreateWebhookRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Create(ctx.Request().Context(), port.WebhookCreateInput{
+		OwnerID:    ownerID,
+		URL:        body.Url,
+		Secret:     body.Secret,
+		EventTypes: eventTypes(body.EventTypes),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Update handles PUT /webhooks/:id.
+func (c *WebhookController) Update(ctx echo.Context, webhookID string) error {
+	var body openapi.ModelsUpdateWebhookRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Update(ctx.Request().Context(), port.WebhookUpdateInput{
+		ID:         webhookID,
+		OwnerID:    ownerID,
+		URL:        body.Url,
+		Secret:     body.Secret,
+		EventTypes: eventTypes(body.EventTypes),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Delete handles DELETE /webhooks/:id.
+func (c *WebhookController) Delete(ctx echo.Context, webhookID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// ListDeliveries handles GET /webhooks/:id/deliveries.
+func (c *WebhookController) ListDeliveries(ctx echo.Context, webhookID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListDeliveries(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Deliveries())
+}
+
+// Replay handles POST /webhooks/:id/deliveries/:deliveryId/replay.
+func (c *WebhookController) Replay(ctx echo.Context, webhookID, deliveryID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Replay(ctx.Request().Context(), port.WebhookReplayInput{
+		WebhookID:  webhookID,
+		DeliveryID: deliveryID,
+		OwnerID:    ownerID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Delivery())
+}
+
+func (c *WebhookController) newIO() (port.WebhookInputPort, *presenter.WebhookPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), c.accountRepoFactory(), output)
+	return input, output
+}
+
+func eventTypes(types []openapi.ModelsWebhookEventType) []string {
+	out := make([]string, 0, len(types))
+	for _, t := range types {
+		out = append(out, string(t))
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newWebhookTestController(input *ctrlmock.WebhookInputStub) *WebhookController {
+	return NewWebhookController(
+		func(repo port.WebhookRepository, accountRepo port.AccountRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewWebhookPresenter,
+		func() port.WebhookRepository { return nil },
+		func() port.AccountRepository { return nil },
+	)
+}
+
+func TestWebhookController_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantEvents []string
+	}{
+		{
+			name:       "[Success] create",
+			ownerID:    "owner",
+			body:       `{"url":"https://example.com/hook","secret":"0123456789abcdef","eventTypes":["note.published"]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"url":"https://example.com/hook"`,
+			wantEvents: []string{"note.published"},
+		},
+		{name: "[Fail] invalid body", ownerID: "owner", body: `{`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] owner missing", ownerID: "", body: `{}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] validation", ownerID: "owner", body: `{}`, inErr: domainerr.ErrInvalidWebhookURL, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidWebhookURL.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WebhookInputStub{
+				Err:          tt.inErr,
+				Subscription: webhook.Subscription{ID: "wh-1", URL: "https://example.com/hook", Secret: "0123456789abcdef"},
+			}
+			ctrl := newWebhookTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(tt.body)), tt.ownerID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Create(echo.New().NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if strings.Contains(rec.Body.String(), "0123456789abcdef") {
+				t.Fatalf("secret must not be exposed: %s", rec.Body.String())
+			}
+			if input.Created.OwnerID != tt.ownerID || !reflect.DeepEqual(input.Created.EventTypes, tt.wantEvents) {
+				t.Fatalf("input = %+v", input.Created)
+			}
+		})
+	}
+}
+
+func TestWebhookController_Replay(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] replay", wantStatus: http.StatusOK, wantBody: `"replayOf":"d-1"`},
+		{name: "[Fail] not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] usecase error", inErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantBody: "db down"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WebhookInputStub{
+				Err:      tt.inErr,
+				Delivery: webhook.Delivery{ID: "d-2", SubscriptionID: "wh-1", Status: webhook.DeliveryPending, ReplayOf: "d-1"},
+			}
+			ctrl := newWebhookTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/webhooks/wh-1/deliveries/d-1/replay", nil), "owner")
+			rec := httptest.NewRecorder()
+			_ = ctrl.Replay(echo.New().NewContext(req, rec), "wh-1", "d-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			want := port.WebhookReplayInput{WebhookID: "wh-1", DeliveryID: "d-1", OwnerID: "owner"}
+			if input.Replayed != want {
+				t.Fatalf("input = %+v, want %+v", input.Replayed, want)
+			}
+		})
+	}
+}
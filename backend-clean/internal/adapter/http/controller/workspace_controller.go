@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceController handles workspace, member and invitation endpoints.
+type WorkspaceController struct {
+	inputFactory         func(repo port.WorkspaceRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort
+	outputFactory        func() *presenter.WorkspacePresenter
+	workspaceRepoFactory func() port.WorkspaceRepository
+	accountRepoFactory   func() port.AccountRepository
+	txFactory            func() port.TxManager
+}
+
+// NewWorkspaceController creates WorkspaceController.
+func NewWorkspaceController(
+	inputFactory func(repo port.WorkspaceRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort,
+	outputFactory func() *presenter.WorkspacePresenter,
+	workspaceRepoFactory func() port.WorkspaceRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *WorkspaceController {
+	return &WorkspaceController{
+		inputFactory:         inputFactory,
+		outputFactory:        outputFactory,
+		workspaceRepoFactory: workspaceRepoFactory,
+		accountRepoFactory:   accountRepoFactory,
+		txFactory:            txFactory,
+	}
+}
+
+// List handles GET /workspaces.
+func (c *WorkspaceController) List(ctx echo.Context) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspaces())
+}
+
+// Create handles POST /workspaces.
+func (c *WorkspaceController) Create(ctx echo.Context) error {
+	var body openapi.ModelsCreateWorkspaceRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Create(ctx.Request().Context(), port.WorkspaceCreateInput{
+		Name:    body.Name,
+		ActorID: actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspace())
+}
+
+// ListInvitations handles GET /workspaces/invitations.
+func (c *WorkspaceController) ListInvitations(ctx echo.Context) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListInvitations(ctx.Request().Context(), actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Invitations())
+}
+
+// Accept handles POST /workspaces/invitations/:invitationId/accept.
+func (c *WorkspaceController) Accept(ctx echo.Context, invitationID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Accept(ctx.Request().Context(), port.WorkspaceAcceptInput{
+		InvitationID: invitationID,
+		ActorID:      actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspace())
+}
+
+// Invite handles POST /workspaces/:workspaceId/invitations.
+func (c *WorkspaceController) Invite(ctx echo.Context, workspaceID string) error {
+	var body openapi.ModelsInviteWorkspaceMemberRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Invite(ctx.Request().Context(), port.WorkspaceInviteInput{
+		WorkspaceID: workspaceID,
+		Email:       body.Email,
+		Role:        workspace.Role(body.Role),
+		ActorID:     actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Invitation())
+}
+
+// ListMembers handles GET /workspaces/:workspaceId/members.
+func (c *WorkspaceController) ListMembers(ctx echo.Context, workspaceID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListMembers(ctx.Request().Context(), workspaceID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Members())
+}
+
+// RemoveMember handles DELETE /workspaces/:workspaceId/members/:accountId.
+func (c *WorkspaceController) RemoveMember(ctx echo.Context, workspaceID, accountID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.RemoveMember(ctx.Request().Context(), port.WorkspaceRemoveMemberInput{
+		WorkspaceID: workspaceID,
+		AccountID:   accountID,
+		ActorID:     actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.RemoveResponse())
+}
+
+func (c *WorkspaceController) newIO() (port.WorkspaceInputPort, *presenter.WorkspacePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.workspaceRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newWorkspaceTestController(input *ctrlmock.WorkspaceInputStub) *WorkspaceController {
+	return NewWorkspaceController(
+		func(repo port.WorkspaceRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewWorkspacePresenter,
+		func() port.WorkspaceRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestWorkspaceController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list workspaces", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"personal":true`},
+		{name: "[Fail] account missing", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr, Workspaces: []workspace.WithRole{{Workspace: workspace.Workspace{ID: "ws-1", Personal: true}, Role: workspace.RoleOwner}}}
+			ctrl := newWorkspaceTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/workspaces", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.List(echo.New().NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.ActorID != tt.accountID {
+				t.Fatalf("actor = %q, want %q", input.ActorID, tt.accountID)
+			}
+		})
+	}
+}
+
+func TestWorkspaceController_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] create workspace", body: `{"name":"Team"}`, wantStatus: http.StatusOK, wantBody: `"role":"owner"`},
+		{name: "[Fail] invalid body", body: `{`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] name missing", body: `{"name":""}`, inErr: &domainerr.ValidationError{Violations: []domainerr.Violation{{Path: "name", Code: domainerr.CodeRequired, Err: domainerr.ErrWorkspaceNameRequired}}}, wantStatus: http.StatusBadRequest, wantBody: `"field":"name"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/workspaces", strings.NewReader(tt.body)), "owner")
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Create(echo.New().NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestWorkspaceController_Invite(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantRole   workspace.Role
+	}{
+		{name: "[Success] invite member", body: `{"email":"new@example.com","role":"member"}`, wantStatus: http.StatusOK, wantBody: `"email":"new@example.com"`, wantRole: workspace.RoleMember},
+		{name: "[Fail] invalid body", body: `{`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] personal workspace", body: `{"email":"new@example.com","role":"admin"}`, inErr: domainerr.ErrPersonalWorkspace, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrPersonalWorkspace.Error(), wantRole: workspace.RoleAdmin},
+		{name: "[Fail] not a manager", body: `{"email":"new@example.com","role":"member"}`, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantRole: workspace.RoleMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/workspaces/ws-1/invitations", strings.NewReader(tt.body)), "owner")
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Invite(echo.New().NewContext(req, rec), "ws-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Invited.Role != tt.wantRole {
+				t.Fatalf("input = %+v", input.Invited)
+			}
+			if tt.wantRole != "" && (input.Invited.WorkspaceID != "ws-1" || input.Invited.ActorID != "owner") {
+				t.Fatalf("input = %+v", input.Invited)
+			}
+		})
+	}
+}
+
+func TestWorkspaceController_RemoveMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] remove member", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] remove owner", inErr: domainerr.ErrRemoveWorkspaceOwner, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrRemoveWorkspaceOwner.Error()},
+		{name: "[Fail] member not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceTestController(input)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/workspaces/ws-1/members/acc-2", nil), "owner")
+			rec := httptest.NewRecorder()
+			_ = ctrl.RemoveMember(echo.New().NewContext(req, rec), "ws-1", "acc-2")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if input.Removed.WorkspaceID != "ws-1" || input.Removed.AccountID != "acc-2" || input.Removed.ActorID != "owner" {
+				t.Fatalf("input = %+v", input.Removed)
+			}
+		})
+	}
+}
@@ -13,11 +13,27 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
 // Defines values for ModelsBadRequestErrorCode.
 const (
 	ModelsBadRequestErrorCodeBADREQUEST ModelsBadRequestErrorCode = "BAD_REQUEST"
 )
 
+// Defines values for ModelsFieldType.
+const (
+	ModelsFieldTypeCheckbox     ModelsFieldType = "checkbox"
+	ModelsFieldTypeDate         ModelsFieldType = "date"
+	ModelsFieldTypeMarkdown     ModelsFieldType = "markdown"
+	ModelsFieldTypeMultiSelect  ModelsFieldType = "multi_select"
+	ModelsFieldTypeNumber       ModelsFieldType = "number"
+	ModelsFieldTypeSingleSelect ModelsFieldType = "single_select"
+	ModelsFieldTypeText         ModelsFieldType = "text"
+	ModelsFieldTypeUrl          ModelsFieldType = "url"
+)
+
 // Defines values for ModelsForbiddenErrorCode.
 const (
 	ModelsForbiddenErrorCodeFORBIDDEN ModelsForbiddenErrorCode = "FORBIDDEN"
@@ -28,10 +44,44 @@ const (
 	ModelsNotFoundErrorCodeNOTFOUND ModelsNotFoundErrorCode = "NOT_FOUND"
 )
 
+// Defines values for ModelsNoteExportFormat.
+const (
+	ModelsNoteExportFormatHtml     ModelsNoteExportFormat = "html"
+	ModelsNoteExportFormatJson     ModelsNoteExportFormat = "json"
+	ModelsNoteExportFormatMarkdown ModelsNoteExportFormat = "markdown"
+)
+
 // Defines values for ModelsNoteStatus.
 const (
-	ModelsNoteStatusDraft   ModelsNoteStatus = "Draft"
-	ModelsNoteStatusPublish ModelsNoteStatus = "Publish"
+	ModelsNoteStatusApproved  ModelsNoteStatus = "Approved"
+	ModelsNoteStatusDraft     ModelsNoteStatus = "Draft"
+	ModelsNoteStatusInReview  ModelsNoteStatus = "InReview"
+	ModelsNoteStatusPublish   ModelsNoteStatus = "Publish"
+	ModelsNoteStatusScheduled ModelsNoteStatus = "Scheduled"
+)
+
+// Defines values for ModelsPreconditionFailedErrorCode.
+const (
+	ModelsPreconditionFailedErrorCodePRECONDITIONFAILED ModelsPreconditionFailedErrorCode = "PRECONDITION_FAILED"
+)
+
+// Defines values for ModelsReviewDecision.
+const (
+	ModelsReviewDecisionApproved ModelsReviewDecision = "Approved"
+	ModelsReviewDecisionRejected ModelsReviewDecision = "Rejected"
+)
+
+// Defines values for ModelsShareRole.
+const (
+	ModelsShareRoleEditor ModelsShareRole = "editor"
+	ModelsShareRoleOwner  ModelsShareRole = "owner"
+	ModelsShareRoleViewer ModelsShareRole = "viewer"
+)
+
+// Defines values for ModelsTemplateExportFormat.
+const (
+	ModelsTemplateExportFormatJson ModelsTemplateExportFormat = "json"
+	ModelsTemplateExportFormatYaml ModelsTemplateExportFormat = "yaml"
 )
 
 // Defines values for ModelsUnauthorizedErrorCode.
@@ -39,6 +89,26 @@ const (
 	ModelsUnauthorizedErrorCodeUNAUTHORIZED ModelsUnauthorizedErrorCode = "UNAUTHORIZED"
 )
 
+// Defines values for ModelsWebhookDeliveryStatus.
+const (
+	ModelsWebhookDeliveryStatusFailed    ModelsWebhookDeliveryStatus = "failed"
+	ModelsWebhookDeliveryStatusPending   ModelsWebhookDeliveryStatus = "pending"
+	ModelsWebhookDeliveryStatusSucceeded ModelsWebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for ModelsWebhookEventType.
+const (
+	ModelsWebhookEventTypeNotePublished   ModelsWebhookEventType = "note.published"
+	ModelsWebhookEventTypeNoteUnpublished ModelsWebhookEventType = "note.unpublished"
+)
+
+// Defines values for ModelsWorkspaceRole.
+const (
+	ModelsWorkspaceRoleAdmin  ModelsWorkspaceRole = "admin"
+	ModelsWorkspaceRoleMember ModelsWorkspaceRole = "member"
+	ModelsWorkspaceRoleOwner  ModelsWorkspaceRole = "owner"
+)
+
 // ModelsAccount アカウント情報
 type ModelsAccount struct {
 	// CreatedAt 作成日時
@@ -69,6 +139,15 @@ type ModelsAccount struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// ModelsAccountImportResponse アカウントデータのインポート結果
+type ModelsAccountImportResponse struct {
+	// Notes 作成したノート数
+	Notes int32 `json:"notes"`
+
+	// Templates 作成したテンプレート数
+	Templates int32 `json:"templates"`
+}
+
 // ModelsAccountResponse アカウントレスポンス
 type ModelsAccountResponse struct {
 	// CreatedAt 作成日時
@@ -114,11 +193,37 @@ type ModelsAccountSummary struct {
 	Thumbnail *string `json:"thumbnail,omitempty"`
 }
 
+// ModelsAssignNoteReviewersRequest レビュアー割り当てリクエスト（指定したアカウントで置き換える）
+type ModelsAssignNoteReviewersRequest struct {
+	// ReviewerIds レビュアーのアカウントID（所有者自身は指定できない）
+	ReviewerIds []string `json:"reviewerIds"`
+}
+
+// ModelsAuthSessionResponse 認証セッションレスポンス
+type ModelsAuthSessionResponse struct {
+	// AccessToken アクセストークン（Bearer トークンとして送信）
+	AccessToken string `json:"accessToken"`
+
+	// AccessTokenExpiresAt アクセストークン有効期限
+	AccessTokenExpiresAt time.Time `json:"accessTokenExpiresAt"`
+
+	// Account アカウント情報
+	Account ModelsAccountResponse `json:"account"`
+
+	// RefreshToken リフレッシュトークン（再発行ごとにローテーション）
+	RefreshToken string `json:"refreshToken"`
+
+	// RefreshTokenExpiresAt リフレッシュトークン有効期限
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
 // ModelsBadRequestError Bad Request エラー
 type ModelsBadRequestError struct {
-	Code    ModelsBadRequestErrorCode `json:"code"`
-	Details interface{}               `json:"details,omitempty"`
-	Message string                    `json:"message"`
+	Code ModelsBadRequestErrorCode `json:"code"`
+
+	// Details 違反ごとの詳細（入力検証エラーの場合）
+	Details *[]ModelsErrorDetail `json:"details,omitempty"`
+	Message string               `json:"message"`
 }
 
 // ModelsBadRequestErrorCode defines model for ModelsBadRequestError.Code.
@@ -126,22 +231,40 @@ type ModelsBadRequestErrorCode string
 
 // ModelsCreateFieldRequest テンプレートフィールド作成リクエスト
 type ModelsCreateFieldRequest struct {
+	// DefaultContent ノート作成時にセクションが省略された場合の既定値
+	DefaultContent *string `json:"defaultContent,omitempty"`
+
 	// IsRequired 必須フラグ
 	IsRequired bool `json:"isRequired"`
 
 	// Label ラベル
 	Label string `json:"label"`
 
+	// MaxLength 最大文字数（省略時は制限なし）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（省略時は制限なし）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options 選択肢（single_select / multi_select で必須。その他の型では指定不可）
+	Options *[]string `json:"options,omitempty"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現（RE2 構文）
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder 空のセクションに表示するプレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type フィールドの型（省略時は text）
+	Type *ModelsFieldType `json:"type,omitempty"`
 }
 
 // ModelsCreateNoteRequest ノート作成リクエスト
 type ModelsCreateNoteRequest struct {
-	// OwnerId 所有者ID
-	OwnerId openapi_types.UUID `json:"ownerId"`
-
-	// Sections セクション（オプション）
+	// Sections セクション（オプション。省略したフィールドは既定値で作成される）
 	Sections *[]ModelsCreateSectionRequest `json:"sections,omitempty"`
 
 	// TemplateId テンプレートID
@@ -165,6 +288,9 @@ type ModelsCreateOrGetAccountRequest struct {
 	// ProviderAccountId プロバイダーのアカウントID
 	ProviderAccountId string `json:"providerAccountId"`
 
+	// Reactivate 無効化されたアカウントを再有効化してログインする
+	Reactivate *bool `json:"reactivate,omitempty"`
+
 	// Thumbnail プロフィール画像URL
 	Thumbnail *string `json:"thumbnail,omitempty"`
 }
@@ -178,6 +304,12 @@ type ModelsCreateSectionRequest struct {
 	FieldId string `json:"fieldId"`
 }
 
+// ModelsCreateShareLinkRequest 公開リンク作成リクエスト
+type ModelsCreateShareLinkRequest struct {
+	// ExpiresAt 有効期限（省略時は失効させるまで有効）
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
 // ModelsCreateTemplateRequest テンプレート作成リクエスト
 type ModelsCreateTemplateRequest struct {
 	// Fields フィールド一覧
@@ -186,8 +318,53 @@ type ModelsCreateTemplateRequest struct {
 	// Name テンプレート名
 	Name string `json:"name"`
 
-	// OwnerId 所有者ID
-	OwnerId openapi_types.UUID `json:"ownerId"`
+	// WorkspaceId 作成先のワークスペースID（省略時は個人ワークスペース）
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+}
+
+// ModelsCreateWebhookRequest Webhook 作成リクエスト
+type ModelsCreateWebhookRequest struct {
+	// EventTypes 購読するイベント（1 つ以上）
+	EventTypes []ModelsWebhookEventType `json:"eventTypes"`
+
+	// Secret 署名用シークレット（16 文字以上）。X-Webhook-Signature は "<timestamp>.<body>" の HMAC-SHA256
+	Secret string `json:"secret"`
+
+	// Url 配信先 URL（http または https。ループバック・プライベート・リンクローカル・メタデータのホストは不可）
+	Url string `json:"url"`
+}
+
+// ModelsCreateWorkspaceRequest ワークスペース作成リクエスト
+type ModelsCreateWorkspaceRequest struct {
+	// Name ワークスペース名
+	Name string `json:"name"`
+}
+
+// ModelsDuplicateNoteRequest ノート複製リクエスト
+type ModelsDuplicateNoteRequest struct {
+	// TemplateId 複製先のテンプレートID（省略時は元のノートのテンプレート。キー・ラベル・表示順の順でフィールドを対応付ける）
+	TemplateId *string `json:"templateId,omitempty"`
+}
+
+// ModelsDuplicateNoteResponse ノート複製レスポンス
+type ModelsDuplicateNoteResponse struct {
+	// Note 作成された下書きノート
+	Note ModelsNoteResponse `json:"note"`
+
+	// Unmapped 複製できなかった内容（空のセクションは含まない）
+	Unmapped []ModelsUnmappedSection `json:"unmapped"`
+}
+
+// ModelsErrorDetail 検証エラーの詳細（違反 1 件）
+type ModelsErrorDetail struct {
+	// Code 違反の種類（REQUIRED, INVALID, DUPLICATE, TOO_SHORT, TOO_LONG, PATTERN_MISMATCH）
+	Code string `json:"code"`
+
+	// Field 違反した値の位置（例: fields[1].label, sections[<fieldId>].content）
+	Field string `json:"field"`
+
+	// Message エラーメッセージ
+	Message string `json:"message"`
 }
 
 // ModelsErrorResponse 共通エラーレスポンス
@@ -195,8 +372,8 @@ type ModelsErrorResponse struct {
 	// Code エラーコード
 	Code string `json:"code"`
 
-	// Details 詳細情報（オプション）
-	Details interface{} `json:"details,omitempty"`
+	// Details 検証エラーの詳細（オプション）
+	Details *[]ModelsErrorDetail `json:"details,omitempty"`
 
 	// Message エラーメッセージ
 	Message string `json:"message"`
@@ -204,19 +381,46 @@ type ModelsErrorResponse struct {
 
 // ModelsField テンプレートフィールド
 type ModelsField struct {
-	// Id フィールドID
+	// DefaultContent ノート作成時にセクションが省略された場合の既定値
+	DefaultContent *string `json:"defaultContent,omitempty"`
+
+	// Id フィールドID（スキーマバージョンごとに異なる）
 	Id string `json:"id"`
 
 	// IsRequired 必須フラグ
 	IsRequired bool `json:"isRequired"`
 
+	// Key フィールドキー（スキーマバージョンをまたいで同じフィールドを指す）
+	Key string `json:"key"`
+
 	// Label ラベル
 	Label string `json:"label"`
 
+	// MaxLength 最大文字数（省略時は制限なし）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（省略時は制限なし）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options 選択肢（選択型のみ。その他の型では空）
+	Options []string `json:"options"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現（RE2 構文）
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder 空のセクションに表示するプレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type フィールドの型
+	Type ModelsFieldType `json:"type"`
 }
 
+// ModelsFieldType フィールドの型（セクション内容の検証方法を決める）
+type ModelsFieldType string
+
 // ModelsForbiddenError Forbidden エラー
 type ModelsForbiddenError struct {
 	Code    ModelsForbiddenErrorCode `json:"code"`
@@ -226,6 +430,21 @@ type ModelsForbiddenError struct {
 // ModelsForbiddenErrorCode defines model for ModelsForbiddenError.Code.
 type ModelsForbiddenErrorCode string
 
+// ModelsInviteWorkspaceMemberRequest メンバー招待リクエスト（招待中のアドレスはロールを変更して招待し直す）
+type ModelsInviteWorkspaceMemberRequest struct {
+	// Email 招待するメールアドレス
+	Email string `json:"email"`
+
+	// Role 参加後のロール（admin または member）
+	Role ModelsWorkspaceRole `json:"role"`
+}
+
+// ModelsLogoutRequest ログアウトリクエスト
+type ModelsLogoutRequest struct {
+	// RefreshToken 失効させるリフレッシュトークン
+	RefreshToken string `json:"refreshToken"`
+}
+
 // ModelsNotFoundError Not Found エラー
 type ModelsNotFoundError struct {
 	Code    ModelsNotFoundErrorCode `json:"code"`
@@ -235,6 +454,9 @@ type ModelsNotFoundError struct {
 // ModelsNotFoundErrorCode defines model for ModelsNotFoundError.Code.
 type ModelsNotFoundErrorCode string
 
+// ModelsNoteExportFormat ノートのエクスポート形式
+type ModelsNoteExportFormat string
+
 // ModelsNoteFilters ノートフィルター（クエリパラメータ）
 type ModelsNoteFilters struct {
 	// OwnerId 所有者IDフィルター
@@ -250,6 +472,18 @@ type ModelsNoteFilters struct {
 	TemplateId *string `json:"templateId,omitempty"`
 }
 
+// ModelsNoteListResponse ノート一覧レスポンス（カーソルページネーション）
+type ModelsNoteListResponse struct {
+	// HasMore 次ページが存在するか
+	HasMore bool `json:"hasMore"`
+
+	// Items ノート一覧
+	Items []ModelsNoteResponse `json:"items"`
+
+	// NextCursor 次ページ取得用カーソル（最終ページでは省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
 // ModelsNoteResponse ノートレスポンス
 type ModelsNoteResponse struct {
 	// CreatedAt 作成日時
@@ -258,15 +492,24 @@ type ModelsNoteResponse struct {
 	// Id ノートID
 	Id string `json:"id"`
 
+	// LatestTemplateSchemaVersion テンプレートの最新スキーマバージョン（templateSchemaVersion より大きければ移行可能）
+	LatestTemplateSchemaVersion int32 `json:"latestTemplateSchemaVersion"`
+
 	// Owner 所有者情報
 	Owner ModelsAccountSummary `json:"owner"`
 
 	// OwnerId 所有者ID
 	OwnerId string `json:"ownerId"`
 
+	// PublishAt 公開予定日時（status が Scheduled のときのみ）
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
 	// Sections セクション
 	Sections []ModelsSection `json:"sections"`
 
+	// Snippet 検索ヒット箇所の抜粋（HTML エスケープ済み。一致部分を <mark> で囲む。q 指定時のみ）
+	Snippet *string `json:"snippet,omitempty"`
+
 	// Status ステータス
 	Status ModelsNoteStatus `json:"status"`
 
@@ -276,294 +519,2358 @@ type ModelsNoteResponse struct {
 	// TemplateName テンプレート名
 	TemplateName string `json:"templateName"`
 
+	// TemplateSchemaVersion ノートが準拠しているテンプレートのスキーマバージョン
+	TemplateSchemaVersion int32 `json:"templateSchemaVersion"`
+
 	// Title タイトル
 	Title string `json:"title"`
 
+	// UnpublishAt 公開終了予定日時（設定されている場合のみ）
+	UnpublishAt *time.Time `json:"unpublishAt,omitempty"`
+
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updatedAt"`
-}
 
-// ModelsNoteStatus ノートのステータス
-type ModelsNoteStatus string
+	// Version バージョン（更新のたびに増加。ETag と同じ値）
+	Version int32 `json:"version"`
 
-// ModelsSection セクション（ノートの各項目）
-type ModelsSection struct {
-	// Content 内容
-	Content string `json:"content"`
+	// WorkspaceId ワークスペースID
+	WorkspaceId string `json:"workspaceId"`
+}
 
-	// FieldId フィールドID
-	FieldId string `json:"fieldId"`
+// ModelsNoteReviewResponse レビュー結果（承認または差し戻し）
+type ModelsNoteReviewResponse struct {
+	// Comment レビューコメント
+	Comment string `json:"comment"`
 
-	// FieldLabel フィールドラベル
-	FieldLabel string `json:"fieldLabel"`
+	// CreatedAt レビュー日時
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Id セクションID
-	Id string `json:"id"`
+	// Decision レビュー結果
+	Decision ModelsReviewDecision `json:"decision"`
 
-	// IsRequired 必須項目かどうか
-	IsRequired bool `json:"isRequired"`
-}
+	// Id レビューID
+	Id string `json:"id"`
 
-// ModelsSuccessResponse 成功レスポンス（削除など）
-type ModelsSuccessResponse struct {
-	Success bool `json:"success"`
+	// ReviewerId レビュアーのアカウントID
+	ReviewerId string `json:"reviewerId"`
 }
 
-// ModelsTemplateResponse テンプレートレスポンス
-type ModelsTemplateResponse struct {
-	// Fields フィールド一覧
-	Fields []ModelsField `json:"fields"`
-
-	// Id テンプレートID
-	Id string `json:"id"`
+// ModelsNoteReviewStateResponse ノートのレビュー状況
+type ModelsNoteReviewStateResponse struct {
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
 
-	// IsUsed 使用中フラグ
-	IsUsed bool `json:"isUsed"`
+	// ReviewerIds 割り当て済みレビュアーのアカウントID（割り当て順）
+	ReviewerIds []string `json:"reviewerIds"`
 
-	// Name テンプレート名
-	Name string `json:"name"`
+	// Reviews レビュー履歴（新しい順）
+	Reviews []ModelsNoteReviewResponse `json:"reviews"`
 
-	// Owner 所有者情報
-	Owner ModelsAccountSummary `json:"owner"`
+	// Status ノートのステータス
+	Status ModelsNoteStatus `json:"status"`
+}
 
-	// OwnerId 所有者ID
-	OwnerId string `json:"ownerId"`
+// ModelsNoteRevisionChange 変更前後の値
+type ModelsNoteRevisionChange struct {
+	// After 変更後
+	After string `json:"after"`
 
-	// UpdatedAt 更新日時
-	UpdatedAt time.Time `json:"updatedAt"`
-}
+	// Before 変更前
+	Before string `json:"before"`
 
-// ModelsUnauthorizedError Unauthorized エラー
-type ModelsUnauthorizedError struct {
-	Code    ModelsUnauthorizedErrorCode `json:"code"`
-	Message string                      `json:"message"`
+	// Changed 変更があったか
+	Changed bool `json:"changed"`
 }
 
-// ModelsUnauthorizedErrorCode defines model for ModelsUnauthorizedError.Code.
-type ModelsUnauthorizedErrorCode string
+// ModelsNoteRevisionDiffResponse リビジョン差分レスポンス
+type ModelsNoteRevisionDiffResponse struct {
+	// From 比較元リビジョン番号
+	From int32 `json:"from"`
 
-// ModelsUpdateFieldRequest フィールド更新リクエスト
-type ModelsUpdateFieldRequest struct {
-	// Id フィールドID（既存フィールドの場合は必須）
-	Id *string `json:"id,omitempty"`
+	// Sections フィールドごとの変更（テンプレートの順序）
+	Sections []ModelsNoteRevisionSectionChange `json:"sections"`
 
-	// IsRequired 必須フラグ
-	IsRequired bool `json:"isRequired"`
+	// Title タイトルの変更
+	Title ModelsNoteRevisionChange `json:"title"`
 
-	// Label ラベル
-	Label string `json:"label"`
+	// To 比較先リビジョン番号
+	To int32 `json:"to"`
+}
 
-	// Order 表示順序
-	Order int32 `json:"order"`
+// ModelsNoteRevisionListResponse ノートリビジョン一覧レスポンス（新しい順）
+type ModelsNoteRevisionListResponse struct {
+	// Items リビジョン一覧
+	Items []ModelsNoteRevisionResponse `json:"items"`
 }
 
-// ModelsUpdateNoteRequest ノート更新リクエスト
-type ModelsUpdateNoteRequest struct {
-	// Id ノートID
-	Id string `json:"id"`
+// ModelsNoteRevisionResponse ノートリビジョン（更新ごとに作成される不変のスナップショット）
+type ModelsNoteRevisionResponse struct {
+	// ActorId 変更したアカウントID
+	ActorId string `json:"actorId"`
 
-	// Sections セクション
-	Sections []ModelsUpdateSectionRequest `json:"sections"`
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Revision リビジョン番号（1 から連番）
+	Revision int32 `json:"revision"`
+
+	// Sections セクション内容
+	Sections []ModelsNoteRevisionSection `json:"sections"`
 
 	// Title タイトル
 	Title string `json:"title"`
 }
 
-// ModelsUpdateSectionRequest セクション更新リクエスト
-type ModelsUpdateSectionRequest struct {
+// ModelsNoteRevisionSection リビジョン時点のセクション内容
+type ModelsNoteRevisionSection struct {
 	// Content 内容
 	Content string `json:"content"`
 
-	// Id セクションID
-	Id string `json:"id"`
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
 }
 
-// ModelsUpdateTemplateRequest テンプレート更新リクエスト
-type ModelsUpdateTemplateRequest struct {
-	// Fields フィールド一覧
-	Fields []ModelsUpdateFieldRequest `json:"fields"`
+// ModelsNoteRevisionSectionChange フィールドごとの変更
+type ModelsNoteRevisionSectionChange struct {
+	// After 変更後
+	After string `json:"after"`
 
-	// Id テンプレートID
-	Id string `json:"id"`
+	// Before 変更前
+	Before string `json:"before"`
 
-	// Name テンプレート名
-	Name string `json:"name"`
+	// Changed 変更があったか
+	Changed bool `json:"changed"`
+
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
+
+	// FieldLabel フィールドラベル（テンプレートから削除済みの場合は空）
+	FieldLabel string `json:"fieldLabel"`
 }
 
-// AccountsGetAccountByEmailParams defines parameters for AccountsGetAccountByEmail.
-type AccountsGetAccountByEmailParams struct {
-	Email string `form:"email" json:"email"`
+// ModelsNoteStatus ノートのステータス
+type ModelsNoteStatus string
+
+// ModelsPreconditionFailedError Precondition Failed エラー（If-Match のバージョン不一致）
+type ModelsPreconditionFailedError struct {
+	Code    ModelsPreconditionFailedErrorCode `json:"code"`
+	Message string                            `json:"message"`
 }
 
-// NotesListNotesParams defines parameters for NotesListNotes.
-type NotesListNotesParams struct {
-	// Q タイトルキーワード検索
-	Q *string `form:"q,omitempty" json:"q,omitempty"`
+// ModelsPreconditionFailedErrorCode defines model for ModelsPreconditionFailedError.Code.
+type ModelsPreconditionFailedErrorCode string
 
-	// Status ステータスフィルター
-	Status *ModelsNoteStatus `form:"status,omitempty" json:"status,omitempty"`
+// ModelsPublicNoteResponse 公開ノート（閲覧専用）
+type ModelsPublicNoteResponse struct {
+	// Id ノートID
+	Id string `json:"id"`
 
-	// TemplateId テンプレートIDフィルター
-	TemplateId *string `form:"templateId,omitempty" json:"templateId,omitempty"`
+	// OwnerName 作成者名
+	OwnerName string `json:"ownerName"`
 
-	// OwnerId 所有者IDフィルター
-	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
-}
+	// Sections セクション（フィールド順）
+	Sections []ModelsPublicSection `json:"sections"`
 
-// NotesDeleteNoteParams defines parameters for NotesDeleteNote.
-type NotesDeleteNoteParams struct {
-	// OwnerId 所有者ID（権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
-}
+	// TemplateName テンプレート名
+	TemplateName string `json:"templateName"`
 
-// NotesUpdateNoteParams defines parameters for NotesUpdateNote.
-type NotesUpdateNoteParams struct {
-	// OwnerId 所有者ID（権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
+	// Title タイトル
+	Title string `json:"title"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// NotesPublishNoteParams defines parameters for NotesPublishNote.
-type NotesPublishNoteParams struct {
-	// OwnerId 所有者ID（公開権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
+// ModelsPublicSection 公開ノートのセクション
+type ModelsPublicSection struct {
+	// Content 内容
+	Content string `json:"content"`
+
+	// FieldLabel フィールドラベル
+	FieldLabel string `json:"fieldLabel"`
+
+	// FieldType フィールドの型
+	FieldType ModelsFieldType `json:"fieldType"`
 }
 
-// NotesUnpublishNoteParams defines parameters for NotesUnpublishNote.
-type NotesUnpublishNoteParams struct {
-	// OwnerId 所有者ID（公開権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
+// ModelsRefreshSessionRequest セッション更新リクエスト
+type ModelsRefreshSessionRequest struct {
+	// RefreshToken リフレッシュトークン
+	RefreshToken string `json:"refreshToken"`
 }
 
-// TemplatesListTemplatesParams defines parameters for TemplatesListTemplates.
-type TemplatesListTemplatesParams struct {
-	// Q テンプレート名のキーワード検索
-	Q *string `form:"q,omitempty" json:"q,omitempty"`
+// ModelsReviewDecision レビュー結果
+type ModelsReviewDecision string
 
-	// OwnerId 所有者IDフィルター
-	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+// ModelsReviewDecisionRequest 承認・差し戻しリクエスト
+type ModelsReviewDecisionRequest struct {
+	// Comment レビューコメント（差し戻し時は必須）
+	Comment *string `json:"comment,omitempty"`
 }
 
-// TemplatesDeleteTemplateParams defines parameters for TemplatesDeleteTemplate.
-type TemplatesDeleteTemplateParams struct {
-	OwnerId string `form:"ownerId" json:"ownerId"`
-}
+// ModelsScheduleNoteRequest ノート公開予約リクエスト
+type ModelsScheduleNoteRequest struct {
+	// PublishAt 公開予定日時（現在より後）
+	PublishAt time.Time `json:"publishAt"`
 
-// TemplatesUpdateTemplateParams defines parameters for TemplatesUpdateTemplate.
-type TemplatesUpdateTemplateParams struct {
-	OwnerId string `form:"ownerId" json:"ownerId"`
+	// UnpublishAt 公開終了予定日時（オプション。publishAt より後）
+	UnpublishAt *time.Time `json:"unpublishAt,omitempty"`
 }
 
-// AccountsCreateOrGetAccountJSONRequestBody defines body for AccountsCreateOrGetAccount for application/json ContentType.
-type AccountsCreateOrGetAccountJSONRequestBody = ModelsCreateOrGetAccountRequest
+// ModelsSection セクション（ノートの各項目）
+type ModelsSection struct {
+	// Content 内容
+	Content string `json:"content"`
 
-// NotesCreateNoteJSONRequestBody defines body for NotesCreateNote for application/json ContentType.
-type NotesCreateNoteJSONRequestBody = ModelsCreateNoteRequest
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
 
-// NotesUpdateNoteJSONRequestBody defines body for NotesUpdateNote for application/json ContentType.
-type NotesUpdateNoteJSONRequestBody = ModelsUpdateNoteRequest
+	// FieldLabel フィールドラベル
+	FieldLabel string `json:"fieldLabel"`
 
-// TemplatesCreateTemplateJSONRequestBody defines body for TemplatesCreateTemplate for application/json ContentType.
-type TemplatesCreateTemplateJSONRequestBody = ModelsCreateTemplateRequest
+	// FieldOptions フィールドの選択肢（選択型のみ）
+	FieldOptions []string `json:"fieldOptions"`
 
-// TemplatesUpdateTemplateJSONRequestBody defines body for TemplatesUpdateTemplate for application/json ContentType.
+	// FieldType フィールドの型
+	FieldType ModelsFieldType `json:"fieldType"`
+
+	// Id セクションID
+	Id string `json:"id"`
+
+	// IsRequired 必須項目かどうか
+	IsRequired bool `json:"isRequired"`
+}
+
+// ModelsShareLinkListResponse 公開リンク一覧
+type ModelsShareLinkListResponse struct {
+	// Items 公開リンク（作成日時の新しい順、失効済みを含む）
+	Items []ModelsShareLinkResponse `json:"items"`
+}
+
+// ModelsShareLinkResponse 公開リンク（公開中のノートをアカウントなしで閲覧できる）
+type ModelsShareLinkResponse struct {
+	// Active 現在有効かどうか（失効・期限切れの場合は false）
+	Active bool `json:"active"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy 作成したアカウントID
+	CreatedBy string `json:"createdBy"`
+
+	// ExpiresAt 有効期限
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id 公開リンクID
+	Id string `json:"id"`
+
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
+
+	// RevokedAt 失効日時（手動の失効・公開停止で設定される）
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Token トークン（作成時のみ返す。再表示はできない）
+	Token *string `json:"token,omitempty"`
+}
+
+// ModelsShareListResponse 共有一覧レスポンス（共有日時の古い順）
+type ModelsShareListResponse struct {
+	// Items 共有一覧
+	Items []ModelsShareResponse `json:"items"`
+}
+
+// ModelsShareRequest 共有リクエスト（共有済みのアカウントはロールを変更する）
+type ModelsShareRequest struct {
+	// Role 付与するロール
+	Role ModelsShareRole `json:"role"`
+}
+
+// ModelsShareResponse 共有（アカウントに付与したロール）
+type ModelsShareResponse struct {
+	// AccountId 共有先のアカウントID
+	AccountId string `json:"accountId"`
+
+	// CreatedAt 共有日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// GrantedBy ロールを付与したアカウントID
+	GrantedBy string `json:"grantedBy"`
+
+	// Role 付与されたロール
+	Role ModelsShareRole `json:"role"`
+
+	// UpdatedAt ロール変更日時
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsShareRole 共有ロール（viewer < editor < owner）
+type ModelsShareRole string
+
+// ModelsSuccessResponse 成功レスポンス（削除など）
+type ModelsSuccessResponse struct {
+	Success bool `json:"success"`
+}
+
+// ModelsTemplateDocument 持ち運び可能なテンプレート（ID・所有者・ワークスペースを含まない）
+type ModelsTemplateDocument struct {
+	// Fields フィールド一覧（表示順）
+	Fields []ModelsTemplateDocumentField `json:"fields"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+
+	// Version 形式バージョン（現在は 1）
+	Version int32 `json:"version"`
+}
+
+// ModelsTemplateDocumentField 持ち運び可能なテンプレートのフィールド（並び順がそのまま表示順になる）
+type ModelsTemplateDocumentField struct {
+	// DefaultContent ノート作成時にセクションが省略された場合の既定値
+	DefaultContent *string `json:"defaultContent,omitempty"`
+
+	// IsRequired 必須フラグ
+	IsRequired bool `json:"isRequired"`
+
+	// Label ラベル
+	Label string `json:"label"`
+
+	// MaxLength 最大文字数（省略時は制限なし）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（省略時は制限なし）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options 選択肢（single_select / multi_select で必須。その他の型では指定不可）
+	Options *[]string `json:"options,omitempty"`
+
+	// Pattern 内容全体が一致すべき正規表現（RE2 構文）
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder 空のセクションに表示するプレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type フィールドの型（省略時は text）
+	Type *ModelsFieldType `json:"type,omitempty"`
+}
+
+// ModelsTemplateExportFormat テンプレートのエクスポート形式
+type ModelsTemplateExportFormat string
+
+// ModelsTemplateListResponse テンプレート一覧レスポンス（カーソルページネーション）
+type ModelsTemplateListResponse struct {
+	// HasMore 次ページが存在するか
+	HasMore bool `json:"hasMore"`
+
+	// Items テンプレート一覧
+	Items []ModelsTemplateResponse `json:"items"`
+
+	// NextCursor 次ページ取得用カーソル（最終ページでは省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ModelsTemplateResponse テンプレートレスポンス
+type ModelsTemplateResponse struct {
+	// Fields フィールド一覧
+	Fields []ModelsField `json:"fields"`
+
+	// Id テンプレートID
+	Id string `json:"id"`
+
+	// IsPublic ギャラリー公開フラグ（公開中はどのアカウントも閲覧・複製できる）
+	IsPublic bool `json:"isPublic"`
+
+	// IsUsed 使用中フラグ
+	IsUsed bool `json:"isUsed"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+
+	// Owner 所有者情報
+	Owner ModelsAccountSummary `json:"owner"`
+
+	// OwnerId 所有者ID
+	OwnerId string `json:"ownerId"`
+
+	// SchemaVersion スキーマバージョン（フィールド構成を変更するたびに増加）
+	SchemaVersion int32 `json:"schemaVersion"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Version バージョン（更新のたびに増加。ETag と同じ値）
+	Version int32 `json:"version"`
+
+	// WorkspaceId ワークスペースID
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// ModelsTemplateVisibilityRequest ギャラリー公開設定リクエスト
+type ModelsTemplateVisibilityRequest struct {
+	// IsPublic ギャラリーに公開するか
+	IsPublic bool `json:"isPublic"`
+}
+
+// ModelsTrashResponse ゴミ箱一覧レスポンス（削除日時の新しい順）
+type ModelsTrashResponse struct {
+	// Notes ゴミ箱内のノート
+	Notes []ModelsTrashedNoteResponse `json:"notes"`
+
+	// Templates ゴミ箱内のテンプレート
+	Templates []ModelsTrashedTemplateResponse `json:"templates"`
+}
+
+// ModelsTrashedNoteResponse ゴミ箱内のノート
+type ModelsTrashedNoteResponse struct {
+	// DeletedAt 削除日時
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// Id ノートID
+	Id string `json:"id"`
+
+	// Status ステータス
+	Status ModelsNoteStatus `json:"status"`
+
+	// TemplateId テンプレートID
+	TemplateId string `json:"templateId"`
+
+	// TemplateName テンプレート名
+	TemplateName string `json:"templateName"`
+
+	// Title タイトル
+	Title string `json:"title"`
+
+	// Version バージョン（復元時の If-Match に使う）
+	Version int32 `json:"version"`
+}
+
+// ModelsTrashedTemplateResponse ゴミ箱内のテンプレート
+type ModelsTrashedTemplateResponse struct {
+	// DeletedAt 削除日時
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// Id テンプレートID
+	Id string `json:"id"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+
+	// Version バージョン（復元時の If-Match に使う）
+	Version int32 `json:"version"`
+}
+
+// ModelsUnauthorizedError Unauthorized エラー
+type ModelsUnauthorizedError struct {
+	Code    ModelsUnauthorizedErrorCode `json:"code"`
+	Message string                      `json:"message"`
+}
+
+// ModelsUnauthorizedErrorCode defines model for ModelsUnauthorizedError.Code.
+type ModelsUnauthorizedErrorCode string
+
+// ModelsUnmappedSection 複製先のテンプレートに対応するフィールドがなかったセクション
+type ModelsUnmappedSection struct {
+	// Content 内容
+	Content string `json:"content"`
+
+	// FieldLabel 元のノートでのフィールドラベル
+	FieldLabel string `json:"fieldLabel"`
+}
+
+// ModelsUpdateFieldRequest フィールド更新リクエスト
+type ModelsUpdateFieldRequest struct {
+	// DefaultContent ノート作成時にセクションが省略された場合の既定値
+	DefaultContent *string `json:"defaultContent,omitempty"`
+
+	// Id フィールドID（既存フィールドの場合は必須。省略すると新しいフィールドとして追加）
+	Id *string `json:"id,omitempty"`
+
+	// IsRequired 必須フラグ
+	IsRequired bool `json:"isRequired"`
+
+	// Label ラベル
+	Label string `json:"label"`
+
+	// MaxLength 最大文字数（省略時は制限なし）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（省略時は制限なし）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options 選択肢（single_select / multi_select で必須。その他の型では指定不可）
+	Options *[]string `json:"options,omitempty"`
+
+	// Order 表示順序
+	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現（RE2 構文）
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder 空のセクションに表示するプレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type フィールドの型（省略時は text）
+	Type *ModelsFieldType `json:"type,omitempty"`
+}
+
+// ModelsUpdateNoteRequest ノート更新リクエスト
+type ModelsUpdateNoteRequest struct {
+	// Id ノートID
+	Id string `json:"id"`
+
+	// Sections セクション
+	Sections []ModelsUpdateSectionRequest `json:"sections"`
+
+	// Title タイトル
+	Title string `json:"title"`
+}
+
+// ModelsUpdateSectionRequest セクション更新リクエスト
+type ModelsUpdateSectionRequest struct {
+	// Content 内容
+	Content string `json:"content"`
+
+	// Id セクションID
+	Id string `json:"id"`
+}
+
+// ModelsUpdateTemplateRequest テンプレート更新リクエスト
+type ModelsUpdateTemplateRequest struct {
+	// Fields フィールド一覧
+	Fields []ModelsUpdateFieldRequest `json:"fields"`
+
+	// Id テンプレートID
+	Id string `json:"id"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+}
+
+// ModelsUpdateWebhookRequest Webhook 更新リクエスト
+type ModelsUpdateWebhookRequest struct {
+	// EventTypes 購読するイベント（1 つ以上）
+	EventTypes []ModelsWebhookEventType `json:"eventTypes"`
+
+	// Secret 新しい署名用シークレット（省略時は現在のものを維持）
+	Secret *string `json:"secret,omitempty"`
+
+	// Url 配信先 URL（http または https。ループバック・プライベート・リンクローカル・メタデータのホストは不可）
+	Url string `json:"url"`
+}
+
+// ModelsUpgradeNoteRequest テンプレートの最新スキーマバージョンへの移行リクエスト
+type ModelsUpgradeNoteRequest struct {
+	// Sections 既存内容を上書き、または追加されたフィールドを埋めるセクション（オプション）
+	Sections *[]ModelsUpgradeSectionRequest `json:"sections,omitempty"`
+}
+
+// ModelsUpgradeSectionRequest スキーマ移行時に内容を指定するセクション
+type ModelsUpgradeSectionRequest struct {
+	// Content 内容
+	Content string `json:"content"`
+
+	// FieldId 最新スキーマバージョンのフィールドID
+	FieldId string `json:"fieldId"`
+}
+
+// ModelsWebhookDeliveryListResponse 配信記録一覧レスポンス（新しい順、最大 50 件）
+type ModelsWebhookDeliveryListResponse struct {
+	// Items 配信記録
+	Items []ModelsWebhookDeliveryResponse `json:"items"`
+}
+
+// ModelsWebhookDeliveryResponse Webhook の配信記録
+type ModelsWebhookDeliveryResponse struct {
+	// Attempts 試行回数
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt 配信成功日時
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId イベントID（再送でも変わらないため重複排除に使える）
+	EventId string `json:"eventId"`
+
+	// EventType イベント種別
+	EventType ModelsWebhookEventType `json:"eventType"`
+
+	// FailedAt 失敗確定日時
+	FailedAt *time.Time `json:"failedAt,omitempty"`
+
+	// Id 配信ID（X-Webhook-Delivery ヘッダーの値）
+	Id string `json:"id"`
+
+	// LastError 最後の失敗理由
+	LastError *string `json:"lastError,omitempty"`
+
+	// NextAttemptAt 次回の試行予定日時
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	// ReplayOf 再送元の配信ID（再送の場合のみ）
+	ReplayOf *string `json:"replayOf,omitempty"`
+
+	// ResponseStatus 最後の試行の HTTP ステータス（応答がなかった場合は省略）
+	ResponseStatus *int32 `json:"responseStatus,omitempty"`
+
+	// Status 配信ステータス
+	Status ModelsWebhookDeliveryStatus `json:"status"`
+
+	// WebhookId Webhook ID
+	WebhookId string `json:"webhookId"`
+}
+
+// ModelsWebhookDeliveryStatus 配信ステータス
+type ModelsWebhookDeliveryStatus string
+
+// ModelsWebhookEventType Webhook で購読できるイベント
+type ModelsWebhookEventType string
+
+// ModelsWebhookListResponse Webhook 一覧レスポンス（作成日時の古い順）
+type ModelsWebhookListResponse struct {
+	// Items Webhook 一覧
+	Items []ModelsWebhookResponse `json:"items"`
+}
+
+// ModelsWebhookResponse Webhook 購読（シークレットは返さない）
+type ModelsWebhookResponse struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes 購読するイベント
+	EventTypes []ModelsWebhookEventType `json:"eventTypes"`
+
+	// Id Webhook ID
+	Id string `json:"id"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url 配信先 URL
+	Url string `json:"url"`
+}
+
+// ModelsWorkspaceInvitationListResponse 招待一覧レスポンス（招待日時の新しい順）
+type ModelsWorkspaceInvitationListResponse struct {
+	// Items 招待一覧
+	Items []ModelsWorkspaceInvitationResponse `json:"items"`
+}
+
+// ModelsWorkspaceInvitationResponse ワークスペースへの招待
+type ModelsWorkspaceInvitationResponse struct {
+	// CreatedAt 招待日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email 招待先のメールアドレス
+	Email string `json:"email"`
+
+	// Id 招待ID
+	Id string `json:"id"`
+
+	// InvitedBy 招待したアカウントID
+	InvitedBy string `json:"invitedBy"`
+
+	// Role 参加後のロール
+	Role ModelsWorkspaceRole `json:"role"`
+
+	// WorkspaceId ワークスペースID
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// ModelsWorkspaceListResponse ワークスペース一覧レスポンス（個人ワークスペースが先頭）
+type ModelsWorkspaceListResponse struct {
+	// Items ワークスペース一覧
+	Items []ModelsWorkspaceResponse `json:"items"`
+}
+
+// ModelsWorkspaceMemberListResponse メンバー一覧レスポンス（参加日時の古い順）
+type ModelsWorkspaceMemberListResponse struct {
+	// Items メンバー一覧
+	Items []ModelsWorkspaceMemberResponse `json:"items"`
+}
+
+// ModelsWorkspaceMemberResponse ワークスペースのメンバー
+type ModelsWorkspaceMemberResponse struct {
+	// AccountId アカウントID
+	AccountId string `json:"accountId"`
+
+	// JoinedAt 参加日時
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// Role ロール
+	Role ModelsWorkspaceRole `json:"role"`
+}
+
+// ModelsWorkspaceResponse ワークスペース（リクエストしたアカウントのロール付き）
+type ModelsWorkspaceResponse struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ワークスペースID
+	Id string `json:"id"`
+
+	// Name ワークスペース名
+	Name string `json:"name"`
+
+	// OwnerId 所有者ID
+	OwnerId string `json:"ownerId"`
+
+	// Personal 個人ワークスペースかどうか（招待・削除はできない）
+	Personal bool `json:"personal"`
+
+	// Role リクエストしたアカウントのロール
+	Role ModelsWorkspaceRole `json:"role"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsWorkspaceRole ワークスペースのロール（member < admin < owner）
+type ModelsWorkspaceRole string
+
+// AccountsGetAccountByEmailParams defines parameters for AccountsGetAccountByEmail.
+type AccountsGetAccountByEmailParams struct {
+	Email string `form:"email" json:"email"`
+}
+
+// NotesListNotesParams defines parameters for NotesListNotes.
+type NotesListNotesParams struct {
+	// Q タイトル・セクション本文の全文検索（指定時は関連度順）
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Status ステータスフィルター
+	Status *ModelsNoteStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// TemplateId テンプレートIDフィルター
+	TemplateId *string `form:"templateId,omitempty" json:"templateId,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// WorkspaceId ワークスペースIDフィルター（省略時は所属する全ワークスペース）
+	WorkspaceId *string `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// SharedWithMe true の場合、他のアカウントから自分に共有されたノートのみ
+	SharedWithMe *bool `form:"sharedWithMe,omitempty" json:"sharedWithMe,omitempty"`
+
+	// Cursor 前ページの nextCursor（省略時は先頭ページ）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（既定 20、最大 100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// NotesDeleteNoteParams defines parameters for NotesDeleteNote.
+type NotesDeleteNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesUpdateNoteParams defines parameters for NotesUpdateNote.
+type NotesUpdateNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesApproveNoteParams defines parameters for NotesApproveNote.
+type NotesApproveNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesExportNoteParams defines parameters for NotesExportNote.
+type NotesExportNoteParams struct {
+	// Format 出力形式
+	Format *ModelsNoteExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// NotesPublishNoteParams defines parameters for NotesPublishNote.
+type NotesPublishNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesRejectNoteParams defines parameters for NotesRejectNote.
+type NotesRejectNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesRestoreNoteParams defines parameters for NotesRestoreNote.
+type NotesRestoreNoteParams struct {
+	// IfMatch ゴミ箱一覧の version。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesDiffNoteRevisionsParams defines parameters for NotesDiffNoteRevisions.
+type NotesDiffNoteRevisionsParams struct {
+	// From 比較元リビジョン番号
+	From int32 `form:"from" json:"from"`
+
+	// To 比較先リビジョン番号
+	To int32 `form:"to" json:"to"`
+}
+
+// NotesScheduleNoteParams defines parameters for NotesScheduleNote.
+type NotesScheduleNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesSubmitNoteForReviewParams defines parameters for NotesSubmitNoteForReview.
+type NotesSubmitNoteForReviewParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesUnpublishNoteParams defines parameters for NotesUnpublishNote.
+type NotesUnpublishNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesUpgradeNoteParams defines parameters for NotesUpgradeNote.
+type NotesUpgradeNoteParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TemplatesListTemplatesParams defines parameters for TemplatesListTemplates.
+type TemplatesListTemplatesParams struct {
+	// Q テンプレート名のキーワード検索
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// WorkspaceId ワークスペースIDフィルター（省略時は所属する全ワークスペース）
+	WorkspaceId *string `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// Cursor 前ページの nextCursor（省略時は先頭ページ）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（既定 20、最大 100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TemplatesListTemplateGalleryParams defines parameters for TemplatesListTemplateGallery.
+type TemplatesListTemplateGalleryParams struct {
+	// Q テンプレート名のキーワード検索
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Cursor 前ページの nextCursor（省略時は先頭ページ）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（既定 20、最大 100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TemplatesImportTemplateParams defines parameters for TemplatesImportTemplate.
+type TemplatesImportTemplateParams struct {
+	// WorkspaceId 作成先のワークスペースID（省略時は個人ワークスペース）
+	WorkspaceId *string `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// TemplatesDeleteTemplateParams defines parameters for TemplatesDeleteTemplate.
+type TemplatesDeleteTemplateParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TemplatesUpdateTemplateParams defines parameters for TemplatesUpdateTemplate.
+type TemplatesUpdateTemplateParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TemplatesCloneTemplateParams defines parameters for TemplatesCloneTemplate.
+type TemplatesCloneTemplateParams struct {
+	// WorkspaceId 作成先のワークスペースID（省略時は個人ワークスペース）
+	WorkspaceId *string `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// TemplatesExportTemplateParams defines parameters for TemplatesExportTemplate.
+type TemplatesExportTemplateParams struct {
+	// Format 出力形式
+	Format *ModelsTemplateExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// TemplatesRestoreTemplateParams defines parameters for TemplatesRestoreTemplate.
+type TemplatesRestoreTemplateParams struct {
+	// IfMatch ゴミ箱一覧の version。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TemplatesSetTemplateVisibilityParams defines parameters for TemplatesSetTemplateVisibility.
+type TemplatesSetTemplateVisibilityParams struct {
+	// IfMatch 取得時の ETag。一致しない場合は 412 を返す
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AccountsCreateOrGetAccountJSONRequestBody defines body for AccountsCreateOrGetAccount for application/json ContentType.
+type AccountsCreateOrGetAccountJSONRequestBody = ModelsCreateOrGetAccountRequest
+
+// AccountsLogoutJSONRequestBody defines body for AccountsLogout for application/json ContentType.
+type AccountsLogoutJSONRequestBody = ModelsLogoutRequest
+
+// AccountsRefreshSessionJSONRequestBody defines body for AccountsRefreshSession for application/json ContentType.
+type AccountsRefreshSessionJSONRequestBody = ModelsRefreshSessionRequest
+
+// NotesCreateNoteJSONRequestBody defines body for NotesCreateNote for application/json ContentType.
+type NotesCreateNoteJSONRequestBody = ModelsCreateNoteRequest
+
+// NotesUpdateNoteJSONRequestBody defines body for NotesUpdateNote for application/json ContentType.
+type NotesUpdateNoteJSONRequestBody = ModelsUpdateNoteRequest
+
+// NotesApproveNoteJSONRequestBody defines body for NotesApproveNote for application/json ContentType.
+type NotesApproveNoteJSONRequestBody = ModelsReviewDecisionRequest
+
+// NotesDuplicateNoteJSONRequestBody defines body for NotesDuplicateNote for application/json ContentType.
+type NotesDuplicateNoteJSONRequestBody = ModelsDuplicateNoteRequest
+
+// NotesCreateNoteLinkJSONRequestBody defines body for NotesCreateNoteLink for application/json ContentType.
+type NotesCreateNoteLinkJSONRequestBody = ModelsCreateShareLinkRequest
+
+// NotesRejectNoteJSONRequestBody defines body for NotesRejectNote for application/json ContentType.
+type NotesRejectNoteJSONRequestBody = ModelsReviewDecisionRequest
+
+// NotesAssignNoteReviewersJSONRequestBody defines body for NotesAssignNoteReviewers for application/json ContentType.
+type NotesAssignNoteReviewersJSONRequestBody = ModelsAssignNoteReviewersRequest
+
+// NotesScheduleNoteJSONRequestBody defines body for NotesScheduleNote for application/json ContentType.
+type NotesScheduleNoteJSONRequestBody = ModelsScheduleNoteRequest
+
+// NotesShareNoteJSONRequestBody defines body for NotesShareNote for application/json ContentType.
+type NotesShareNoteJSONRequestBody = ModelsShareRequest
+
+// NotesUpgradeNoteJSONRequestBody defines body for NotesUpgradeNote for application/json ContentType.
+type NotesUpgradeNoteJSONRequestBody = ModelsUpgradeNoteRequest
+
+// TemplatesCreateTemplateJSONRequestBody defines body for TemplatesCreateTemplate for application/json ContentType.
+type TemplatesCreateTemplateJSONRequestBody = ModelsCreateTemplateRequest
+
+// TemplatesImportTemplateJSONRequestBody defines body for TemplatesImportTemplate for application/json ContentType.
+type TemplatesImportTemplateJSONRequestBody = ModelsTemplateDocument
+
+// TemplatesUpdateTemplateJSONRequestBody defines body for TemplatesUpdateTemplate for application/json ContentType.
 type TemplatesUpdateTemplateJSONRequestBody = ModelsUpdateTemplateRequest
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create or get account via OAuth
-	// (POST /api/accounts/auth)
-	AccountsCreateOrGetAccount(ctx echo.Context) error
-	// Get account by email
-	// (GET /api/accounts/by-email)
-	AccountsGetAccountByEmail(ctx echo.Context, params AccountsGetAccountByEmailParams) error
-	// Get current account
-	// (GET /api/accounts/me)
-	AccountsGetCurrentAccount(ctx echo.Context) error
-	// Get account by ID
-	// (GET /api/accounts/{accountId})
-	AccountsGetAccountById(ctx echo.Context, accountId string) error
-	// Get notes list
-	// (GET /api/notes)
-	NotesListNotes(ctx echo.Context, params NotesListNotesParams) error
-	// Create note
-	// (POST /api/notes)
-	NotesCreateNote(ctx echo.Context) error
-	// Delete note
-	// (DELETE /api/notes/{noteId})
-	NotesDeleteNote(ctx echo.Context, noteId string, params NotesDeleteNoteParams) error
-	// Get note by ID
-	// (GET /api/notes/{noteId})
-	NotesGetNoteById(ctx echo.Context, noteId string) error
-	// Update note
-	// (PUT /api/notes/{noteId})
-	NotesUpdateNote(ctx echo.Context, noteId string, params NotesUpdateNoteParams) error
-	// Publish note
-	// (POST /api/notes/{noteId}/publish)
-	NotesPublishNote(ctx echo.Context, noteId string, params NotesPublishNoteParams) error
-	// Unpublish note
-	// (POST /api/notes/{noteId}/unpublish)
-	NotesUnpublishNote(ctx echo.Context, noteId string, params NotesUnpublishNoteParams) error
-	// Get templates list
-	// (GET /api/templates)
-	TemplatesListTemplates(ctx echo.Context, params TemplatesListTemplatesParams) error
-	// Create template
-	// (POST /api/templates)
-	TemplatesCreateTemplate(ctx echo.Context) error
-	// Delete template
-	// (DELETE /api/templates/{templateId})
-	TemplatesDeleteTemplate(ctx echo.Context, templateId string, params TemplatesDeleteTemplateParams) error
-	// Get template by ID
-	// (GET /api/templates/{templateId})
-	TemplatesGetTemplateById(ctx echo.Context, templateId string) error
-	// Update template
-	// (PUT /api/templates/{templateId})
-	TemplatesUpdateTemplate(ctx echo.Context, templateId string, params TemplatesUpdateTemplateParams) error
+// TemplatesShareTemplateJSONRequestBody defines body for TemplatesShareTemplate for application/json ContentType.
+type TemplatesShareTemplateJSONRequestBody = ModelsShareRequest
+
+// TemplatesSetTemplateVisibilityJSONRequestBody defines body for TemplatesSetTemplateVisibility for application/json ContentType.
+type TemplatesSetTemplateVisibilityJSONRequestBody = ModelsTemplateVisibilityRequest
+
+// WebhooksCreateWebhookJSONRequestBody defines body for WebhooksCreateWebhook for application/json ContentType.
+type WebhooksCreateWebhookJSONRequestBody = ModelsCreateWebhookRequest
+
+// WebhooksUpdateWebhookJSONRequestBody defines body for WebhooksUpdateWebhook for application/json ContentType.
+type WebhooksUpdateWebhookJSONRequestBody = ModelsUpdateWebhookRequest
+
+// WorkspacesCreateWorkspaceJSONRequestBody defines body for WorkspacesCreateWorkspace for application/json ContentType.
+type WorkspacesCreateWorkspaceJSONRequestBody = ModelsCreateWorkspaceRequest
+
+// WorkspacesInviteWorkspaceMemberJSONRequestBody defines body for WorkspacesInviteWorkspaceMember for application/json ContentType.
+type WorkspacesInviteWorkspaceMemberJSONRequestBody = ModelsInviteWorkspaceMemberRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Create or get account via OAuth
+	// (POST /api/accounts/auth)
+	AccountsCreateOrGetAccount(ctx echo.Context) error
+	// Get account by email
+	// (GET /api/accounts/by-email)
+	AccountsGetAccountByEmail(ctx echo.Context, params AccountsGetAccountByEmailParams) error
+	// Logout
+	// (POST /api/accounts/logout)
+	AccountsLogout(ctx echo.Context) error
+	// Get current account
+	// (GET /api/accounts/me)
+	AccountsGetCurrentAccount(ctx echo.Context) error
+	// Export account data
+	// (GET /api/accounts/me/export)
+	AccountsExportAccountData(ctx echo.Context) error
+	// Import account data
+	// (POST /api/accounts/me/import)
+	AccountsImportAccountData(ctx echo.Context) error
+	// Refresh session
+	// (POST /api/accounts/refresh)
+	AccountsRefreshSession(ctx echo.Context) error
+	// Get account by ID
+	// (GET /api/accounts/{accountId})
+	AccountsGetAccountById(ctx echo.Context, accountId string) error
+	// Get notes list
+	// (GET /api/notes)
+	NotesListNotes(ctx echo.Context, params NotesListNotesParams) error
+	// Create note
+	// (POST /api/notes)
+	NotesCreateNote(ctx echo.Context) error
+	// Delete note
+	// (DELETE /api/notes/{noteId})
+	NotesDeleteNote(ctx echo.Context, noteId string, params NotesDeleteNoteParams) error
+	// Get note by ID
+	// (GET /api/notes/{noteId})
+	NotesGetNoteById(ctx echo.Context, noteId string) error
+	// Update note
+	// (PUT /api/notes/{noteId})
+	NotesUpdateNote(ctx echo.Context, noteId string, params NotesUpdateNoteParams) error
+	// Approve note in review
+	// (POST /api/notes/{noteId}/approve)
+	NotesApproveNote(ctx echo.Context, noteId string, params NotesApproveNoteParams) error
+	// Duplicate note
+	// (POST /api/notes/{noteId}/duplicate)
+	NotesDuplicateNote(ctx echo.Context, noteId string) error
+	// Export note
+	// (GET /api/notes/{noteId}/export)
+	NotesExportNote(ctx echo.Context, noteId string, params NotesExportNoteParams) error
+	// List note public links
+	// (GET /api/notes/{noteId}/links)
+	NotesListNoteLinks(ctx echo.Context, noteId string) error
+	// Create note public link
+	// (POST /api/notes/{noteId}/links)
+	NotesCreateNoteLink(ctx echo.Context, noteId string) error
+	// Revoke note public link
+	// (DELETE /api/notes/{noteId}/links/{linkId})
+	NotesRevokeNoteLink(ctx echo.Context, noteId string, linkId string) error
+	// Publish note
+	// (POST /api/notes/{noteId}/publish)
+	NotesPublishNote(ctx echo.Context, noteId string, params NotesPublishNoteParams) error
+	// Reject note in review
+	// (POST /api/notes/{noteId}/reject)
+	NotesRejectNote(ctx echo.Context, noteId string, params NotesRejectNoteParams) error
+	// Assign note reviewers
+	// (PUT /api/notes/{noteId}/reviewers)
+	NotesAssignNoteReviewers(ctx echo.Context, noteId string) error
+	// Get note review state
+	// (GET /api/notes/{noteId}/reviews)
+	NotesGetNoteReviews(ctx echo.Context, noteId string) error
+	// Restore note from trash
+	// (POST /api/notes/{noteId}/restore)
+	NotesRestoreNote(ctx echo.Context, noteId string, params NotesRestoreNoteParams) error
+	// List note revisions
+	// (GET /api/notes/{noteId}/revisions)
+	NotesListNoteRevisions(ctx echo.Context, noteId string) error
+	// Diff note revisions
+	// (GET /api/notes/{noteId}/revisions/diff)
+	NotesDiffNoteRevisions(ctx echo.Context, noteId string, params NotesDiffNoteRevisionsParams) error
+	// Restore note revision
+	// (POST /api/notes/{noteId}/revisions/{revision}/restore)
+	NotesRestoreNoteRevision(ctx echo.Context, noteId string, revision int32) error
+	// Schedule note publishing
+	// (POST /api/notes/{noteId}/schedule)
+	NotesScheduleNote(ctx echo.Context, noteId string, params NotesScheduleNoteParams) error
+	// List note shares
+	// (GET /api/notes/{noteId}/shares)
+	NotesListNoteShares(ctx echo.Context, noteId string) error
+	// Unshare note
+	// (DELETE /api/notes/{noteId}/shares/{accountId})
+	NotesUnshareNote(ctx echo.Context, noteId string, accountId string) error
+	// Share note
+	// (PUT /api/notes/{noteId}/shares/{accountId})
+	NotesShareNote(ctx echo.Context, noteId string, accountId string) error
+	// Submit note for review
+	// (POST /api/notes/{noteId}/submit)
+	NotesSubmitNoteForReview(ctx echo.Context, noteId string, params NotesSubmitNoteForReviewParams) error
+	// Unpublish note
+	// (POST /api/notes/{noteId}/unpublish)
+	NotesUnpublishNote(ctx echo.Context, noteId string, params NotesUnpublishNoteParams) error
+	// Upgrade note to latest template schema
+	// (POST /api/notes/{noteId}/upgrade)
+	NotesUpgradeNote(ctx echo.Context, noteId string, params NotesUpgradeNoteParams) error
+	// Get templates list
+	// (GET /api/templates)
+	TemplatesListTemplates(ctx echo.Context, params TemplatesListTemplatesParams) error
+	// Create template
+	// (POST /api/templates)
+	TemplatesCreateTemplate(ctx echo.Context) error
+	// List template gallery
+	// (GET /api/templates/gallery)
+	TemplatesListTemplateGallery(ctx echo.Context, params TemplatesListTemplateGalleryParams) error
+	// Import template
+	// (POST /api/templates/import)
+	TemplatesImportTemplate(ctx echo.Context, params TemplatesImportTemplateParams) error
+	// Delete template
+	// (DELETE /api/templates/{templateId})
+	TemplatesDeleteTemplate(ctx echo.Context, templateId string, params TemplatesDeleteTemplateParams) error
+	// Get template by ID
+	// (GET /api/templates/{templateId})
+	TemplatesGetTemplateById(ctx echo.Context, templateId string) error
+	// Update template
+	// (PUT /api/templates/{templateId})
+	TemplatesUpdateTemplate(ctx echo.Context, templateId string, params TemplatesUpdateTemplateParams) error
+	// Clone template
+	// (POST /api/templates/{templateId}/clone)
+	TemplatesCloneTemplate(ctx echo.Context, templateId string, params TemplatesCloneTemplateParams) error
+	// Export template
+	// (GET /api/templates/{templateId}/export)
+	TemplatesExportTemplate(ctx echo.Context, templateId string, params TemplatesExportTemplateParams) error
+	// Restore template from trash
+	// (POST /api/templates/{templateId}/restore)
+	TemplatesRestoreTemplate(ctx echo.Context, templateId string, params TemplatesRestoreTemplateParams) error
+	// List template shares
+	// (GET /api/templates/{templateId}/shares)
+	TemplatesListTemplateShares(ctx echo.Context, templateId string) error
+	// Unshare template
+	// (DELETE /api/templates/{templateId}/shares/{accountId})
+	TemplatesUnshareTemplate(ctx echo.Context, templateId string, accountId string) error
+	// Share template
+	// (PUT /api/templates/{templateId}/shares/{accountId})
+	TemplatesShareTemplate(ctx echo.Context, templateId string, accountId string) error
+	// Set template visibility
+	// (PUT /api/templates/{templateId}/visibility)
+	TemplatesSetTemplateVisibility(ctx echo.Context, templateId string, params TemplatesSetTemplateVisibilityParams) error
+	// List trashed notes and templates
+	// (GET /api/trash)
+	TrashListTrash(ctx echo.Context) error
+	// List webhooks
+	// (GET /api/webhooks)
+	WebhooksListWebhooks(ctx echo.Context) error
+	// Create webhook
+	// (POST /api/webhooks)
+	WebhooksCreateWebhook(ctx echo.Context) error
+	// Delete webhook
+	// (DELETE /api/webhooks/{webhookId})
+	WebhooksDeleteWebhook(ctx echo.Context, webhookId string) error
+	// Get webhook by ID
+	// (GET /api/webhooks/{webhookId})
+	WebhooksGetWebhook(ctx echo.Context, webhookId string) error
+	// Update webhook
+	// (PUT /api/webhooks/{webhookId})
+	WebhooksUpdateWebhook(ctx echo.Context, webhookId string) error
+	// List webhook deliveries
+	// (GET /api/webhooks/{webhookId}/deliveries)
+	WebhooksListWebhookDeliveries(ctx echo.Context, webhookId string) error
+	// Replay webhook delivery
+	// (POST /api/webhooks/{webhookId}/deliveries/{deliveryId}/replay)
+	WebhooksReplayWebhookDelivery(ctx echo.Context, webhookId string, deliveryId string) error
+	// List workspaces
+	// (GET /api/workspaces)
+	WorkspacesListWorkspaces(ctx echo.Context) error
+	// Create workspace
+	// (POST /api/workspaces)
+	WorkspacesCreateWorkspace(ctx echo.Context) error
+	// List pending invitations
+	// (GET /api/workspaces/invitations)
+	WorkspacesListWorkspaceInvitations(ctx echo.Context) error
+	// Accept invitation
+	// (POST /api/workspaces/invitations/{invitationId}/accept)
+	WorkspacesAcceptWorkspaceInvitation(ctx echo.Context, invitationId string) error
+	// Invite member
+	// (POST /api/workspaces/{workspaceId}/invitations)
+	WorkspacesInviteWorkspaceMember(ctx echo.Context, workspaceId string) error
+	// List workspace members
+	// (GET /api/workspaces/{workspaceId}/members)
+	WorkspacesListWorkspaceMembers(ctx echo.Context, workspaceId string) error
+	// Remove member
+	// (DELETE /api/workspaces/{workspaceId}/members/{accountId})
+	WorkspacesRemoveWorkspaceMember(ctx echo.Context, workspaceId string, accountId string) error
+	// View public note
+	// (GET /public/notes/{token})
+	PublicViewPublicNote(ctx echo.Context, token string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// AccountsCreateOrGetAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsCreateOrGetAccount(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsCreateOrGetAccount(ctx)
+	return err
+}
+
+// AccountsGetAccountByEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetAccountByEmail(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsGetAccountByEmailParams
+	// ------------- Required query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "email", ctx.QueryParams(), &params.Email)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetAccountByEmail(ctx, params)
+	return err
+}
+
+// AccountsLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsLogout(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsLogout(ctx)
+	return err
+}
+
+// AccountsGetCurrentAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetCurrentAccount(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetCurrentAccount(ctx)
+	return err
+}
+
+// AccountsExportAccountData converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsExportAccountData(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsExportAccountData(ctx)
+	return err
+}
+
+// AccountsImportAccountData converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsImportAccountData(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsImportAccountData(ctx)
+	return err
+}
+
+// AccountsRefreshSession converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsRefreshSession(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsRefreshSession(ctx)
+	return err
+}
+
+// AccountsGetAccountById converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetAccountById(ctx, accountId)
+	return err
+}
+
+// NotesListNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNotes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesListNotesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "templateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "workspaceId", ctx.QueryParams(), &params.WorkspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "sharedWithMe" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sharedWithMe", ctx.QueryParams(), &params.SharedWithMe)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sharedWithMe: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNotes(ctx, params)
+	return err
+}
+
+// NotesCreateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesCreateNote(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesCreateNote(ctx)
+	return err
+}
+
+// NotesDeleteNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesDeleteNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDeleteNote(ctx, noteId, params)
+	return err
+}
+
+// NotesGetNoteById converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesGetNoteById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesGetNoteById(ctx, noteId)
+	return err
+}
+
+// NotesUpdateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUpdateNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUpdateNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUpdateNote(ctx, noteId, params)
+	return err
+}
+
+// NotesApproveNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesApproveNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesApproveNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesApproveNote(ctx, noteId, params)
+	return err
+}
+
+// NotesDuplicateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDuplicateNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDuplicateNote(ctx, noteId)
+	return err
+}
+
+// NotesExportNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesExportNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesExportNoteParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesExportNote(ctx, noteId, params)
+	return err
+}
+
+// NotesListNoteLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNoteLinks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNoteLinks(ctx, noteId)
+	return err
+}
+
+// NotesCreateNoteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesCreateNoteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesCreateNoteLink(ctx, noteId)
+	return err
+}
+
+// NotesRevokeNoteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesRevokeNoteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "linkId", ctx.Param("linkId"), &linkId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter linkId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesRevokeNoteLink(ctx, noteId, linkId)
+	return err
+}
+
+// NotesPublishNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesPublishNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesPublishNote(ctx, noteId, params)
+	return err
+}
+
+// NotesRejectNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesRejectNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesRejectNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesRejectNote(ctx, noteId, params)
+	return err
+}
+
+// NotesAssignNoteReviewers converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesAssignNoteReviewers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesAssignNoteReviewers(ctx, noteId)
+	return err
+}
+
+// NotesGetNoteReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesGetNoteReviews(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesGetNoteReviews(ctx, noteId)
+	return err
+}
+
+// NotesRestoreNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesRestoreNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesRestoreNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesRestoreNote(ctx, noteId, params)
+	return err
+}
+
+// NotesListNoteRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNoteRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNoteRevisions(ctx, noteId)
+	return err
+}
+
+// NotesDiffNoteRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDiffNoteRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesDiffNoteRevisionsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDiffNoteRevisions(ctx, noteId, params)
+	return err
+}
+
+// NotesRestoreNoteRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesRestoreNoteRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesRestoreNoteRevision(ctx, noteId, revision)
+	return err
+}
+
+// NotesScheduleNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesScheduleNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesScheduleNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesScheduleNote(ctx, noteId, params)
+	return err
+}
+
+// NotesListNoteShares converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNoteShares(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNoteShares(ctx, noteId)
+	return err
+}
+
+// NotesUnshareNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUnshareNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUnshareNote(ctx, noteId, accountId)
+	return err
+}
+
+// NotesShareNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesShareNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesShareNote(ctx, noteId, accountId)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// NotesSubmitNoteForReview converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesSubmitNoteForReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesSubmitNoteForReviewParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesSubmitNoteForReview(ctx, noteId, params)
+	return err
+}
+
+// NotesUnpublishNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUnpublishNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUnpublishNote(ctx, noteId, params)
+	return err
+}
+
+// NotesUpgradeNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUpgradeNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUpgradeNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUpgradeNote(ctx, noteId, params)
+	return err
+}
+
+// TemplatesListTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesListTemplatesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "workspaceId", ctx.QueryParams(), &params.WorkspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesListTemplates(ctx, params)
+	return err
+}
+
+// TemplatesCreateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesCreateTemplate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesCreateTemplate(ctx)
+	return err
+}
+
+// TemplatesListTemplateGallery converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTemplateGallery(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesListTemplateGalleryParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesListTemplateGallery(ctx, params)
+	return err
+}
+
+// TemplatesImportTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesImportTemplate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesImportTemplateParams
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "workspaceId", ctx.QueryParams(), &params.WorkspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesImportTemplate(ctx, params)
+	return err
+}
+
+// TemplatesDeleteTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesDeleteTemplateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesDeleteTemplate(ctx, templateId, params)
+	return err
+}
+
+// TemplatesGetTemplateById converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesGetTemplateById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesGetTemplateById(ctx, templateId)
+	return err
+}
+
+// TemplatesUpdateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesUpdateTemplateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesUpdateTemplate(ctx, templateId, params)
+	return err
 }
 
-// AccountsCreateOrGetAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsCreateOrGetAccount(ctx echo.Context) error {
+// TemplatesCloneTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesCloneTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesCloneTemplateParams
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "workspaceId", ctx.QueryParams(), &params.WorkspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsCreateOrGetAccount(ctx)
+	err = w.Handler.TemplatesCloneTemplate(ctx, templateId, params)
 	return err
 }
 
-// AccountsGetAccountByEmail converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetAccountByEmail(ctx echo.Context) error {
+// TemplatesExportTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesExportTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AccountsGetAccountByEmailParams
-	// ------------- Required query parameter "email" -------------
+	var params TemplatesExportTemplateParams
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", false, true, "email", ctx.QueryParams(), &params.Email)
+	err = runtime.BindQueryParameter("form", false, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetAccountByEmail(ctx, params)
+	err = w.Handler.TemplatesExportTemplate(ctx, templateId, params)
 	return err
 }
 
-// AccountsGetCurrentAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetCurrentAccount(ctx echo.Context) error {
+// TemplatesRestoreTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesRestoreTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesRestoreTemplateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetCurrentAccount(ctx)
+	err = w.Handler.TemplatesRestoreTemplate(ctx, templateId, params)
 	return err
 }
 
-// AccountsGetAccountById converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error {
+// TemplatesListTemplateShares converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTemplateShares(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesListTemplateShares(ctx, templateId)
+	return err
+}
+
+// TemplatesUnshareTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesUnshareTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
 	// ------------- Path parameter "accountId" -------------
 	var accountId string
 
@@ -572,272 +2879,334 @@ func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetAccountById(ctx, accountId)
+	err = w.Handler.TemplatesUnshareTemplate(ctx, templateId, accountId)
 	return err
 }
 
-// NotesListNotes converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesListNotes(ctx echo.Context) error {
+// TemplatesShareTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesShareTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesListNotesParams
-	// ------------- Optional query parameter "q" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
 
-	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
 	}
 
-	// ------------- Optional query parameter "templateId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesShareTemplate(ctx, templateId, accountId)
+	return err
+}
+
+// TemplatesSetTemplateVisibility converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesSetTemplateVisibility(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
-	// ------------- Optional query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesSetTemplateVisibilityParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesListNotes(ctx, params)
+	err = w.Handler.TemplatesSetTemplateVisibility(ctx, templateId, params)
 	return err
 }
 
-// NotesCreateNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesCreateNote(ctx echo.Context) error {
+// TrashListTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) TrashListTrash(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesCreateNote(ctx)
+	err = w.Handler.TrashListTrash(ctx)
 	return err
 }
 
-// NotesDeleteNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
+// WebhooksListWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksListWebhooks(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesDeleteNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhooksListWebhooks(ctx)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
-	}
+// WebhooksCreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksCreateWebhook(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesDeleteNote(ctx, noteId, params)
+	err = w.Handler.WebhooksCreateWebhook(ctx)
 	return err
 }
 
-// NotesGetNoteById converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesGetNoteById(ctx echo.Context) error {
+// WebhooksDeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksDeleteWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesGetNoteById(ctx, noteId)
+	err = w.Handler.WebhooksDeleteWebhook(ctx, webhookId)
 	return err
 }
 
-// NotesUpdateNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesUpdateNote(ctx echo.Context) error {
+// WebhooksGetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksGetWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesUpdateNoteParams
-	// ------------- Required query parameter "ownerId" -------------
-
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesUpdateNote(ctx, noteId, params)
+	err = w.Handler.WebhooksGetWebhook(ctx, webhookId)
 	return err
 }
 
-// NotesPublishNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
+// WebhooksUpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksUpdateWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesPublishNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhooksUpdateWebhook(ctx, webhookId)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+// WebhooksListWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksListWebhookDeliveries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesPublishNote(ctx, noteId, params)
+	err = w.Handler.WebhooksListWebhookDeliveries(ctx, webhookId)
 	return err
 }
 
-// NotesUnpublishNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
+// WebhooksReplayWebhookDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksReplayWebhookDelivery(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesUnpublishNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", ctx.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesUnpublishNote(ctx, noteId, params)
+	err = w.Handler.WebhooksReplayWebhookDelivery(ctx, webhookId, deliveryId)
 	return err
 }
 
-// TemplatesListTemplates converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesListTemplates(ctx echo.Context) error {
+// WorkspacesListWorkspaces converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesListWorkspaces(ctx echo.Context) error {
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesListTemplatesParams
-	// ------------- Optional query parameter "q" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesListWorkspaces(ctx)
+	return err
+}
 
-	// ------------- Optional query parameter "ownerId" -------------
+// WorkspacesCreateWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesCreateWorkspace(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesListTemplates(ctx, params)
+	err = w.Handler.WorkspacesCreateWorkspace(ctx)
 	return err
 }
 
-// TemplatesCreateTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesCreateTemplate(ctx echo.Context) error {
+// WorkspacesListWorkspaceInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesListWorkspaceInvitations(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesCreateTemplate(ctx)
+	err = w.Handler.WorkspacesListWorkspaceInvitations(ctx)
 	return err
 }
 
-// TemplatesDeleteTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error {
+// WorkspacesAcceptWorkspaceInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesAcceptWorkspaceInvitation(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", ctx.Param("invitationId"), &invitationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter invitationId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesDeleteTemplateParams
-	// ------------- Required query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesAcceptWorkspaceInvitation(ctx, invitationId)
+	return err
+}
+
+// WorkspacesInviteWorkspaceMember converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesInviteWorkspaceMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesDeleteTemplate(ctx, templateId, params)
+	err = w.Handler.WorkspacesInviteWorkspaceMember(ctx, workspaceId)
 	return err
 }
 
-// TemplatesGetTemplateById converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesGetTemplateById(ctx echo.Context) error {
+// WorkspacesListWorkspaceMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesListWorkspaceMembers(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesGetTemplateById(ctx, templateId)
+	err = w.Handler.WorkspacesListWorkspaceMembers(ctx, workspaceId)
 	return err
 }
 
-// TemplatesUpdateTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error {
+// WorkspacesRemoveWorkspaceMember converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesRemoveWorkspaceMember(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesUpdateTemplateParams
-	// ------------- Required query parameter "ownerId" -------------
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesUpdateTemplate(ctx, templateId, params)
+	err = w.Handler.WorkspacesRemoveWorkspaceMember(ctx, workspaceId, accountId)
+	return err
+}
+
+// PublicViewPublicNote converts echo context to params.
+func (w *ServerInterfaceWrapper) PublicViewPublicNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PublicViewPublicNote(ctx, token)
 	return err
 }
 
@@ -871,19 +3240,67 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.POST(baseURL+"/api/accounts/auth", wrapper.AccountsCreateOrGetAccount)
 	router.GET(baseURL+"/api/accounts/by-email", wrapper.AccountsGetAccountByEmail)
+	router.POST(baseURL+"/api/accounts/logout", wrapper.AccountsLogout)
 	router.GET(baseURL+"/api/accounts/me", wrapper.AccountsGetCurrentAccount)
+	router.GET(baseURL+"/api/accounts/me/export", wrapper.AccountsExportAccountData)
+	router.POST(baseURL+"/api/accounts/me/import", wrapper.AccountsImportAccountData)
+	router.POST(baseURL+"/api/accounts/refresh", wrapper.AccountsRefreshSession)
 	router.GET(baseURL+"/api/accounts/:accountId", wrapper.AccountsGetAccountById)
 	router.GET(baseURL+"/api/notes", wrapper.NotesListNotes)
 	router.POST(baseURL+"/api/notes", wrapper.NotesCreateNote)
 	router.DELETE(baseURL+"/api/notes/:noteId", wrapper.NotesDeleteNote)
 	router.GET(baseURL+"/api/notes/:noteId", wrapper.NotesGetNoteById)
 	router.PUT(baseURL+"/api/notes/:noteId", wrapper.NotesUpdateNote)
+	router.POST(baseURL+"/api/notes/:noteId/approve", wrapper.NotesApproveNote)
+	router.POST(baseURL+"/api/notes/:noteId/duplicate", wrapper.NotesDuplicateNote)
+	router.GET(baseURL+"/api/notes/:noteId/export", wrapper.NotesExportNote)
+	router.GET(baseURL+"/api/notes/:noteId/links", wrapper.NotesListNoteLinks)
+	router.POST(baseURL+"/api/notes/:noteId/links", wrapper.NotesCreateNoteLink)
+	router.DELETE(baseURL+"/api/notes/:noteId/links/:linkId", wrapper.NotesRevokeNoteLink)
 	router.POST(baseURL+"/api/notes/:noteId/publish", wrapper.NotesPublishNote)
+	router.POST(baseURL+"/api/notes/:noteId/reject", wrapper.NotesRejectNote)
+	router.PUT(baseURL+"/api/notes/:noteId/reviewers", wrapper.NotesAssignNoteReviewers)
+	router.GET(baseURL+"/api/notes/:noteId/reviews", wrapper.NotesGetNoteReviews)
+	router.POST(baseURL+"/api/notes/:noteId/restore", wrapper.NotesRestoreNote)
+	router.GET(baseURL+"/api/notes/:noteId/revisions", wrapper.NotesListNoteRevisions)
+	router.GET(baseURL+"/api/notes/:noteId/revisions/diff", wrapper.NotesDiffNoteRevisions)
+	router.POST(baseURL+"/api/notes/:noteId/revisions/:revision/restore", wrapper.NotesRestoreNoteRevision)
+	router.POST(baseURL+"/api/notes/:noteId/schedule", wrapper.NotesScheduleNote)
+	router.GET(baseURL+"/api/notes/:noteId/shares", wrapper.NotesListNoteShares)
+	router.DELETE(baseURL+"/api/notes/:noteId/shares/:accountId", wrapper.NotesUnshareNote)
+	router.PUT(baseURL+"/api/notes/:noteId/shares/:accountId", wrapper.NotesShareNote)
+	router.POST(baseURL+"/api/notes/:noteId/submit", wrapper.NotesSubmitNoteForReview)
 	router.POST(baseURL+"/api/notes/:noteId/unpublish", wrapper.NotesUnpublishNote)
+	router.POST(baseURL+"/api/notes/:noteId/upgrade", wrapper.NotesUpgradeNote)
 	router.GET(baseURL+"/api/templates", wrapper.TemplatesListTemplates)
 	router.POST(baseURL+"/api/templates", wrapper.TemplatesCreateTemplate)
+	router.GET(baseURL+"/api/templates/gallery", wrapper.TemplatesListTemplateGallery)
+	router.POST(baseURL+"/api/templates/import", wrapper.TemplatesImportTemplate)
 	router.DELETE(baseURL+"/api/templates/:templateId", wrapper.TemplatesDeleteTemplate)
 	router.GET(baseURL+"/api/templates/:templateId", wrapper.TemplatesGetTemplateById)
 	router.PUT(baseURL+"/api/templates/:templateId", wrapper.TemplatesUpdateTemplate)
+	router.POST(baseURL+"/api/templates/:templateId/clone", wrapper.TemplatesCloneTemplate)
+	router.GET(baseURL+"/api/templates/:templateId/export", wrapper.TemplatesExportTemplate)
+	router.POST(baseURL+"/api/templates/:templateId/restore", wrapper.TemplatesRestoreTemplate)
+	router.GET(baseURL+"/api/templates/:templateId/shares", wrapper.TemplatesListTemplateShares)
+	router.DELETE(baseURL+"/api/templates/:templateId/shares/:accountId", wrapper.TemplatesUnshareTemplate)
+	router.PUT(baseURL+"/api/templates/:templateId/shares/:accountId", wrapper.TemplatesShareTemplate)
+	router.PUT(baseURL+"/api/templates/:templateId/visibility", wrapper.TemplatesSetTemplateVisibility)
+	router.GET(baseURL+"/api/trash", wrapper.TrashListTrash)
+	router.GET(baseURL+"/api/webhooks", wrapper.WebhooksListWebhooks)
+	router.POST(baseURL+"/api/webhooks", wrapper.WebhooksCreateWebhook)
+	router.DELETE(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksDeleteWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksGetWebhook)
+	router.PUT(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksUpdateWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookId/deliveries", wrapper.WebhooksListWebhookDeliveries)
+	router.POST(baseURL+"/api/webhooks/:webhookId/deliveries/:deliveryId/replay", wrapper.WebhooksReplayWebhookDelivery)
+	router.GET(baseURL+"/api/workspaces", wrapper.WorkspacesListWorkspaces)
+	router.POST(baseURL+"/api/workspaces", wrapper.WorkspacesCreateWorkspace)
+	router.GET(baseURL+"/api/workspaces/invitations", wrapper.WorkspacesListWorkspaceInvitations)
+	router.POST(baseURL+"/api/workspaces/invitations/:invitationId/accept", wrapper.WorkspacesAcceptWorkspaceInvitation)
+	router.POST(baseURL+"/api/workspaces/:workspaceId/invitations", wrapper.WorkspacesInviteWorkspaceMember)
+	router.GET(baseURL+"/api/workspaces/:workspaceId/members", wrapper.WorkspacesListWorkspaceMembers)
+	router.DELETE(baseURL+"/api/workspaces/:workspaceId/members/:accountId", wrapper.WorkspacesRemoveWorkspaceMember)
+	router.GET(baseURL+"/public/notes/:token", wrapper.PublicViewPublicNote)
 
 }
@@ -0,0 +1,71 @@
+// Package middleware contains Echo middleware for the HTTP adapter.
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type accountIDKey struct{}
+
+// Auth verifies the Authorization bearer token on every route except publicPaths
+// and stores the authenticated account ID on the request context.
+// publicPaths are matched against the registered route path (e.g. "/api/accounts/auth").
+func Auth(verifier port.TokenVerifier, publicPaths ...string) echo.MiddlewareFunc {
+	public := make(map[string]struct{}, len(publicPaths))
+	for _, p := range publicPaths {
+		public[p] = struct{}{}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if _, ok := public[ctx.Path()]; ok {
+				return next(ctx)
+			}
+			token, ok := bearerToken(ctx.Request())
+			if !ok {
+				return unauthorized(ctx, "missing bearer token")
+			}
+			req := ctx.Request()
+			accountID, err := verifier.Verify(req.Context(), token)
+			if err != nil {
+				return unauthorized(ctx, "invalid bearer token")
+			}
+			ctx.SetRequest(req.WithContext(WithAccountID(req.Context(), accountID)))
+			return next(ctx)
+		}
+	}
+}
+
+// WithAccountID returns a context carrying the authenticated account ID.
+func WithAccountID(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, accountIDKey{}, accountID)
+}
+
+// AccountIDFromContext returns the authenticated account ID, if any.
+func AccountIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(accountIDKey{}).(string)
+	if !ok || strings.TrimSpace(id) == "" {
+		return "", false
+	}
+	return id, true
+}
+
+func bearerToken(req *http.Request) (string, bool) {
+	h := req.Header.Get(echo.HeaderAuthorization)
+	scheme, token, found := strings.Cut(h, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func unauthorized(ctx echo.Context, msg string) error {
+	return ctx.JSON(http.StatusUnauthorized, openapi.ModelsUnauthorizedError{Code: openapi.ModelsUnauthorizedErrorCodeUNAUTHORIZED, Message: msg})
+}
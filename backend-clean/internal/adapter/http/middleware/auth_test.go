@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type verifierStub struct{}
+
+func (verifierStub) Verify(_ context.Context, token string) (string, error) {
+	if token == "good" {
+		return "acc-1", nil
+	}
+	return "", errors.New("bad token")
+}
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		header     string
+		wantStatus int
+		wantID     string
+	}{
+		{name: "[Success] valid bearer token", path: "/api/notes", header: "Bearer good", wantStatus: http.StatusOK, wantID: "acc-1"},
+		{name: "[Success] scheme is case-insensitive", path: "/api/notes", header: "bearer good", wantStatus: http.StatusOK, wantID: "acc-1"},
+		{name: "[Success] public path skips verification", path: "/api/accounts/auth", wantStatus: http.StatusOK},
+		{name: "[Fail] missing header", path: "/api/notes", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] wrong scheme", path: "/api/notes", header: "Basic good", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] invalid token", path: "/api/notes", header: "Bearer bad", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(Auth(verifierStub{}, "/api/accounts/auth"))
+			var gotID string
+			handler := func(c echo.Context) error {
+				gotID, _ = AccountIDFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			}
+			e.GET("/api/notes", handler)
+			e.GET("/api/accounts/auth", handler)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotID != tt.wantID {
+				t.Fatalf("account id = %q, want %q", gotID, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LoginSecretHeader carries the secret shared between this API and the frontend server.
+const LoginSecretHeader = "X-Login-Secret"
+
+// LoginSecret refuses requests to paths that do not carry secret in LoginSecretHeader.
+// Login trusts the OAuth identity in its body, so only the frontend server, which completed
+// the OAuth sign-in itself, may call it. An empty secret refuses every request to paths.
+func LoginSecret(secret string, paths ...string) echo.MiddlewareFunc {
+	guarded := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		guarded[p] = struct{}{}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if _, ok := guarded[ctx.Path()]; !ok {
+				return next(ctx)
+			}
+			given := ctx.Request().Header.Get(LoginSecretHeader)
+			if secret == "" || subtle.ConstantTimeCompare([]byte(given), []byte(secret)) != 1 {
+				return unauthorized(ctx, "invalid login secret")
+			}
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestLoginSecret(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		path       string
+		header     string
+		wantStatus int
+	}{
+		{name: "[Success] login with the shared secret", secret: "s3cret", path: "/api/accounts/auth", header: "s3cret", wantStatus: http.StatusOK},
+		{name: "[Success] other paths need no secret", secret: "s3cret", path: "/api/notes", wantStatus: http.StatusOK},
+		{name: "[Fail] unverified login without secret", secret: "s3cret", path: "/api/accounts/auth", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] wrong secret", secret: "s3cret", path: "/api/accounts/auth", header: "guess", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] no secret configured", path: "/api/accounts/auth", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(LoginSecret(tt.secret, "/api/accounts/auth"))
+			reached := false
+			handler := func(c echo.Context) error {
+				reached = true
+				return c.NoContent(http.StatusOK)
+			}
+			e.POST("/api/accounts/auth", handler)
+			e.POST("/api/notes", handler)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(LoginSecretHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if reached != (tt.wantStatus == http.StatusOK) {
+				t.Fatalf("handler reached = %v", reached)
+			}
+		})
+	}
+}
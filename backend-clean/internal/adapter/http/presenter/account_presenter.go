@@ -25,11 +25,22 @@ func NewAccountPresenter() *AccountPresenter {
 
 // PresentAccount stores converted account response.
 func (p *AccountPresenter) PresentAccount(_ context.Context, a *account.Account) error {
+	resp := toAccountResponse(a)
+	p.account = &resp
+	return nil
+}
+
+// Response returns the last account response.
+func (p *AccountPresenter) Response() *openapi.ModelsAccountResponse {
+	return p.account
+}
+
+func toAccountResponse(a *account.Account) openapi.ModelsAccountResponse {
 	var lastLogin time.Time
 	if a.LastLoginAt != nil {
 		lastLogin = *a.LastLoginAt
 	}
-	p.account = &openapi.ModelsAccountResponse{
+	return openapi.ModelsAccountResponse{
 		Id:          a.ID,
 		Email:       a.Email.String(),
 		FirstName:   a.FirstName,
@@ -40,12 +51,6 @@ func (p *AccountPresenter) PresentAccount(_ context.Context, a *account.Account)
 		CreatedAt:   a.CreatedAt,
 		UpdatedAt:   a.UpdatedAt,
 	}
-	return nil
-}
-
-// Response returns the last account response.
-func (p *AccountPresenter) Response() *openapi.ModelsAccountResponse {
-	return p.account
 }
 
 func strPtrOrNil(s string) *string {
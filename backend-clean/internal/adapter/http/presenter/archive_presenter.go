@@ -0,0 +1,57 @@
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchivePresenter renders account archives as JSON Lines downloads and import results as OpenAPI responses.
+type ArchivePresenter struct {
+	accountID string
+	body      []byte
+	imported  openapi.ModelsAccountImportResponse
+}
+
+var _ port.ArchiveOutputPort = (*ArchivePresenter)(nil)
+
+// NewArchivePresenter creates an ArchivePresenter.
+func NewArchivePresenter() *ArchivePresenter {
+	return &ArchivePresenter{}
+}
+
+// PresentArchive encodes the archive.
+func (p *ArchivePresenter) PresentArchive(_ context.Context, a *archive.Archive) error {
+	var buf bytes.Buffer
+	if err := archive.Encode(&buf, *a); err != nil {
+		return err
+	}
+	p.accountID = a.AccountID
+	p.body = buf.Bytes()
+	return nil
+}
+
+// PresentArchiveImported stores the number of created templates and notes.
+func (p *ArchivePresenter) PresentArchiveImported(_ context.Context, templates, notes int) error {
+	p.imported = openapi.ModelsAccountImportResponse{Templates: int32(templates), Notes: int32(notes)} //nolint:gosec // counts of one archive
+	return nil
+}
+
+// Body returns the encoded archive.
+func (p *ArchivePresenter) Body() []byte {
+	return p.body
+}
+
+// Filename returns the suggested download name, e.g. account-<id>.jsonl.
+func (p *ArchivePresenter) Filename() string {
+	return fmt.Sprintf("account-%s.jsonl", p.accountID)
+}
+
+// Imported returns the import result.
+func (p *ArchivePresenter) Imported() openapi.ModelsAccountImportResponse {
+	return p.imported
+}
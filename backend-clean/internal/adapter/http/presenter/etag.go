@@ -0,0 +1,8 @@
+package presenter
+
+import "strconv"
+
+// etag formats a resource version as a strong entity tag, e.g. "3".
+func etag(version int32) string {
+	return strconv.Quote(strconv.Itoa(int(version)))
+}
@@ -0,0 +1,137 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// exportFiles maps each export format to its content type and file extension.
+var exportFiles = map[openapi.ModelsNoteExportFormat]struct {
+	contentType string
+	ext         string
+}{
+	openapi.ModelsNoteExportFormatMarkdown: {contentType: "text/markdown; charset=UTF-8", ext: "md"},
+	openapi.ModelsNoteExportFormatHtml:     {contentType: "text/html; charset=UTF-8", ext: "html"},
+	openapi.ModelsNoteExportFormatJson:     {contentType: "application/json", ext: "json"},
+}
+
+// NoteExportPresenter renders a single note as a downloadable Markdown, HTML or JSON document.
+// It stands in for NotePresenter as port.NoteOutputPort, so the note use case is unaware of
+// the format; only PresentNote produces a document.
+type NoteExportPresenter struct {
+	*NotePresenter
+	format openapi.ModelsNoteExportFormat
+	noteID string
+	body   []byte
+}
+
+var _ port.NoteOutputPort = (*NoteExportPresenter)(nil)
+
+// NewNoteExportPresenter creates a NoteExportPresenter for one of the formats IsExportFormat accepts.
+func NewNoteExportPresenter(format openapi.ModelsNoteExportFormat) *NoteExportPresenter {
+	return &NoteExportPresenter{NotePresenter: NewNotePresenter(), format: format}
+}
+
+// IsExportFormat reports whether format is one NoteExportPresenter can render.
+func IsExportFormat(format openapi.ModelsNoteExportFormat) bool {
+	_, ok := exportFiles[format]
+	return ok
+}
+
+// PresentNote renders the note in the presenter's format.
+func (p *NoteExportPresenter) PresentNote(ctx context.Context, n *note.WithMeta) error {
+	if err := p.NotePresenter.PresentNote(ctx, n); err != nil {
+		return err
+	}
+	p.noteID = n.Note.ID
+	var err error
+	switch p.format {
+	case openapi.ModelsNoteExportFormatJson:
+		p.body, err = json.MarshalIndent(p.Note(), "", "  ")
+	case openapi.ModelsNoteExportFormatHtml:
+		p.body, err = renderStandaloneNote(toStandaloneNote(toNoteExport(*n)))
+	default:
+		p.body = []byte(toMarkdown(toNoteExport(*n)))
+	}
+	return err
+}
+
+// Body returns the rendered document.
+func (p *NoteExportPresenter) Body() []byte {
+	return p.body
+}
+
+// ContentType returns the media type of the rendered document.
+func (p *NoteExportPresenter) ContentType() string {
+	return exportFiles[p.format].contentType
+}
+
+// Filename returns the suggested download name, e.g. note-<id>.md.
+func (p *NoteExportPresenter) Filename() string {
+	return fmt.Sprintf("note-%s.%s", p.noteID, exportFiles[p.format].ext)
+}
+
+// noteExport is the format-neutral view of an exported note.
+type noteExport struct {
+	Title    string
+	Owner    string
+	Status   string
+	Template string
+	Sections []standaloneSection
+}
+
+func toNoteExport(n note.WithMeta) noteExport {
+	sections := make([]note.SectionWithField, len(n.Sections))
+	copy(sections, n.Sections)
+	sort.SliceStable(sections, func(i, j int) bool { return sections[i].FieldOrder < sections[j].FieldOrder })
+	out := noteExport{
+		Title:    n.Note.Title,
+		Owner:    strings.TrimSpace(n.OwnerFirstName + " " + n.OwnerLastName),
+		Status:   string(n.Note.Status),
+		Template: n.TemplateName,
+		Sections: make([]standaloneSection, 0, len(sections)),
+	}
+	for _, s := range sections {
+		out.Sections = append(out.Sections, standaloneSection{Label: s.FieldLabel, Content: s.Section.Content})
+	}
+	return out
+}
+
+func toStandaloneNote(e noteExport) standaloneNote {
+	return standaloneNote{
+		Title: e.Title,
+		Details: []standaloneDetail{
+			{Label: "Owner", Value: e.Owner},
+			{Label: "Status", Value: e.Status},
+			{Label: "Template", Value: e.Template},
+		},
+		Sections: e.Sections,
+	}
+}
+
+// toMarkdown renders headings on a single line; section content is kept as written.
+func toMarkdown(e noteExport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", oneLine(e.Title))
+	fmt.Fprintf(&b, "- Owner: %s\n", oneLine(e.Owner))
+	fmt.Fprintf(&b, "- Status: %s\n", e.Status)
+	fmt.Fprintf(&b, "- Template: %s\n", oneLine(e.Template))
+	for _, s := range e.Sections {
+		fmt.Fprintf(&b, "\n## %s\n", oneLine(s.Label))
+		if content := strings.TrimRight(s.Content, "\n"); content != "" {
+			fmt.Fprintf(&b, "\n%s\n", content)
+		}
+	}
+	return b.String()
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
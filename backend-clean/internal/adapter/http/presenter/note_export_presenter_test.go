@@ -0,0 +1,86 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteExportPresenter_PresentNote(t *testing.T) {
+	n := &note.WithMeta{
+		Note:           note.Note{ID: "note-1", Title: "Weekly <report>", Status: note.StatusPublish},
+		TemplateName:   "Weekly",
+		OwnerFirstName: "Taro",
+		OwnerLastName:  "Yamada",
+		Sections: []note.SectionWithField{
+			{Section: note.Section{Content: "Next week"}, FieldLabel: "Plan", FieldOrder: 2},
+			{Section: note.Section{Content: "Shipped <b>it</b>"}, FieldLabel: "Done", FieldOrder: 1},
+		},
+	}
+	tests := []struct {
+		name            string
+		format          openapi.ModelsNoteExportFormat
+		wantContentType string
+		wantFilename    string
+		wantInOrder     []string
+	}{
+		{
+			name:            "[Success] markdown",
+			format:          openapi.ModelsNoteExportFormatMarkdown,
+			wantContentType: "text/markdown; charset=UTF-8",
+			wantFilename:    "note-note-1.md",
+			wantInOrder:     []string{"# Weekly <report>\n", "- Owner: Taro Yamada\n", "- Status: Publish\n", "## Done\n\nShipped <b>it</b>\n", "## Plan\n\nNext week\n"},
+		},
+		{
+			name:            "[Success] html escapes content",
+			format:          openapi.ModelsNoteExportFormatHtml,
+			wantContentType: "text/html; charset=UTF-8",
+			wantFilename:    "note-note-1.html",
+			wantInOrder:     []string{"<h1>Weekly &lt;report&gt;</h1>", "<dd>Taro Yamada</dd>", "<dd>Publish</dd>", "<h2>Done</h2>", "Shipped &lt;b&gt;it&lt;/b&gt;", "<h2>Plan</h2>"},
+		},
+		{
+			name:            "[Success] json",
+			format:          openapi.ModelsNoteExportFormatJson,
+			wantContentType: "application/json",
+			wantFilename:    "note-note-1.json",
+			wantInOrder:     []string{`"id": "note-1"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewNoteExportPresenter(tt.format)
+			if err := p.PresentNote(context.Background(), n); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.ContentType() != tt.wantContentType || p.Filename() != tt.wantFilename {
+				t.Fatalf("content type = %q, filename = %q", p.ContentType(), p.Filename())
+			}
+			body := string(p.Body())
+			rest := body
+			for _, want := range tt.wantInOrder {
+				i := strings.Index(rest, want)
+				if i < 0 {
+					t.Fatalf("body missing %q in order:\n%s", want, body)
+				}
+				rest = rest[i+len(want):]
+			}
+			if tt.format == openapi.ModelsNoteExportFormatJson {
+				var res openapi.ModelsNoteResponse
+				if err := json.Unmarshal(p.Body(), &res); err != nil || res.Owner.FirstName != "Taro" {
+					t.Fatalf("json = %s, err = %v", body, err)
+				}
+			}
+		})
+	}
+}
+
+func TestIsExportFormat(t *testing.T) {
+	if !IsExportFormat(openapi.ModelsNoteExportFormatMarkdown) || IsExportFormat("pdf") {
+		t.Fatalf("unexpected format support")
+	}
+}
@@ -6,14 +6,18 @@ import (
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // NotePresenter converts note domain models to OpenAPI responses.
 type NotePresenter struct {
-	note      *openapi.ModelsNoteResponse
-	notes     []openapi.ModelsNoteResponse
-	deletedOK bool
+	note       *openapi.ModelsNoteResponse
+	notes      openapi.ModelsNoteListResponse
+	revisions  openapi.ModelsNoteRevisionListResponse
+	diff       *openapi.ModelsNoteRevisionDiffResponse
+	duplicated *openapi.ModelsDuplicateNoteResponse
+	deletedOK  bool
 }
 
 var _ port.NoteOutputPort = (*NotePresenter)(nil)
@@ -23,13 +27,17 @@ func NewNotePresenter() *NotePresenter {
 	return &NotePresenter{}
 }
 
-// PresentNoteList stores note list response.
-func (p *NotePresenter) PresentNoteList(_ context.Context, notes []note.WithMeta) error {
+// PresentNoteList stores one page of the note list response.
+func (p *NotePresenter) PresentNoteList(_ context.Context, notes []note.WithMeta, page pagination.Info) error {
 	res := make([]openapi.ModelsNoteResponse, 0, len(notes))
 	for _, n := range notes {
 		res = append(res, toNoteResponse(n))
 	}
-	p.notes = res
+	p.notes = openapi.ModelsNoteListResponse{
+		Items:      res,
+		NextCursor: nextCursor(page),
+		HasMore:    page.HasMore,
+	}
 	return nil
 }
 
@@ -46,16 +54,97 @@ func (p *NotePresenter) PresentNoteDeleted(_ context.Context) error {
 	return nil
 }
 
+// PresentNoteRevisions stores the revision history response.
+func (p *NotePresenter) PresentNoteRevisions(_ context.Context, revisions []note.Revision) error {
+	items := make([]openapi.ModelsNoteRevisionResponse, 0, len(revisions))
+	for _, r := range revisions {
+		sections := make([]openapi.ModelsNoteRevisionSection, 0, len(r.Sections))
+		for _, s := range r.Sections {
+			sections = append(sections, openapi.ModelsNoteRevisionSection{FieldId: s.FieldID, Content: s.Content})
+		}
+		items = append(items, openapi.ModelsNoteRevisionResponse{
+			Revision:  int32(r.Number), //nolint:gosec // revision numbers are small positive ints
+			Title:     r.Title,
+			Sections:  sections,
+			ActorId:   r.ActorID,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	p.revisions = openapi.ModelsNoteRevisionListResponse{Items: items}
+	return nil
+}
+
+// PresentNoteRevisionDiff stores the revision diff response.
+func (p *NotePresenter) PresentNoteRevisionDiff(_ context.Context, diff note.RevisionDiff) error {
+	sections := make([]openapi.ModelsNoteRevisionSectionChange, 0, len(diff.Sections))
+	for _, c := range diff.Sections {
+		sections = append(sections, openapi.ModelsNoteRevisionSectionChange{
+			FieldId:    c.FieldID,
+			FieldLabel: c.FieldLabel,
+			Before:     c.Before,
+			After:      c.After,
+			Changed:    c.Changed,
+		})
+	}
+	p.diff = &openapi.ModelsNoteRevisionDiffResponse{
+		From: int32(diff.From), //nolint:gosec // revision numbers are small positive ints
+		To:   int32(diff.To),   //nolint:gosec // revision numbers are small positive ints
+		Title: openapi.ModelsNoteRevisionChange{
+			Before:  diff.Title.Before,
+			After:   diff.Title.After,
+			Changed: diff.Title.Changed,
+		},
+		Sections: sections,
+	}
+	return nil
+}
+
+// PresentNoteDuplicated stores the new note with the content its template did not take over.
+// The new note is also presented as the last note, so ETag applies to it.
+func (p *NotePresenter) PresentNoteDuplicated(_ context.Context, n *note.WithMeta, unmapped []note.UnmappedSection) error {
+	resp := toNoteResponse(*n)
+	p.note = &resp
+	items := make([]openapi.ModelsUnmappedSection, 0, len(unmapped))
+	for _, u := range unmapped {
+		items = append(items, openapi.ModelsUnmappedSection{FieldLabel: u.FieldLabel, Content: u.Content})
+	}
+	p.duplicated = &openapi.ModelsDuplicateNoteResponse{Note: resp, Unmapped: items}
+	return nil
+}
+
 // Note returns the last note response.
 func (p *NotePresenter) Note() *openapi.ModelsNoteResponse {
 	return p.note
 }
 
+// ETag returns the entity tag of the last note response, or "" when none was presented.
+func (p *NotePresenter) ETag() string {
+	if p.note == nil {
+		return ""
+	}
+	return etag(p.note.Version)
+}
+
 // Notes returns the note list response.
-func (p *NotePresenter) Notes() []openapi.ModelsNoteResponse {
+func (p *NotePresenter) Notes() openapi.ModelsNoteListResponse {
 	return p.notes
 }
 
+// Revisions returns the revision history response.
+func (p *NotePresenter) Revisions() openapi.ModelsNoteRevisionListResponse {
+	return p.revisions
+}
+
+// RevisionDiff returns the revision diff response.
+func (p *NotePresenter) RevisionDiff() *openapi.ModelsNoteRevisionDiffResponse {
+	return p.diff
+}
+
+// Duplicated returns the duplicate response.
+func (p *NotePresenter) Duplicated() *openapi.ModelsDuplicateNoteResponse {
+	return p.duplicated
+}
+
 // DeleteResponse returns deletion success response.
 func (p *NotePresenter) DeleteResponse() openapi.ModelsSuccessResponse {
 	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
@@ -65,13 +154,19 @@ func toNoteResponse(n note.WithMeta) openapi.ModelsNoteResponse {
 	sections := make([]openapi.ModelsSection, 0, len(n.Sections))
 	for _, s := range n.Sections {
 		sections = append(sections, openapi.ModelsSection{
-			Id:         s.Section.ID,
-			FieldId:    s.Section.FieldID,
-			FieldLabel: s.FieldLabel,
-			Content:    s.Section.Content,
-			IsRequired: s.IsRequired,
+			Id:           s.Section.ID,
+			FieldId:      s.Section.FieldID,
+			FieldLabel:   s.FieldLabel,
+			Content:      s.Section.Content,
+			IsRequired:   s.IsRequired,
+			FieldType:    openapi.ModelsFieldType(s.FieldType),
+			FieldOptions: nonNilOptions(s.FieldOptions),
 		})
 	}
+	var snippet *string
+	if n.Snippet != "" {
+		snippet = &n.Snippet
+	}
 	return openapi.ModelsNoteResponse{
 		Id:           n.Note.ID,
 		Title:        n.Note.Title,
@@ -84,9 +179,16 @@ func toNoteResponse(n note.WithMeta) openapi.ModelsNoteResponse {
 			LastName:  n.OwnerLastName,
 			Thumbnail: n.OwnerThumbnail,
 		},
-		Status:    openapi.ModelsNoteStatus(n.Note.Status),
-		Sections:  sections,
-		CreatedAt: n.Note.CreatedAt,
-		UpdatedAt: n.Note.UpdatedAt,
+		WorkspaceId:                 n.Note.WorkspaceID,
+		Status:                      openapi.ModelsNoteStatus(n.Note.Status),
+		PublishAt:                   n.Note.PublishAt,
+		UnpublishAt:                 n.Note.UnpublishAt,
+		Sections:                    sections,
+		CreatedAt:                   n.Note.CreatedAt,
+		UpdatedAt:                   n.Note.UpdatedAt,
+		Version:                     int32(n.Note.Version),        //nolint:gosec
+		TemplateSchemaVersion:       int32(n.Note.SchemaVersion),  //nolint:gosec
+		LatestTemplateSchemaVersion: int32(n.LatestSchemaVersion), //nolint:gosec
+		Snippet:                     snippet,
 	}
 }
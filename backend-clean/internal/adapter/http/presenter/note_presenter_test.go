@@ -2,10 +2,14 @@ package presenter
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/template"
 )
 
 func TestNotePresenter_TableDriven(t *testing.T) {
@@ -15,6 +19,7 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 		action    string
 		single    *note.WithMeta
 		list      []note.WithMeta
+		page      pagination.Info
 		wantID    string
 		wantCount int
 	}{
@@ -28,6 +33,7 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 					TemplateID: "tpl-1",
 					OwnerID:    "owner-1",
 					Status:     note.StatusDraft,
+					Version:    3,
 					CreatedAt:  now,
 					UpdatedAt:  now,
 				},
@@ -39,6 +45,7 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 						Section:    note.Section{ID: "sec1", FieldID: "f1", Content: "c1"},
 						FieldLabel: "Title",
 						IsRequired: true,
+						FieldType:  template.FieldTypeText,
 					},
 				},
 			},
@@ -50,6 +57,19 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 			list:      []note.WithMeta{{Note: note.Note{ID: "n1"}}, {Note: note.Note{ID: "n2"}}},
 			wantCount: 2,
 		},
+		{
+			name:      "[Success] list with next page",
+			action:    "list",
+			list:      []note.WithMeta{{Note: note.Note{ID: "n1", UpdatedAt: now}}},
+			page:      pagination.Info{NextCursor: &pagination.Cursor{UpdatedAt: now, ID: "n1"}, HasMore: true},
+			wantCount: 1,
+		},
+		{
+			name:      "[Success] search hit keeps snippet",
+			action:    "list",
+			list:      []note.WithMeta{{Note: note.Note{ID: "n1"}, Rank: 0.6, Snippet: "<mark>hit</mark>"}},
+			wantCount: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -67,10 +87,32 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 				if len(resp.Sections) != len(tt.single.Sections) {
 					t.Fatalf("sections not mapped: %+v", resp.Sections)
 				}
+				if resp.Sections[0].FieldType != openapi.ModelsFieldTypeText || resp.Sections[0].FieldOptions == nil {
+					t.Fatalf("field type not mapped: %+v", resp.Sections[0])
+				}
+				if int(resp.Version) != tt.single.Note.Version || p.ETag() != `"3"` {
+					t.Fatalf("version not mapped: %d, ETag %s", resp.Version, p.ETag())
+				}
 			case "list":
-				_ = p.PresentNoteList(context.Background(), tt.list)
-				if len(p.Notes()) != tt.wantCount {
-					t.Fatalf("want %d notes, got %d", tt.wantCount, len(p.Notes()))
+				_ = p.PresentNoteList(context.Background(), tt.list, tt.page)
+				resp := p.Notes()
+				if len(resp.Items) != tt.wantCount {
+					t.Fatalf("want %d notes, got %d", tt.wantCount, len(resp.Items))
+				}
+				if resp.HasMore != tt.page.HasMore {
+					t.Fatalf("hasMore mismatch: %+v", resp)
+				}
+				if tt.page.NextCursor == nil && resp.NextCursor != nil {
+					t.Fatalf("nextCursor must be omitted on the last page")
+				}
+				if tt.page.NextCursor != nil && (resp.NextCursor == nil || *resp.NextCursor != tt.page.NextCursor.Encode()) {
+					t.Fatalf("nextCursor not encoded: %v", resp.NextCursor)
+				}
+				for i, item := range resp.Items {
+					want := tt.list[i].Snippet
+					if (want == "") != (item.Snippet == nil) || (item.Snippet != nil && *item.Snippet != want) {
+						t.Fatalf("snippet = %v, want %q", item.Snippet, want)
+					}
 				}
 			}
 		})
@@ -84,3 +126,66 @@ func TestNotePresenter_PresentNoteDeleted(t *testing.T) {
 		t.Fatalf("delete flag not set")
 	}
 }
+
+func TestNotePresenter_PresentNoteRevisions(t *testing.T) {
+	p := NewNotePresenter()
+	_ = p.PresentNoteRevisions(context.Background(), []note.Revision{
+		{Number: 2, Title: "B", ActorID: "a1", Sections: []note.RevisionSection{{FieldID: "f1", Content: "c"}}},
+		{Number: 1, Title: "A", ActorID: "a1"},
+	})
+	items := p.Revisions().Items
+	if len(items) != 2 || items[0].Revision != 2 || items[0].Sections[0].FieldId != "f1" {
+		t.Fatalf("unexpected revisions: %+v", items)
+	}
+	if items[1].Sections == nil {
+		t.Fatalf("sections must encode as an empty array")
+	}
+}
+
+func TestNotePresenter_PresentNoteRevisionDiff(t *testing.T) {
+	p := NewNotePresenter()
+	_ = p.PresentNoteRevisionDiff(context.Background(), note.RevisionDiff{
+		From:     1,
+		To:       2,
+		Title:    note.Change{Before: "A", After: "B", Changed: true},
+		Sections: []note.SectionChange{{FieldID: "f1", FieldLabel: "Body", Change: note.Change{Before: "x", After: "x"}}},
+	})
+	diff := p.RevisionDiff()
+	if diff == nil || diff.From != 1 || diff.To != 2 || !diff.Title.Changed {
+		t.Fatalf("unexpected diff: %+v", diff)
+	}
+	if len(diff.Sections) != 1 || diff.Sections[0].FieldLabel != "Body" || diff.Sections[0].Changed {
+		t.Fatalf("unexpected section changes: %+v", diff.Sections)
+	}
+}
+
+func TestNotePresenter_PresentNoteDuplicated(t *testing.T) {
+	tests := []struct {
+		name         string
+		unmapped     []note.UnmappedSection
+		wantUnmapped []openapi.ModelsUnmappedSection
+	}{
+		{name: "[Success] everything mapped", wantUnmapped: []openapi.ModelsUnmappedSection{}},
+		{
+			name:         "[Success] unmapped content",
+			unmapped:     []note.UnmappedSection{{FieldLabel: "Risks", Content: "none"}},
+			wantUnmapped: []openapi.ModelsUnmappedSection{{FieldLabel: "Risks", Content: "none"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewNotePresenter()
+			n := &note.WithMeta{Note: note.Note{ID: "note-2", Status: note.StatusDraft, Version: 1}}
+			if err := p.PresentNoteDuplicated(context.Background(), n, tt.unmapped); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := p.Duplicated()
+			if got == nil || got.Note.Id != "note-2" || !reflect.DeepEqual(got.Unmapped, tt.wantUnmapped) {
+				t.Fatalf("duplicated = %+v", got)
+			}
+			if p.ETag() != `"1"` {
+				t.Fatalf("ETag = %q", p.ETag())
+			}
+		})
+	}
+}
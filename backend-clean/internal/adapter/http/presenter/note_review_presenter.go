@@ -0,0 +1,70 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteReviewPresenter converts review workflow results to OpenAPI responses.
+type NoteReviewPresenter struct {
+	state *openapi.ModelsNoteReviewStateResponse
+	note  *openapi.ModelsNoteResponse
+}
+
+var _ port.NoteReviewOutputPort = (*NoteReviewPresenter)(nil)
+
+// NewNoteReviewPresenter creates a NoteReviewPresenter.
+func NewNoteReviewPresenter() *NoteReviewPresenter {
+	return &NoteReviewPresenter{}
+}
+
+// PresentReviewState stores the reviewers and review history response.
+func (p *NoteReviewPresenter) PresentReviewState(_ context.Context, state note.ReviewState) error {
+	reviewerIDs := make([]string, 0, len(state.Reviewers))
+	reviewerIDs = append(reviewerIDs, state.Reviewers...)
+	reviews := make([]openapi.ModelsNoteReviewResponse, 0, len(state.Reviews))
+	for _, r := range state.Reviews {
+		reviews = append(reviews, openapi.ModelsNoteReviewResponse{
+			Id:         r.ID,
+			ReviewerId: r.ReviewerID,
+			Decision:   openapi.ModelsReviewDecision(r.Decision),
+			Comment:    r.Comment,
+			CreatedAt:  r.CreatedAt,
+		})
+	}
+	p.state = &openapi.ModelsNoteReviewStateResponse{
+		NoteId:      state.Note.ID,
+		Status:      openapi.ModelsNoteStatus(state.Note.Status),
+		ReviewerIds: reviewerIDs,
+		Reviews:     reviews,
+	}
+	return nil
+}
+
+// PresentNote stores the note after a review transition.
+func (p *NoteReviewPresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
+	resp := toNoteResponse(*n)
+	p.note = &resp
+	return nil
+}
+
+// State returns the review state response.
+func (p *NoteReviewPresenter) State() *openapi.ModelsNoteReviewStateResponse {
+	return p.state
+}
+
+// Note returns the note response.
+func (p *NoteReviewPresenter) Note() *openapi.ModelsNoteResponse {
+	return p.note
+}
+
+// ETag returns the entity tag of the presented note, or "" when none was presented.
+func (p *NoteReviewPresenter) ETag() string {
+	if p.note == nil {
+		return ""
+	}
+	return etag(p.note.Version)
+}
@@ -0,0 +1,12 @@
+package presenter
+
+import "immortal-architecture-clean/backend/internal/domain/pagination"
+
+// nextCursor encodes the cursor of the following page, or nil on the last page.
+func nextCursor(page pagination.Info) *string {
+	if page.NextCursor == nil {
+		return nil
+	}
+	s := page.NextCursor.Encode()
+	return &s
+}
@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/session"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SessionPresenter converts issued sessions to OpenAPI responses.
+type SessionPresenter struct {
+	session   *openapi.ModelsAuthSessionResponse
+	loggedOut bool
+}
+
+var _ port.SessionOutputPort = (*SessionPresenter)(nil)
+
+// NewSessionPresenter creates SessionPresenter.
+func NewSessionPresenter() *SessionPresenter {
+	return &SessionPresenter{}
+}
+
+// PresentSession stores the account and token pair.
+func (p *SessionPresenter) PresentSession(_ context.Context, a *account.Account, t *session.Tokens) error {
+	p.session = &openapi.ModelsAuthSessionResponse{
+		Account:               toAccountResponse(a),
+		AccessToken:           t.AccessToken,
+		AccessTokenExpiresAt:  t.AccessTokenExpiresAt,
+		RefreshToken:          t.RefreshToken,
+		RefreshTokenExpiresAt: t.RefreshTokenExpiresAt,
+	}
+	return nil
+}
+
+// PresentLogout marks logout success.
+func (p *SessionPresenter) PresentLogout(_ context.Context) error {
+	p.loggedOut = true
+	return nil
+}
+
+// Session returns the last session response.
+func (p *SessionPresenter) Session() *openapi.ModelsAuthSessionResponse {
+	return p.session
+}
+
+// LogoutResponse returns logout success response.
+func (p *SessionPresenter) LogoutResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.loggedOut}
+}
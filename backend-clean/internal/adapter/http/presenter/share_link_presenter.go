@@ -0,0 +1,135 @@
+package presenter
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareLinkPresenter converts public links and the notes behind them to OpenAPI responses.
+type ShareLinkPresenter struct {
+	link    *openapi.ModelsShareLinkResponse
+	links   openapi.ModelsShareLinkListResponse
+	note    *openapi.ModelsPublicNoteResponse
+	revoked bool
+}
+
+var _ port.ShareLinkOutputPort = (*ShareLinkPresenter)(nil)
+
+// NewShareLinkPresenter creates a ShareLinkPresenter.
+func NewShareLinkPresenter() *ShareLinkPresenter {
+	return &ShareLinkPresenter{}
+}
+
+// PresentShareLink stores a created link together with its token.
+func (p *ShareLinkPresenter) PresentShareLink(_ context.Context, link *sharelink.Link, token string) error {
+	if link == nil {
+		p.link = nil
+		return nil
+	}
+	res := toShareLinkResponse(*link, time.Now())
+	res.Token = &token
+	p.link = &res
+	return nil
+}
+
+// PresentShareLinks stores the list response.
+func (p *ShareLinkPresenter) PresentShareLinks(_ context.Context, links []sharelink.Link) error {
+	now := time.Now()
+	items := make([]openapi.ModelsShareLinkResponse, 0, len(links))
+	for _, l := range links {
+		items = append(items, toShareLinkResponse(l, now))
+	}
+	p.links = openapi.ModelsShareLinkListResponse{Items: items}
+	return nil
+}
+
+// PresentShareLinkRevoked marks revoke success.
+func (p *ShareLinkPresenter) PresentShareLinkRevoked(_ context.Context) error {
+	p.revoked = true
+	return nil
+}
+
+// PresentPublicNote stores the read-only note response.
+func (p *ShareLinkPresenter) PresentPublicNote(_ context.Context, n *note.WithMeta) error {
+	if n == nil {
+		p.note = nil
+		return nil
+	}
+	sections := make([]openapi.ModelsPublicSection, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		sections = append(sections, openapi.ModelsPublicSection{
+			FieldLabel: s.FieldLabel,
+			FieldType:  openapi.ModelsFieldType(s.FieldType),
+			Content:    s.Section.Content,
+		})
+	}
+	p.note = &openapi.ModelsPublicNoteResponse{
+		Id:           n.Note.ID,
+		Title:        n.Note.Title,
+		TemplateName: n.TemplateName,
+		OwnerName:    strings.TrimSpace(n.OwnerFirstName + " " + n.OwnerLastName),
+		Sections:     sections,
+		UpdatedAt:    n.Note.UpdatedAt,
+	}
+	return nil
+}
+
+// Link returns the created link response.
+func (p *ShareLinkPresenter) Link() *openapi.ModelsShareLinkResponse {
+	return p.link
+}
+
+// Links returns the list response.
+func (p *ShareLinkPresenter) Links() openapi.ModelsShareLinkListResponse {
+	return p.links
+}
+
+// RevokeResponse returns revoke success response.
+func (p *ShareLinkPresenter) RevokeResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.revoked}
+}
+
+// PublicNote returns the read-only note response.
+func (p *ShareLinkPresenter) PublicNote() *openapi.ModelsPublicNoteResponse {
+	return p.note
+}
+
+// PublicNoteHTML renders the read-only note as an HTML page.
+func (p *ShareLinkPresenter) PublicNoteHTML() (string, error) {
+	if p.note == nil {
+		return "", nil
+	}
+	sections := make([]standaloneSection, 0, len(p.note.Sections))
+	for _, sec := range p.note.Sections {
+		sections = append(sections, standaloneSection{Label: sec.FieldLabel, Content: sec.Content})
+	}
+	page, err := renderStandaloneNote(standaloneNote{
+		Title: p.note.Title,
+		Details: []standaloneDetail{
+			{Label: "Template", Value: p.note.TemplateName},
+			{Label: "Owner", Value: p.note.OwnerName},
+		},
+		UpdatedAt: &p.note.UpdatedAt,
+		Sections:  sections,
+		NoIndex:   true,
+	})
+	return string(page), err
+}
+
+func toShareLinkResponse(l sharelink.Link, now time.Time) openapi.ModelsShareLinkResponse {
+	return openapi.ModelsShareLinkResponse{
+		Id:        l.ID,
+		NoteId:    l.NoteID,
+		CreatedBy: l.CreatedBy,
+		ExpiresAt: l.ExpiresAt,
+		RevokedAt: l.RevokedAt,
+		Active:    l.IsActive(now),
+		CreatedAt: l.CreatedAt,
+	}
+}
@@ -0,0 +1,75 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SharePresenter converts grants on notes and templates to OpenAPI responses.
+type SharePresenter struct {
+	share   *openapi.ModelsShareResponse
+	shares  openapi.ModelsShareListResponse
+	revoked bool
+}
+
+var _ port.ShareOutputPort = (*SharePresenter)(nil)
+
+// NewSharePresenter creates a SharePresenter.
+func NewSharePresenter() *SharePresenter {
+	return &SharePresenter{}
+}
+
+// PresentShares stores the list response.
+func (p *SharePresenter) PresentShares(_ context.Context, grants []share.Grant) error {
+	items := make([]openapi.ModelsShareResponse, 0, len(grants))
+	for _, g := range grants {
+		items = append(items, toShareResponse(g))
+	}
+	p.shares = openapi.ModelsShareListResponse{Items: items}
+	return nil
+}
+
+// PresentShare stores a single grant response.
+func (p *SharePresenter) PresentShare(_ context.Context, grant *share.Grant) error {
+	if grant == nil {
+		p.share = nil
+		return nil
+	}
+	res := toShareResponse(*grant)
+	p.share = &res
+	return nil
+}
+
+// PresentShareRevoked marks revoke success.
+func (p *SharePresenter) PresentShareRevoked(_ context.Context) error {
+	p.revoked = true
+	return nil
+}
+
+// Shares returns the list response.
+func (p *SharePresenter) Shares() openapi.ModelsShareListResponse {
+	return p.shares
+}
+
+// Share returns the single grant response.
+func (p *SharePresenter) Share() *openapi.ModelsShareResponse {
+	return p.share
+}
+
+// RevokeResponse returns revoke success response.
+func (p *SharePresenter) RevokeResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.revoked}
+}
+
+func toShareResponse(g share.Grant) openapi.ModelsShareResponse {
+	return openapi.ModelsShareResponse{
+		AccountId: g.AccountID,
+		Role:      openapi.ModelsShareRole(g.Role),
+		GrantedBy: g.GrantedBy,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
@@ -0,0 +1,72 @@
+package presenter
+
+import (
+	"bytes"
+	"html/template"
+	"time"
+)
+
+// standaloneNoteHTML renders a note as a page of its own, for public links and HTML exports;
+// html/template escapes all note content.
+var standaloneNoteHTML = template.Must(template.New("standalone-note").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+{{if .NoIndex}}<meta name="robots" content="noindex">
+{{end}}<title>{{.Title}}</title>
+</head>
+<body>
+<article>
+<header>
+<h1>{{.Title}}</h1>
+<dl>
+{{range .Details}}<dt>{{.Label}}</dt><dd>{{.Value}}</dd>
+{{end}}{{with .UpdatedAt}}<dt>Updated</dt><dd><time datetime="{{.Format "2006-01-02T15:04:05Z07:00"}}">{{.Format "2006-01-02"}}</time></dd>
+{{end}}</dl>
+</header>
+{{range .Sections}}<section>
+<h2>{{.Label}}</h2>
+<p style="white-space: pre-wrap">{{.Content}}</p>
+</section>
+{{end}}</article>
+</body>
+</html>
+`))
+
+// standaloneNote is what a standalone note page shows.
+type standaloneNote struct {
+	Title string
+	// Details are listed under the title in order; entries without a value are left out.
+	Details []standaloneDetail
+	// UpdatedAt is shown after the details when set.
+	UpdatedAt *time.Time
+	Sections  []standaloneSection
+	// NoIndex asks search engines not to index the page.
+	NoIndex bool
+}
+
+type standaloneDetail struct {
+	Label string
+	Value string
+}
+
+type standaloneSection struct {
+	Label   string
+	Content string
+}
+
+func renderStandaloneNote(n standaloneNote) ([]byte, error) {
+	details := make([]standaloneDetail, 0, len(n.Details))
+	for _, d := range n.Details {
+		if d.Value != "" {
+			details = append(details, d)
+		}
+	}
+	n.Details = details
+	var buf bytes.Buffer
+	if err := standaloneNoteHTML.Execute(&buf, n); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
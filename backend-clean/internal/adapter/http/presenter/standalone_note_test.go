@@ -0,0 +1,41 @@
+package presenter
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestShareLinkPresenter_PublicNoteHTML(t *testing.T) {
+	updated := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	p := NewShareLinkPresenter()
+	err := p.PresentPublicNote(context.Background(), &note.WithMeta{
+		Note:         note.Note{ID: "note-1", Title: "Weekly <report>", UpdatedAt: updated},
+		TemplateName: "Weekly",
+		Sections: []note.SectionWithField{
+			{Section: note.Section{Content: "Shipped <b>it</b>"}, FieldLabel: "Done"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	page, err := p.PublicNoteHTML()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rest := page
+	for _, want := range []string{`<meta name="robots" content="noindex">`, "<h1>Weekly &lt;report&gt;</h1>", "<dd>Weekly</dd>", `<time datetime="2026-10-17T09:00:00Z">2026-10-17</time>`, "<h2>Done</h2>", "Shipped &lt;b&gt;it&lt;/b&gt;"} {
+		i := strings.Index(rest, want)
+		if i < 0 {
+			t.Fatalf("page missing %q in order:\n%s", want, page)
+		}
+		rest = rest[i+len(want):]
+	}
+	if strings.Contains(page, "<dt>Owner</dt>") {
+		t.Fatalf("owner without a name must be left out:\n%s", page)
+	}
+}
@@ -0,0 +1,133 @@
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// templateExportFiles maps each template export format to its content type and file extension.
+var templateExportFiles = map[openapi.ModelsTemplateExportFormat]struct {
+	contentType string
+	ext         string
+}{
+	openapi.ModelsTemplateExportFormatJson: {contentType: "application/json", ext: "json"},
+	openapi.ModelsTemplateExportFormatYaml: {contentType: "application/yaml", ext: "yaml"},
+}
+
+// TemplateExportPresenter renders a single template as a portable JSON or YAML document.
+// Like NoteExportPresenter it stands in for TemplatePresenter as port.TemplateOutputPort;
+// only PresentTemplate produces a document.
+type TemplateExportPresenter struct {
+	*TemplatePresenter
+	format     openapi.ModelsTemplateExportFormat
+	templateID string
+	body       []byte
+}
+
+var _ port.TemplateOutputPort = (*TemplateExportPresenter)(nil)
+
+// NewTemplateExportPresenter creates a TemplateExportPresenter for one of the formats IsTemplateExportFormat accepts.
+func NewTemplateExportPresenter(format openapi.ModelsTemplateExportFormat) *TemplateExportPresenter {
+	return &TemplateExportPresenter{TemplatePresenter: NewTemplatePresenter(), format: format}
+}
+
+// IsTemplateExportFormat reports whether format is one TemplateExportPresenter can render.
+func IsTemplateExportFormat(format openapi.ModelsTemplateExportFormat) bool {
+	_, ok := templateExportFiles[format]
+	return ok
+}
+
+// PresentTemplate renders the template's portable document in the presenter's format.
+func (p *TemplateExportPresenter) PresentTemplate(ctx context.Context, tpl *template.WithUsage) error {
+	if err := p.TemplatePresenter.PresentTemplate(ctx, tpl); err != nil {
+		return err
+	}
+	p.templateID = tpl.Template.ID
+	body, err := json.MarshalIndent(ToTemplateDocument(template.NewDocument(tpl.Template)), "", "  ")
+	if err != nil {
+		return err
+	}
+	if p.format == openapi.ModelsTemplateExportFormatYaml {
+		body, err = jsonToYAML(body)
+	}
+	p.body = body
+	return err
+}
+
+// Body returns the rendered document.
+func (p *TemplateExportPresenter) Body() []byte {
+	return p.body
+}
+
+// ContentType returns the media type of the rendered document.
+func (p *TemplateExportPresenter) ContentType() string {
+	return templateExportFiles[p.format].contentType
+}
+
+// Filename returns the suggested download name, e.g. template-<id>.yaml.
+func (p *TemplateExportPresenter) Filename() string {
+	return fmt.Sprintf("template-%s.%s", p.templateID, templateExportFiles[p.format].ext)
+}
+
+// ToTemplateDocument converts a portable template document to its OpenAPI form.
+func ToTemplateDocument(d template.Document) openapi.ModelsTemplateDocument {
+	fields := make([]openapi.ModelsTemplateDocumentField, 0, len(d.Fields))
+	for _, f := range d.Fields {
+		fieldType := openapi.ModelsFieldType(f.Type)
+		fields = append(fields, openapi.ModelsTemplateDocumentField{
+			Label:          f.Label,
+			IsRequired:     f.IsRequired,
+			Type:           &fieldType,
+			Options:        optionalOptions(f.Options),
+			MinLength:      optionalInt32(f.MinLength),
+			MaxLength:      optionalInt32(f.MaxLength),
+			Pattern:        strPtrOrNil(f.Pattern),
+			Placeholder:    strPtrOrNil(f.Placeholder),
+			DefaultContent: strPtrOrNil(f.DefaultContent),
+		})
+	}
+	return openapi.ModelsTemplateDocument{Version: int32(d.Version), Name: d.Name, Fields: fields} //nolint:gosec // document format version
+}
+
+// optionalOptions omits options from fields that have none.
+func optionalOptions(options []string) *[]string {
+	if len(options) == 0 {
+		return nil
+	}
+	return &options
+}
+
+// jsonToYAML re-encodes a JSON document as block-style YAML, keeping its key order.
+func jsonToYAML(doc []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(doc, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// blockStyle drops the flow and quoting styles JSON brings, leaving YAML to pick the plainest form.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
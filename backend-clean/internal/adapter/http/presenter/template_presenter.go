@@ -5,6 +5,7 @@ import (
 	"context"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
@@ -12,7 +13,7 @@ import (
 // TemplatePresenter converts template domain models to OpenAPI responses.
 type TemplatePresenter struct {
 	template *openapi.ModelsTemplateResponse
-	list     []openapi.ModelsTemplateResponse
+	list     openapi.ModelsTemplateListResponse
 	deleted  bool
 }
 
@@ -23,13 +24,17 @@ func NewTemplatePresenter() *TemplatePresenter {
 	return &TemplatePresenter{}
 }
 
-// PresentTemplateList stores template list response.
-func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates []template.WithUsage) error {
+// PresentTemplateList stores one page of the template list response.
+func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates []template.WithUsage, page pagination.Info) error {
 	res := make([]openapi.ModelsTemplateResponse, 0, len(templates))
 	for _, t := range templates {
 		res = append(res, toTemplateResponse(t))
 	}
-	p.list = res
+	p.list = openapi.ModelsTemplateListResponse{
+		Items:      res,
+		NextCursor: nextCursor(page),
+		HasMore:    page.HasMore,
+	}
 	return nil
 }
 
@@ -51,8 +56,16 @@ func (p *TemplatePresenter) Template() *openapi.ModelsTemplateResponse {
 	return p.template
 }
 
+// ETag returns the entity tag of the last template response, or "" when none was presented.
+func (p *TemplatePresenter) ETag() string {
+	if p.template == nil {
+		return ""
+	}
+	return etag(p.template.Version)
+}
+
 // Templates returns the template list response.
-func (p *TemplatePresenter) Templates() []openapi.ModelsTemplateResponse {
+func (p *TemplatePresenter) Templates() openapi.ModelsTemplateListResponse {
 	return p.list
 }
 
@@ -65,10 +78,18 @@ func toTemplateResponse(t template.WithUsage) openapi.ModelsTemplateResponse {
 	fields := make([]openapi.ModelsField, 0, len(t.Template.Fields))
 	for _, f := range t.Template.Fields {
 		fields = append(fields, openapi.ModelsField{
-			Id:         f.ID,
-			Label:      f.Label,
-			Order:      int32(f.Order), //nolint:gosec
-			IsRequired: f.IsRequired,
+			Id:             f.ID,
+			Key:            f.Key,
+			Label:          f.Label,
+			Order:          int32(f.Order), //nolint:gosec
+			IsRequired:     f.IsRequired,
+			Type:           openapi.ModelsFieldType(f.Type),
+			Options:        nonNilOptions(f.Options),
+			MinLength:      optionalInt32(f.MinLength),
+			MaxLength:      optionalInt32(f.MaxLength),
+			Pattern:        strPtrOrNil(f.Pattern),
+			Placeholder:    strPtrOrNil(f.Placeholder),
+			DefaultContent: strPtrOrNil(f.DefaultContent),
 		})
 	}
 	return openapi.ModelsTemplateResponse{
@@ -81,8 +102,29 @@ func toTemplateResponse(t template.WithUsage) openapi.ModelsTemplateResponse {
 			LastName:  t.Owner.LastName,
 			Thumbnail: t.Owner.Thumbnail,
 		},
-		Fields:    fields,
-		IsUsed:    t.IsUsed,
-		UpdatedAt: t.Template.UpdatedAt,
+		WorkspaceId:   t.Template.WorkspaceID,
+		Fields:        fields,
+		IsUsed:        t.IsUsed,
+		IsPublic:      t.Template.IsPublic,
+		UpdatedAt:     t.Template.UpdatedAt,
+		Version:       int32(t.Template.Version),       //nolint:gosec
+		SchemaVersion: int32(t.Template.SchemaVersion), //nolint:gosec
+	}
+}
+
+// nonNilOptions keeps options a JSON array even for fields without choices.
+func nonNilOptions(options []string) []string {
+	if options == nil {
+		return []string{}
+	}
+	return options
+}
+
+// optionalInt32 omits an unset (zero) constraint from the response.
+func optionalInt32(v int) *int32 {
+	if v == 0 {
+		return nil
 	}
+	n := int32(v) //nolint:gosec
+	return &n
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/template"
 )
 
@@ -70,12 +71,15 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 					t.Fatalf("UpdatedAt not set")
 				}
 			case "list":
-				err = p.PresentTemplateList(context.Background(), tt.list)
+				err = p.PresentTemplateList(context.Background(), tt.list, pagination.Info{})
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if len(p.Templates()) != tt.wantCount {
-					t.Fatalf("want %d templates, got %d", tt.wantCount, len(p.Templates()))
+				if len(p.Templates().Items) != tt.wantCount {
+					t.Fatalf("want %d templates, got %d", tt.wantCount, len(p.Templates().Items))
+				}
+				if p.Templates().HasMore || p.Templates().NextCursor != nil {
+					t.Fatalf("last page must not advertise a cursor")
 				}
 			}
 		})
@@ -0,0 +1,65 @@
+// Package presenter contains HTTP presenters that implement output ports.
+package presenter
+
+import (
+	"context"
+	"time"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TrashPresenter converts trashed notes and templates to OpenAPI responses.
+type TrashPresenter struct {
+	trash openapi.ModelsTrashResponse
+}
+
+var _ port.TrashOutputPort = (*TrashPresenter)(nil)
+
+// NewTrashPresenter creates a TrashPresenter.
+func NewTrashPresenter() *TrashPresenter {
+	return &TrashPresenter{}
+}
+
+// PresentTrash stores the trash response.
+func (p *TrashPresenter) PresentTrash(_ context.Context, notes []note.WithMeta, templates []template.Template) error {
+	res := openapi.ModelsTrashResponse{
+		Notes:     make([]openapi.ModelsTrashedNoteResponse, 0, len(notes)),
+		Templates: make([]openapi.ModelsTrashedTemplateResponse, 0, len(templates)),
+	}
+	for _, n := range notes {
+		res.Notes = append(res.Notes, openapi.ModelsTrashedNoteResponse{
+			Id:           n.Note.ID,
+			Title:        n.Note.Title,
+			TemplateId:   n.Note.TemplateID,
+			TemplateName: n.TemplateName,
+			Status:       openapi.ModelsNoteStatus(n.Note.Status),
+			Version:      int32(n.Note.Version), //nolint:gosec
+			DeletedAt:    deletedAt(n.Note.DeletedAt),
+		})
+	}
+	for _, t := range templates {
+		res.Templates = append(res.Templates, openapi.ModelsTrashedTemplateResponse{
+			Id:        t.ID,
+			Name:      t.Name,
+			Version:   int32(t.Version), //nolint:gosec
+			DeletedAt: deletedAt(t.DeletedAt),
+		})
+	}
+	p.trash = res
+	return nil
+}
+
+// Trash returns the trash response.
+func (p *TrashPresenter) Trash() openapi.ModelsTrashResponse {
+	return p.trash
+}
+
+func deletedAt(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
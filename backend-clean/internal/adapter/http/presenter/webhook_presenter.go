@@ -0,0 +1,139 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookPresenter converts webhook subscriptions and deliveries to OpenAPI responses.
+// Secrets are never presented.
+type WebhookPresenter struct {
+	webhook    *openapi.ModelsWebhookResponse
+	webhooks   openapi.ModelsWebhookListResponse
+	delivery   *openapi.ModelsWebhookDeliveryResponse
+	deliveries openapi.ModelsWebhookDeliveryListResponse
+	deleted    bool
+}
+
+var _ port.WebhookOutputPort = (*WebhookPresenter)(nil)
+
+// NewWebhookPresenter creates a WebhookPresenter.
+func NewWebhookPresenter() *WebhookPresenter {
+	return &WebhookPresenter{}
+}
+
+// PresentWebhookList stores the list response.
+func (p *WebhookPresenter) PresentWebhookList(_ context.Context, subs []webhook.Subscription) error {
+	items := make([]openapi.ModelsWebhookResponse, 0, len(subs))
+	for _, s := range subs {
+		items = append(items, toWebhookResponse(s))
+	}
+	p.webhooks = openapi.ModelsWebhookListResponse{Items: items}
+	return nil
+}
+
+// PresentWebhook stores a single subscription response.
+func (p *WebhookPresenter) PresentWebhook(_ context.Context, sub *webhook.Subscription) error {
+	if sub == nil {
+		p.webhook = nil
+		return nil
+	}
+	res := toWebhookResponse(*sub)
+	p.webhook = &res
+	return nil
+}
+
+// PresentWebhookDeleted marks delete success.
+func (p *WebhookPresenter) PresentWebhookDeleted(_ context.Context) error {
+	p.deleted = true
+	return nil
+}
+
+// PresentDeliveryList stores the delivery log response.
+func (p *WebhookPresenter) PresentDeliveryList(_ context.Context, deliveries []webhook.Delivery) error {
+	items := make([]openapi.ModelsWebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, toWebhookDeliveryResponse(d))
+	}
+	p.deliveries = openapi.ModelsWebhookDeliveryListResponse{Items: items}
+	return nil
+}
+
+// PresentDelivery stores a single delivery response.
+func (p *WebhookPresenter) PresentDelivery(_ context.Context, delivery *webhook.Delivery) error {
+	if delivery == nil {
+		p.delivery = nil
+		return nil
+	}
+	res := toWebhookDeliveryResponse(*delivery)
+	p.delivery = &res
+	return nil
+}
+
+// Webhooks returns the list response.
+func (p *WebhookPresenter) Webhooks() openapi.ModelsWebhookListResponse {
+	return p.webhooks
+}
+
+// Webhook returns the last presented subscription.
+func (p *WebhookPresenter) Webhook() *openapi.ModelsWebhookResponse {
+	return p.webhook
+}
+
+// Deliveries returns the delivery log response.
+func (p *WebhookPresenter) Deliveries() openapi.ModelsWebhookDeliveryListResponse {
+	return p.deliveries
+}
+
+// Delivery returns the last presented delivery.
+func (p *WebhookPresenter) Delivery() *openapi.ModelsWebhookDeliveryResponse {
+	return p.delivery
+}
+
+// DeleteResponse returns success response.
+func (p *WebhookPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deleted}
+}
+
+func toWebhookResponse(s webhook.Subscription) openapi.ModelsWebhookResponse {
+	events := make([]openapi.ModelsWebhookEventType, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		events = append(events, openapi.ModelsWebhookEventType(t))
+	}
+	return openapi.ModelsWebhookResponse{
+		Id:         s.ID,
+		Url:        s.URL,
+		EventTypes: events,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(d webhook.Delivery) openapi.ModelsWebhookDeliveryResponse {
+	res := openapi.ModelsWebhookDeliveryResponse{
+		Id:            d.ID,
+		WebhookId:     d.SubscriptionID,
+		EventId:       d.EventID,
+		EventType:     openapi.ModelsWebhookEventType(d.EventType),
+		Status:        openapi.ModelsWebhookDeliveryStatus(d.Status),
+		Attempts:      int32(d.Attempts), //nolint:gosec
+		NextAttemptAt: d.NextAttemptAt,
+		DeliveredAt:   d.DeliveredAt,
+		FailedAt:      d.FailedAt,
+		CreatedAt:     d.CreatedAt,
+	}
+	if d.ResponseStatus != 0 {
+		status := int32(d.ResponseStatus) //nolint:gosec
+		res.ResponseStatus = &status
+	}
+	if d.LastError != "" {
+		res.LastError = &d.LastError
+	}
+	if d.ReplayOf != "" {
+		res.ReplayOf = &d.ReplayOf
+	}
+	return res
+}
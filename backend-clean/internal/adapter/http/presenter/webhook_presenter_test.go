@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+func TestWebhookPresenter_Delivery(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		delivery      webhook.Delivery
+		wantStatus    *int32
+		wantLastError *string
+		wantReplayOf  *string
+	}{
+		{
+			name:     "[Success] pending delivery omits optional fields",
+			delivery: webhook.Delivery{ID: "d-1", SubscriptionID: "wh-1", Status: webhook.DeliveryPending, NextAttemptAt: now},
+		},
+		{
+			name: "[Success] failed replay carries response details",
+			delivery: webhook.Delivery{
+				ID: "d-2", SubscriptionID: "wh-1", Status: webhook.DeliveryFailed, Attempts: 8,
+				ResponseStatus: 500, LastError: "status 500", ReplayOf: "d-1", FailedAt: &now,
+			},
+			wantStatus:    ptr(int32(500)),
+			wantLastError: ptr("status 500"),
+			wantReplayOf:  ptr("d-1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewWebhookPresenter()
+			if err := p.PresentDelivery(context.Background(), &tt.delivery); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := p.Delivery()
+			if got.Id != tt.delivery.ID || got.WebhookId != tt.delivery.SubscriptionID || string(got.Status) != string(tt.delivery.Status) {
+				t.Fatalf("unexpected delivery: %+v", got)
+			}
+			if !equalPtr(got.ResponseStatus, tt.wantStatus) || !equalPtr(got.LastError, tt.wantLastError) || !equalPtr(got.ReplayOf, tt.wantReplayOf) {
+				t.Fatalf("optional fields = %v %v %v", got.ResponseStatus, got.LastError, got.ReplayOf)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
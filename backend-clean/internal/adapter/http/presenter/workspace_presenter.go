@@ -0,0 +1,137 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspacePresenter converts workspaces, members and invitations to OpenAPI responses.
+type WorkspacePresenter struct {
+	workspace   *openapi.ModelsWorkspaceResponse
+	workspaces  openapi.ModelsWorkspaceListResponse
+	members     openapi.ModelsWorkspaceMemberListResponse
+	invitation  *openapi.ModelsWorkspaceInvitationResponse
+	invitations openapi.ModelsWorkspaceInvitationListResponse
+	removed     bool
+}
+
+var _ port.WorkspaceOutputPort = (*WorkspacePresenter)(nil)
+
+// NewWorkspacePresenter creates a WorkspacePresenter.
+func NewWorkspacePresenter() *WorkspacePresenter {
+	return &WorkspacePresenter{}
+}
+
+// PresentWorkspaces stores the list response.
+func (p *WorkspacePresenter) PresentWorkspaces(_ context.Context, list []workspace.WithRole) error {
+	items := make([]openapi.ModelsWorkspaceResponse, 0, len(list))
+	for _, ws := range list {
+		items = append(items, toWorkspaceResponse(ws))
+	}
+	p.workspaces = openapi.ModelsWorkspaceListResponse{Items: items}
+	return nil
+}
+
+// PresentWorkspace stores a single workspace response.
+func (p *WorkspacePresenter) PresentWorkspace(_ context.Context, ws workspace.WithRole) error {
+	res := toWorkspaceResponse(ws)
+	p.workspace = &res
+	return nil
+}
+
+// PresentMembers stores the member list response.
+func (p *WorkspacePresenter) PresentMembers(_ context.Context, members []workspace.Member) error {
+	items := make([]openapi.ModelsWorkspaceMemberResponse, 0, len(members))
+	for _, m := range members {
+		items = append(items, openapi.ModelsWorkspaceMemberResponse{
+			AccountId: m.AccountID,
+			Role:      openapi.ModelsWorkspaceRole(m.Role),
+			JoinedAt:  m.JoinedAt,
+		})
+	}
+	p.members = openapi.ModelsWorkspaceMemberListResponse{Items: items}
+	return nil
+}
+
+// PresentInvitation stores a single invitation response.
+func (p *WorkspacePresenter) PresentInvitation(_ context.Context, inv *workspace.Invitation) error {
+	if inv == nil {
+		p.invitation = nil
+		return nil
+	}
+	res := toInvitationResponse(*inv)
+	p.invitation = &res
+	return nil
+}
+
+// PresentInvitations stores the invitation list response.
+func (p *WorkspacePresenter) PresentInvitations(_ context.Context, invs []workspace.Invitation) error {
+	items := make([]openapi.ModelsWorkspaceInvitationResponse, 0, len(invs))
+	for _, inv := range invs {
+		items = append(items, toInvitationResponse(inv))
+	}
+	p.invitations = openapi.ModelsWorkspaceInvitationListResponse{Items: items}
+	return nil
+}
+
+// PresentMemberRemoved marks remove success.
+func (p *WorkspacePresenter) PresentMemberRemoved(_ context.Context) error {
+	p.removed = true
+	return nil
+}
+
+// Workspaces returns the list response.
+func (p *WorkspacePresenter) Workspaces() openapi.ModelsWorkspaceListResponse {
+	return p.workspaces
+}
+
+// Workspace returns the single workspace response.
+func (p *WorkspacePresenter) Workspace() *openapi.ModelsWorkspaceResponse {
+	return p.workspace
+}
+
+// Members returns the member list response.
+func (p *WorkspacePresenter) Members() openapi.ModelsWorkspaceMemberListResponse {
+	return p.members
+}
+
+// Invitation returns the single invitation response.
+func (p *WorkspacePresenter) Invitation() *openapi.ModelsWorkspaceInvitationResponse {
+	return p.invitation
+}
+
+// Invitations returns the invitation list response.
+func (p *WorkspacePresenter) Invitations() openapi.ModelsWorkspaceInvitationListResponse {
+	return p.invitations
+}
+
+// RemoveResponse returns remove success response.
+func (p *WorkspacePresenter) RemoveResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.removed}
+}
+
+func toWorkspaceResponse(ws workspace.WithRole) openapi.ModelsWorkspaceResponse {
+	return openapi.ModelsWorkspaceResponse{
+		Id:        ws.Workspace.ID,
+		Name:      ws.Workspace.Name,
+		OwnerId:   ws.Workspace.OwnerID,
+		Personal:  ws.Workspace.Personal,
+		Role:      openapi.ModelsWorkspaceRole(ws.Role),
+		CreatedAt: ws.Workspace.CreatedAt,
+		UpdatedAt: ws.Workspace.UpdatedAt,
+	}
+}
+
+func toInvitationResponse(inv workspace.Invitation) openapi.ModelsWorkspaceInvitationResponse {
+	return openapi.ModelsWorkspaceInvitationResponse{
+		Id:          inv.ID,
+		WorkspaceId: inv.WorkspaceID,
+		Email:       inv.Email,
+		Role:        openapi.ModelsWorkspaceRole(inv.Role),
+		InvitedBy:   inv.InvitedBy,
+		CreatedAt:   inv.CreatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/adapter/job/presenter"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchiveController handles account export and import job execution.
+type ArchiveController struct {
+	inputFactory       func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort
+	outputFactory      func() *presenter.ArchivePresenter
+	tplRepoFactory     func() port.TemplateRepository
+	noteRepoFactory    func() port.NoteRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+}
+
+// NewArchiveController creates a new ArchiveController.
+func NewArchiveController(
+	inputFactory func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort,
+	outputFactory func() *presenter.ArchivePresenter,
+	tplRepoFactory func() port.TemplateRepository,
+	noteRepoFactory func() port.NoteRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *ArchiveController {
+	return &ArchiveController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		tplRepoFactory:     tplRepoFactory,
+		noteRepoFactory:    noteRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// Export writes the archive of accountID to w and returns the number of exported templates and notes.
+func (c *ArchiveController) Export(ctx context.Context, accountID string, w io.Writer) (int, int, error) {
+	log.Printf("starting account export: %s", accountID)
+
+	p := c.outputFactory()
+	interactor := c.newInput(p)
+
+	if err := interactor.Export(ctx, accountID); err != nil {
+		return 0, 0, err
+	}
+	if err := archive.Encode(w, *p.Archive()); err != nil {
+		return 0, 0, err
+	}
+
+	return p.Templates(), p.Notes(), nil
+}
+
+// Import recreates the archive read from r under accountID and returns the number of created templates and notes.
+func (c *ArchiveController) Import(ctx context.Context, accountID string, r io.Reader) (int, int, error) {
+	log.Printf("starting account import: %s", accountID)
+
+	a, err := archive.Decode(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	p := c.outputFactory()
+	interactor := c.newInput(p)
+
+	if err := interactor.Import(ctx, port.ArchiveImportInput{Archive: *a, OwnerID: accountID}); err != nil {
+		return 0, 0, err
+	}
+
+	return p.Templates(), p.Notes(), nil
+}
+
+func (c *ArchiveController) newInput(p *presenter.ArchivePresenter) port.ArchiveInputPort {
+	return c.inputFactory(c.tplRepoFactory(), c.noteRepoFactory(), c.accountRepoFactory(), c.txFactory(), p)
+}
@@ -0,0 +1,48 @@
+// Package controller contains job controllers.
+package controller
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/job/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// PurgeController handles trash purge job execution.
+type PurgeController struct {
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.PurgeJobOutputPort) port.PurgeJobInputPort
+	outputFactory   func() *presenter.PurgePresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+}
+
+// NewPurgeController creates a new PurgeController.
+func NewPurgeController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.PurgeJobOutputPort) port.PurgeJobInputPort,
+	outputFactory func() *presenter.PurgePresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+) *PurgeController {
+	return &PurgeController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		tplRepoFactory:  tplRepoFactory,
+	}
+}
+
+// Run executes the purge job and returns the number of purged notes and templates.
+func (c *PurgeController) Run(ctx context.Context, retention time.Duration) (int, int, error) {
+	log.Printf("starting trash purge job (retention %v, 0 = default)", retention)
+
+	p := c.outputFactory()
+	interactor := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), p)
+
+	if err := interactor.Execute(ctx, retention); err != nil {
+		return 0, 0, err
+	}
+
+	return p.PurgedNotes(), p.PurgedTemplates(), nil
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/job/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+const (
+	// DefaultRelayBatchSize is how many events the relay claims per batch when not configured.
+	DefaultRelayBatchSize = 100
+	// DefaultRelayPollInterval is how long the relay idles after a partial batch when not configured.
+	DefaultRelayPollInterval = time.Second
+)
+
+// RelayController runs the outbox relay until its context is cancelled.
+type RelayController struct {
+	inputFactory  func(repo port.OutboxRepository, sink port.EventSink, output port.OutboxRelayOutputPort) port.OutboxRelayInputPort
+	outputFactory func() *presenter.RelayPresenter
+	repoFactory   func() port.OutboxRepository
+	sink          port.EventSink
+}
+
+// NewRelayController creates a new RelayController delivering to sink.
+func NewRelayController(
+	inputFactory func(repo port.OutboxRepository, sink port.EventSink, output port.OutboxRelayOutputPort) port.OutboxRelayInputPort,
+	outputFactory func() *presenter.RelayPresenter,
+	repoFactory func() port.OutboxRepository,
+	sink port.EventSink,
+) *RelayController {
+	return &RelayController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+		sink:          sink,
+	}
+}
+
+// Run relays batches back to back while they come back full, and polls every
+// pollInterval otherwise. Batch errors are logged and retried after pollInterval.
+// It returns once ctx is done.
+func (c *RelayController) Run(ctx context.Context, batchSize int, pollInterval time.Duration) {
+	if batchSize <= 0 {
+		batchSize = DefaultRelayBatchSize
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultRelayPollInterval
+	}
+	log.Printf("starting outbox relay (batch %d, poll %v)", batchSize, pollInterval)
+
+	poll(ctx, "outbox relay", pollInterval, func() (bool, error) {
+		p := c.outputFactory()
+		interactor := c.inputFactory(c.repoFactory(), c.sink, p)
+		if err := interactor.Execute(ctx, batchSize); err != nil {
+			return false, err
+		}
+		return p.Result().Total() >= batchSize, nil
+	})
+}
+
+// poll runs batch back to back while it reports a full batch, and every
+// pollInterval otherwise. Batch errors are logged under name and retried after
+// pollInterval. It returns once ctx is done.
+func poll(ctx context.Context, name string, pollInterval time.Duration, batch func() (full bool, err error)) {
+	for {
+		full, err := batch()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("%s batch failed: %v", name, err)
+		} else if full {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
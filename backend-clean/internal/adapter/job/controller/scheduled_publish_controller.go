@@ -0,0 +1,44 @@
+// Package controller contains job controllers.
+package controller
+
+import (
+	"context"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/adapter/job/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ScheduledPublishController handles scheduled publishing job execution.
+type ScheduledPublishController struct {
+	inputFactory    func(noteRepo port.NoteRepository, output port.ScheduledPublishJobOutputPort) port.ScheduledPublishJobInputPort
+	outputFactory   func() *presenter.ScheduledPublishPresenter
+	noteRepoFactory func() port.NoteRepository
+}
+
+// NewScheduledPublishController creates a new ScheduledPublishController.
+func NewScheduledPublishController(
+	inputFactory func(noteRepo port.NoteRepository, output port.ScheduledPublishJobOutputPort) port.ScheduledPublishJobInputPort,
+	outputFactory func() *presenter.ScheduledPublishPresenter,
+	noteRepoFactory func() port.NoteRepository,
+) *ScheduledPublishController {
+	return &ScheduledPublishController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+	}
+}
+
+// Run executes the scheduled publishing job and returns the number of published and unpublished notes.
+func (c *ScheduledPublishController) Run(ctx context.Context) (int, int, error) {
+	log.Println("starting scheduled publishing job")
+
+	p := c.outputFactory()
+	interactor := c.inputFactory(c.noteRepoFactory(), p)
+
+	if err := interactor.Execute(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	return p.Published(), p.Unpublished(), nil
+}
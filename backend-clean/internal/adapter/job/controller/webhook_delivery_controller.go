@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/job/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookDeliveryController sends pending webhook deliveries until its context is cancelled.
+type WebhookDeliveryController struct {
+	inputFactory  func(repo port.WebhookDeliveryRepository, sender port.WebhookSender, output port.WebhookDeliveryOutputPort) port.WebhookDeliveryInputPort
+	outputFactory func() *presenter.WebhookDeliveryPresenter
+	repoFactory   func() port.WebhookDeliveryRepository
+	sender        port.WebhookSender
+}
+
+// NewWebhookDeliveryController creates a new WebhookDeliveryController posting with sender.
+func NewWebhookDeliveryController(
+	inputFactory func(repo port.WebhookDeliveryRepository, sender port.WebhookSender, output port.WebhookDeliveryOutputPort) port.WebhookDeliveryInputPort,
+	outputFactory func() *presenter.WebhookDeliveryPresenter,
+	repoFactory func() port.WebhookDeliveryRepository,
+	sender port.WebhookSender,
+) *WebhookDeliveryController {
+	return &WebhookDeliveryController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+		sender:        sender,
+	}
+}
+
+// Run sends deliveries with the same batching and polling as RelayController.Run.
+func (c *WebhookDeliveryController) Run(ctx context.Context, batchSize int, pollInterval time.Duration) {
+	if batchSize <= 0 {
+		batchSize = DefaultRelayBatchSize
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultRelayPollInterval
+	}
+	log.Printf("starting webhook delivery (batch %d, poll %v)", batchSize, pollInterval)
+
+	poll(ctx, "webhook delivery", pollInterval, func() (bool, error) {
+		p := c.outputFactory()
+		interactor := c.inputFactory(c.repoFactory(), c.sender, p)
+		if err := interactor.Execute(ctx, batchSize); err != nil {
+			return false, err
+		}
+		return p.Result().Total() >= batchSize, nil
+	})
+}
@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"context"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchivePresenter outputs account export and import job results.
+type ArchivePresenter struct {
+	archive   *archive.Archive
+	templates int
+	notes     int
+}
+
+var _ port.ArchiveOutputPort = (*ArchivePresenter)(nil)
+
+// NewArchivePresenter creates a new ArchivePresenter.
+func NewArchivePresenter() *ArchivePresenter {
+	return &ArchivePresenter{}
+}
+
+// PresentArchive logs and stores the exported archive.
+func (p *ArchivePresenter) PresentArchive(_ context.Context, a *archive.Archive) error {
+	p.archive = a
+	p.templates = len(a.Templates)
+	p.notes = len(a.Notes)
+	log.Printf("exported %d templates and %d notes of account %s", p.templates, p.notes, a.AccountID)
+	return nil
+}
+
+// PresentArchiveImported logs and stores the import result.
+func (p *ArchivePresenter) PresentArchiveImported(_ context.Context, templates, notes int) error {
+	p.templates = templates
+	p.notes = notes
+	log.Printf("imported %d templates and %d notes", templates, notes)
+	return nil
+}
+
+// Archive returns the exported archive.
+func (p *ArchivePresenter) Archive() *archive.Archive {
+	return p.archive
+}
+
+// Templates returns the number of templates exported or imported.
+func (p *ArchivePresenter) Templates() int {
+	return p.templates
+}
+
+// Notes returns the number of notes exported or imported.
+func (p *ArchivePresenter) Notes() int {
+	return p.notes
+}
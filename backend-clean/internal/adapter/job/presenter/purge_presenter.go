@@ -0,0 +1,40 @@
+// Package presenter contains job presenters that implement output ports.
+package presenter
+
+import (
+	"context"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// PurgePresenter outputs trash purge job results.
+type PurgePresenter struct {
+	purgedNotes     int
+	purgedTemplates int
+}
+
+var _ port.PurgeJobOutputPort = (*PurgePresenter)(nil)
+
+// NewPurgePresenter creates a new PurgePresenter.
+func NewPurgePresenter() *PurgePresenter {
+	return &PurgePresenter{}
+}
+
+// PresentResult logs and stores the purge result.
+func (p *PurgePresenter) PresentResult(_ context.Context, purgedNotes, purgedTemplates int) error {
+	p.purgedNotes = purgedNotes
+	p.purgedTemplates = purgedTemplates
+	log.Printf("purged %d notes and %d templates from the trash", purgedNotes, purgedTemplates)
+	return nil
+}
+
+// PurgedNotes returns the number of purged notes.
+func (p *PurgePresenter) PurgedNotes() int {
+	return p.purgedNotes
+}
+
+// PurgedTemplates returns the number of purged templates.
+func (p *PurgePresenter) PurgedTemplates() int {
+	return p.purgedTemplates
+}
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"context"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// RelayPresenter outputs outbox relay batch results.
+type RelayPresenter struct {
+	result outbox.RelayResult
+}
+
+var _ port.OutboxRelayOutputPort = (*RelayPresenter)(nil)
+
+// NewRelayPresenter creates a new RelayPresenter.
+func NewRelayPresenter() *RelayPresenter {
+	return &RelayPresenter{}
+}
+
+// PresentResult stores the batch result and logs it unless the batch was empty.
+func (p *RelayPresenter) PresentResult(_ context.Context, result outbox.RelayResult) error {
+	p.result = result
+	if result.Total() > 0 {
+		log.Printf("relayed outbox events: %d delivered, %d retried, %d failed", result.Delivered, result.Retried, result.Failed)
+	}
+	return nil
+}
+
+// Result returns the last batch result.
+func (p *RelayPresenter) Result() outbox.RelayResult {
+	return p.result
+}
@@ -0,0 +1,40 @@
+// Package presenter contains job presenters that implement output ports.
+package presenter
+
+import (
+	"context"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ScheduledPublishPresenter outputs scheduled publishing job results.
+type ScheduledPublishPresenter struct {
+	published   int
+	unpublished int
+}
+
+var _ port.ScheduledPublishJobOutputPort = (*ScheduledPublishPresenter)(nil)
+
+// NewScheduledPublishPresenter creates a new ScheduledPublishPresenter.
+func NewScheduledPublishPresenter() *ScheduledPublishPresenter {
+	return &ScheduledPublishPresenter{}
+}
+
+// PresentResult logs and stores the scheduled publishing result.
+func (p *ScheduledPublishPresenter) PresentResult(_ context.Context, published, unpublished int) error {
+	p.published = published
+	p.unpublished = unpublished
+	log.Printf("published %d scheduled notes and unpublished %d notes", published, unpublished)
+	return nil
+}
+
+// Published returns the number of notes published.
+func (p *ScheduledPublishPresenter) Published() int {
+	return p.published
+}
+
+// Unpublished returns the number of notes unpublished.
+func (p *ScheduledPublishPresenter) Unpublished() int {
+	return p.unpublished
+}
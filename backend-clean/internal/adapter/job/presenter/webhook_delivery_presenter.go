@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"context"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookDeliveryPresenter outputs webhook delivery batch results.
+type WebhookDeliveryPresenter struct {
+	result outbox.RelayResult
+}
+
+var _ port.WebhookDeliveryOutputPort = (*WebhookDeliveryPresenter)(nil)
+
+// NewWebhookDeliveryPresenter creates a new WebhookDeliveryPresenter.
+func NewWebhookDeliveryPresenter() *WebhookDeliveryPresenter {
+	return &WebhookDeliveryPresenter{}
+}
+
+// PresentResult stores the batch result and logs it unless the batch was empty.
+func (p *WebhookDeliveryPresenter) PresentResult(_ context.Context, result outbox.RelayResult) error {
+	p.result = result
+	if result.Total() > 0 {
+		log.Printf("sent webhook deliveries: %d succeeded, %d retried, %d failed", result.Delivered, result.Retried, result.Failed)
+	}
+	return nil
+}
+
+// Result returns the last batch result.
+func (p *WebhookDeliveryPresenter) Result() outbox.RelayResult {
+	return p.result
+}
@@ -26,4 +26,6 @@ type OAuthAccountInput struct {
 	Provider          string
 	ProviderAccountID string
 	Thumbnail         *string
+	// Reactivate lets a deactivated account log in again.
+	Reactivate bool
 }
@@ -28,6 +28,27 @@ func Validate(a Account) error {
 	return nil
 }
 
+// EnsureActive rejects accounts that have been deactivated.
+func EnsureActive(a Account) error {
+	// ルール: 無効化されたアカウントは操作できない
+	if !a.IsActive {
+		return domainerr.ErrAccountInactive
+	}
+	return nil
+}
+
+// NeedsReactivation reports whether logging in must reactivate the account first.
+func NeedsReactivation(a Account, requested bool) (bool, error) {
+	if a.IsActive {
+		return false, nil
+	}
+	// ルール: 無効化されたアカウントは明示的に再有効化を求めた場合のみログインできる
+	if !requested {
+		return false, domainerr.ErrAccountInactive
+	}
+	return true, nil
+}
+
 // UpdateProfile merges latest profile info on login.
 func UpdateProfile(current Account, input OAuthAccountInput) (Account, error) {
 	email, err := ParseEmail(input.Email)
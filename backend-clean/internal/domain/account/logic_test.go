@@ -150,3 +150,49 @@ func TestUpdateProfile(t *testing.T) {
 }
 
 func ptr[T any](v T) *T { return &v }
+
+func TestEnsureActive(t *testing.T) {
+	tests := []struct {
+		name      string
+		acc       Account
+		wantError error
+	}{
+		{name: "[Success] active account", acc: Account{IsActive: true}},
+		{name: "[Fail] inactive account", acc: Account{IsActive: false}, wantError: domainerr.ErrAccountInactive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := EnsureActive(tt.acc); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNeedsReactivation(t *testing.T) {
+	tests := []struct {
+		name      string
+		acc       Account
+		requested bool
+		want      bool
+		wantError error
+	}{
+		{name: "[Success] active account logs in", acc: Account{IsActive: true}},
+		{name: "[Success] active account ignores request", acc: Account{IsActive: true}, requested: true},
+		{name: "[Success] inactive account reactivates on request", acc: Account{IsActive: false}, requested: true, want: true},
+		{name: "[Fail] inactive account without request", acc: Account{IsActive: false}, wantError: domainerr.ErrAccountInactive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NeedsReactivation(tt.acc, tt.requested)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if got != tt.want {
+				t.Fatalf("NeedsReactivation = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
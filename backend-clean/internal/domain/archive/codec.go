@@ -0,0 +1,266 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// MediaType is the media type of an encoded archive.
+const MediaType = "application/x-ndjson"
+
+// maxLineSize bounds one encoded record; sections hold the longest content.
+const maxLineSize = 16 << 20
+
+// Record types. Every line of an archive is one JSON object whose "type" is one of these.
+const (
+	recordArchive  = "archive"
+	recordTemplate = "template"
+	recordField    = "field"
+	recordNote     = "note"
+	recordSection  = "section"
+)
+
+type recordType struct {
+	Type string `json:"type"`
+}
+
+type archiveRecord struct {
+	Type       string    `json:"type"`
+	Version    int       `json:"version"`
+	AccountID  string    `json:"accountId"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+type templateRecord struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type fieldRecord struct {
+	Type           string             `json:"type"`
+	TemplateID     string             `json:"templateId"`
+	ID             string             `json:"id"`
+	Key            string             `json:"key"`
+	Label          string             `json:"label"`
+	Order          int                `json:"order"`
+	IsRequired     bool               `json:"isRequired"`
+	FieldType      template.FieldType `json:"fieldType"`
+	Options        []string           `json:"options,omitempty"`
+	MinLength      int                `json:"minLength,omitempty"`
+	MaxLength      int                `json:"maxLength,omitempty"`
+	Pattern        string             `json:"pattern,omitempty"`
+	Placeholder    string             `json:"placeholder,omitempty"`
+	DefaultContent string             `json:"defaultContent,omitempty"`
+}
+
+type noteRecord struct {
+	Type       string          `json:"type"`
+	ID         string          `json:"id"`
+	TemplateID string          `json:"templateId"`
+	Title      string          `json:"title"`
+	Status     note.NoteStatus `json:"status"`
+}
+
+type sectionRecord struct {
+	Type     string `json:"type"`
+	NoteID   string `json:"noteId"`
+	ID       string `json:"id"`
+	FieldID  string `json:"fieldId"`
+	FieldKey string `json:"fieldKey"`
+	Content  string `json:"content"`
+}
+
+// Encode writes a as JSON Lines: an archive line carrying the format version, then each
+// template followed by its fields, then each note followed by its sections.
+func Encode(w io.Writer, a Archive) error {
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(archiveRecord{Type: recordArchive, Version: a.Version, AccountID: a.AccountID, ExportedAt: a.ExportedAt.UTC()}); err != nil {
+		return err
+	}
+	for _, t := range a.Templates {
+		if err := enc.Encode(templateRecord{Type: recordTemplate, ID: t.ID, Name: t.Name}); err != nil {
+			return err
+		}
+		for _, f := range t.Fields {
+			if err := enc.Encode(fieldRecord{
+				Type:           recordField,
+				TemplateID:     t.ID,
+				ID:             f.ID,
+				Key:            f.Key,
+				Label:          f.Label,
+				Order:          f.Order,
+				IsRequired:     f.IsRequired,
+				FieldType:      f.Type,
+				Options:        f.Options,
+				MinLength:      f.MinLength,
+				MaxLength:      f.MaxLength,
+				Pattern:        f.Pattern,
+				Placeholder:    f.Placeholder,
+				DefaultContent: f.DefaultContent,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, n := range a.Notes {
+		if err := enc.Encode(noteRecord{Type: recordNote, ID: n.ID, TemplateID: n.TemplateID, Title: n.Title, Status: n.Status}); err != nil {
+			return err
+		}
+		for _, s := range n.Sections {
+			if err := enc.Encode(sectionRecord{Type: recordSection, NoteID: n.ID, ID: s.ID, FieldID: s.FieldID, FieldKey: s.FieldKey, Content: s.Content}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Decode reads an archive written by Encode. The archive line must come first and
+// fields and sections must follow the template or note they belong to.
+// Lines that break this are reported with their line number as errors.ErrArchiveMalformed;
+// archives of another format version are errors.ErrArchiveVersionUnsupported.
+func Decode(r io.Reader) (*Archive, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	d := &decoder{templates: map[string]int{}, notes: map[string]int{}}
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := d.decodeLine(scanner.Bytes()); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", line+1, malformed(err.Error()))
+	}
+	if d.archive == nil {
+		return nil, malformed("archive is empty")
+	}
+	return d.archive, nil
+}
+
+// decoder builds an archive line by line, indexing templates and notes by their archived IDs.
+type decoder struct {
+	archive   *Archive
+	templates map[string]int
+	notes     map[string]int
+}
+
+func (d *decoder) decodeLine(raw []byte) error {
+	var rt recordType
+	if err := json.Unmarshal(raw, &rt); err != nil {
+		return malformed(err.Error())
+	}
+	if d.archive == nil && rt.Type != recordArchive {
+		return malformed("archive record must come first")
+	}
+	switch rt.Type {
+	case recordArchive:
+		return d.decodeArchive(raw)
+	case recordTemplate:
+		return d.decodeTemplate(raw)
+	case recordField:
+		return d.decodeField(raw)
+	case recordNote:
+		return d.decodeNote(raw)
+	case recordSection:
+		return d.decodeSection(raw)
+	default:
+		return malformed(fmt.Sprintf("unknown record type %q", rt.Type))
+	}
+}
+
+func (d *decoder) decodeArchive(raw []byte) error {
+	if d.archive != nil {
+		return malformed("duplicate archive record")
+	}
+	var rec archiveRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return malformed(err.Error())
+	}
+	if rec.Version != Version {
+		return fmt.Errorf("version %d: %w", rec.Version, domainerr.ErrArchiveVersionUnsupported)
+	}
+	d.archive = &Archive{Version: rec.Version, AccountID: rec.AccountID, ExportedAt: rec.ExportedAt}
+	return nil
+}
+
+func (d *decoder) decodeTemplate(raw []byte) error {
+	var rec templateRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return malformed(err.Error())
+	}
+	if _, dup := d.templates[rec.ID]; dup || rec.ID == "" {
+		return malformed("template id missing or repeated")
+	}
+	d.templates[rec.ID] = len(d.archive.Templates)
+	d.archive.Templates = append(d.archive.Templates, template.Template{ID: rec.ID, Name: rec.Name})
+	return nil
+}
+
+func (d *decoder) decodeField(raw []byte) error {
+	var rec fieldRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return malformed(err.Error())
+	}
+	i, ok := d.templates[rec.TemplateID]
+	if !ok {
+		return malformed("field of unknown template")
+	}
+	d.archive.Templates[i].Fields = append(d.archive.Templates[i].Fields, template.Field{
+		ID:             rec.ID,
+		Key:            rec.Key,
+		Label:          rec.Label,
+		Order:          rec.Order,
+		IsRequired:     rec.IsRequired,
+		Type:           rec.FieldType,
+		Options:        rec.Options,
+		MinLength:      rec.MinLength,
+		MaxLength:      rec.MaxLength,
+		Pattern:        rec.Pattern,
+		Placeholder:    rec.Placeholder,
+		DefaultContent: rec.DefaultContent,
+	})
+	return nil
+}
+
+func (d *decoder) decodeNote(raw []byte) error {
+	var rec noteRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return malformed(err.Error())
+	}
+	if _, dup := d.notes[rec.ID]; dup || rec.ID == "" {
+		return malformed("note id missing or repeated")
+	}
+	d.notes[rec.ID] = len(d.archive.Notes)
+	d.archive.Notes = append(d.archive.Notes, Note{ID: rec.ID, TemplateID: rec.TemplateID, Title: rec.Title, Status: rec.Status})
+	return nil
+}
+
+func (d *decoder) decodeSection(raw []byte) error {
+	var rec sectionRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return malformed(err.Error())
+	}
+	i, ok := d.notes[rec.NoteID]
+	if !ok {
+		return malformed("section of unknown note")
+	}
+	d.archive.Notes[i].Sections = append(d.archive.Notes[i].Sections, Section{ID: rec.ID, FieldID: rec.FieldID, FieldKey: rec.FieldKey, Content: rec.Content})
+	return nil
+}
+
+func malformed(reason string) error {
+	return fmt.Errorf("%w: %s", domainerr.ErrArchiveMalformed, reason)
+}
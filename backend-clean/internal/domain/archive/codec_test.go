@@ -0,0 +1,86 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	a := Archive{
+		Version:    Version,
+		AccountID:  "acc-1",
+		ExportedAt: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+		Templates: []template.Template{{
+			ID:   "tpl-1",
+			Name: "Weekly",
+			Fields: []template.Field{
+				{ID: "f-1", Key: "k-1", Label: "Summary", Order: 1, IsRequired: true, Type: template.FieldTypeText, MaxLength: 200},
+				{ID: "f-2", Key: "k-2", Label: "Mood", Order: 2, Type: template.FieldTypeSingleSelect, Options: []string{"good", "bad"}, DefaultContent: "good"},
+			},
+		}},
+		Notes: []Note{{
+			ID:         "note-1",
+			TemplateID: "tpl-1",
+			Title:      "Week 42",
+			Status:     note.StatusPublish,
+			Sections:   []Section{{ID: "s-1", FieldID: "f-1", FieldKey: "k-1", Content: "line one\nline two"}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, a); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 6 {
+		t.Fatalf("lines = %d, want 6:\n%s", lines, buf.String())
+	}
+	got, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !reflect.DeepEqual(*got, a) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", *got, a)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	header := `{"type":"archive","version":1,"accountId":"acc-1","exportedAt":"2026-10-16T09:00:00Z"}`
+	tests := []struct {
+		name      string
+		input     string
+		wantError error
+		wantLine  string
+	}{
+		{name: "[Success] header only", input: header + "\n"},
+		{name: "[Success] blank lines are skipped", input: header + "\n\n" + `{"type":"template","id":"tpl-1","name":"Weekly"}` + "\n"},
+		{name: "[Fail] empty input", input: "", wantError: domainerr.ErrArchiveMalformed},
+		{name: "[Fail] invalid JSON", input: header + "\n{", wantError: domainerr.ErrArchiveMalformed, wantLine: "line 2"},
+		{name: "[Fail] header missing", input: `{"type":"template","id":"tpl-1"}`, wantError: domainerr.ErrArchiveMalformed, wantLine: "line 1"},
+		{name: "[Fail] other version", input: `{"type":"archive","version":2}`, wantError: domainerr.ErrArchiveVersionUnsupported},
+		{name: "[Fail] duplicate header", input: header + "\n" + header, wantError: domainerr.ErrArchiveMalformed, wantLine: "line 2"},
+		{name: "[Fail] field before its template", input: header + "\n" + `{"type":"field","templateId":"tpl-1","id":"f-1"}`, wantError: domainerr.ErrArchiveMalformed, wantLine: "line 2"},
+		{name: "[Fail] section before its note", input: header + "\n" + `{"type":"section","noteId":"note-1","id":"s-1"}`, wantError: domainerr.ErrArchiveMalformed, wantLine: "line 2"},
+		{name: "[Fail] repeated note", input: header + "\n" + `{"type":"note","id":"note-1"}` + "\n" + `{"type":"note","id":"note-1"}`, wantError: domainerr.ErrArchiveMalformed, wantLine: "line 3"},
+		{name: "[Fail] unknown record type", input: header + "\n" + `{"type":"comment"}`, wantError: domainerr.ErrArchiveMalformed, wantLine: "line 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(strings.NewReader(tt.input))
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantLine != "" && !strings.HasPrefix(err.Error(), tt.wantLine+":") {
+				t.Fatalf("want error at %s, got %v", tt.wantLine, err)
+			}
+		})
+	}
+}
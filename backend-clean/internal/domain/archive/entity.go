@@ -0,0 +1,61 @@
+// Package archive models the portable copy of an account's templates and notes.
+package archive
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// Version is the archive format version this build writes and reads.
+const Version = 1
+
+// Archive holds the templates and notes of one account.
+// IDs are the ones of the exporting system; importing creates everything under new IDs.
+type Archive struct {
+	Version    int
+	AccountID  string
+	ExportedAt time.Time
+	// Templates carry the fields of their latest schema version.
+	Templates []template.Template
+	Notes     []Note
+}
+
+// Note is an archived note.
+type Note struct {
+	ID         string
+	TemplateID string
+	Title      string
+	Status     note.NoteStatus
+	Sections   []Section
+}
+
+// Section is an archived section. FieldKey names the field across schema versions,
+// so sections of notes pinned to an earlier version still find their field.
+type Section struct {
+	ID       string
+	FieldID  string
+	FieldKey string
+	Content  string
+}
+
+// FromNote converts a stored note into its archived form.
+func FromNote(n note.WithMeta) Note {
+	out := Note{
+		ID:         n.Note.ID,
+		TemplateID: n.Note.TemplateID,
+		Title:      n.Note.Title,
+		Status:     n.Note.Status,
+		Sections:   make([]Section, 0, len(n.Sections)),
+	}
+	for _, s := range n.Sections {
+		out.Sections = append(out.Sections, Section{
+			ID:       s.Section.ID,
+			FieldID:  s.Section.FieldID,
+			FieldKey: s.FieldKey,
+			Content:  s.Section.Content,
+		})
+	}
+	return out
+}
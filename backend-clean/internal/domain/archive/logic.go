@@ -0,0 +1,48 @@
+package archive
+
+import (
+	"fmt"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// Validate checks a can be imported. Templates and notes themselves are validated
+// by the template and note rules when they are recreated.
+func Validate(a Archive) error {
+	// ルール: 取り込めるのは同じフォーマットバージョンのアーカイブのみ
+	if a.Version != Version {
+		return domainerr.ErrArchiveVersionUnsupported
+	}
+	templates := make(map[string]bool, len(a.Templates))
+	for _, t := range a.Templates {
+		templates[t.ID] = true
+	}
+	verr := &domainerr.ValidationError{}
+	// ルール: ノートのテンプレートはアーカイブに含まれていなければならない
+	for i, n := range a.Notes {
+		if !templates[n.TemplateID] {
+			verr.Add(fmt.Sprintf("notes[%d].templateId", i), domainerr.CodeInvalid, domainerr.ErrArchiveTemplateMissing)
+		}
+	}
+	return verr.Err()
+}
+
+// SectionsFor binds the sections of n to fields, the latest fields of its recreated template.
+// ルール: セクションはフィールドキーで対応付け、テンプレートの最新スキーマにないフィールドのセクションは取り込まない
+func (n Note) SectionsFor(fields []template.Field) []note.Section {
+	byKey := make(map[string]string, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f.ID
+	}
+	sections := make([]note.Section, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		fieldID, ok := byKey[s.FieldKey]
+		if !ok || s.FieldKey == "" {
+			continue
+		}
+		sections = append(sections, note.Section{FieldID: fieldID, Content: s.Content})
+	}
+	return sections
+}
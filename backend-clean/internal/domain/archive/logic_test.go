@@ -0,0 +1,70 @@
+package archive
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestValidate(t *testing.T) {
+	templates := []template.Template{{ID: "tpl-1"}}
+	tests := []struct {
+		name      string
+		archive   Archive
+		wantPath  string
+		wantError error
+	}{
+		{name: "[Success] notes of archived templates", archive: Archive{Version: Version, Templates: templates, Notes: []Note{{TemplateID: "tpl-1"}}}},
+		{name: "[Success] empty archive", archive: Archive{Version: Version}},
+		{name: "[Fail] other version", archive: Archive{Version: 2}, wantError: domainerr.ErrArchiveVersionUnsupported},
+		{name: "[Fail] template missing", archive: Archive{Version: Version, Templates: templates, Notes: []Note{{TemplateID: "tpl-1"}, {TemplateID: "tpl-2"}}}, wantPath: "notes[1].templateId", wantError: domainerr.ErrArchiveTemplateMissing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.archive)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantPath == "" {
+				return
+			}
+			var verr *domainerr.ValidationError
+			if !errors.As(err, &verr) || verr.Violations[0].Path != tt.wantPath {
+				t.Fatalf("want violation at %q, got %v", tt.wantPath, err)
+			}
+		})
+	}
+}
+
+func TestNote_SectionsFor(t *testing.T) {
+	n := Note{Sections: []Section{
+		{ID: "old-s-1", FieldID: "old-f-1", FieldKey: "k-1", Content: "kept"},
+		{ID: "old-s-2", FieldID: "old-f-2", FieldKey: "k-removed", Content: "dropped"},
+		{ID: "old-s-3", FieldID: "old-f-3", Content: "no key"},
+	}}
+	fields := []template.Field{{ID: "new-f-1", Key: "k-1"}, {ID: "new-f-2", Key: "k-2"}}
+
+	got := n.SectionsFor(fields)
+	want := []note.Section{{FieldID: "new-f-1", Content: "kept"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestFromNote(t *testing.T) {
+	n := note.WithMeta{
+		Note: note.Note{ID: "note-1", TemplateID: "tpl-1", Title: "Week 42", Status: note.StatusDraft},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "s-1", FieldID: "f-1", Content: "hello"}, FieldKey: "k-1"},
+		},
+	}
+	want := Note{ID: "note-1", TemplateID: "tpl-1", Title: "Week 42", Status: note.StatusDraft, Sections: []Section{{ID: "s-1", FieldID: "f-1", FieldKey: "k-1", Content: "hello"}}}
+	if got := FromNote(n); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -1,13 +1,18 @@
 // Package errors defines domain-level error values.
 package errors
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	// ErrNotFound indicates resource not found.
 	ErrNotFound = errors.New("not found")
 	// ErrTemplateInUse indicates template is referenced by notes.
 	ErrTemplateInUse = errors.New("template is used by notes")
+	// ErrTemplateTrashed indicates the note's template is in the trash.
+	ErrTemplateTrashed = errors.New("template is in the trash")
 	// ErrUnauthorized indicates authorization failure.
 	ErrUnauthorized = errors.New("unauthorized")
 	// ErrInvalidStatus indicates invalid status value.
@@ -26,10 +31,24 @@ var (
 	ErrFieldOrderInvalid = errors.New("field order must be greater than zero and unique")
 	// ErrFieldLabelRequired indicates field label missing.
 	ErrFieldLabelRequired = errors.New("field label is required")
+	// ErrInvalidFieldType indicates an unknown field type.
+	ErrInvalidFieldType = errors.New("invalid field type")
+	// ErrFieldOptionsInvalid indicates select options are missing or duplicated, or given to a non-select field.
+	ErrFieldOptionsInvalid = errors.New("select fields require unique, non-empty options; other field types take none")
+	// ErrFieldConstraintInvalid indicates field constraints contradict each other or the default content breaks them.
+	ErrFieldConstraintInvalid = errors.New("field constraints are invalid")
 	// ErrSectionsMissing indicates sections don't match template.
 	ErrSectionsMissing = errors.New("sections do not match template fields")
 	// ErrRequiredFieldEmpty indicates required field content missing.
 	ErrRequiredFieldEmpty = errors.New("required field content is empty")
+	// ErrInvalidSectionContent indicates section content does not match its field type.
+	ErrInvalidSectionContent = errors.New("section content does not match field type")
+	// ErrSectionTooShort indicates section content is below the field's minimum length.
+	ErrSectionTooShort = errors.New("section content is shorter than the field allows")
+	// ErrSectionTooLong indicates section content is above the field's maximum length.
+	ErrSectionTooLong = errors.New("section content is longer than the field allows")
+	// ErrSectionPatternMismatch indicates section content does not match the field's pattern.
+	ErrSectionPatternMismatch = errors.New("section content does not match the field pattern")
 	// ErrProviderRequired indicates provider missing.
 	ErrProviderRequired = errors.New("provider is required")
 	// ErrProviderAccountRequired indicates provider account id missing.
@@ -38,4 +57,144 @@ var (
 	ErrTitleRequired = errors.New("title is required")
 	// ErrOwnerRequired indicates owner missing.
 	ErrOwnerRequired = errors.New("owner is required")
+	// ErrAccountInactive indicates the account has been deactivated.
+	ErrAccountInactive = errors.New("account is inactive")
+	// ErrSessionInvalid indicates the refresh token is unknown or revoked.
+	ErrSessionInvalid = errors.New("session is invalid")
+	// ErrSessionExpired indicates the refresh token has expired.
+	ErrSessionExpired = errors.New("session expired")
+	// ErrInvalidCursor indicates a malformed pagination cursor.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidPageLimit indicates the requested page size is out of range.
+	ErrInvalidPageLimit = errors.New("limit must be between 1 and 100")
+	// ErrVersionConflict indicates the resource changed since the caller read it.
+	ErrVersionConflict = errors.New("version conflict")
+	// ErrWatchInterrupted indicates the note feed stopped before the watcher left; it can resume from its last cursor.
+	ErrWatchInterrupted = errors.New("watch interrupted")
+	// ErrInvalidWebhookURL indicates the webhook target is not an absolute http(s) URL.
+	ErrInvalidWebhookURL = errors.New("webhook url must be an absolute http or https url")
+	// ErrWebhookHostNotAllowed indicates the webhook target is a loopback, private, link-local or metadata host.
+	ErrWebhookHostNotAllowed = errors.New("webhook url must point to a public internet host")
+	// ErrWebhookSecretTooShort indicates the webhook signing secret is too short.
+	ErrWebhookSecretTooShort = errors.New("webhook secret must be at least 16 characters")
+	// ErrWebhookEventRequired indicates a webhook subscribes to no events.
+	ErrWebhookEventRequired = errors.New("webhook requires at least one event type")
+	// ErrUnsupportedWebhookEvent indicates an event type webhooks cannot subscribe to.
+	ErrUnsupportedWebhookEvent = errors.New("unsupported webhook event type")
+	// ErrDuplicateWebhookEvent indicates the same event type is listed twice.
+	ErrDuplicateWebhookEvent = errors.New("webhook event types must be unique")
+	// ErrPublishAtRequired indicates a note is scheduled without a publish time.
+	ErrPublishAtRequired = errors.New("scheduled notes require a publish time")
+	// ErrScheduleInPast indicates a publish or unpublish time that is not in the future.
+	ErrScheduleInPast = errors.New("schedule time must be in the future")
+	// ErrUnpublishBeforePublish indicates the unpublish time does not follow the publish time.
+	ErrUnpublishBeforePublish = errors.New("unpublish time must be after the publish time")
+	// ErrScheduleNotAllowed indicates schedule times given for a status that cannot use them.
+	ErrScheduleNotAllowed = errors.New("schedule times are not allowed for this status")
+	// ErrReviewRequired indicates publishing a note that has not been approved while review is required.
+	ErrReviewRequired = errors.New("note must be approved before publishing")
+	// ErrReviewerRequired indicates a note is submitted for review without reviewers.
+	ErrReviewerRequired = errors.New("at least one reviewer is required")
+	// ErrDuplicateReviewer indicates the same reviewer is listed twice.
+	ErrDuplicateReviewer = errors.New("reviewers must be unique")
+	// ErrSelfReview indicates the owner is assigned as a reviewer of their own note.
+	ErrSelfReview = errors.New("owners cannot review their own notes")
+	// ErrUnknownReviewer indicates a reviewer that is not an active account.
+	ErrUnknownReviewer = errors.New("reviewer is not an active account")
+	// ErrReviewersLocked indicates reviewers changed after the note was approved or published.
+	ErrReviewersLocked = errors.New("reviewers can only change while the note is a draft or in review")
+	// ErrNoteLockedForReview indicates editing a note that is in review or approved.
+	ErrNoteLockedForReview = errors.New("notes in review or approved cannot be edited; move them back to draft first")
+	// ErrReviewCommentRequired indicates a rejection without a comment.
+	ErrReviewCommentRequired = errors.New("rejecting a note requires a comment")
+	// ErrReviewCommentTooLong indicates a review comment above the length limit.
+	ErrReviewCommentTooLong = errors.New("review comment must be at most 2000 characters")
+	// ErrInvalidShareRole indicates a share role other than viewer, editor or owner.
+	ErrInvalidShareRole = errors.New("share role must be viewer, editor or owner")
+	// ErrShareWithOwner indicates a resource is shared with its own owner.
+	ErrShareWithOwner = errors.New("resources cannot be shared with their owner")
+	// ErrUnknownShareAccount indicates sharing with an account that is missing or inactive.
+	ErrUnknownShareAccount = errors.New("share target is not an active account")
+	// ErrWorkspaceNameRequired indicates a workspace without a name.
+	ErrWorkspaceNameRequired = errors.New("workspace name is required")
+	// ErrWorkspaceNameTooLong indicates a workspace name above the length limit.
+	ErrWorkspaceNameTooLong = errors.New("workspace name must be at most 100 characters")
+	// ErrInvalidWorkspaceRole indicates a workspace role that cannot be used.
+	ErrInvalidWorkspaceRole = errors.New("workspace role must be admin or member")
+	// ErrPersonalWorkspace indicates inviting others into a personal workspace.
+	ErrPersonalWorkspace = errors.New("personal workspaces cannot have other members")
+	// ErrAlreadyWorkspaceMember indicates inviting an account that is already a member.
+	ErrAlreadyWorkspaceMember = errors.New("account is already a member of the workspace")
+	// ErrInvitationAccepted indicates an invitation that has already been used.
+	ErrInvitationAccepted = errors.New("invitation has already been accepted")
+	// ErrRemoveWorkspaceOwner indicates removing the owner from their workspace.
+	ErrRemoveWorkspaceOwner = errors.New("the workspace owner cannot be removed")
+	// ErrNotWorkspaceMember indicates sharing with an account outside the resource's workspace.
+	ErrNotWorkspaceMember = errors.New("account is not a member of the workspace")
+	// ErrShareLinkNotPublished indicates a public link requested for a note that is not published.
+	ErrShareLinkNotPublished = errors.New("only published notes can have public links")
+	// ErrShareLinkExpiryInPast indicates a public link that would expire before it is created.
+	ErrShareLinkExpiryInPast = errors.New("link expiry must be in the future")
+	// ErrArchiveMalformed indicates an account archive that cannot be decoded.
+	ErrArchiveMalformed = errors.New("archive is malformed")
+	// ErrArchiveVersionUnsupported indicates an account archive written in another format version.
+	ErrArchiveVersionUnsupported = errors.New("archive version is not supported")
+	// ErrArchiveTemplateMissing indicates an archived note whose template is not in the archive.
+	ErrArchiveTemplateMissing = errors.New("template of the note is missing from the archive")
+	// ErrTemplateDocumentVersionUnsupported indicates a template document written in another format version.
+	ErrTemplateDocumentVersionUnsupported = errors.New("template document version is not supported")
+)
+
+// Violation codes name the kind of rule a value broke, independent of the field.
+const (
+	CodeRequired        = "REQUIRED"
+	CodeInvalid         = "INVALID"
+	CodeDuplicate       = "DUPLICATE"
+	CodeTooShort        = "TOO_SHORT"
+	CodeTooLong         = "TOO_LONG"
+	CodePatternMismatch = "PATTERN_MISMATCH"
 )
+
+// Violation is one broken rule of a validated input.
+type Violation struct {
+	// Path locates the offending value, e.g. "fields[1].label" or "sections[<fieldId>].content".
+	Path string
+	Code string
+	Err  error
+}
+
+// ValidationError aggregates every violation found in one input; validators return it to report
+// all broken rules together instead of stopping at the first.
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Add records a violation.
+func (e *ValidationError) Add(path, code string, err error) {
+	e.Violations = append(e.Violations, Violation{Path: path, Code: code, Err: err})
+}
+
+// Err returns e when it holds violations and nil otherwise.
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Path+": "+v.Err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap lets errors.Is match the error of any violation.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		errs = append(errs, v.Err)
+	}
+	return errs
+}
@@ -0,0 +1,71 @@
+package note
+
+import (
+	"strings"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// UnmappedSection is content of a source section that no field of the target template took over.
+type UnmappedSection struct {
+	FieldLabel string
+	Content    string
+}
+
+// MapSections copies the content of a note's sections onto the fields of a template, for a new note.
+// Each field takes the content of the first unused section with the same field key, else the same label
+// (ignoring case and surrounding space), else the same field order. Content the field does not accept
+// (see template.Field.CheckContent) is not carried over. Fields left without content start with their
+// default content. Sections no field took are returned as unmapped unless they are empty.
+// ルール: 対応付けはキー、ラベル、表示順の優先順で行い、1 つのセクションは 1 つのフィールドにしか写さない
+// ルール: 写し先フィールドの型や制約に合わない内容は写さず、未対応として返す
+func MapSections(source []SectionWithField, fields []template.Field) ([]Section, []UnmappedSection) {
+	used := make([]bool, len(source))
+	matched := make([]int, len(fields))
+	for i := range matched {
+		matched[i] = -1
+	}
+	rules := []func(s SectionWithField, f template.Field) bool{
+		func(s SectionWithField, f template.Field) bool { return f.Key != "" && s.FieldKey == f.Key },
+		func(s SectionWithField, f template.Field) bool { return sameLabel(s.FieldLabel, f.Label) },
+		func(s SectionWithField, f template.Field) bool { return s.FieldOrder == f.Order },
+	}
+	for _, rule := range rules {
+		for i, f := range fields {
+			if matched[i] >= 0 {
+				continue
+			}
+			for j, s := range source {
+				if !used[j] && rule(s, f) {
+					matched[i] = j
+					used[j] = true
+					break
+				}
+			}
+		}
+	}
+
+	sections := make([]Section, 0, len(fields))
+	for i, f := range fields {
+		s := Section{FieldID: f.ID, Content: f.DefaultContent}
+		if j := matched[i]; j >= 0 {
+			if content := source[j].Section.Content; f.CheckContent(content) == nil {
+				s.Content = content
+			} else {
+				used[j] = false
+			}
+		}
+		sections = append(sections, s)
+	}
+	var unmapped []UnmappedSection
+	for j, s := range source {
+		if !used[j] && s.Section.Content != "" {
+			unmapped = append(unmapped, UnmappedSection{FieldLabel: s.FieldLabel, Content: s.Section.Content})
+		}
+	}
+	return sections, unmapped
+}
+
+func sameLabel(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
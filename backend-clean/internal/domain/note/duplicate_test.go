@@ -0,0 +1,84 @@
+package note
+
+import (
+	"reflect"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestMapSections(t *testing.T) {
+	source := []SectionWithField{
+		{Section: Section{ID: "s1", FieldID: "f1", Content: "why"}, FieldKey: "k1", FieldLabel: "Background", FieldOrder: 1},
+		{Section: Section{ID: "s2", FieldID: "f2", Content: "how"}, FieldKey: "k2", FieldLabel: "Solution", FieldOrder: 2},
+		{Section: Section{ID: "s3", FieldID: "f3", Content: "later"}, FieldKey: "k3", FieldLabel: "Follow-up", FieldOrder: 3},
+	}
+
+	tests := []struct {
+		name         string
+		fields       []template.Field
+		want         []Section
+		wantUnmapped []UnmappedSection
+	}{
+		{
+			name: "[Success] same template keeps every section",
+			fields: []template.Field{
+				{ID: "f1", Key: "k1", Label: "Background", Order: 1},
+				{ID: "f2", Key: "k2", Label: "Solution", Order: 2},
+				{ID: "f3", Key: "k3", Label: "Follow-up", Order: 3},
+			},
+			want: []Section{{FieldID: "f1", Content: "why"}, {FieldID: "f2", Content: "how"}, {FieldID: "f3", Content: "later"}},
+		},
+		{
+			name: "[Success] labels win over order",
+			fields: []template.Field{
+				{ID: "g1", Key: "x1", Label: " solution ", Order: 1},
+				{ID: "g2", Key: "x2", Label: "Background", Order: 2},
+			},
+			want:         []Section{{FieldID: "g1", Content: "how"}, {FieldID: "g2", Content: "why"}},
+			wantUnmapped: []UnmappedSection{{FieldLabel: "Follow-up", Content: "later"}},
+		},
+		{
+			name: "[Success] order maps fields without a matching label",
+			fields: []template.Field{
+				{ID: "g1", Key: "x1", Label: "Context", Order: 1},
+				{ID: "g2", Key: "x2", Label: "Solution", Order: 2},
+				{ID: "g3", Key: "x3", Label: "Notes", Order: 4, DefaultContent: "-"},
+			},
+			want:         []Section{{FieldID: "g1", Content: "why"}, {FieldID: "g2", Content: "how"}, {FieldID: "g3", Content: "-"}},
+			wantUnmapped: []UnmappedSection{{FieldLabel: "Follow-up", Content: "later"}},
+		},
+		{
+			name: "[Success] content the target field rejects is left unmapped",
+			fields: []template.Field{
+				{ID: "g1", Key: "k1", Label: "Background", Order: 1, Type: template.FieldTypeNumber, DefaultContent: "0"},
+				{ID: "g2", Key: "k2", Label: "Solution", Order: 2, Type: template.FieldTypeText},
+			},
+			want:         []Section{{FieldID: "g1", Content: "0"}, {FieldID: "g2", Content: "how"}},
+			wantUnmapped: []UnmappedSection{{FieldLabel: "Background", Content: "why"}, {FieldLabel: "Follow-up", Content: "later"}},
+		},
+		{
+			name:         "[Success] template without fields",
+			want:         []Section{},
+			wantUnmapped: []UnmappedSection{{FieldLabel: "Background", Content: "why"}, {FieldLabel: "Solution", Content: "how"}, {FieldLabel: "Follow-up", Content: "later"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unmapped := MapSections(source, tt.fields)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("sections = %+v, want %+v", got, tt.want)
+			}
+			if !reflect.DeepEqual(unmapped, tt.wantUnmapped) {
+				t.Fatalf("unmapped = %+v, want %+v", unmapped, tt.wantUnmapped)
+			}
+		})
+	}
+}
+
+func TestMapSections_SkipsEmptyLeftovers(t *testing.T) {
+	source := []SectionWithField{{Section: Section{FieldID: "f1"}, FieldLabel: "Empty", FieldOrder: 1}}
+	if _, unmapped := MapSections(source, nil); unmapped != nil {
+		t.Fatalf("unmapped = %+v", unmapped)
+	}
+}
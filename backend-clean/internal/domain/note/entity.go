@@ -11,6 +11,12 @@ type NoteStatus string
 const (
 	StatusDraft   NoteStatus = "Draft"
 	StatusPublish NoteStatus = "Publish"
+	// StatusScheduled notes are published by the scheduled publishing job once PublishAt has passed.
+	StatusScheduled NoteStatus = "Scheduled"
+	// StatusInReview notes wait for a decision of one of their assigned reviewers.
+	StatusInReview NoteStatus = "InReview"
+	// StatusApproved notes passed review and can be published or scheduled by their owner.
+	StatusApproved NoteStatus = "Approved"
 )
 
 // Note aggregate root.
@@ -23,6 +29,23 @@ type Note struct {
 	Sections   []Section
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	Version    int
+	// SchemaVersion is the template schema version the sections were written against.
+	SchemaVersion int
+	// DeletedAt is set while the note is in the trash.
+	DeletedAt *time.Time
+	// PublishAt is when a scheduled note gets published; set only while Status is StatusScheduled.
+	PublishAt *time.Time
+	// UnpublishAt is when the note goes back to draft after being published (optional).
+	UnpublishAt *time.Time
+	// WorkspaceID is the workspace of the note's template; notes never leave it.
+	WorkspaceID string
+}
+
+// Schedule holds the publish and unpublish times requested with a status change.
+type Schedule struct {
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
 }
 
 // Section represents note content for a field.
@@ -0,0 +1,63 @@
+package note
+
+import "time"
+
+// EventType names the kind of change an Event reports.
+type EventType string
+
+// Event types.
+const (
+	EventCreated       EventType = "created"
+	EventUpdated       EventType = "updated"
+	EventStatusChanged EventType = "status_changed"
+	EventDeleted       EventType = "deleted"
+)
+
+// Event reports a committed change to a note.
+type Event struct {
+	// Cursor is the event's position in the note feed; watchers resume after it.
+	Cursor      string
+	Type        EventType
+	NoteID      string
+	OwnerID     string
+	WorkspaceID string
+	TemplateID  string
+	Status      NoteStatus
+	Version     int
+	OccurredAt  time.Time
+}
+
+// NewEvent describes a change of type t that left the note as n.
+func NewEvent(t EventType, n Note, at time.Time) Event {
+	return Event{
+		Type:        t,
+		NoteID:      n.ID,
+		OwnerID:     n.OwnerID,
+		WorkspaceID: n.WorkspaceID,
+		TemplateID:  n.TemplateID,
+		Status:      n.Status,
+		Version:     n.Version,
+		OccurredAt:  at,
+	}
+}
+
+// EventFilter selects events; nil fields match every event.
+type EventFilter struct {
+	OwnerID    *string
+	TemplateID *string
+	Status     *NoteStatus
+}
+
+// Matches reports whether e passes every set filter.
+func (f EventFilter) Matches(e Event) bool {
+	if f.OwnerID != nil && *f.OwnerID != e.OwnerID {
+		return false
+	}
+	if f.TemplateID != nil && *f.TemplateID != e.TemplateID {
+		return false
+	}
+	if f.Status != nil && *f.Status != e.Status {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+package note
+
+import "testing"
+
+func TestEventFilter_Matches(t *testing.T) {
+	owner, other := "owner-1", "owner-2"
+	tpl := "tpl-1"
+	publish := StatusPublish
+	e := Event{Type: EventStatusChanged, NoteID: "note-1", OwnerID: owner, TemplateID: tpl, Status: StatusPublish}
+
+	tests := []struct {
+		name   string
+		filter EventFilter
+		want   bool
+	}{
+		{name: "[Success] empty filter matches everything", want: true},
+		{name: "[Success] every filter matches", filter: EventFilter{OwnerID: &owner, TemplateID: &tpl, Status: &publish}, want: true},
+		{name: "[Fail] other owner", filter: EventFilter{OwnerID: &other}},
+		{name: "[Fail] other status", filter: EventFilter{TemplateID: &tpl, Status: ptr(StatusDraft)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(e); got != tt.want {
+				t.Fatalf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -1,37 +1,87 @@
 package note
 
 import (
+	"strings"
+	"time"
+
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/template"
-	"strings"
 )
 
 // Validate checks if status is valid.
 func (s NoteStatus) Validate() error {
-	if s != StatusDraft && s != StatusPublish {
-		return domainerr.ErrInvalidStatus
+	switch s {
+	case StatusDraft, StatusPublish, StatusScheduled, StatusInReview, StatusApproved:
+		return nil
 	}
-	return nil
+	return domainerr.ErrInvalidStatus
 }
 
 // CanChangeStatus validates status transition.
+// ルール: 公開済みのノートは予約状態に戻せない（一度 Draft にする）
+// ルール: レビューは Draft -> InReview -> Approved の順に進み、InReview / Approved からは Draft に戻せる
 func CanChangeStatus(from, to NoteStatus) error {
-	if from == StatusDraft && to == StatusPublish {
-		return nil
-	}
-	if from == StatusPublish && to == StatusDraft {
-		return nil
-	}
 	if from == to {
 		return nil
 	}
+	switch from {
+	case StatusDraft:
+		if to == StatusPublish || to == StatusScheduled || to == StatusInReview {
+			return nil
+		}
+	case StatusInReview:
+		if to == StatusApproved || to == StatusDraft {
+			return nil
+		}
+	case StatusApproved:
+		if to == StatusPublish || to == StatusScheduled || to == StatusDraft {
+			return nil
+		}
+	case StatusScheduled:
+		if to == StatusPublish || to == StatusDraft {
+			return nil
+		}
+	case StatusPublish:
+		if to == StatusDraft {
+			return nil
+		}
+	}
 	return domainerr.ErrInvalidStatusChange
 }
 
-// ValidateSections checks that sections match template fields and required fields are filled.
+// Validate checks the schedule requested together with a change to status.
+// ルール: Scheduled には未来の公開日時が必須。公開停止日時は Publish / Scheduled でのみ指定でき、未来かつ公開日時より後
+func (s Schedule) Validate(status NoteStatus, now time.Time) error {
+	verr := &domainerr.ValidationError{}
+	switch {
+	case status == StatusScheduled && s.PublishAt == nil:
+		verr.Add("publishAt", domainerr.CodeRequired, domainerr.ErrPublishAtRequired)
+	case status == StatusScheduled && !s.PublishAt.After(now):
+		verr.Add("publishAt", domainerr.CodeInvalid, domainerr.ErrScheduleInPast)
+	case status != StatusScheduled && s.PublishAt != nil:
+		verr.Add("publishAt", domainerr.CodeInvalid, domainerr.ErrScheduleNotAllowed)
+	}
+	if s.UnpublishAt != nil {
+		switch {
+		case status == StatusDraft:
+			verr.Add("unpublishAt", domainerr.CodeInvalid, domainerr.ErrScheduleNotAllowed)
+		case !s.UnpublishAt.After(now):
+			verr.Add("unpublishAt", domainerr.CodeInvalid, domainerr.ErrScheduleInPast)
+		case s.PublishAt != nil && !s.UnpublishAt.After(*s.PublishAt):
+			verr.Add("unpublishAt", domainerr.CodeInvalid, domainerr.ErrUnpublishBeforePublish)
+		}
+	}
+	return verr.Err()
+}
+
+// ValidateSections checks that sections match template fields, required fields are filled
+// and content fits the type and constraints of its field. All broken rules are reported
+// together as a *errors.ValidationError whose paths name the field ID.
 func ValidateSections(tplFields []template.Field, sections []Section) error {
+	verr := &domainerr.ValidationError{}
 	if len(sections) == 0 {
-		return domainerr.ErrSectionsMissing
+		verr.Add("sections", domainerr.CodeRequired, domainerr.ErrSectionsMissing)
+		return verr
 	}
 	lookup := make(map[string]template.Field)
 	for _, f := range tplFields {
@@ -39,23 +89,48 @@ func ValidateSections(tplFields []template.Field, sections []Section) error {
 	}
 	seen := make(map[string]bool)
 	for _, s := range sections {
+		path := "sections[" + s.FieldID + "]"
 		f, ok := lookup[s.FieldID]
 		if !ok {
-			return domainerr.ErrSectionsMissing
+			verr.Add(path, domainerr.CodeInvalid, domainerr.ErrSectionsMissing)
+			continue
 		}
 		if seen[s.FieldID] {
-			return domainerr.ErrSectionsMissing
+			verr.Add(path, domainerr.CodeDuplicate, domainerr.ErrSectionsMissing)
+			continue
 		}
 		seen[s.FieldID] = true
 		if f.IsRequired && s.Content == "" {
-			return domainerr.ErrRequiredFieldEmpty
+			verr.Add(path+".content", domainerr.CodeRequired, domainerr.ErrRequiredFieldEmpty)
+			continue
+		}
+		if err := f.CheckContent(s.Content); err != nil {
+			verr.Add(path+".content", template.ContentCode(err), err)
 		}
 	}
 	// ensure all template fields are covered
-	if len(seen) != len(lookup) {
-		return domainerr.ErrSectionsMissing
+	for _, f := range tplFields {
+		if !seen[f.ID] {
+			verr.Add("sections["+f.ID+"]", domainerr.CodeRequired, domainerr.ErrSectionsMissing)
+		}
 	}
-	return nil
+	return verr.Err()
+}
+
+// FillDefaults appends a section holding the field's default content for every template field
+// the sections leave out.
+// ルール: ノート作成時に省略されたセクションはフィールドの既定値で作成される
+func FillDefaults(tplFields []template.Field, sections []Section) []Section {
+	given := make(map[string]bool, len(sections))
+	for _, s := range sections {
+		given[s.FieldID] = true
+	}
+	for _, f := range tplFields {
+		if !given[f.ID] {
+			sections = append(sections, Section{FieldID: f.ID, Content: f.DefaultContent})
+		}
+	}
+	return sections
 }
 
 // ValidateNoteForCreate validates a note creation attempt against template and required fields.
@@ -78,6 +153,15 @@ func ValidateNoteForCreate(title string, tpl template.Template, sections []Secti
 	return nil
 }
 
+// CheckVersion rejects writes based on a stale read.
+// ルール: expected が 0 の場合（If-Match なし）は検証しない
+func (n Note) CheckVersion(expected int) error {
+	if expected != 0 && expected != n.Version {
+		return domainerr.ErrVersionConflict
+	}
+	return nil
+}
+
 // ValidateNoteOwnership ensures only owner can mutate a note.
 func ValidateNoteOwnership(noteOwnerID, actorID string) error {
 	if strings.TrimSpace(noteOwnerID) == "" || strings.TrimSpace(actorID) == "" {
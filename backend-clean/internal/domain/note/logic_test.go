@@ -2,7 +2,9 @@ package note
 
 import (
 	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/template"
@@ -75,6 +77,59 @@ func TestCanChangeStatus(t *testing.T) {
 			from: StatusDraft,
 			to:   StatusDraft,
 		},
+		{
+			name: "[Success] draft to scheduled",
+			from: StatusDraft,
+			to:   StatusScheduled,
+		},
+		{
+			name: "[Success] draft to in review",
+			from: StatusDraft,
+			to:   StatusInReview,
+		},
+		{
+			name: "[Success] in review to approved",
+			from: StatusInReview,
+			to:   StatusApproved,
+		},
+		{
+			name: "[Success] in review back to draft",
+			from: StatusInReview,
+			to:   StatusDraft,
+		},
+		{
+			name: "[Success] approved to publish",
+			from: StatusApproved,
+			to:   StatusPublish,
+		},
+		{
+			name:      "[Fail] draft cannot skip review to approved",
+			from:      StatusDraft,
+			to:        StatusApproved,
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name:      "[Fail] published note cannot go into review",
+			from:      StatusPublish,
+			to:        StatusInReview,
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name: "[Success] scheduled to publish",
+			from: StatusScheduled,
+			to:   StatusPublish,
+		},
+		{
+			name: "[Success] scheduled back to draft",
+			from: StatusScheduled,
+			to:   StatusDraft,
+		},
+		{
+			name:      "[Fail] publish to scheduled",
+			from:      StatusPublish,
+			to:        StatusScheduled,
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
 		{
 			name:      "[Fail] invalid transition",
 			from:      NoteStatus("Invalid"),
@@ -96,6 +151,72 @@ func TestCanChangeStatus(t *testing.T) {
 	}
 }
 
+func TestSchedule_Validate(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	later, latest, earlier := now.Add(time.Hour), now.Add(2*time.Hour), now.Add(-time.Minute)
+	tests := []struct {
+		name      string
+		status    NoteStatus
+		schedule  Schedule
+		wantPaths []string
+		wantError error
+	}{
+		{name: "[Success] draft without times", status: StatusDraft},
+		{name: "[Success] publish with unpublish time", status: StatusPublish, schedule: Schedule{UnpublishAt: &later}},
+		{name: "[Success] scheduled with both times", status: StatusScheduled, schedule: Schedule{PublishAt: &later, UnpublishAt: &latest}},
+		{
+			name:      "[Fail] scheduled without publish time",
+			status:    StatusScheduled,
+			wantPaths: []string{"publishAt"},
+			wantError: domainerr.ErrPublishAtRequired,
+		},
+		{
+			name:      "[Fail] publish time in the past",
+			status:    StatusScheduled,
+			schedule:  Schedule{PublishAt: &earlier},
+			wantPaths: []string{"publishAt"},
+			wantError: domainerr.ErrScheduleInPast,
+		},
+		{
+			name:      "[Fail] unpublish before publish",
+			status:    StatusScheduled,
+			schedule:  Schedule{PublishAt: &latest, UnpublishAt: &later},
+			wantPaths: []string{"unpublishAt"},
+			wantError: domainerr.ErrUnpublishBeforePublish,
+		},
+		{
+			name:      "[Fail] times on a draft",
+			status:    StatusDraft,
+			schedule:  Schedule{PublishAt: &later, UnpublishAt: &latest},
+			wantPaths: []string{"publishAt", "unpublishAt"},
+			wantError: domainerr.ErrScheduleNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate(tt.status, now)
+			if tt.wantError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *domainerr.ValidationError
+			if !errors.As(err, &verr) || !errors.Is(err, tt.wantError) {
+				t.Fatalf("want validation error %v, got %v", tt.wantError, err)
+			}
+			paths := make([]string, 0, len(verr.Violations))
+			for _, v := range verr.Violations {
+				paths = append(paths, v.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Fatalf("paths = %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+}
+
 func TestValidateSections(t *testing.T) {
 	tplFields := []template.Field{
 		{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
@@ -117,9 +238,49 @@ func TestValidateSections(t *testing.T) {
 			{FieldID: "f1", Content: ""},
 			{FieldID: "f2", Content: "body"},
 		}
-		if err := ValidateSections(tplFields, sections); !errors.Is(err, domainerr.ErrRequiredFieldEmpty) {
+		err := ValidateSections(tplFields, sections)
+		if !errors.Is(err, domainerr.ErrRequiredFieldEmpty) {
 			t.Fatalf("expected ErrRequiredFieldEmpty, got %v", err)
 		}
+		var verr *domainerr.ValidationError
+		if !errors.As(err, &verr) || len(verr.Violations) != 1 || verr.Violations[0].Path != "sections[f1].content" || verr.Violations[0].Code != domainerr.CodeRequired {
+			t.Fatalf("error must name the failing field: %v", err)
+		}
+	})
+
+	t.Run("[Fail] every broken rule is reported", func(t *testing.T) {
+		constrained := []template.Field{tplFields[0], {ID: "f2", Label: "Body", Order: 2, Pattern: `\d+`}, {ID: "f3", Label: "Extra", Order: 3}}
+		sections := []Section{
+			{FieldID: "f1", Content: ""},
+			{FieldID: "f2", Content: "abc"},
+			{FieldID: "unknown", Content: "x"},
+		}
+		var verr *domainerr.ValidationError
+		if !errors.As(ValidateSections(constrained, sections), &verr) {
+			t.Fatalf("expected a ValidationError")
+		}
+		want := []domainerr.Violation{
+			{Path: "sections[f1].content", Code: domainerr.CodeRequired, Err: domainerr.ErrRequiredFieldEmpty},
+			{Path: "sections[f2].content", Code: domainerr.CodePatternMismatch, Err: domainerr.ErrSectionPatternMismatch},
+			{Path: "sections[unknown]", Code: domainerr.CodeInvalid, Err: domainerr.ErrSectionsMissing},
+			{Path: "sections[f3]", Code: domainerr.CodeRequired, Err: domainerr.ErrSectionsMissing},
+		}
+		if !reflect.DeepEqual(verr.Violations, want) {
+			t.Fatalf("violations = %+v, want %+v", verr.Violations, want)
+		}
+	})
+
+	t.Run("[Fail] content breaks a field constraint", func(t *testing.T) {
+		constrained := []template.Field{tplFields[0], {ID: "f2", Label: "Body", Order: 2, MaxLength: 3}}
+		sections := []Section{
+			{FieldID: "f1", Content: "hello"},
+			{FieldID: "f2", Content: "too long"},
+		}
+		err := ValidateSections(constrained, sections)
+		var verr *domainerr.ValidationError
+		if !errors.Is(err, domainerr.ErrSectionTooLong) || !errors.As(err, &verr) || verr.Violations[0].Path != "sections[f2].content" {
+			t.Fatalf("expected ErrSectionTooLong on f2, got %v", err)
+		}
 	})
 
 	t.Run("[Fail] missing template field", func(t *testing.T) {
@@ -142,6 +303,64 @@ func TestValidateSections(t *testing.T) {
 	})
 }
 
+func TestValidateSections_FieldTypes(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     template.Field
+		content   string
+		wantError error
+	}{
+		{name: "[Success] empty content of any type", field: template.Field{Type: template.FieldTypeNumber}, content: ""},
+		{name: "[Success] markdown", field: template.Field{Type: template.FieldTypeMarkdown}, content: "# heading"},
+		{name: "[Success] number", field: template.Field{Type: template.FieldTypeNumber}, content: "-12.5"},
+		{name: "[Fail] number", field: template.Field{Type: template.FieldTypeNumber}, content: "twelve", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] date", field: template.Field{Type: template.FieldTypeDate}, content: "2026-10-16"},
+		{name: "[Fail] date with time", field: template.Field{Type: template.FieldTypeDate}, content: "2026-10-16T09:00:00Z", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] single select", field: template.Field{Type: template.FieldTypeSingleSelect, Options: []string{"low", "high"}}, content: "high"},
+		{name: "[Fail] single select outside options", field: template.Field{Type: template.FieldTypeSingleSelect, Options: []string{"low", "high"}}, content: "mid", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] multi select", field: template.Field{Type: template.FieldTypeMultiSelect, Options: []string{"go", "sql"}}, content: `["sql","go"]`},
+		{name: "[Success] multi select with nothing chosen", field: template.Field{Type: template.FieldTypeMultiSelect, Options: []string{"go"}}, content: `[]`},
+		{name: "[Fail] multi select not JSON", field: template.Field{Type: template.FieldTypeMultiSelect, Options: []string{"go"}}, content: "go", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] multi select duplicate", field: template.Field{Type: template.FieldTypeMultiSelect, Options: []string{"go"}}, content: `["go","go"]`, wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] multi select outside options", field: template.Field{Type: template.FieldTypeMultiSelect, Options: []string{"go"}}, content: `["rust"]`, wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] url", field: template.Field{Type: template.FieldTypeURL}, content: "https://example.com/a?b=c"},
+		{name: "[Fail] url without scheme", field: template.Field{Type: template.FieldTypeURL}, content: "example.com", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] url with other scheme", field: template.Field{Type: template.FieldTypeURL}, content: "javascript:alert(1)", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] checkbox", field: template.Field{Type: template.FieldTypeCheckbox}, content: "false"},
+		{name: "[Fail] checkbox", field: template.Field{Type: template.FieldTypeCheckbox}, content: "yes", wantError: domainerr.ErrInvalidSectionContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.field.ID = "f1"
+			err := ValidateSections([]template.Field{tt.field}, []Section{{FieldID: "f1", Content: tt.content}})
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestFillDefaults(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f1", DefaultContent: "one"},
+		{ID: "f2", DefaultContent: "two"},
+		{ID: "f3"},
+	}
+	got := FillDefaults(fields, []Section{{FieldID: "f2", Content: "given"}})
+	want := []Section{
+		{FieldID: "f2", Content: "given"},
+		{FieldID: "f1", Content: "one"},
+		{FieldID: "f3", Content: ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FillDefaults = %+v, want %+v", got, want)
+	}
+}
+
 func TestValidateNoteForCreate(t *testing.T) {
 	validTpl := template.Template{
 		ID:      "tpl-1",
@@ -201,3 +420,24 @@ func TestValidateNoteForCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestNote_CheckVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		expected  int
+		wantError error
+	}{
+		{name: "[Success] matching version", expected: 2},
+		{name: "[Success] no precondition", expected: 0},
+		{name: "[Fail] stale version", expected: 1, wantError: domainerr.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Note{Version: 2}.CheckVersion(tt.expected)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package note
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// MaxReviewCommentLength is the longest review comment accepted, in characters.
+const MaxReviewCommentLength = 2000
+
+// ReviewDecision is the verdict of a reviewer on a note in review.
+type ReviewDecision string
+
+// Review decisions.
+const (
+	ReviewApproved ReviewDecision = "Approved"
+	ReviewRejected ReviewDecision = "Rejected"
+)
+
+// Review is one decision of an assigned reviewer, kept as the review history of a note.
+type Review struct {
+	ID         string
+	NoteID     string
+	ReviewerID string
+	Decision   ReviewDecision
+	Comment    string
+	CreatedAt  time.Time
+}
+
+// ReviewState is the reviewers assigned to a note together with its review history, newest first.
+type ReviewState struct {
+	Note      Note
+	Reviewers []string
+	Reviews   []Review
+}
+
+// Workflow configures which status changes notes may go through on top of CanChangeStatus.
+type Workflow struct {
+	// RequireReview makes Approved the only status a draft can reach Publish or Scheduled through.
+	RequireReview bool
+}
+
+// CanChangeStatus validates a status transition under the workflow.
+// ルール: レビュー必須の場合、Draft から直接 Publish / Scheduled にはできない（承認を経る）
+func (w Workflow) CanChangeStatus(from, to NoteStatus) error {
+	if err := CanChangeStatus(from, to); err != nil {
+		return err
+	}
+	if w.RequireReview && from == StatusDraft && (to == StatusPublish || to == StatusScheduled) {
+		return domainerr.ErrReviewRequired
+	}
+	return nil
+}
+
+// CanEdit checks that a note in status s accepts changes to its title and sections.
+// ルール: InReview / Approved のノートは編集不可（レビューした内容のまま公開するため、Draft に戻してから編集する）
+func (s NoteStatus) CanEdit() error {
+	if s == StatusInReview || s == StatusApproved {
+		return domainerr.ErrNoteLockedForReview
+	}
+	return nil
+}
+
+// ValidateReviewers checks the reviewers an owner assigns to a note. An empty list clears the reviewers.
+// ルール: レビュアーは重複なし、オーナー自身は不可
+func ValidateReviewers(ownerID string, reviewerIDs []string) error {
+	verr := &domainerr.ValidationError{}
+	seen := make(map[string]bool, len(reviewerIDs))
+	for i, id := range reviewerIDs {
+		path := "reviewerIds[" + strconv.Itoa(i) + "]"
+		switch {
+		case strings.TrimSpace(id) == "":
+			verr.Add(path, domainerr.CodeRequired, domainerr.ErrUnknownReviewer)
+		case id == ownerID:
+			verr.Add(path, domainerr.CodeInvalid, domainerr.ErrSelfReview)
+		case seen[id]:
+			verr.Add(path, domainerr.CodeDuplicate, domainerr.ErrDuplicateReviewer)
+		}
+		seen[id] = true
+	}
+	return verr.Err()
+}
+
+// NewReview builds the decision of a reviewer with its trimmed comment.
+// ID and CreatedAt are assigned when the review is persisted.
+// ルール: 却下にはコメント必須、コメントは 2000 文字以内
+func NewReview(noteID, reviewerID string, decision ReviewDecision, comment string) (Review, error) {
+	comment = strings.TrimSpace(comment)
+	verr := &domainerr.ValidationError{}
+	switch {
+	case decision == ReviewRejected && comment == "":
+		verr.Add("comment", domainerr.CodeRequired, domainerr.ErrReviewCommentRequired)
+	case utf8.RuneCountInString(comment) > MaxReviewCommentLength:
+		verr.Add("comment", domainerr.CodeTooLong, domainerr.ErrReviewCommentTooLong)
+	}
+	if err := verr.Err(); err != nil {
+		return Review{}, err
+	}
+	return Review{
+		NoteID:     noteID,
+		ReviewerID: reviewerID,
+		Decision:   decision,
+		Comment:    comment,
+	}, nil
+}
+
+// IsReviewer reports whether accountID is one of reviewerIDs.
+func IsReviewer(reviewerIDs []string, accountID string) bool {
+	for _, id := range reviewerIDs {
+		if id == accountID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package note
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestWorkflow_CanChangeStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow Workflow
+		from     NoteStatus
+		to       NoteStatus
+		wantErr  error
+	}{
+		{name: "[Success] draft publishes directly when review is optional", from: StatusDraft, to: StatusPublish},
+		{name: "[Success] approved note publishes when review is required", workflow: Workflow{RequireReview: true}, from: StatusApproved, to: StatusPublish},
+		{name: "[Success] approved note is scheduled when review is required", workflow: Workflow{RequireReview: true}, from: StatusApproved, to: StatusScheduled},
+		{name: "[Success] published note unpublishes when review is required", workflow: Workflow{RequireReview: true}, from: StatusPublish, to: StatusDraft},
+		{name: "[Fail] draft cannot publish when review is required", workflow: Workflow{RequireReview: true}, from: StatusDraft, to: StatusPublish, wantErr: domainerr.ErrReviewRequired},
+		{name: "[Fail] draft cannot be scheduled when review is required", workflow: Workflow{RequireReview: true}, from: StatusDraft, to: StatusScheduled, wantErr: domainerr.ErrReviewRequired},
+		{name: "[Fail] note in review cannot publish", from: StatusInReview, to: StatusPublish, wantErr: domainerr.ErrInvalidStatusChange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.workflow.CanChangeStatus(tt.from, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNoteStatus_CanEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  NoteStatus
+		wantErr error
+	}{
+		{name: "[Success] draft", status: StatusDraft},
+		{name: "[Success] published", status: StatusPublish},
+		{name: "[Success] scheduled", status: StatusScheduled},
+		{name: "[Fail] in review", status: StatusInReview, wantErr: domainerr.ErrNoteLockedForReview},
+		{name: "[Fail] approved", status: StatusApproved, wantErr: domainerr.ErrNoteLockedForReview},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.status.CanEdit(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateReviewers(t *testing.T) {
+	tests := []struct {
+		name      string
+		reviewers []string
+		wantErr   error
+		wantPath  string
+	}{
+		{name: "[Success] distinct reviewers", reviewers: []string{"r1", "r2"}},
+		{name: "[Success] no reviewers", reviewers: nil},
+		{name: "[Fail] owner reviews own note", reviewers: []string{"r1", "owner"}, wantErr: domainerr.ErrSelfReview, wantPath: "reviewerIds[1]"},
+		{name: "[Fail] duplicate reviewer", reviewers: []string{"r1", "r1"}, wantErr: domainerr.ErrDuplicateReviewer, wantPath: "reviewerIds[1]"},
+		{name: "[Fail] blank reviewer", reviewers: []string{" "}, wantErr: domainerr.ErrUnknownReviewer, wantPath: "reviewerIds[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReviewers("owner", tt.reviewers)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+			var verr *domainerr.ValidationError
+			if tt.wantPath != "" && (!errors.As(err, &verr) || verr.Violations[0].Path != tt.wantPath) {
+				t.Fatalf("want violation at %s, got %v", tt.wantPath, err)
+			}
+		})
+	}
+}
+
+func TestNewReview(t *testing.T) {
+	tests := []struct {
+		name        string
+		decision    ReviewDecision
+		comment     string
+		wantErr     error
+		wantComment string
+	}{
+		{name: "[Success] approve without comment", decision: ReviewApproved},
+		{name: "[Success] reject with trimmed comment", decision: ReviewRejected, comment: "  add sources \n", wantComment: "add sources"},
+		{name: "[Fail] reject without comment", decision: ReviewRejected, comment: "   ", wantErr: domainerr.ErrReviewCommentRequired},
+		{name: "[Fail] comment too long", decision: ReviewApproved, comment: strings.Repeat("あ", MaxReviewCommentLength+1), wantErr: domainerr.ErrReviewCommentTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReview("n1", "r1", tt.decision, tt.comment)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && (r.NoteID != "n1" || r.ReviewerID != "r1" || r.Decision != tt.decision || r.Comment != tt.wantComment) {
+				t.Fatalf("unexpected review: %+v", r)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package note
+
+import (
+	"sort"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// Revision is an immutable snapshot of a note written on every change.
+type Revision struct {
+	ID        string
+	NoteID    string
+	Number    int
+	Title     string
+	Sections  []RevisionSection
+	ActorID   string
+	CreatedAt time.Time
+}
+
+// RevisionSection is the content of one template field at a revision.
+type RevisionSection struct {
+	FieldID string
+	Content string
+}
+
+// RevisionDiff is a field-by-field comparison of two revisions.
+type RevisionDiff struct {
+	From     int
+	To       int
+	Title    Change
+	Sections []SectionChange
+}
+
+// Change holds a before/after pair.
+type Change struct {
+	Before  string
+	After   string
+	Changed bool
+}
+
+// SectionChange is the change of one template field between two revisions.
+type SectionChange struct {
+	FieldID    string
+	FieldLabel string
+	Change
+}
+
+// NewRevision snapshots the title and section contents of a note.
+// ID, Number and CreatedAt are assigned when the revision is persisted.
+func NewRevision(noteID, actorID, title string, sections []Section) Revision {
+	snapshot := make([]RevisionSection, 0, len(sections))
+	for _, s := range sections {
+		snapshot = append(snapshot, RevisionSection{FieldID: s.FieldID, Content: s.Content})
+	}
+	return Revision{
+		NoteID:   noteID,
+		ActorID:  actorID,
+		Title:    title,
+		Sections: snapshot,
+	}
+}
+
+// SnapshotSections converts the stored sections of a note to plain sections.
+func SnapshotSections(sections []SectionWithField) []Section {
+	out := make([]Section, 0, len(sections))
+	for _, s := range sections {
+		out = append(out, s.Section)
+	}
+	return out
+}
+
+// RestoreSections maps the revision content onto the note's current sections.
+// Fields that no longer have a section are returned without an ID so they are created.
+func (r Revision) RestoreSections(current []SectionWithField) []Section {
+	sectionByField := make(map[string]string, len(current))
+	for _, s := range current {
+		sectionByField[s.Section.FieldID] = s.Section.ID
+	}
+	sections := make([]Section, 0, len(r.Sections))
+	for _, s := range r.Sections {
+		sections = append(sections, Section{
+			ID:      sectionByField[s.FieldID],
+			NoteID:  r.NoteID,
+			FieldID: s.FieldID,
+			Content: s.Content,
+		})
+	}
+	return sections
+}
+
+// DiffRevisions compares two revisions field by field.
+// Fields are ordered by the template; fields missing from the template are appended unlabeled.
+func DiffRevisions(from, to Revision, fields []template.Field) RevisionDiff {
+	before := make(map[string]string, len(from.Sections))
+	for _, s := range from.Sections {
+		before[s.FieldID] = s.Content
+	}
+	after := make(map[string]string, len(to.Sections))
+	for _, s := range to.Sections {
+		after[s.FieldID] = s.Content
+	}
+
+	ordered := append([]template.Field(nil), fields...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+
+	seen := make(map[string]bool, len(ordered))
+	changes := make([]SectionChange, 0, len(ordered))
+	for _, f := range ordered {
+		seen[f.ID] = true
+		changes = append(changes, SectionChange{FieldID: f.ID, FieldLabel: f.Label, Change: newChange(before[f.ID], after[f.ID])})
+	}
+	for _, rev := range []Revision{from, to} {
+		for _, s := range rev.Sections {
+			if seen[s.FieldID] {
+				continue
+			}
+			seen[s.FieldID] = true
+			changes = append(changes, SectionChange{FieldID: s.FieldID, Change: newChange(before[s.FieldID], after[s.FieldID])})
+		}
+	}
+
+	return RevisionDiff{
+		From:     from.Number,
+		To:       to.Number,
+		Title:    newChange(from.Title, to.Title),
+		Sections: changes,
+	}
+}
+
+func newChange(before, after string) Change {
+	return Change{Before: before, After: after, Changed: before != after}
+}
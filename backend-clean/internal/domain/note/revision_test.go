@@ -0,0 +1,75 @@
+package note
+
+import (
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f2", Label: "Solution", Order: 2},
+		{ID: "f1", Label: "Background", Order: 1},
+	}
+	from := Revision{Number: 1, Title: "Draft", Sections: []RevisionSection{
+		{FieldID: "f1", Content: "why"},
+		{FieldID: "f2", Content: "how"},
+		{FieldID: "gone", Content: "old field"},
+	}}
+	to := Revision{Number: 3, Title: "Final", Sections: []RevisionSection{
+		{FieldID: "f1", Content: "why"},
+		{FieldID: "f2", Content: "how, revised"},
+	}}
+
+	diff := DiffRevisions(from, to, fields)
+
+	if diff.From != 1 || diff.To != 3 {
+		t.Fatalf("unexpected range: %d..%d", diff.From, diff.To)
+	}
+	if !diff.Title.Changed || diff.Title.Before != "Draft" || diff.Title.After != "Final" {
+		t.Fatalf("unexpected title change: %+v", diff.Title)
+	}
+	tests := []struct {
+		name        string
+		fieldID     string
+		wantLabel   string
+		wantChanged bool
+	}{
+		{name: "[Success] unchanged field first by order", fieldID: "f1", wantLabel: "Background"},
+		{name: "[Success] changed field", fieldID: "f2", wantLabel: "Solution", wantChanged: true},
+		{name: "[Success] field removed from template is kept", fieldID: "gone", wantChanged: true},
+	}
+	if len(diff.Sections) != len(tests) {
+		t.Fatalf("want %d sections, got %d", len(tests), len(diff.Sections))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diff.Sections[i]
+			if got.FieldID != tt.fieldID || got.FieldLabel != tt.wantLabel || got.Changed != tt.wantChanged {
+				t.Fatalf("unexpected change: %+v", got)
+			}
+		})
+	}
+}
+
+func TestRevision_RestoreSections(t *testing.T) {
+	rev := Revision{NoteID: "n1", Sections: []RevisionSection{
+		{FieldID: "f1", Content: "old"},
+		{FieldID: "f2", Content: "older"},
+	}}
+	current := []SectionWithField{
+		{Section: Section{ID: "s1", NoteID: "n1", FieldID: "f1", Content: "new"}},
+	}
+
+	got := rev.RestoreSections(current)
+
+	if len(got) != 2 {
+		t.Fatalf("want 2 sections, got %d", len(got))
+	}
+	if got[0].ID != "s1" || got[0].Content != "old" {
+		t.Fatalf("existing section not reused: %+v", got[0])
+	}
+	if got[1].ID != "" || got[1].FieldID != "f2" || got[1].NoteID != "n1" {
+		t.Fatalf("missing section must be created: %+v", got[1])
+	}
+}
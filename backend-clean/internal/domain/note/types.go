@@ -1,12 +1,28 @@
 // Package note holds note domain models.
 package note
 
+import (
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
 // Filters for listing notes.
 type Filters struct {
 	Status     *NoteStatus
 	TemplateID *string
 	OwnerID    *string
-	Query      *string
+	// WorkspaceID keeps only notes of one workspace.
+	WorkspaceID *string
+	// MemberID keeps only notes of workspaces this account is a member of.
+	MemberID *string
+	// SharedWith keeps only notes another owner has shared with this account.
+	SharedWith *string
+	// Query is a full-text search over the title and section content; results are ranked by relevance.
+	Query *string
+	// Cursor resumes after the given row; nil starts from the newest note.
+	Cursor *pagination.Cursor
+	// Limit is the maximum number of rows to return (0 = pagination.DefaultLimit).
+	Limit int
 }
 
 // SectionWithField represents a section with template field metadata.
@@ -15,6 +31,10 @@ type SectionWithField struct {
 	FieldLabel string
 	FieldOrder int
 	IsRequired bool
+	FieldKey   string
+	FieldType  template.FieldType
+	// FieldOptions are the choices of a select field.
+	FieldOptions []string
 }
 
 // WithMeta represents a note with template metadata.
@@ -25,4 +45,15 @@ type WithMeta struct {
 	OwnerLastName  string
 	OwnerThumbnail *string
 	Sections       []SectionWithField
+	// LatestSchemaVersion is the template's current schema version; the note can be upgraded when it is ahead.
+	LatestSchemaVersion int
+	// Rank and Snippet are set only when listing with Filters.Query.
+	// Snippet is an HTML-escaped excerpt with matches wrapped in <mark>...</mark>.
+	Rank    float32
+	Snippet string
+}
+
+// PageCursor returns the keyset position of the note.
+func (n WithMeta) PageCursor() pagination.Cursor {
+	return pagination.Cursor{Rank: n.Rank, UpdatedAt: n.Note.UpdatedAt, ID: n.Note.ID}
 }
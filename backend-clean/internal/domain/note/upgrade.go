@@ -0,0 +1,38 @@
+package note
+
+import (
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// UpgradeSections maps the sections of a note onto the fields of a newer template schema version.
+// Sections are matched by field key and keep their ID and content; fields new in the target version start empty.
+// content overrides section content by target field ID. Sections of removed fields are dropped,
+// their content stays in the revision history.
+func UpgradeSections(noteID string, current []SectionWithField, fields []template.Field, content map[string]string) ([]Section, error) {
+	byKey := make(map[string]Section, len(current))
+	for _, s := range current {
+		byKey[s.FieldKey] = s.Section
+	}
+	targets := make(map[string]bool, len(fields))
+	sections := make([]Section, 0, len(fields))
+	for _, f := range fields {
+		targets[f.ID] = true
+		s := Section{NoteID: noteID, FieldID: f.ID}
+		if prev, ok := byKey[f.Key]; ok {
+			s.ID = prev.ID
+			s.Content = prev.Content
+		}
+		if c, ok := content[f.ID]; ok {
+			s.Content = c
+		}
+		sections = append(sections, s)
+	}
+	// ルール: 指定された内容はすべて移行先のフィールドに対応していなければならない
+	for fieldID := range content {
+		if !targets[fieldID] {
+			return nil, domainerr.ErrSectionsMissing
+		}
+	}
+	return sections, nil
+}
@@ -0,0 +1,64 @@
+package note
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestUpgradeSections(t *testing.T) {
+	current := []SectionWithField{
+		{Section: Section{ID: "s1", NoteID: "n1", FieldID: "f1-v1", Content: "why"}, FieldKey: "k1"},
+		{Section: Section{ID: "s2", NoteID: "n1", FieldID: "f2-v1", Content: "dropped"}, FieldKey: "k2"},
+	}
+	fields := []template.Field{
+		{ID: "f1-v2", Key: "k1", Label: "Background", Order: 1},
+		{ID: "f3-v2", Key: "k3", Label: "Risks", Order: 2},
+	}
+
+	tests := []struct {
+		name      string
+		content   map[string]string
+		want      []Section
+		wantError error
+	}{
+		{
+			name: "[Success] sections follow their field key",
+			want: []Section{
+				{ID: "s1", NoteID: "n1", FieldID: "f1-v2", Content: "why"},
+				{NoteID: "n1", FieldID: "f3-v2"},
+			},
+		},
+		{
+			name:    "[Success] supplied content fills new fields",
+			content: map[string]string{"f3-v2": "none"},
+			want: []Section{
+				{ID: "s1", NoteID: "n1", FieldID: "f1-v2", Content: "why"},
+				{NoteID: "n1", FieldID: "f3-v2", Content: "none"},
+			},
+		},
+		{
+			name:      "[Fail] content for a field of another version",
+			content:   map[string]string{"f2-v1": "x"},
+			wantError: domainerr.ErrSectionsMissing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UpgradeSections("n1", current, fields, tt.content)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("want %d sections, got %d", len(tt.want), len(got))
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Fatalf("section %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Package outbox holds domain events recorded for asynchronous delivery.
+package outbox
+
+import "time"
+
+// Aggregate types an event can be about.
+const (
+	AggregateNote      = "note"
+	AggregateTemplate  = "template"
+	AggregateAccount   = "account"
+	AggregateWorkspace = "workspace"
+)
+
+// Event types.
+const (
+	NoteCreated     = "note.created"
+	NoteUpdated     = "note.updated"
+	NotePublished   = "note.published"
+	NoteUnpublished = "note.unpublished"
+	NoteScheduled   = "note.scheduled"
+	NoteUnscheduled = "note.unscheduled"
+	NoteDeleted     = "note.deleted"
+	NoteRestored    = "note.restored"
+
+	NoteSubmitted       = "note.submitted"
+	NoteApproved        = "note.approved"
+	NoteRejected        = "note.rejected"
+	NoteReviewWithdrawn = "note.review_withdrawn"
+
+	NoteShared       = "note.shared"
+	NoteUnshared     = "note.unshared"
+	TemplateShared   = "template.shared"
+	TemplateUnshared = "template.unshared"
+
+	NoteLinkCreated = "note.link_created"
+	NoteLinkRevoked = "note.link_revoked"
+
+	TemplateCreated  = "template.created"
+	TemplateUpdated  = "template.updated"
+	TemplateDeleted  = "template.deleted"
+	TemplateRestored = "template.restored"
+
+	WorkspaceCreated       = "workspace.created"
+	WorkspaceMemberInvited = "workspace.member_invited"
+	WorkspaceMemberJoined  = "workspace.member_joined"
+	WorkspaceMemberRemoved = "workspace.member_removed"
+
+	AccountReactivated = "account.reactivated"
+	AccountDeactivated = "account.deactivated"
+)
+
+// Event is a domain event recorded in the same transaction as the change it describes.
+type Event struct {
+	Type          string
+	AggregateType string
+	AggregateID   string
+	OccurredAt    time.Time
+	// Payload is a snapshot of the aggregate after the change; it is stored as JSON.
+	Payload map[string]any
+}
+
+// Message is a recorded event awaiting delivery by the relay.
+type Message struct {
+	ID            string
+	Type          string
+	AggregateType string
+	AggregateID   string
+	OccurredAt    time.Time
+	// Payload is the JSON encoded Event.Payload.
+	Payload []byte
+	// Attempts counts the failed deliveries so far.
+	Attempts int
+	// Position is where the message sits in the note feed; only feed reads set it.
+	Position FeedCursor
+}
+
+// RelayResult summarizes one relay pass.
+type RelayResult struct {
+	Delivered int
+	// Retried messages failed and are scheduled for another attempt.
+	Retried int
+	// Failed messages used up their attempts and will not be delivered.
+	Failed int
+}
+
+// Total returns how many messages the pass handled.
+func (r RelayResult) Total() int {
+	return r.Delivered + r.Retried + r.Failed
+}
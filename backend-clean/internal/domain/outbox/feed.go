@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// FeedCursor is a position in the note feed, the note events of the outbox in the order
+// their transactions recorded them. The zero value is before the first event.
+type FeedCursor struct {
+	// TxID is the transaction that recorded the event.
+	TxID int64
+	// Seq orders the events within a transaction.
+	Seq int64
+}
+
+// Encode returns the opaque string form handed to watchers.
+func (c FeedCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%d.%d", c.TxID, c.Seq))
+}
+
+// ParseFeedCursor decodes a watcher supplied cursor.
+func ParseFeedCursor(raw string) (FeedCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(raw))
+	if err != nil {
+		return FeedCursor{}, domainerr.ErrInvalidCursor
+	}
+	var c FeedCursor
+	if _, err := fmt.Sscanf(string(decoded), "%d.%d", &c.TxID, &c.Seq); err != nil || c.TxID < 0 || c.Seq < 0 {
+		return FeedCursor{}, domainerr.ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// noteEventTypes maps the outbox event types that change a note onto the events watchers receive.
+// Shares and public links are about who can see the note, not the note itself, so they are left out.
+var noteEventTypes = map[string]note.EventType{
+	NoteCreated:         note.EventCreated,
+	NoteUpdated:         note.EventUpdated,
+	NoteRestored:        note.EventUpdated,
+	NotePublished:       note.EventStatusChanged,
+	NoteUnpublished:     note.EventStatusChanged,
+	NoteScheduled:       note.EventStatusChanged,
+	NoteUnscheduled:     note.EventStatusChanged,
+	NoteSubmitted:       note.EventStatusChanged,
+	NoteApproved:        note.EventStatusChanged,
+	NoteRejected:        note.EventStatusChanged,
+	NoteReviewWithdrawn: note.EventStatusChanged,
+	NoteDeleted:         note.EventDeleted,
+}
+
+type notePayload struct {
+	ID          string `json:"id"`
+	OwnerID     string `json:"owner_id"`
+	WorkspaceID string `json:"workspace_id"`
+	TemplateID  string `json:"template_id"`
+	Status      string `json:"status"`
+	Version     int    `json:"version"`
+}
+
+// ToNoteEvent converts a message read from the note feed into the event watchers receive.
+// ok is false for messages that do not change a note.
+func ToNoteEvent(m Message) (e note.Event, ok bool, err error) {
+	t, ok := noteEventTypes[m.Type]
+	if !ok || m.AggregateType != AggregateNote {
+		return note.Event{}, false, nil
+	}
+	var p notePayload
+	if err := json.Unmarshal(m.Payload, &p); err != nil {
+		return note.Event{}, false, err
+	}
+	return note.Event{
+		Cursor:      m.Position.Encode(),
+		Type:        t,
+		NoteID:      p.ID,
+		OwnerID:     p.OwnerID,
+		WorkspaceID: p.WorkspaceID,
+		TemplateID:  p.TemplateID,
+		Status:      note.NoteStatus(p.Status),
+		Version:     p.Version,
+		OccurredAt:  m.OccurredAt,
+	}, true, nil
+}
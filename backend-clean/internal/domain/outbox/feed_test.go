@@ -0,0 +1,95 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestFeedCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		want      FeedCursor
+		wantError error
+	}{
+		{name: "[Success] round trip", raw: FeedCursor{TxID: 812, Seq: 40}.Encode(), want: FeedCursor{TxID: 812, Seq: 40}},
+		{name: "[Fail] not base64", raw: "%%%", wantError: domainerr.ErrInvalidCursor},
+		{name: "[Fail] not a position", raw: FeedCursor{}.Encode()[:2], wantError: domainerr.ErrInvalidCursor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFeedCursor(tt.raw)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if got != tt.want {
+				t.Fatalf("cursor = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToNoteEvent(t *testing.T) {
+	at := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	n := note.Note{ID: "note-1", OwnerID: "owner-1", WorkspaceID: "ws-1", TemplateID: "tpl-1", Status: note.StatusPublish, Version: 3}
+	message := func(e Event) Message {
+		m := Message{Type: e.Type, AggregateType: e.AggregateType, AggregateID: e.AggregateID, OccurredAt: e.OccurredAt, Position: FeedCursor{TxID: 7, Seq: 2}}
+		m.Payload = mustJSON(t, e.Payload)
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		message Message
+		want    note.Event
+		wantOK  bool
+	}{
+		{
+			name:    "[Success] status change",
+			message: message(NoteEvent(NotePublished, n, at)),
+			want: note.Event{
+				Cursor: FeedCursor{TxID: 7, Seq: 2}.Encode(), Type: note.EventStatusChanged, NoteID: "note-1", OwnerID: "owner-1",
+				WorkspaceID: "ws-1", TemplateID: "tpl-1", Status: note.StatusPublish, Version: 3, OccurredAt: at,
+			},
+			wantOK: true,
+		},
+		{
+			name:    "[Success] restore is reported as an update",
+			message: message(NoteEvent(NoteRestored, n, at)),
+			want: note.Event{
+				Cursor: FeedCursor{TxID: 7, Seq: 2}.Encode(), Type: note.EventUpdated, NoteID: "note-1", OwnerID: "owner-1",
+				WorkspaceID: "ws-1", TemplateID: "tpl-1", Status: note.StatusPublish, Version: 3, OccurredAt: at,
+			},
+			wantOK: true,
+		},
+		{
+			name:    "[Success] shares do not change the note",
+			message: Message{Type: NoteShared, AggregateType: AggregateNote, Payload: []byte(`{}`)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := ToNoteEvent(tt.message)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("ToNoteEvent = %+v, %v; want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return raw
+}
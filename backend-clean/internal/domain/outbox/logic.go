@@ -0,0 +1,214 @@
+package outbox
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+const (
+	// MaxAttempts is how many deliveries of a message are tried before it is marked failed.
+	MaxAttempts = 10
+	// ClaimLease is how long a claimed message is held back from other relays while it is delivered;
+	// a message still unsettled when the lease runs out is claimed again.
+	ClaimLease  = 5 * time.Minute
+	baseBackoff = 5 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// NoteEvent describes a change that left the note as n.
+// Schedule times are included only when set.
+func NoteEvent(eventType string, n note.Note, at time.Time) Event {
+	e := Event{
+		Type:          eventType,
+		AggregateType: AggregateNote,
+		AggregateID:   n.ID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":           n.ID,
+			"title":        n.Title,
+			"template_id":  n.TemplateID,
+			"owner_id":     n.OwnerID,
+			"workspace_id": n.WorkspaceID,
+			"status":       string(n.Status),
+			"version":      n.Version,
+		},
+	}
+	if n.PublishAt != nil {
+		e.Payload["publish_at"] = n.PublishAt.UTC()
+	}
+	if n.UnpublishAt != nil {
+		e.Payload["unpublish_at"] = n.UnpublishAt.UTC()
+	}
+	return e
+}
+
+// NoteStatusEvent returns the event type for a change from one status to another.
+// Cancelling a schedule is reported as unscheduled since the note was never published,
+// and an owner taking a note back out of review as review_withdrawn.
+func NoteStatusEvent(from, to note.NoteStatus) string {
+	switch {
+	case to == note.StatusPublish:
+		return NotePublished
+	case to == note.StatusScheduled:
+		return NoteScheduled
+	case to == note.StatusInReview:
+		return NoteSubmitted
+	case to == note.StatusApproved:
+		return NoteApproved
+	case from == note.StatusScheduled:
+		return NoteUnscheduled
+	case from == note.StatusInReview || from == note.StatusApproved:
+		return NoteReviewWithdrawn
+	default:
+		return NoteUnpublished
+	}
+}
+
+// ReviewEvent describes a review decision that left the note as n.
+func ReviewEvent(eventType string, n note.Note, r note.Review, at time.Time) Event {
+	e := NoteEvent(eventType, n, at)
+	e.Payload["reviewer_id"] = r.ReviewerID
+	e.Payload["comment"] = r.Comment
+	return e
+}
+
+// TemplateEvent describes a change that left the template as t.
+func TemplateEvent(eventType string, t template.Template, at time.Time) Event {
+	return Event{
+		Type:          eventType,
+		AggregateType: AggregateTemplate,
+		AggregateID:   t.ID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":             t.ID,
+			"name":           t.Name,
+			"owner_id":       t.OwnerID,
+			"version":        t.Version,
+			"schema_version": t.SchemaVersion,
+			"is_public":      t.IsPublic,
+		},
+	}
+}
+
+// ShareEvent describes a grant on a note or template being set or revoked; the aggregate is the shared resource.
+func ShareEvent(eventType string, g share.Grant, at time.Time) Event {
+	e := Event{
+		Type:          eventType,
+		AggregateType: string(g.ResourceType),
+		AggregateID:   g.ResourceID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":         g.ResourceID,
+			"account_id": g.AccountID,
+		},
+	}
+	if g.Role != "" {
+		e.Payload["role"] = string(g.Role)
+	}
+	if g.GrantedBy != "" {
+		e.Payload["granted_by"] = g.GrantedBy
+	}
+	return e
+}
+
+// LinkEvent describes a public link to a note being created or revoked; the aggregate is the note.
+// The token is never part of the payload.
+func LinkEvent(eventType string, l sharelink.Link, at time.Time) Event {
+	e := Event{
+		Type:          eventType,
+		AggregateType: AggregateNote,
+		AggregateID:   l.NoteID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":      l.NoteID,
+			"link_id": l.ID,
+		},
+	}
+	if l.CreatedBy != "" {
+		e.Payload["created_by"] = l.CreatedBy
+	}
+	if l.ExpiresAt != nil {
+		e.Payload["expires_at"] = l.ExpiresAt.UTC()
+	}
+	return e
+}
+
+// WorkspaceEvent describes a change that left the workspace as w.
+func WorkspaceEvent(eventType string, w workspace.Workspace, at time.Time) Event {
+	return Event{
+		Type:          eventType,
+		AggregateType: AggregateWorkspace,
+		AggregateID:   w.ID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":       w.ID,
+			"name":     w.Name,
+			"owner_id": w.OwnerID,
+		},
+	}
+}
+
+// MemberEvent describes a membership of a workspace being added or removed; the aggregate is the workspace.
+func MemberEvent(eventType string, m workspace.Member, at time.Time) Event {
+	e := Event{
+		Type:          eventType,
+		AggregateType: AggregateWorkspace,
+		AggregateID:   m.WorkspaceID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":         m.WorkspaceID,
+			"account_id": m.AccountID,
+		},
+	}
+	if m.Role != "" {
+		e.Payload["role"] = string(m.Role)
+	}
+	return e
+}
+
+// InvitationEvent describes an invitation to a workspace being sent; the aggregate is the workspace.
+func InvitationEvent(eventType string, inv workspace.Invitation, at time.Time) Event {
+	return Event{
+		Type:          eventType,
+		AggregateType: AggregateWorkspace,
+		AggregateID:   inv.WorkspaceID,
+		OccurredAt:    at,
+		Payload: map[string]any{
+			"id":            inv.WorkspaceID,
+			"invitation_id": inv.ID,
+			"email":         inv.Email,
+			"role":          string(inv.Role),
+			"invited_by":    inv.InvitedBy,
+		},
+	}
+}
+
+// AccountEvent describes a change to the account with the given ID.
+func AccountEvent(eventType, accountID string, at time.Time) Event {
+	return Event{
+		Type:          eventType,
+		AggregateType: AggregateAccount,
+		AggregateID:   accountID,
+		OccurredAt:    at,
+		Payload:       map[string]any{"id": accountID},
+	}
+}
+
+// NextAttempt decides what happens after the attempts-th failed delivery: retry after
+// the returned delay, or give up when retry is false.
+func NextAttempt(attempts int) (delay time.Duration, retry bool) {
+	// ルール: 配信失敗は指数バックオフで再試行し、上限回数に達したら諦める
+	if attempts >= MaxAttempts {
+		return 0, false
+	}
+	delay = baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff), true
+}
@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNextAttempt(t *testing.T) {
+	tests := []struct {
+		name      string
+		attempts  int
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{name: "[Success] first failure waits the base delay", attempts: 1, wantDelay: 5 * time.Second, wantRetry: true},
+		{name: "[Success] delay doubles per failure", attempts: 3, wantDelay: 20 * time.Second, wantRetry: true},
+		{name: "[Success] last retry before giving up", attempts: MaxAttempts - 1, wantDelay: 21*time.Minute + 20*time.Second, wantRetry: true},
+		{name: "[Fail] attempts used up", attempts: MaxAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := NextAttempt(tt.attempts)
+			if delay != tt.wantDelay || retry != tt.wantRetry {
+				t.Fatalf("NextAttempt(%d) = %v, %v; want %v, %v", tt.attempts, delay, retry, tt.wantDelay, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestNoteStatusEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to note.NoteStatus
+		want     string
+	}{
+		{name: "[Success] publish", from: note.StatusDraft, to: note.StatusPublish, want: NotePublished},
+		{name: "[Success] scheduled note published", from: note.StatusScheduled, to: note.StatusPublish, want: NotePublished},
+		{name: "[Success] unpublish", from: note.StatusPublish, to: note.StatusDraft, want: NoteUnpublished},
+		{name: "[Success] schedule", from: note.StatusDraft, to: note.StatusScheduled, want: NoteScheduled},
+		{name: "[Success] cancel schedule", from: note.StatusScheduled, to: note.StatusDraft, want: NoteUnscheduled},
+		{name: "[Success] submit for review", from: note.StatusDraft, to: note.StatusInReview, want: NoteSubmitted},
+		{name: "[Success] approve", from: note.StatusInReview, to: note.StatusApproved, want: NoteApproved},
+		{name: "[Success] approved note published", from: note.StatusApproved, to: note.StatusPublish, want: NotePublished},
+		{name: "[Success] withdraw from review", from: note.StatusInReview, to: note.StatusDraft, want: NoteReviewWithdrawn},
+		{name: "[Success] withdraw approved note", from: note.StatusApproved, to: note.StatusDraft, want: NoteReviewWithdrawn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NoteStatusEvent(tt.from, tt.to); got != tt.want {
+				t.Fatalf("NoteStatusEvent(%s, %s) = %s, want %s", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Package pagination holds keyset pagination value objects shared by list use cases.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+const (
+	// DefaultLimit is used when the caller does not ask for a page size.
+	DefaultLimit = 20
+	// MaxLimit caps the page size a caller may ask for.
+	MaxLimit = 100
+)
+
+// Cursor identifies the last row of a page in (updated_at DESC, id DESC) order.
+// Ranked searches order by rank first, so their cursors also carry the rank.
+type Cursor struct {
+	Rank      float32
+	UpdatedAt time.Time
+	ID        string
+}
+
+// Info describes the returned page.
+type Info struct {
+	// NextCursor points after the last returned row; nil on the last page.
+	NextCursor *Cursor
+	HasMore    bool
+}
+
+type cursorPayload struct {
+	Rank      float32   `json:"r,omitempty"`
+	UpdatedAt time.Time `json:"u"`
+	ID        string    `json:"i"`
+}
+
+// Encode returns the opaque string form handed to clients.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(cursorPayload{Rank: c.Rank, UpdatedAt: c.UpdatedAt.UTC(), ID: c.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// ParseCursor decodes a client supplied cursor. An empty string means the first page.
+func ParseCursor(raw string) (*Cursor, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	var p cursorPayload
+	if err := json.Unmarshal(decoded, &p); err != nil {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	if p.ID == "" || p.UpdatedAt.IsZero() || p.Rank < 0 {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	return &Cursor{Rank: p.Rank, UpdatedAt: p.UpdatedAt, ID: p.ID}, nil
+}
+
+// NormalizeLimit applies the default page size and rejects out of range values.
+func NormalizeLimit(limit int) (int, error) {
+	// ルール: 未指定なら既定件数、指定する場合は 1〜MaxLimit 件
+	if limit == 0 {
+		return DefaultLimit, nil
+	}
+	if limit < 0 || limit > MaxLimit {
+		return 0, domainerr.ErrInvalidPageLimit
+	}
+	return limit, nil
+}
+
+// Trim cuts a result fetched with limit+1 rows down to the page and reports whether more rows exist.
+func Trim[T any](items []T, limit int, cursorOf func(T) Cursor) ([]T, Info) {
+	if len(items) <= limit {
+		return items, Info{}
+	}
+	items = items[:limit]
+	next := cursorOf(items[len(items)-1])
+	return items, Info{NextCursor: &next, HasMore: true}
+}
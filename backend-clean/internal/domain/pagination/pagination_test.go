@@ -0,0 +1,92 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	for _, c := range []Cursor{
+		{UpdatedAt: time.Date(2026, 10, 16, 1, 2, 3, 456789000, time.UTC), ID: "n1"},
+		{Rank: 0.0607927, UpdatedAt: time.Date(2026, 10, 16, 1, 2, 3, 0, time.UTC), ID: "n2"},
+	} {
+		got, err := ParseCursor(c.Encode())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !got.UpdatedAt.Equal(c.UpdatedAt) || got.ID != c.ID || got.Rank != c.Rank {
+			t.Fatalf("got %+v, want %+v", got, c)
+		}
+	}
+}
+
+func TestParseCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		wantNil   bool
+		wantError error
+	}{
+		{name: "[Success] empty means first page", raw: "", wantNil: true},
+		{name: "[Fail] not base64", raw: "***", wantError: domainerr.ErrInvalidCursor},
+		{name: "[Fail] not json", raw: "bm90LWpzb24", wantError: domainerr.ErrInvalidCursor},
+		{name: "[Fail] missing id", raw: Cursor{UpdatedAt: time.Now()}.Encode(), wantError: domainerr.ErrInvalidCursor},
+		{name: "[Fail] negative rank", raw: Cursor{Rank: -1, UpdatedAt: time.Now(), ID: "n1"}.Encode(), wantError: domainerr.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCursor(tt.raw)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantNil && got != nil {
+				t.Fatalf("want nil cursor, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestNormalizeLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		want      int
+		wantError error
+	}{
+		{name: "[Success] default when unset", limit: 0, want: DefaultLimit},
+		{name: "[Success] explicit limit", limit: 5, want: 5},
+		{name: "[Success] max limit", limit: MaxLimit, want: MaxLimit},
+		{name: "[Fail] negative", limit: -1, wantError: domainerr.ErrInvalidPageLimit},
+		{name: "[Fail] above max", limit: MaxLimit + 1, wantError: domainerr.ErrInvalidPageLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeLimit(tt.limit)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrim(t *testing.T) {
+	cursorOf := func(id string) Cursor { return Cursor{ID: id} }
+
+	items, info := Trim([]string{"a", "b"}, 2, cursorOf)
+	if len(items) != 2 || info.HasMore || info.NextCursor != nil {
+		t.Fatalf("last page: items=%v info=%+v", items, info)
+	}
+
+	items, info = Trim([]string{"a", "b", "c"}, 2, cursorOf)
+	if len(items) != 2 || !info.HasMore || info.NextCursor == nil || info.NextCursor.ID != "b" {
+		t.Fatalf("more pages: items=%v info=%+v", items, info)
+	}
+}
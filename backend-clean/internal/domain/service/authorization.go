@@ -0,0 +1,30 @@
+package service
+
+import (
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/share"
+)
+
+// RoleOn resolves the role the actor holds on a resource owned by ownerID.
+// grant is the actor's grant on the resource, nil when there is none.
+// ルール: オーナーは常に owner、それ以外は共有されたロール、共有がなければ権限なし
+func RoleOn(ownerID, actorID string, grant *share.Grant) share.Role {
+	switch {
+	case actorID == "":
+		return ""
+	case ownerID == actorID:
+		return share.RoleOwner
+	case grant != nil && grant.AccountID == actorID:
+		return grant.Role
+	}
+	return ""
+}
+
+// Authorize checks the actor holds at least the required role on a resource owned by ownerID.
+// ルール: 編集は editor 以上、公開・削除・共有の変更は owner のみ
+func Authorize(ownerID, actorID string, grant *share.Grant, required share.Role) error {
+	if !RoleOn(ownerID, actorID, grant).Includes(required) {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
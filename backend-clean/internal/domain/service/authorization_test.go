@@ -0,0 +1,42 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/share"
+)
+
+func TestAuthorize(t *testing.T) {
+	editor := &share.Grant{AccountID: "friend", Role: share.RoleEditor}
+	tests := []struct {
+		name      string
+		actorID   string
+		grant     *share.Grant
+		required  share.Role
+		wantError error
+	}{
+		{name: "[Success] owner can do anything", actorID: "owner-1", required: share.RoleOwner},
+		{name: "[Success] editor can edit", actorID: "friend", grant: editor, required: share.RoleEditor},
+		{name: "[Success] editor can view", actorID: "friend", grant: editor, required: share.RoleViewer},
+		{name: "[Success] shared owner can manage", actorID: "friend", grant: &share.Grant{AccountID: "friend", Role: share.RoleOwner}, required: share.RoleOwner},
+		{name: "[Fail] editor cannot manage", actorID: "friend", grant: editor, required: share.RoleOwner, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] viewer cannot edit", actorID: "friend", grant: &share.Grant{AccountID: "friend", Role: share.RoleViewer}, required: share.RoleEditor, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] grant of another account", actorID: "stranger", grant: editor, required: share.RoleViewer, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] no grant", actorID: "stranger", required: share.RoleViewer, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] anonymous actor", actorID: "", required: share.RoleViewer, wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Authorize("owner-1", tt.actorID, tt.grant, tt.required)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// CanAssignReviewers checks if the actor can replace the reviewers of the note.
+// ルール: オーナーのみ、Draft / InReview の間のみ変更可
+func CanAssignReviewers(n note.Note, actorID string, reviewerIDs []string) error {
+	if n.OwnerID != actorID || actorID == "" {
+		return domainerr.ErrUnauthorized
+	}
+	if n.Status != note.StatusDraft && n.Status != note.StatusInReview {
+		return domainerr.ErrReviewersLocked
+	}
+	return note.ValidateReviewers(n.OwnerID, reviewerIDs)
+}
+
+// CanSubmitForReview checks if the actor can submit the note to its reviewers.
+// ルール: オーナーのみ、レビュアー 1 人以上、Draft -> InReview のみ
+func CanSubmitForReview(n note.Note, actorID string, reviewerIDs []string) error {
+	if n.OwnerID != actorID || actorID == "" {
+		return domainerr.ErrUnauthorized
+	}
+	if n.Status != note.StatusDraft {
+		return domainerr.ErrInvalidStatusChange
+	}
+	if len(reviewerIDs) == 0 {
+		return domainerr.ErrReviewerRequired
+	}
+	return note.CanChangeStatus(n.Status, note.StatusInReview)
+}
+
+// CanDecideReview checks if the actor can approve or reject the note.
+// ルール: アサインされたレビュアーのみ、InReview -> Approved（承認）、InReview -> Draft（却下）のみ
+func CanDecideReview(n note.Note, actorID string, reviewerIDs []string) error {
+	if actorID == "" || !note.IsReviewer(reviewerIDs, actorID) {
+		return domainerr.ErrUnauthorized
+	}
+	if n.Status != note.StatusInReview {
+		return domainerr.ErrInvalidStatusChange
+	}
+	return nil
+}
+
+// CanViewReviews checks if the actor can see the reviewers and review history of the note.
+// ルール: オーナーとアサインされたレビュアーのみ
+func CanViewReviews(n note.Note, actorID string, reviewerIDs []string) error {
+	if actorID == "" || (n.OwnerID != actorID && !note.IsReviewer(reviewerIDs, actorID)) {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
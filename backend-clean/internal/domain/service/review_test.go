@@ -0,0 +1,114 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestCanAssignReviewers(t *testing.T) {
+	tests := []struct {
+		name      string
+		note      note.Note
+		actorID   string
+		reviewers []string
+		wantError error
+	}{
+		{name: "[Success] owner assigns reviewers to draft", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "owner-1", reviewers: []string{"r1", "r2"}},
+		{name: "[Success] owner changes reviewers while in review", note: note.Note{OwnerID: "owner-1", Status: note.StatusInReview}, actorID: "owner-1", reviewers: []string{"r1"}},
+		{name: "[Success] owner clears reviewers", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "owner-1"},
+		{name: "[Fail] not the owner", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "r1", reviewers: []string{"r1"}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] approved note", note: note.Note{OwnerID: "owner-1", Status: note.StatusApproved}, actorID: "owner-1", reviewers: []string{"r1"}, wantError: domainerr.ErrReviewersLocked},
+		{name: "[Fail] owner as reviewer", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "owner-1", reviewers: []string{"owner-1"}, wantError: domainerr.ErrSelfReview},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanAssignReviewers(tt.note, tt.actorID, tt.reviewers)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestCanSubmitForReview(t *testing.T) {
+	tests := []struct {
+		name      string
+		note      note.Note
+		actorID   string
+		reviewers []string
+		wantError error
+	}{
+		{name: "[Success] owner submits draft", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "owner-1", reviewers: []string{"r1"}},
+		{name: "[Fail] reviewer cannot submit", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "r1", reviewers: []string{"r1"}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] no reviewers", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "owner-1", wantError: domainerr.ErrReviewerRequired},
+		{name: "[Fail] already in review", note: note.Note{OwnerID: "owner-1", Status: note.StatusInReview}, actorID: "owner-1", reviewers: []string{"r1"}, wantError: domainerr.ErrInvalidStatusChange},
+		{name: "[Fail] published note", note: note.Note{OwnerID: "owner-1", Status: note.StatusPublish}, actorID: "owner-1", reviewers: []string{"r1"}, wantError: domainerr.ErrInvalidStatusChange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanSubmitForReview(tt.note, tt.actorID, tt.reviewers)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestCanDecideReview(t *testing.T) {
+	tests := []struct {
+		name      string
+		note      note.Note
+		actorID   string
+		wantError error
+	}{
+		{name: "[Success] assigned reviewer", note: note.Note{OwnerID: "owner-1", Status: note.StatusInReview}, actorID: "r1"},
+		{name: "[Fail] owner cannot approve", note: note.Note{OwnerID: "owner-1", Status: note.StatusInReview}, actorID: "owner-1", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] unassigned account", note: note.Note{OwnerID: "owner-1", Status: note.StatusInReview}, actorID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] note not in review", note: note.Note{OwnerID: "owner-1", Status: note.StatusDraft}, actorID: "r1", wantError: domainerr.ErrInvalidStatusChange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanDecideReview(tt.note, tt.actorID, []string{"r1", "r2"})
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestCanViewReviews(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		wantError error
+	}{
+		{name: "[Success] owner", actorID: "owner-1"},
+		{name: "[Success] assigned reviewer", actorID: "r1"},
+		{name: "[Fail] other account", actorID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] anonymous", actorID: "", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanViewReviews(note.Note{OwnerID: "owner-1"}, tt.actorID, []string{"r1"})
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
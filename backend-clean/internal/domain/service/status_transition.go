@@ -2,26 +2,36 @@
 package service
 
 import (
+	"time"
+
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/share"
 )
 
-// CanPublish checks if the actor can publish the note.
-// ルール: オーナーのみ、Draft -> Publish のみ。
-func CanPublish(n note.Note, actorID string) error {
-	if n.OwnerID != actorID || actorID == "" {
+// CanPublish checks if an actor holding role can publish the note now (to is Publish) or schedule it (to is Scheduled),
+// and that the requested schedule fits the target status.
+// ルール: owner ロールのみ、Draft/Approved/Scheduled -> Publish、Draft/Approved -> Scheduled のみ。予約日時は未来。
+func CanPublish(n note.Note, role share.Role, to note.NoteStatus, s note.Schedule, now time.Time) error {
+	if !role.Includes(share.RoleOwner) {
 		return domainerr.ErrUnauthorized
 	}
 	if err := n.Status.Validate(); err != nil {
 		return err
 	}
-	return note.CanChangeStatus(n.Status, note.StatusPublish)
+	if to != note.StatusPublish && to != note.StatusScheduled {
+		return domainerr.ErrInvalidStatusChange
+	}
+	if err := note.CanChangeStatus(n.Status, to); err != nil {
+		return err
+	}
+	return s.Validate(to, now)
 }
 
-// CanUnpublish checks if the actor can unpublish the note.
-// ルール: オーナーのみ、Publish -> Draft のみ。
-func CanUnpublish(n note.Note, actorID string) error {
-	if n.OwnerID != actorID || actorID == "" {
+// CanUnpublish checks if an actor holding role can unpublish the note.
+// ルール: owner ロールのみ、Publish -> Draft、Scheduled -> Draft（予約取消）、InReview/Approved -> Draft（レビュー取下げ）のみ。
+func CanUnpublish(n note.Note, role share.Role) error {
+	if !role.Includes(share.RoleOwner) {
 		return domainerr.ErrUnauthorized
 	}
 	if err := n.Status.Validate(); err != nil {
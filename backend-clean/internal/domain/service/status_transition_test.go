@@ -3,40 +3,96 @@ package service
 import (
 	"errors"
 	"testing"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/share"
 )
 
 func TestCanPublish(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	later, earlier := now.Add(time.Hour), now.Add(-time.Hour)
 	tests := []struct {
 		name      string
 		note      note.Note
-		actorID   string
+		role      share.Role
+		to        note.NoteStatus
+		schedule  note.Schedule
 		wantError error
 	}{
 		{
-			name:    "[Success] owner can publish draft",
-			note:    note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft},
-			actorID: "owner-1",
+			name: "[Success] owner can publish draft",
+			note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft},
+			role: share.RoleOwner,
 		},
 		{
-			name:      "[Fail] unauthorized actor",
+			name: "[Success] owner can publish approved note",
+			note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusApproved},
+			role: share.RoleOwner,
+		},
+		{
+			name:      "[Fail] note in review cannot be published",
+			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusInReview},
+			role:      share.RoleOwner,
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name:     "[Success] owner can schedule draft",
+			note:     note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft},
+			role:     share.RoleOwner,
+			to:       note.StatusScheduled,
+			schedule: note.Schedule{PublishAt: &later},
+		},
+		{
+			name: "[Success] owner can publish scheduled note early",
+			note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusScheduled, PublishAt: &later},
+			role: share.RoleOwner,
+		},
+		{
+			name:      "[Fail] schedule in the past",
+			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft},
+			role:      share.RoleOwner,
+			to:        note.StatusScheduled,
+			schedule:  note.Schedule{PublishAt: &earlier},
+			wantError: domainerr.ErrScheduleInPast,
+		},
+		{
+			name:      "[Fail] published note cannot be scheduled",
+			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusPublish},
+			role:      share.RoleOwner,
+			to:        note.StatusScheduled,
+			schedule:  note.Schedule{PublishAt: &later},
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name:      "[Fail] draft is not a publish target",
+			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusPublish},
+			role:      share.RoleOwner,
+			to:        note.StatusDraft,
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name:      "[Fail] editor cannot publish",
 			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft},
-			actorID:   "other",
+			role:      share.RoleEditor,
 			wantError: domainerr.ErrUnauthorized,
 		},
 		{
 			name:      "[Fail] invalid status value",
 			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.NoteStatus("Invalid")},
-			actorID:   "owner-1",
+			role:      share.RoleOwner,
 			wantError: domainerr.ErrInvalidStatus,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := CanPublish(tt.note, tt.actorID)
+			to := tt.to
+			if to == "" {
+				to = note.StatusPublish
+			}
+			err := CanPublish(tt.note, tt.role, to, tt.schedule, now)
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -51,31 +107,41 @@ func TestCanUnpublish(t *testing.T) {
 	tests := []struct {
 		name      string
 		note      note.Note
-		actorID   string
+		role      share.Role
 		wantError error
 	}{
 		{
-			name:    "[Success] owner can unpublish publish",
-			note:    note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusPublish},
-			actorID: "owner-1",
+			name: "[Success] owner can unpublish publish",
+			note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusPublish},
+			role: share.RoleOwner,
+		},
+		{
+			name: "[Success] owner can cancel a schedule",
+			note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusScheduled},
+			role: share.RoleOwner,
+		},
+		{
+			name: "[Success] owner can withdraw a note from review",
+			note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusInReview},
+			role: share.RoleOwner,
 		},
 		{
-			name:      "[Fail] unauthorized actor",
+			name:      "[Fail] editor cannot unpublish",
 			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusPublish},
-			actorID:   "other",
+			role:      share.RoleEditor,
 			wantError: domainerr.ErrUnauthorized,
 		},
 		{
 			name:      "[Fail] invalid status value",
 			note:      note.Note{ID: "n1", OwnerID: "owner-1", Status: note.NoteStatus("Invalid")},
-			actorID:   "owner-1",
+			role:      share.RoleOwner,
 			wantError: domainerr.ErrInvalidStatus,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := CanUnpublish(tt.note, tt.actorID)
+			err := CanUnpublish(tt.note, tt.role)
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -0,0 +1,23 @@
+// Package session holds login session domain models.
+package session
+
+import "time"
+
+// Session is a server-side refresh session. Only the hash of the
+// refresh token is stored; the raw token is handed to the client once.
+type Session struct {
+	ID               string
+	AccountID        string
+	RefreshTokenHash string
+	ExpiresAt        time.Time
+	RevokedAt        *time.Time
+	CreatedAt        time.Time
+}
+
+// Tokens is the credential pair issued for a session.
+type Tokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
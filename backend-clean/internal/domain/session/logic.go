@@ -0,0 +1,32 @@
+package session
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// CanIssue checks whether a session may be issued for the account.
+func CanIssue(a account.Account) error {
+	// ルール: 無効化されたアカウントにはセッションを発行しない
+	return account.EnsureActive(a)
+}
+
+// CanRefresh checks whether the session may be rotated at now.
+func CanRefresh(s Session, now time.Time) error {
+	// ルール: 失効済みのセッションは再利用できない
+	if s.IsRevoked() {
+		return domainerr.ErrSessionInvalid
+	}
+	// ルール: 有効期限切れのセッションは再発行できない
+	if !now.Before(s.ExpiresAt) {
+		return domainerr.ErrSessionExpired
+	}
+	return nil
+}
+
+// IsRevoked reports whether the session was revoked (logout or rotation).
+func (s Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
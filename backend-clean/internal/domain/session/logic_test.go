@@ -0,0 +1,49 @@
+package session
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestCanIssue(t *testing.T) {
+	tests := []struct {
+		name    string
+		acc     account.Account
+		wantErr error
+	}{
+		{name: "[Success] active account", acc: account.Account{IsActive: true}},
+		{name: "[Fail] inactive account", acc: account.Account{IsActive: false}, wantErr: domainerr.ErrAccountInactive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CanIssue(tt.acc); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCanRefresh(t *testing.T) {
+	now := time.Now()
+	revoked := now.Add(-time.Minute)
+	tests := []struct {
+		name    string
+		session Session
+		wantErr error
+	}{
+		{name: "[Success] active session", session: Session{ExpiresAt: now.Add(time.Hour)}},
+		{name: "[Fail] revoked session", session: Session{ExpiresAt: now.Add(time.Hour), RevokedAt: &revoked}, wantErr: domainerr.ErrSessionInvalid},
+		{name: "[Fail] expired session", session: Session{ExpiresAt: now.Add(-time.Second)}, wantErr: domainerr.ErrSessionExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CanRefresh(tt.session, now); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
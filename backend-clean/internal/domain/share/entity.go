@@ -0,0 +1,35 @@
+// Package share models access grants that let other accounts view or edit a note or template.
+package share
+
+import "time"
+
+// Role is the access level a grant gives on a resource.
+type Role string
+
+// Roles, from least to most access.
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleOwner  Role = "owner"
+)
+
+// ResourceType names the kind of resource a grant is on.
+type ResourceType string
+
+// Resource types that can be shared.
+const (
+	ResourceNote     ResourceType = "note"
+	ResourceTemplate ResourceType = "template"
+)
+
+// Grant gives AccountID a role on a resource owned by someone else.
+type Grant struct {
+	ResourceType ResourceType
+	ResourceID   string
+	AccountID    string
+	Role         Role
+	// GrantedBy is the account that last set the role.
+	GrantedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,40 @@
+package share
+
+import (
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+var roleRank = map[Role]int{RoleViewer: 1, RoleEditor: 2, RoleOwner: 3}
+
+// Validate checks the role is one of the defined roles.
+func (r Role) Validate() error {
+	if _, ok := roleRank[r]; !ok {
+		return domainerr.ErrInvalidShareRole
+	}
+	return nil
+}
+
+// Includes reports whether r grants at least the access of required.
+// ルール: viewer < editor < owner の順に上位ロールは下位ロールの権限を含む。空のロールは何も含まない
+func (r Role) Includes(required Role) bool {
+	have, ok := roleRank[r]
+	return ok && have >= roleRank[required]
+}
+
+// ValidateGrant checks a grant on a resource owned by ownerID before it is saved.
+func ValidateGrant(ownerID string, g Grant) error {
+	verr := &domainerr.ValidationError{}
+	// ルール: 共有先アカウントは必須で、リソースのオーナー自身には共有できない
+	switch {
+	case strings.TrimSpace(g.AccountID) == "":
+		verr.Add("accountId", domainerr.CodeRequired, domainerr.ErrUnknownShareAccount)
+	case g.AccountID == ownerID:
+		verr.Add("accountId", domainerr.CodeInvalid, domainerr.ErrShareWithOwner)
+	}
+	if err := g.Role.Validate(); err != nil {
+		verr.Add("role", domainerr.CodeInvalid, err)
+	}
+	return verr.Err()
+}
@@ -0,0 +1,76 @@
+package share
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestRole_Includes(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     Role
+		required Role
+		want     bool
+	}{
+		{name: "[Success] owner includes editor", role: RoleOwner, required: RoleEditor, want: true},
+		{name: "[Success] editor includes viewer", role: RoleEditor, required: RoleViewer, want: true},
+		{name: "[Success] viewer includes viewer", role: RoleViewer, required: RoleViewer, want: true},
+		{name: "[Fail] viewer does not include editor", role: RoleViewer, required: RoleEditor},
+		{name: "[Fail] editor does not include owner", role: RoleEditor, required: RoleOwner},
+		{name: "[Fail] no role includes nothing", role: "", required: RoleViewer},
+		{name: "[Fail] unknown role includes nothing", role: Role("admin"), required: RoleViewer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.role.Includes(tt.required); got != tt.want {
+				t.Fatalf("Includes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateGrant(t *testing.T) {
+	tests := []struct {
+		name      string
+		grant     Grant
+		wantPaths []string
+		wantError error
+	}{
+		{name: "[Success] editor grant", grant: Grant{AccountID: "friend", Role: RoleEditor}},
+		{name: "[Success] co-owner grant", grant: Grant{AccountID: "friend", Role: RoleOwner}},
+		{name: "[Fail] missing account", grant: Grant{Role: RoleViewer}, wantPaths: []string{"accountId"}, wantError: domainerr.ErrUnknownShareAccount},
+		{name: "[Fail] shared with owner", grant: Grant{AccountID: "owner-1", Role: RoleViewer}, wantPaths: []string{"accountId"}, wantError: domainerr.ErrShareWithOwner},
+		{name: "[Fail] unknown role", grant: Grant{AccountID: "friend", Role: Role("admin")}, wantPaths: []string{"role"}, wantError: domainerr.ErrInvalidShareRole},
+		{name: "[Fail] every violation at once", grant: Grant{}, wantPaths: []string{"accountId", "role"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGrant("owner-1", tt.grant)
+			if len(tt.wantPaths) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *domainerr.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("want *ValidationError, got %v", err)
+			}
+			if len(verr.Violations) != len(tt.wantPaths) {
+				t.Fatalf("violations = %+v, want paths %v", verr.Violations, tt.wantPaths)
+			}
+			for i, p := range tt.wantPaths {
+				if verr.Violations[i].Path != p {
+					t.Fatalf("violations[%d].Path = %q, want %q", i, verr.Violations[i].Path, p)
+				}
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
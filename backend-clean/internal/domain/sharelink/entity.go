@@ -0,0 +1,18 @@
+// Package sharelink models public read-only links to published notes.
+package sharelink
+
+import "time"
+
+// Link lets anyone holding its token read a published note without an account.
+// Only the hash of the token is stored; the raw token is handed to the creator once.
+type Link struct {
+	ID        string
+	NoteID    string
+	TokenHash string
+	CreatedBy string
+	// ExpiresAt is when the link stops working; nil links work until revoked.
+	ExpiresAt *time.Time
+	// RevokedAt is set once the link is revoked by hand or by unpublishing the note.
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,54 @@
+package sharelink
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// HashToken returns the hex SHA-256 of a link token. Tokens carry 256 bits of
+// entropy, so an unsalted fast hash is sufficient.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateLink checks a link may be created for n at now.
+func ValidateLink(n note.Note, l Link, now time.Time) error {
+	// ルール: 公開リンクを発行できるのは公開中のノートのみ
+	if n.Status != note.StatusPublish {
+		return domainerr.ErrShareLinkNotPublished
+	}
+	verr := &domainerr.ValidationError{}
+	// ルール: 有効期限を指定する場合は未来の日時
+	if l.ExpiresAt != nil && !l.ExpiresAt.After(now) {
+		verr.Add("expiresAt", domainerr.CodeInvalid, domainerr.ErrShareLinkExpiryInPast)
+	}
+	return verr.Err()
+}
+
+// IsActive reports whether the link still works at now.
+func (l Link) IsActive(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	return l.ExpiresAt == nil || now.Before(*l.ExpiresAt)
+}
+
+// CanView checks the note behind l may be shown to anyone at now.
+// Revoked and expired links, and links to notes no longer published, are reported as
+// errors.ErrNotFound so a token reveals nothing about the note once it stops working.
+func CanView(l Link, n note.Note, now time.Time) error {
+	// ルール: 失効・期限切れのリンクでは閲覧できない
+	if !l.IsActive(now) {
+		return domainerr.ErrNotFound
+	}
+	// ルール: 公開を取り下げた・ゴミ箱に入れたノートはリンクから閲覧できない
+	if n.Status != note.StatusPublish || n.DeletedAt != nil {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package sharelink
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestHashToken(t *testing.T) {
+	if HashToken("abc") != HashToken("abc") {
+		t.Fatalf("hash must be stable")
+	}
+	if HashToken("abc") == HashToken("abd") {
+		t.Fatalf("different tokens must not share a hash")
+	}
+	if got := len(HashToken("abc")); got != 64 {
+		t.Fatalf("hash length = %d, want 64", got)
+	}
+}
+
+func TestValidateLink(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	tests := []struct {
+		name      string
+		status    note.NoteStatus
+		expiresAt *time.Time
+		wantPath  string
+		wantError error
+	}{
+		{name: "[Success] published note without expiry", status: note.StatusPublish},
+		{name: "[Success] published note with future expiry", status: note.StatusPublish, expiresAt: &future},
+		{name: "[Fail] draft note", status: note.StatusDraft, wantError: domainerr.ErrShareLinkNotPublished},
+		{name: "[Fail] expiry in the past", status: note.StatusPublish, expiresAt: &past, wantPath: "expiresAt", wantError: domainerr.ErrShareLinkExpiryInPast},
+		{name: "[Fail] expiry now", status: note.StatusPublish, expiresAt: &now, wantPath: "expiresAt", wantError: domainerr.ErrShareLinkExpiryInPast},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLink(note.Note{Status: tt.status}, Link{ExpiresAt: tt.expiresAt}, now)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantPath == "" {
+				return
+			}
+			var verr *domainerr.ValidationError
+			if !errors.As(err, &verr) || verr.Violations[0].Path != tt.wantPath {
+				t.Fatalf("want violation at %q, got %v", tt.wantPath, err)
+			}
+		})
+	}
+}
+
+func TestCanView(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	published := note.Note{Status: note.StatusPublish}
+	tests := []struct {
+		name      string
+		link      Link
+		note      note.Note
+		wantError error
+	}{
+		{name: "[Success] live link to published note", link: Link{}, note: published},
+		{name: "[Success] link before expiry", link: Link{ExpiresAt: &future}, note: published},
+		{name: "[Fail] revoked link", link: Link{RevokedAt: &past}, note: published, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] expired link", link: Link{ExpiresAt: &past}, note: published, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] unpublished note", link: Link{}, note: note.Note{Status: note.StatusDraft}, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] trashed note", link: Link{}, note: note.Note{Status: note.StatusPublish, DeletedAt: &past}, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanView(tt.link, tt.note, now)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package template
+
+import (
+	"fmt"
+	"slices"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// DocumentVersion is the format version of portable template documents.
+const DocumentVersion = 1
+
+// Document is the portable form of a template: its name and fields in display order,
+// without IDs, keys, owner or workspace, so that any account can import it.
+type Document struct {
+	Version int
+	Name    string
+	Fields  []Field
+}
+
+// NewDocument returns the portable document of t.
+func NewDocument(t Template) Document {
+	return Document{Version: DocumentVersion, Name: t.Name, Fields: CloneFields(t.Fields)}
+}
+
+// CheckVersion rejects documents written in another format version.
+func (d Document) CheckVersion() error {
+	if d.Version != DocumentVersion {
+		return fmt.Errorf("version %d: %w", d.Version, domainerr.ErrTemplateDocumentVersionUnsupported)
+	}
+	return nil
+}
+
+// CloneFields copies fields into new fields of another template.
+// ルール: 複製したフィールドは元の表示順を保ち、ID とキーは引き継がない（順序は 1 から振り直す）
+func CloneFields(fields []Field) []Field {
+	out := make([]Field, len(fields))
+	copy(out, fields)
+	slices.SortStableFunc(out, func(a, b Field) int { return a.Order - b.Order })
+	for i := range out {
+		out[i].ID = ""
+		out[i].Key = ""
+		out[i].Order = i + 1
+		out[i].Options = slices.Clone(out[i].Options)
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package template
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestCloneFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		fields     []Field
+		wantLabels []string
+	}{
+		{
+			name: "[Success] keeps display order and renumbers",
+			fields: []Field{
+				{ID: "f2", Key: "k2", Label: "Solution", Order: 5},
+				{ID: "f1", Key: "k1", Label: "Background", Order: 2, IsRequired: true},
+			},
+			wantLabels: []string{"Background", "Solution"},
+		},
+		{
+			name:       "[Success] unordered fields keep their position",
+			fields:     []Field{{Label: "A"}, {Label: "B"}},
+			wantLabels: []string{"A", "B"},
+		},
+		{name: "[Success] no fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CloneFields(tt.fields)
+			if len(got) != len(tt.wantLabels) {
+				t.Fatalf("got %d fields, want %d", len(got), len(tt.wantLabels))
+			}
+			for i, label := range tt.wantLabels {
+				if got[i].Label != label || got[i].Order != i+1 || got[i].ID != "" || got[i].Key != "" {
+					t.Fatalf("field %d = %+v", i, got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCloneFields_KeepsFlagsAndCopiesOptions(t *testing.T) {
+	src := []Field{{ID: "f1", Label: "Mood", Order: 1, IsRequired: true, Type: FieldTypeSingleSelect, Options: []string{"good", "bad"}}}
+	got := CloneFields(src)
+	got[0].Options[0] = "changed"
+	if !got[0].IsRequired || got[0].Type != FieldTypeSingleSelect || src[0].Options[0] != "good" || src[0].ID != "f1" {
+		t.Fatalf("clone = %+v, source = %+v", got[0], src[0])
+	}
+}
+
+func TestDocument_CheckVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		doc       Document
+		wantError error
+	}{
+		{name: "[Success] current version", doc: NewDocument(Template{Name: "Weekly"})},
+		{name: "[Fail] other version", doc: Document{Version: 2, Name: "Weekly"}, wantError: domainerr.ErrTemplateDocumentVersionUnsupported},
+		{name: "[Fail] version missing", doc: Document{Name: "Weekly"}, wantError: domainerr.ErrTemplateDocumentVersionUnsupported},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.doc.CheckVersion(); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
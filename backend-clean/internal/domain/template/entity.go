@@ -10,12 +10,57 @@ type Template struct {
 	OwnerID   string
 	Fields    []Field
 	UpdatedAt time.Time
+	Version   int
+	// SchemaVersion is the latest version of the field set; it changes only when fields change.
+	SchemaVersion int
+	// DeletedAt is set while the template is in the trash.
+	DeletedAt *time.Time
+	// WorkspaceID is the workspace the template and all of its notes belong to.
+	WorkspaceID string
+	// IsPublic lists the template in the gallery, where any account can read and clone it.
+	IsPublic bool
 }
 
 // Field represents a template field definition.
+// ID names the field within one schema version; Key names the same field across versions.
 type Field struct {
 	ID         string
+	Key        string
 	Label      string
 	Order      int
 	IsRequired bool
+	Type       FieldType
+	// Options are the allowed values of a select field.
+	Options []string
+	// MinLength and MaxLength bound the content length in characters; 0 means no bound.
+	MinLength int
+	MaxLength int
+	// Pattern is a regular expression the whole content must match; empty means any.
+	Pattern string
+	// Placeholder is the hint an editor shows in an empty section.
+	Placeholder string
+	// DefaultContent fills the section when a note is created without it.
+	DefaultContent string
 }
+
+// FieldType decides what section content a field accepts.
+type FieldType string
+
+const (
+	// FieldTypeText accepts any text.
+	FieldTypeText FieldType = "text"
+	// FieldTypeMarkdown accepts any text rendered as Markdown.
+	FieldTypeMarkdown FieldType = "markdown"
+	// FieldTypeNumber accepts a decimal number.
+	FieldTypeNumber FieldType = "number"
+	// FieldTypeDate accepts a calendar date (YYYY-MM-DD).
+	FieldTypeDate FieldType = "date"
+	// FieldTypeSingleSelect accepts one of the field options.
+	FieldTypeSingleSelect FieldType = "single_select"
+	// FieldTypeMultiSelect accepts a JSON array of distinct field options.
+	FieldTypeMultiSelect FieldType = "multi_select"
+	// FieldTypeURL accepts an absolute http(s) URL.
+	FieldTypeURL FieldType = "url"
+	// FieldTypeCheckbox accepts "true" or "false".
+	FieldTypeCheckbox FieldType = "checkbox"
+)
@@ -1,60 +1,284 @@
 package template
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
 
-// NormalizeAndValidate sets missing order and validates fields.
+// NormalizeAndValidate sets missing order and type and validates fields.
 func NormalizeAndValidate(fields []Field) ([]Field, error) {
+	normalize(fields)
+	verr := &domainerr.ValidationError{}
+	validateFields(verr, fields)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func normalize(fields []Field) {
 	for i := range fields {
 		if fields[i].Order == 0 {
 			fields[i].Order = i + 1
 		}
+		if fields[i].Type == "" {
+			fields[i].Type = FieldTypeText
+		}
 	}
-	if err := validateFields(fields); err != nil {
-		return nil, err
-	}
-	return fields, nil
 }
 
-func validateFields(fields []Field) error {
-	seen := make(map[int]bool)
+func validateFields(verr *domainerr.ValidationError, fields []Field) {
 	if len(fields) == 0 {
-		return domainerr.ErrFieldRequired
+		verr.Add("fields", domainerr.CodeRequired, domainerr.ErrFieldRequired)
+		return
 	}
-	for _, f := range fields {
+	seen := make(map[int]bool)
+	for i, f := range fields {
+		path := fmt.Sprintf("fields[%d]", i)
 		if f.Label == "" {
-			return domainerr.ErrFieldLabelRequired
+			verr.Add(path+".label", domainerr.CodeRequired, domainerr.ErrFieldLabelRequired)
+		}
+		switch {
+		case f.Order <= 0:
+			verr.Add(path+".order", domainerr.CodeInvalid, domainerr.ErrFieldOrderInvalid)
+		case seen[f.Order]:
+			verr.Add(path+".order", domainerr.CodeDuplicate, domainerr.ErrFieldOrderInvalid)
 		}
-		order := f.Order
-		if order <= 0 {
-			return domainerr.ErrFieldOrderInvalid
+		seen[f.Order] = true
+		if err := f.Type.Validate(); err != nil {
+			verr.Add(path+".type", domainerr.CodeInvalid, err)
+			continue
 		}
-		if seen[order] {
-			return domainerr.ErrFieldOrderInvalid
+		validateOptions(verr, path, f)
+		validateConstraints(verr, path, f)
+	}
+}
+
+// ルール: 選択型フィールドには重複のない空でない選択肢が 1 つ以上必要。その他の型は選択肢を持たない
+func validateOptions(verr *domainerr.ValidationError, path string, f Field) {
+	path += ".options"
+	if !f.Type.IsSelect() {
+		if len(f.Options) > 0 {
+			verr.Add(path, domainerr.CodeInvalid, domainerr.ErrFieldOptionsInvalid)
+		}
+		return
+	}
+	if len(f.Options) == 0 {
+		verr.Add(path, domainerr.CodeRequired, domainerr.ErrFieldOptionsInvalid)
+		return
+	}
+	seen := make(map[string]bool, len(f.Options))
+	for j, o := range f.Options {
+		switch {
+		case strings.TrimSpace(o) == "":
+			verr.Add(fmt.Sprintf("%s[%d]", path, j), domainerr.CodeRequired, domainerr.ErrFieldOptionsInvalid)
+		case seen[o]:
+			verr.Add(fmt.Sprintf("%s[%d]", path, j), domainerr.CodeDuplicate, domainerr.ErrFieldOptionsInvalid)
+		}
+		seen[o] = true
+	}
+}
+
+// ルール: 最小長は最大長を超えられず、パターンは正規表現として有効で、既定値はフィールド自身の制約を満たす
+func validateConstraints(verr *domainerr.ValidationError, path string, f Field) {
+	valid := true
+	if f.MinLength < 0 || (f.MaxLength > 0 && f.MinLength > f.MaxLength) {
+		verr.Add(path+".minLength", domainerr.CodeInvalid, domainerr.ErrFieldConstraintInvalid)
+		valid = false
+	}
+	if f.MaxLength < 0 {
+		verr.Add(path+".maxLength", domainerr.CodeInvalid, domainerr.ErrFieldConstraintInvalid)
+		valid = false
+	}
+	if f.Pattern != "" {
+		if _, err := compilePattern(f.Pattern); err != nil {
+			verr.Add(path+".pattern", domainerr.CodeInvalid, domainerr.ErrFieldConstraintInvalid)
+			valid = false
+		}
+	}
+	if !valid {
+		return
+	}
+	if err := f.CheckContent(f.DefaultContent); err != nil {
+		verr.Add(path+".defaultContent", ContentCode(err), domainerr.ErrFieldConstraintInvalid)
+	}
+}
+
+// CheckContent checks section content against the field type, length bounds and pattern.
+// ルール: 空の内容は型や制約に関係なく受け付ける（必須かどうかは IsRequired で判定する）
+func (f Field) CheckContent(content string) error {
+	if content == "" {
+		return nil
+	}
+	if !f.Type.accepts(f.Options, content) {
+		return domainerr.ErrInvalidSectionContent
+	}
+	length := utf8.RuneCountInString(content)
+	if length < f.MinLength {
+		return domainerr.ErrSectionTooShort
+	}
+	if f.MaxLength > 0 && length > f.MaxLength {
+		return domainerr.ErrSectionTooLong
+	}
+	if f.Pattern != "" {
+		re, err := compilePattern(f.Pattern)
+		if err != nil || !re.MatchString(content) {
+			return domainerr.ErrSectionPatternMismatch
 		}
-		seen[order] = true
 	}
 	return nil
 }
 
+// ContentCode names the violation code of an error returned by CheckContent.
+func ContentCode(err error) string {
+	switch {
+	case errors.Is(err, domainerr.ErrSectionTooShort):
+		return domainerr.CodeTooShort
+	case errors.Is(err, domainerr.ErrSectionTooLong):
+		return domainerr.CodeTooLong
+	case errors.Is(err, domainerr.ErrSectionPatternMismatch):
+		return domainerr.CodePatternMismatch
+	default:
+		return domainerr.CodeInvalid
+	}
+}
+
+// compilePattern anchors the pattern so it has to match the whole content.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// accepts reports whether non-empty content is a valid value of the type.
+func (t FieldType) accepts(options []string, content string) bool {
+	switch t {
+	case FieldTypeNumber:
+		// ルール: NaN や Infinity は数値として扱わない
+		v, err := strconv.ParseFloat(content, 64)
+		return err == nil && !math.IsNaN(v) && !math.IsInf(v, 0)
+	case FieldTypeDate:
+		_, err := time.Parse(time.DateOnly, content)
+		return err == nil
+	case FieldTypeSingleSelect:
+		return slices.Contains(options, content)
+	case FieldTypeMultiSelect:
+		return validMultiSelect(options, content)
+	case FieldTypeURL:
+		u, err := url.ParseRequestURI(content)
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case FieldTypeCheckbox:
+		return content == "true" || content == "false"
+	}
+	return true
+}
+
+// validMultiSelect reports whether content is a JSON array of distinct options.
+func validMultiSelect(options []string, content string) bool {
+	var values []string
+	if err := json.Unmarshal([]byte(content), &values); err != nil || values == nil {
+		return false
+	}
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v] || !slices.Contains(options, v) {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
+
+// Validate checks if the field type is known.
+func (t FieldType) Validate() error {
+	switch t {
+	case FieldTypeText, FieldTypeMarkdown, FieldTypeNumber, FieldTypeDate,
+		FieldTypeSingleSelect, FieldTypeMultiSelect, FieldTypeURL, FieldTypeCheckbox:
+		return nil
+	}
+	return domainerr.ErrInvalidFieldType
+}
+
+// IsSelect reports whether the field takes its content from Options.
+func (t FieldType) IsSelect() bool {
+	return t == FieldTypeSingleSelect || t == FieldTypeMultiSelect
+}
+
 // ValidateTemplate ensures template has required attributes and valid fields.
 func ValidateTemplate(t Template) error {
+	verr := &domainerr.ValidationError{}
 	if t.Name == "" {
-		return domainerr.ErrTemplateNameRequired
+		verr.Add("name", domainerr.CodeRequired, domainerr.ErrTemplateNameRequired)
 	}
 	if t.OwnerID == "" {
-		return domainerr.ErrTemplateOwnerRequired
+		verr.Add("ownerId", domainerr.CodeRequired, domainerr.ErrTemplateOwnerRequired)
 	}
-	if _, err := NormalizeAndValidate(t.Fields); err != nil {
-		return err
+	normalize(t.Fields)
+	validateFields(verr, t.Fields)
+	return verr.Err()
+}
+
+// CarryFieldKeys gives each edited field the key of the current field its ID refers to.
+// ルール: ID なしのフィールドは新規。現行スキーマバージョンにない ID や重複した ID はエラー
+func CarryFieldKeys(current, edited []Field) ([]Field, error) {
+	keyByID := make(map[string]string, len(current))
+	for _, f := range current {
+		keyByID[f.ID] = f.Key
 	}
-	return nil
+	seen := make(map[string]bool, len(edited))
+	out := make([]Field, 0, len(edited))
+	for _, f := range edited {
+		f.Key = ""
+		if f.ID != "" {
+			key, ok := keyByID[f.ID]
+			if !ok || seen[f.ID] {
+				return nil, domainerr.ErrInvalidTemplateField
+			}
+			seen[f.ID] = true
+			f.Key = key
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// FieldsChanged reports whether edited fields (keys carried over) differ from the current ones.
+// Adding or removing a field, or changing any of its attributes, needs a new schema version.
+func FieldsChanged(current, edited []Field) bool {
+	if len(current) != len(edited) {
+		return true
+	}
+	byKey := make(map[string]Field, len(current))
+	for _, f := range current {
+		byKey[f.Key] = f
+	}
+	for _, f := range edited {
+		c, ok := byKey[f.Key]
+		if f.Key == "" || !ok || c.Label != f.Label || c.Order != f.Order || c.IsRequired != f.IsRequired {
+			return true
+		}
+		if c.Type != f.Type || !slices.Equal(c.Options, f.Options) {
+			return true
+		}
+		if c.MinLength != f.MinLength || c.MaxLength != f.MaxLength || c.Pattern != f.Pattern ||
+			c.Placeholder != f.Placeholder || c.DefaultContent != f.DefaultContent {
+			return true
+		}
+	}
+	return false
 }
 
 // CanDeleteTemplate returns error if template is in use.
+// ルール: ゴミ箱にあるノートは使用中に数えない（ノートの完全削除まではテンプレートも完全削除されない）
 func CanDeleteTemplate(isUsed bool) error {
 	if isUsed {
 		return domainerr.ErrTemplateInUse
@@ -62,6 +286,15 @@ func CanDeleteTemplate(isUsed bool) error {
 	return nil
 }
 
+// CheckVersion rejects writes based on a stale read.
+// ルール: expected が 0 の場合（If-Match なし）は検証しない
+func (t Template) CheckVersion(expected int) error {
+	if expected != 0 && expected != t.Version {
+		return domainerr.ErrVersionConflict
+	}
+	return nil
+}
+
 // ValidateTemplateOwnership ensures only owner can mutate a template.
 func ValidateTemplateOwnership(templateOwnerID, actorID string) error {
 	if strings.TrimSpace(templateOwnerID) == "" || strings.TrimSpace(actorID) == "" {
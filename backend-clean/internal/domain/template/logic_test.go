@@ -42,6 +42,84 @@ func TestNormalizeAndValidate(t *testing.T) {
 			},
 			wantError: domainerr.ErrFieldOrderInvalid,
 		},
+		{
+			name: "[Success] select field with options",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldTypeSingleSelect, Options: []string{"low", "high"}},
+				{ID: "f2", Label: "Tags", Order: 2, Type: FieldTypeMultiSelect, Options: []string{"go", "sql"}},
+			},
+			wantOrder: []int{1, 2},
+		},
+		{
+			name: "[Fail] unknown type",
+			fields: []Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: "color"},
+			},
+			wantError: domainerr.ErrInvalidFieldType,
+		},
+		{
+			name: "[Fail] select field without options",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldTypeSingleSelect},
+			},
+			wantError: domainerr.ErrFieldOptionsInvalid,
+		},
+		{
+			name: "[Fail] duplicate option",
+			fields: []Field{
+				{ID: "f1", Label: "Tags", Order: 1, Type: FieldTypeMultiSelect, Options: []string{"go", "go"}},
+			},
+			wantError: domainerr.ErrFieldOptionsInvalid,
+		},
+		{
+			name: "[Fail] blank option",
+			fields: []Field{
+				{ID: "f1", Label: "Tags", Order: 1, Type: FieldTypeMultiSelect, Options: []string{"go", " "}},
+			},
+			wantError: domainerr.ErrFieldOptionsInvalid,
+		},
+		{
+			name: "[Fail] options on a non-select field",
+			fields: []Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: FieldTypeText, Options: []string{"a"}},
+			},
+			wantError: domainerr.ErrFieldOptionsInvalid,
+		},
+		{
+			name: "[Success] constraints with a matching default",
+			fields: []Field{
+				{ID: "f1", Label: "Code", Order: 1, MinLength: 3, MaxLength: 8, Pattern: `[A-Z]+-\d+`, DefaultContent: "ABC-1"},
+			},
+			wantOrder: []int{1},
+		},
+		{
+			name: "[Fail] min length above max length",
+			fields: []Field{
+				{ID: "f1", Label: "Code", Order: 1, MinLength: 5, MaxLength: 4},
+			},
+			wantError: domainerr.ErrFieldConstraintInvalid,
+		},
+		{
+			name: "[Fail] invalid pattern",
+			fields: []Field{
+				{ID: "f1", Label: "Code", Order: 1, Pattern: "[a-"},
+			},
+			wantError: domainerr.ErrFieldConstraintInvalid,
+		},
+		{
+			name: "[Fail] default breaks the pattern",
+			fields: []Field{
+				{ID: "f1", Label: "Code", Order: 1, Pattern: `\d+`, DefaultContent: "abc"},
+			},
+			wantError: domainerr.ErrFieldConstraintInvalid,
+		},
+		{
+			name: "[Fail] default is not a valid option",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldTypeSingleSelect, Options: []string{"low"}, DefaultContent: "high"},
+			},
+			wantError: domainerr.ErrFieldConstraintInvalid,
+		},
 	}
 
 	for _, tt := range tests {
@@ -61,6 +139,9 @@ func TestNormalizeAndValidate(t *testing.T) {
 					if f.Order != tt.wantOrder[i] {
 						t.Fatalf("order mismatch at %d: want %d, got %d", i, tt.wantOrder[i], f.Order)
 					}
+					if f.Type == "" {
+						t.Fatalf("type not defaulted at %d", i)
+					}
 				}
 			}
 		})
@@ -123,6 +204,38 @@ func TestValidateTemplate(t *testing.T) {
 	}
 }
 
+func TestValidateTemplate_ReportsEveryViolation(t *testing.T) {
+	tpl := Template{
+		OwnerID: "owner-1",
+		Fields: []Field{
+			{ID: "f1", Label: "Title", Order: 1},
+			{ID: "f2", Order: 1, Type: FieldTypeSingleSelect},
+			{ID: "f3", Label: "Body", Order: 3, MinLength: 5, MaxLength: 2},
+		},
+	}
+
+	err := ValidateTemplate(tpl)
+	var verr *domainerr.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("want ValidationError, got %v", err)
+	}
+	want := []domainerr.Violation{
+		{Path: "name", Code: domainerr.CodeRequired, Err: domainerr.ErrTemplateNameRequired},
+		{Path: "fields[1].label", Code: domainerr.CodeRequired, Err: domainerr.ErrFieldLabelRequired},
+		{Path: "fields[1].order", Code: domainerr.CodeDuplicate, Err: domainerr.ErrFieldOrderInvalid},
+		{Path: "fields[1].options", Code: domainerr.CodeRequired, Err: domainerr.ErrFieldOptionsInvalid},
+		{Path: "fields[2].minLength", Code: domainerr.CodeInvalid, Err: domainerr.ErrFieldConstraintInvalid},
+	}
+	if len(verr.Violations) != len(want) {
+		t.Fatalf("violations = %v, want %v", verr.Violations, want)
+	}
+	for i, v := range verr.Violations {
+		if v.Path != want[i].Path || v.Code != want[i].Code || !errors.Is(v.Err, want[i].Err) {
+			t.Fatalf("violation[%d] = %+v, want %+v", i, v, want[i])
+		}
+	}
+}
+
 func TestCanDeleteTemplate(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -194,3 +307,106 @@ func TestValidateTemplateOwnership(t *testing.T) {
 		})
 	}
 }
+
+func TestCarryFieldKeys(t *testing.T) {
+	current := []Field{
+		{ID: "f1", Key: "k1", Label: "Background", Order: 1},
+		{ID: "f2", Key: "k2", Label: "Solution", Order: 2},
+	}
+	tests := []struct {
+		name      string
+		edited    []Field
+		wantKeys  []string
+		wantError error
+	}{
+		{
+			name:     "[Success] existing fields keep their key, new fields get none",
+			edited:   []Field{{ID: "f2", Label: "How", Order: 1}, {Label: "Risks", Order: 2}},
+			wantKeys: []string{"k2", ""},
+		},
+		{
+			name:      "[Fail] unknown field ID",
+			edited:    []Field{{ID: "f9", Label: "Other", Order: 1}},
+			wantError: domainerr.ErrInvalidTemplateField,
+		},
+		{
+			name:      "[Fail] same field twice",
+			edited:    []Field{{ID: "f1", Label: "A", Order: 1}, {ID: "f1", Label: "B", Order: 2}},
+			wantError: domainerr.ErrInvalidTemplateField,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CarryFieldKeys(current, tt.edited)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			for i, key := range tt.wantKeys {
+				if got[i].Key != key {
+					t.Fatalf("field %d: key = %q, want %q", i, got[i].Key, key)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldsChanged(t *testing.T) {
+	current := []Field{
+		{ID: "f1", Key: "k1", Label: "Background", Order: 1},
+		{ID: "f2", Key: "k2", Label: "Solution", Order: 2, IsRequired: true},
+	}
+	tests := []struct {
+		name   string
+		edited []Field
+		want   bool
+	}{
+		{name: "[Success] same fields", edited: []Field{{Key: "k1", Label: "Background", Order: 1}, {Key: "k2", Label: "Solution", Order: 2, IsRequired: true}}},
+		{name: "[Success] relabeled", edited: []Field{{Key: "k1", Label: "Context", Order: 1}, {Key: "k2", Label: "Solution", Order: 2, IsRequired: true}}, want: true},
+		{name: "[Success] required flag dropped", edited: []Field{{Key: "k1", Label: "Background", Order: 1}, {Key: "k2", Label: "Solution", Order: 2}}, want: true},
+		{name: "[Success] field replaced by a new one", edited: []Field{{Key: "k1", Label: "Background", Order: 1}, {Label: "Solution", Order: 2, IsRequired: true}}, want: true},
+		{name: "[Success] field removed", edited: []Field{{Key: "k1", Label: "Background", Order: 1}}, want: true},
+		{name: "[Success] type changed", edited: []Field{{Key: "k1", Label: "Background", Order: 1, Type: FieldTypeMarkdown}, {Key: "k2", Label: "Solution", Order: 2, IsRequired: true}}, want: true},
+		{name: "[Success] default changed", edited: []Field{{Key: "k1", Label: "Background", Order: 1, DefaultContent: "TBD"}, {Key: "k2", Label: "Solution", Order: 2, IsRequired: true}}, want: true},
+		{name: "[Success] options changed", edited: []Field{{Key: "k1", Label: "Background", Order: 1}, {Key: "k2", Label: "Solution", Order: 2, IsRequired: true, Options: []string{"a"}}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FieldsChanged(current, tt.edited); got != tt.want {
+				t.Fatalf("FieldsChanged = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestField_CheckContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     Field
+		content   string
+		wantError error
+	}{
+		{name: "[Success] empty content skips constraints", field: Field{MinLength: 3, Pattern: `\d+`}, content: ""},
+		{name: "[Success] length counts characters", field: Field{MinLength: 3, MaxLength: 3}, content: "日本語"},
+		{name: "[Fail] too short", field: Field{MinLength: 3}, content: "ab", wantError: domainerr.ErrSectionTooShort},
+		{name: "[Fail] too long", field: Field{MaxLength: 3}, content: "abcd", wantError: domainerr.ErrSectionTooLong},
+		{name: "[Success] pattern", field: Field{Pattern: `[A-Z]{3}-\d+`}, content: "ABC-42"},
+		{name: "[Fail] pattern must match the whole content", field: Field{Pattern: `\d+`}, content: "abc123", wantError: domainerr.ErrSectionPatternMismatch},
+		{name: "[Success] number", field: Field{Type: FieldTypeNumber}, content: "-1.5e3"},
+		{name: "[Fail] number rejects NaN", field: Field{Type: FieldTypeNumber}, content: "NaN", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] number rejects Inf", field: Field{Type: FieldTypeNumber}, content: "Inf", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] number rejects Infinity", field: Field{Type: FieldTypeNumber}, content: "-Infinity", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] number rejects overflow", field: Field{Type: FieldTypeNumber}, content: "1e999", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] type is checked before constraints", field: Field{Type: FieldTypeNumber, MaxLength: 1}, content: "abc", wantError: domainerr.ErrInvalidSectionContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.field.CheckContent(tt.content)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -1,10 +1,22 @@
 // Package template holds template domain models.
 package template
 
+import "immortal-architecture-clean/backend/internal/domain/pagination"
+
 // Filters for listing templates.
 type Filters struct {
 	Query   *string
 	OwnerID *string
+	// WorkspaceID keeps only templates of one workspace.
+	WorkspaceID *string
+	// MemberID keeps only templates of workspaces this account is a member of.
+	MemberID *string
+	// PublicOnly keeps only templates listed in the gallery.
+	PublicOnly bool
+	// Cursor resumes after the given row; nil starts from the newest template.
+	Cursor *pagination.Cursor
+	// Limit is the maximum number of rows to return (0 = pagination.DefaultLimit).
+	Limit int
 }
 
 // Owner holds minimal owner info for embedding.
@@ -16,8 +28,14 @@ type Owner struct {
 }
 
 // WithUsage is a template with usage metadata.
+// IsUsed reports whether a note outside the trash uses the template.
 type WithUsage struct {
 	Template Template
 	IsUsed   bool
 	Owner    Owner
 }
+
+// PageCursor returns the keyset position of the template.
+func (t WithUsage) PageCursor() pagination.Cursor {
+	return pagination.Cursor{UpdatedAt: t.Template.UpdatedAt, ID: t.Template.ID}
+}
@@ -0,0 +1,62 @@
+// Package webhook models outgoing webhook subscriptions and the deliveries made to them.
+package webhook
+
+import "time"
+
+// Subscription asks for events of the listed types about the owner's notes to be posted to URL.
+type Subscription struct {
+	ID      string
+	OwnerID string
+	URL     string
+	// Secret signs every delivery; it is never shown again after creation.
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DeliveryStatus is the state of one delivery.
+type DeliveryStatus string
+
+// Delivery statuses.
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is one event posted, or to be posted, to a subscription.
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	// EventID is the ID of the outbox message the delivery carries; receivers can use it to deduplicate.
+	EventID   string
+	EventType string
+	// Payload is the JSON request body.
+	Payload  []byte
+	Status   DeliveryStatus
+	Attempts int
+	// ResponseStatus is the HTTP status of the last attempt, 0 when no response was received.
+	ResponseStatus int
+	LastError      string
+	NextAttemptAt  time.Time
+	DeliveredAt    *time.Time
+	FailedAt       *time.Time
+	// ReplayOf is the ID of the delivery this one re-sends, empty for the original delivery.
+	ReplayOf  string
+	CreatedAt time.Time
+}
+
+// DueDelivery is a claimed delivery together with where to send it and how to sign it.
+type DueDelivery struct {
+	Delivery
+	URL    string
+	Secret string
+}
+
+// Request is a signed HTTP request ready to be posted.
+type Request struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+// Request headers set on every delivery.
+const (
+	HeaderDeliveryID = "X-Webhook-Delivery"
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderSignature  = "X-Webhook-Signature"
+)
+
+const (
+	// MinSecretLength is the shortest signing secret accepted.
+	MinSecretLength = 16
+	// MaxAttempts is how many times a delivery is tried before it is marked failed.
+	MaxAttempts = 8
+	// ClaimLease is how long a claimed delivery is held back from other workers while it is sent;
+	// a delivery still unsettled when the lease runs out is claimed again.
+	ClaimLease  = 5 * time.Minute
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+// reservedPrefixes are IPv4 ranges outside the internet that the standard library does not classify:
+// "this network", shared address space (also used for cloud metadata), IETF protocol assignments,
+// benchmarking and the reserved block including broadcast.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// internalHostnames are names that resolve to the host itself or to a cloud metadata service.
+var internalHostnames = []string{"localhost", "metadata", "metadata.google.internal"}
+
+// IsPublicAddr reports whether webhooks may be delivered to ip.
+// ルール: 配信先にループバック・プライベート・リンクローカル（メタデータを含む）などのアドレスは使えない
+func IsPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, p := range reservedPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPublicHost reports whether host may be a webhook target. Names are resolved only when
+// delivering, so here only IP literals and well-known internal names can be refused.
+func isPublicHost(host string) bool {
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return IsPublicAddr(ip)
+	}
+	name := strings.TrimSuffix(strings.ToLower(host), ".")
+	if slices.Contains(internalHostnames, name) || strings.HasSuffix(name, ".localhost") || strings.HasSuffix(name, ".internal") {
+		return false
+	}
+	return true
+}
+
+// EventTypes lists the events a webhook can subscribe to.
+var EventTypes = []string{outbox.NotePublished, outbox.NoteUnpublished}
+
+// IsSupportedEvent reports whether webhooks can subscribe to eventType.
+func IsSupportedEvent(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// ValidateSubscription checks a subscription before it is saved and reports every violation.
+func ValidateSubscription(s Subscription) error {
+	verr := &domainerr.ValidationError{}
+	if s.OwnerID == "" {
+		verr.Add("ownerId", domainerr.CodeRequired, domainerr.ErrOwnerRequired)
+	}
+	// ルール: 配信先は絶対 URL の http / https に限る
+	if u, err := url.Parse(s.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		verr.Add("url", domainerr.CodeInvalid, domainerr.ErrInvalidWebhookURL)
+	} else if !isPublicHost(u.Hostname()) {
+		verr.Add("url", domainerr.CodeInvalid, domainerr.ErrWebhookHostNotAllowed)
+	}
+	// ルール: 署名用シークレットは推測できない長さを要求する
+	if len(s.Secret) < MinSecretLength {
+		verr.Add("secret", domainerr.CodeTooShort, domainerr.ErrWebhookSecretTooShort)
+	}
+	// ルール: 購読するイベントは1つ以上で、対応している種類を重複なく指定する
+	if len(s.EventTypes) == 0 {
+		verr.Add("eventTypes", domainerr.CodeRequired, domainerr.ErrWebhookEventRequired)
+	}
+	seen := make(map[string]struct{}, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		path := fmt.Sprintf("eventTypes[%d]", i)
+		if !IsSupportedEvent(t) {
+			verr.Add(path, domainerr.CodeInvalid, domainerr.ErrUnsupportedWebhookEvent)
+			continue
+		}
+		if _, dup := seen[t]; dup {
+			verr.Add(path, domainerr.CodeDuplicate, domainerr.ErrDuplicateWebhookEvent)
+		}
+		seen[t] = struct{}{}
+	}
+	return verr.Err()
+}
+
+// ValidateOwnership ensures only the owner can see or change a subscription.
+func ValidateOwnership(ownerID, actorID string) error {
+	// ルール: Webhook は署名シークレットを持つため、所有者以外には参照も変更もさせない
+	if ownerID == "" || ownerID != actorID {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// Subscribes reports whether the subscription wants events of eventType.
+func (s Subscription) Subscribes(eventType string) bool {
+	return slices.Contains(s.EventTypes, eventType)
+}
+
+// EventOwner returns the account whose note an event is about.
+func EventOwner(msg outbox.Message) (string, error) {
+	var payload struct {
+		OwnerID string `json:"owner_id"`
+	}
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return "", fmt.Errorf("decode payload of event %s: %w", msg.ID, err)
+	}
+	if payload.OwnerID == "" {
+		return "", fmt.Errorf("event %s has no owner", msg.ID)
+	}
+	return payload.OwnerID, nil
+}
+
+// body is the JSON document posted to receivers.
+type body struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewDelivery builds the pending delivery of msg to sub.
+func NewDelivery(sub Subscription, msg outbox.Message, now time.Time) (Delivery, error) {
+	payload, err := json.Marshal(body{
+		ID:         msg.ID,
+		Type:       msg.Type,
+		OccurredAt: msg.OccurredAt.UTC(),
+		Data:       msg.Payload,
+	})
+	if err != nil {
+		return Delivery{}, err
+	}
+	return Delivery{
+		SubscriptionID: sub.ID,
+		EventID:        msg.ID,
+		EventType:      msg.Type,
+		Payload:        payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+	}, nil
+}
+
+// Replay returns a new pending delivery that re-sends d's payload.
+func (d Delivery) Replay(now time.Time) Delivery {
+	return Delivery{
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		ReplayOf:       d.ID,
+	}
+}
+
+// Sign returns the signature header value for body sent at timestamp:
+// "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the valid signature of body sent at timestamp.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// NewRequest signs d for sending at now.
+func NewRequest(d DueDelivery, now time.Time) Request {
+	ts := now.Unix()
+	return Request{
+		URL: d.URL,
+		Headers: map[string]string{
+			"Content-Type":   "application/json",
+			HeaderDeliveryID: d.ID,
+			HeaderEvent:      d.EventType,
+			HeaderTimestamp:  strconv.FormatInt(ts, 10),
+			HeaderSignature:  Sign(d.Secret, ts, d.Payload),
+		},
+		Body: d.Payload,
+	}
+}
+
+// NextAttempt decides what happens after the attempts-th failed delivery: retry after
+// the returned delay, or give up when retry is false.
+func NextAttempt(attempts int) (delay time.Duration, retry bool) {
+	// ルール: 配信失敗は指数バックオフで再試行し、上限回数に達したら失敗として記録する
+	if attempts >= MaxAttempts {
+		return 0, false
+	}
+	delay = baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff), true
+}
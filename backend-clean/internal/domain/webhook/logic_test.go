@@ -0,0 +1,198 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+func TestValidateSubscription(t *testing.T) {
+	valid := Subscription{
+		OwnerID:    "owner-1",
+		URL:        "https://example.com/hooks",
+		Secret:     strings.Repeat("s", MinSecretLength),
+		EventTypes: []string{outbox.NotePublished},
+	}
+	tests := []struct {
+		name      string
+		mutate    func(s *Subscription)
+		wantPaths []string
+	}{
+		{name: "[Success] valid subscription", mutate: func(*Subscription) {}},
+		{name: "[Success] plain http and both events", mutate: func(s *Subscription) {
+			s.URL = "http://hooks.example.com:9000/in"
+			s.EventTypes = []string{outbox.NotePublished, outbox.NoteUnpublished}
+		}},
+		{name: "[Fail] relative url", mutate: func(s *Subscription) { s.URL = "/hooks" }, wantPaths: []string{"url"}},
+		{name: "[Fail] loopback address", mutate: func(s *Subscription) { s.URL = "http://127.0.0.1:9000/in" }, wantPaths: []string{"url"}},
+		{name: "[Fail] localhost", mutate: func(s *Subscription) { s.URL = "http://localhost:9000/in" }, wantPaths: []string{"url"}},
+		{name: "[Fail] private address", mutate: func(s *Subscription) { s.URL = "https://10.0.0.5/hooks" }, wantPaths: []string{"url"}},
+		{name: "[Fail] cloud metadata address", mutate: func(s *Subscription) { s.URL = "http://169.254.169.254/latest/meta-data" }, wantPaths: []string{"url"}},
+		{name: "[Fail] cloud metadata name", mutate: func(s *Subscription) { s.URL = "http://metadata.google.internal/computeMetadata/v1" }, wantPaths: []string{"url"}},
+		{name: "[Fail] IPv4-mapped loopback", mutate: func(s *Subscription) { s.URL = "http://[::ffff:127.0.0.1]/in" }, wantPaths: []string{"url"}},
+		{name: "[Fail] non-http scheme", mutate: func(s *Subscription) { s.URL = "ftp://example.com" }, wantPaths: []string{"url"}},
+		{name: "[Fail] short secret", mutate: func(s *Subscription) { s.Secret = "short" }, wantPaths: []string{"secret"}},
+		{name: "[Fail] no events", mutate: func(s *Subscription) { s.EventTypes = nil }, wantPaths: []string{"eventTypes"}},
+		{name: "[Fail] unsupported and duplicate events", mutate: func(s *Subscription) {
+			s.EventTypes = []string{outbox.NotePublished, outbox.NoteCreated, outbox.NotePublished}
+		}, wantPaths: []string{"eventTypes[1]", "eventTypes[2]"}},
+		{name: "[Fail] every violation at once", mutate: func(s *Subscription) {
+			*s = Subscription{}
+		}, wantPaths: []string{"ownerId", "url", "secret", "eventTypes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			tt.mutate(&s)
+			err := ValidateSubscription(s)
+			if len(tt.wantPaths) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *domainerr.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if len(verr.Violations) != len(tt.wantPaths) {
+				t.Fatalf("violations = %+v, want paths %v", verr.Violations, tt.wantPaths)
+			}
+			for i, p := range tt.wantPaths {
+				if verr.Violations[i].Path != p {
+					t.Fatalf("violation %d path = %s, want %s", i, verr.Violations[i].Path, p)
+				}
+			}
+		})
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+		want bool
+	}{
+		{name: "[Success] public IPv4", ip: "93.184.216.34", want: true},
+		{name: "[Success] public IPv6", ip: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{name: "[Fail] loopback", ip: "127.0.0.1"},
+		{name: "[Fail] IPv6 loopback", ip: "::1"},
+		{name: "[Fail] private", ip: "192.168.1.10"},
+		{name: "[Fail] link-local metadata", ip: "169.254.169.254"},
+		{name: "[Fail] IPv6 metadata", ip: "fd00:ec2::254"},
+		{name: "[Fail] shared address space", ip: "100.100.100.200"},
+		{name: "[Fail] unspecified", ip: "0.0.0.0"},
+		{name: "[Fail] broadcast", ip: "255.255.255.255"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPublicAddr(netip.MustParseAddr(tt.ip)); got != tt.want {
+				t.Fatalf("IsPublicAddr(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    string
+		wantErr bool
+	}{
+		{name: "[Success] owner in payload", payload: `{"id":"n1","owner_id":"owner-1"}`, want: "owner-1"},
+		{name: "[Fail] owner missing", payload: `{"id":"n1"}`, wantErr: true},
+		{name: "[Fail] malformed payload", payload: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EventOwner(outbox.Message{ID: "m1", Payload: []byte(tt.payload)})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("owner = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDeliveryAndRequest(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	msg := outbox.Message{
+		ID:         "m1",
+		Type:       outbox.NotePublished,
+		OccurredAt: now.Add(-time.Minute),
+		Payload:    []byte(`{"id":"n1","owner_id":"owner-1"}`),
+	}
+	d, err := NewDelivery(Subscription{ID: "sub-1"}, msg, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.SubscriptionID != "sub-1" || d.EventID != "m1" || d.Status != DeliveryPending || !d.NextAttemptAt.Equal(now) {
+		t.Fatalf("unexpected delivery: %+v", d)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(d.Payload, &got); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if got["id"] != "m1" || got["type"] != outbox.NotePublished || got["data"].(map[string]any)["id"] != "n1" {
+		t.Fatalf("unexpected payload: %s", d.Payload)
+	}
+
+	d.ID = "d1"
+	req := NewRequest(DueDelivery{Delivery: d, URL: "https://example.com", Secret: "secret"}, now)
+	if req.Headers[HeaderDeliveryID] != "d1" || req.Headers[HeaderEvent] != outbox.NotePublished {
+		t.Fatalf("unexpected headers: %v", req.Headers)
+	}
+	if !Verify("secret", now.Unix(), req.Body, req.Headers[HeaderSignature]) {
+		t.Fatalf("signature does not verify: %s", req.Headers[HeaderSignature])
+	}
+	if Verify("other", now.Unix(), req.Body, req.Headers[HeaderSignature]) {
+		t.Fatal("signature verified with the wrong secret")
+	}
+
+	replay := d.Replay(now.Add(time.Hour))
+	if replay.ID != "" || replay.ReplayOf != "d1" || replay.EventID != "m1" || string(replay.Payload) != string(d.Payload) {
+		t.Fatalf("unexpected replay: %+v", replay)
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac key
+	want := "sha256=9d713ed406bb7076d4123f0dc2c39d2df5c654ed4b0cd56b52c8b4c940bd63ae"
+	if got := Sign("key", 1700000000, []byte("{}")); got != want {
+		t.Fatalf("Sign = %s, want %s", got, want)
+	}
+}
+
+func TestNextAttempt(t *testing.T) {
+	tests := []struct {
+		name      string
+		attempts  int
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{name: "[Success] first failure waits the base delay", attempts: 1, wantDelay: 30 * time.Second, wantRetry: true},
+		{name: "[Success] delay doubles per failure", attempts: 3, wantDelay: 2 * time.Minute, wantRetry: true},
+		{name: "[Success] last retry before giving up", attempts: MaxAttempts - 1, wantDelay: 32 * time.Minute, wantRetry: true},
+		{name: "[Fail] attempts used up", attempts: MaxAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := NextAttempt(tt.attempts)
+			if delay != tt.wantDelay || retry != tt.wantRetry {
+				t.Fatalf("NextAttempt(%d) = %v, %v; want %v, %v", tt.attempts, delay, retry, tt.wantDelay, tt.wantRetry)
+			}
+		})
+	}
+}
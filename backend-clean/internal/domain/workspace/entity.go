@@ -0,0 +1,54 @@
+// Package workspace models the tenancy boundary that templates and notes belong to.
+package workspace
+
+import "time"
+
+// Role is the part a member plays in a workspace.
+type Role string
+
+// Workspace roles, from most to least privileged.
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+// PersonalName is the name given to the personal workspace created for every account.
+const PersonalName = "Personal"
+
+// Workspace groups the templates and notes a team shares.
+// Personal workspaces belong to a single account and cannot have other members.
+type Workspace struct {
+	ID        string
+	Name      string
+	OwnerID   string
+	Personal  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WithRole is a workspace together with the role the listing account has in it.
+type WithRole struct {
+	Workspace Workspace
+	Role      Role
+}
+
+// Member is an account's membership in a workspace.
+type Member struct {
+	WorkspaceID string
+	AccountID   string
+	Role        Role
+	JoinedAt    time.Time
+}
+
+// Invitation asks the account with Email to join a workspace with Role.
+type Invitation struct {
+	ID          string
+	WorkspaceID string
+	Email       string
+	Role        Role
+	InvitedBy   string
+	CreatedAt   time.Time
+	// AcceptedAt is set once the invitation has been used.
+	AcceptedAt *time.Time
+}
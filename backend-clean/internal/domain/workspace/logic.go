@@ -0,0 +1,96 @@
+package workspace
+
+import (
+	"strings"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// MaxNameLength is the longest workspace name accepted.
+const MaxNameLength = 100
+
+// Validate checks the role is one of the defined roles.
+func (r Role) Validate() error {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return nil
+	}
+	return domainerr.ErrInvalidWorkspaceRole
+}
+
+// ManagesMembers reports whether the role can invite and remove members.
+func (r Role) ManagesMembers() bool {
+	return r == RoleOwner || r == RoleAdmin
+}
+
+// ValidateWorkspace checks a workspace before it is created.
+func ValidateWorkspace(w Workspace) error {
+	verr := &domainerr.ValidationError{}
+	// ルール: ワークスペース名は必須で 100 文字以内
+	name := strings.TrimSpace(w.Name)
+	switch {
+	case name == "":
+		verr.Add("name", domainerr.CodeRequired, domainerr.ErrWorkspaceNameRequired)
+	case len([]rune(name)) > MaxNameLength:
+		verr.Add("name", domainerr.CodeTooLong, domainerr.ErrWorkspaceNameTooLong)
+	}
+	return verr.Err()
+}
+
+// ValidateInvitation checks inviter may invite inv into ws.
+func ValidateInvitation(ws Workspace, inviter Member, inv Invitation) error {
+	// ルール: 個人ワークスペースには他のメンバーを招待できない
+	if ws.Personal {
+		return domainerr.ErrPersonalWorkspace
+	}
+	// ルール: 招待できるのは owner / admin のみ
+	if !inviter.Role.ManagesMembers() {
+		return domainerr.ErrUnauthorized
+	}
+	verr := &domainerr.ValidationError{}
+	if _, err := account.ParseEmail(inv.Email); err != nil {
+		verr.Add("email", domainerr.CodeInvalid, err)
+	}
+	// ルール: 招待で付与できるのは admin / member。owner はワークスペースの作成者のみ
+	if err := inv.Role.Validate(); err != nil {
+		verr.Add("role", domainerr.CodeInvalid, err)
+	} else if inv.Role == RoleOwner {
+		verr.Add("role", domainerr.CodeInvalid, domainerr.ErrInvalidWorkspaceRole)
+	}
+	return verr.Err()
+}
+
+// CanAccept checks the account with email may accept inv.
+func CanAccept(inv Invitation, email string) error {
+	// ルール: 招待は一度だけ使え、宛先のメールアドレスのアカウントだけが受け入れられる
+	if inv.AcceptedAt != nil {
+		return domainerr.ErrInvitationAccepted
+	}
+	if !strings.EqualFold(strings.TrimSpace(inv.Email), strings.TrimSpace(email)) {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// CanRemoveMember checks actor may remove target from their workspace.
+func CanRemoveMember(actor, target Member) error {
+	// ルール: オーナーは外せない
+	if target.Role == RoleOwner {
+		return domainerr.ErrRemoveWorkspaceOwner
+	}
+	// ルール: メンバーは自分で抜けられる
+	if actor.AccountID == target.AccountID {
+		return nil
+	}
+	// ルール: owner は誰でも、admin は member だけを外せる
+	switch actor.Role {
+	case RoleOwner:
+		return nil
+	case RoleAdmin:
+		if target.Role == RoleMember {
+			return nil
+		}
+	}
+	return domainerr.ErrUnauthorized
+}
@@ -0,0 +1,124 @@
+package workspace
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestValidateWorkspace(t *testing.T) {
+	tests := []struct {
+		name      string
+		ws        Workspace
+		wantError error
+	}{
+		{name: "[Success] team workspace", ws: Workspace{Name: "Design"}},
+		{name: "[Fail] blank name", ws: Workspace{Name: "  "}, wantError: domainerr.ErrWorkspaceNameRequired},
+		{name: "[Fail] name too long", ws: Workspace{Name: strings.Repeat("あ", MaxNameLength+1)}, wantError: domainerr.ErrWorkspaceNameTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWorkspace(tt.ws)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateInvitation(t *testing.T) {
+	team := Workspace{ID: "ws-1", Name: "Design"}
+	tests := []struct {
+		name      string
+		ws        Workspace
+		inviter   Role
+		inv       Invitation
+		wantPaths []string
+		wantError error
+	}{
+		{name: "[Success] admin invites member", ws: team, inviter: RoleAdmin, inv: Invitation{Email: "new@example.com", Role: RoleMember}},
+		{name: "[Success] owner invites admin", ws: team, inviter: RoleOwner, inv: Invitation{Email: "new@example.com", Role: RoleAdmin}},
+		{name: "[Fail] personal workspace", ws: Workspace{ID: "ws-2", Personal: true}, inviter: RoleOwner, inv: Invitation{Email: "new@example.com", Role: RoleMember}, wantError: domainerr.ErrPersonalWorkspace},
+		{name: "[Fail] member cannot invite", ws: team, inviter: RoleMember, inv: Invitation{Email: "new@example.com", Role: RoleMember}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] owner role cannot be invited", ws: team, inviter: RoleOwner, inv: Invitation{Email: "new@example.com", Role: RoleOwner}, wantPaths: []string{"role"}, wantError: domainerr.ErrInvalidWorkspaceRole},
+		{name: "[Fail] unknown role", ws: team, inviter: RoleOwner, inv: Invitation{Email: "new@example.com", Role: "guest"}, wantPaths: []string{"role"}, wantError: domainerr.ErrInvalidWorkspaceRole},
+		{name: "[Fail] every violation at once", ws: team, inviter: RoleOwner, inv: Invitation{Email: "nobody", Role: "guest"}, wantPaths: []string{"email", "role"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateInvitation(tt.ws, Member{AccountID: "inviter", Role: tt.inviter}, tt.inv)
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if len(tt.wantPaths) == 0 {
+				if tt.wantError == nil && err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *domainerr.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("want *ValidationError, got %v", err)
+			}
+			if len(verr.Violations) != len(tt.wantPaths) {
+				t.Fatalf("violations = %+v, want paths %v", verr.Violations, tt.wantPaths)
+			}
+			for i, p := range tt.wantPaths {
+				if verr.Violations[i].Path != p {
+					t.Fatalf("violations[%d].Path = %q, want %q", i, verr.Violations[i].Path, p)
+				}
+			}
+		})
+	}
+}
+
+func TestCanAccept(t *testing.T) {
+	accepted := time.Now()
+	tests := []struct {
+		name      string
+		inv       Invitation
+		email     string
+		wantError error
+	}{
+		{name: "[Success] addressee", inv: Invitation{Email: "new@example.com"}, email: "New@Example.com"},
+		{name: "[Fail] already accepted", inv: Invitation{Email: "new@example.com", AcceptedAt: &accepted}, email: "new@example.com", wantError: domainerr.ErrInvitationAccepted},
+		{name: "[Fail] someone else", inv: Invitation{Email: "new@example.com"}, email: "other@example.com", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CanAccept(tt.inv, tt.email); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestCanRemoveMember(t *testing.T) {
+	tests := []struct {
+		name      string
+		actor     Member
+		target    Member
+		wantError error
+	}{
+		{name: "[Success] owner removes admin", actor: Member{AccountID: "a", Role: RoleOwner}, target: Member{AccountID: "b", Role: RoleAdmin}},
+		{name: "[Success] admin removes member", actor: Member{AccountID: "a", Role: RoleAdmin}, target: Member{AccountID: "b", Role: RoleMember}},
+		{name: "[Success] member leaves", actor: Member{AccountID: "a", Role: RoleMember}, target: Member{AccountID: "a", Role: RoleMember}},
+		{name: "[Fail] owner cannot be removed", actor: Member{AccountID: "a", Role: RoleOwner}, target: Member{AccountID: "a", Role: RoleOwner}, wantError: domainerr.ErrRemoveWorkspaceOwner},
+		{name: "[Fail] admin cannot remove admin", actor: Member{AccountID: "a", Role: RoleAdmin}, target: Member{AccountID: "b", Role: RoleAdmin}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] member cannot remove others", actor: Member{AccountID: "a", Role: RoleMember}, target: Member{AccountID: "b", Role: RoleMember}, wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CanRemoveMember(tt.actor, tt.target); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
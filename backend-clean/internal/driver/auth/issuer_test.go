@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestIssuer_AccessTokenRoundTrip(t *testing.T) {
+	issuer, err := NewIssuer(IssuerOptions{Secret: "secret", Issuer: "issuer", Audience: "api", AccessTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	verifier, err := NewVerifier(Options{Secret: "secret", Issuer: "issuer", Audience: "api"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+
+	token, exp, err := issuer.IssueAccessToken(context.Background(), "acc-1")
+	if err != nil {
+		t.Fatalf("IssueAccessToken: %v", err)
+	}
+	if d := time.Until(exp); d <= 0 || d > time.Minute {
+		t.Fatalf("expiry = %v, want within 1m", d)
+	}
+	id, err := verifier.Verify(context.Background(), token)
+	if err != nil || id != "acc-1" {
+		t.Fatalf("Verify = %q, %v", id, err)
+	}
+}
+
+func TestIssuer_RefreshToken(t *testing.T) {
+	issuer, err := NewIssuer(IssuerOptions{Secret: "secret"})
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	a, hash, exp, err := issuer.IssueRefreshToken(context.Background())
+	if err != nil {
+		t.Fatalf("IssueRefreshToken: %v", err)
+	}
+	b, _, _, _ := issuer.IssueRefreshToken(context.Background())
+	if a == b {
+		t.Fatal("refresh tokens must be unique")
+	}
+	if hash != issuer.HashRefreshToken(a) || hash == a {
+		t.Fatalf("hash = %q does not match token", hash)
+	}
+	if time.Until(exp) < defaultRefreshTTL-time.Minute {
+		t.Fatalf("expiry = %v, want default refresh TTL", exp)
+	}
+}
+
+func TestNewIssuer_Config(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    IssuerOptions
+		wantErr bool
+	}{
+		{name: "[Success] HS256 default", opts: IssuerOptions{Secret: "secret"}},
+		{name: "[Fail] HS256 without secret", opts: IssuerOptions{}, wantErr: true},
+		{name: "[Fail] RS256 without key", opts: IssuerOptions{Algorithm: AlgRS256}, wantErr: true},
+		{name: "[Fail] unsupported algorithm", opts: IssuerOptions{Algorithm: "none", Secret: "secret"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewIssuer(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Package auth issues and verifies JWT bearer tokens (HS256 / RS256) and refresh tokens.
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+const (
+	// AlgHS256 is HMAC-SHA256.
+	AlgHS256 = "HS256"
+	// AlgRS256 is RSASSA-PKCS1-v1_5 with SHA-256.
+	AlgRS256 = "RS256"
+)
+
+var (
+	// ErrMalformedToken indicates the token is not a well-formed compact JWS.
+	ErrMalformedToken = errors.New("malformed token")
+	// ErrUnsupportedAlgorithm indicates the token algorithm is not accepted.
+	ErrUnsupportedAlgorithm = errors.New("unsupported token algorithm")
+	// ErrInvalidSignature indicates signature verification failed.
+	ErrInvalidSignature = errors.New("invalid token signature")
+	// ErrUnknownKey indicates no key matches the token's key ID.
+	ErrUnknownKey = errors.New("no key for token")
+	// ErrTokenExpired indicates the token is past its exp claim.
+	ErrTokenExpired = errors.New("token expired")
+	// ErrTokenNotYetValid indicates the token is before its nbf claim.
+	ErrTokenNotYetValid = errors.New("token not yet valid")
+	// ErrInvalidClaims indicates required claims are missing or mismatched.
+	ErrInvalidClaims = errors.New("invalid token claims")
+)
+
+var b64 = base64.RawURLEncoding
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// Claims are the registered JWT claims this service relies on.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss,omitempty"`
+	Audience  Audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+}
+
+// Audience accepts both the string and array forms of the aud claim.
+type Audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+// Contains reports whether aud is one of the audiences.
+func (a Audience) Contains(aud string) bool {
+	for _, v := range a {
+		if v == aud {
+			return true
+		}
+	}
+	return false
+}
+
+type parsedToken struct {
+	header       header
+	claims       Claims
+	signingInput string
+	signature    []byte
+}
+
+func parse(token string) (*parsedToken, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, ErrMalformedToken
+	}
+	var c Claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, ErrMalformedToken
+	}
+	sig, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	return &parsedToken{
+		header:       h,
+		claims:       c,
+		signingInput: parts[0] + "." + parts[1],
+		signature:    sig,
+	}, nil
+}
+
+func decodeSegment(seg string, v any) error {
+	raw, err := b64.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func encodeSegment(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return b64.EncodeToString(raw), nil
+}
+
+func signHS256(input string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+	return mac.Sum(nil)
+}
+
+func verifyHS256(input string, sig, secret []byte) error {
+	if !hmac.Equal(sig, signHS256(input, secret)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func verifyRS256(input string, sig []byte, key *rsa.PublicKey) error {
+	digest := sha256.Sum256([]byte(input))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// verificationKey is either an HMAC secret or an RSA public key.
+type verificationKey struct {
+	alg    string
+	secret []byte
+	public *rsa.PublicKey
+}
+
+// keySet indexes verification keys by kid. The empty kid holds a key
+// configured without an identifier (shared secret or single PEM key).
+type keySet map[string]verificationKey
+
+func (ks keySet) lookup(kid string) (verificationKey, error) {
+	if k, ok := ks[kid]; ok {
+		return k, nil
+	}
+	// A token without kid may still be verified when only one key exists.
+	if kid == "" && len(ks) == 1 {
+		for _, k := range ks {
+			return k, nil
+		}
+	}
+	return verificationKey{}, ErrUnknownKey
+}
+
+// loadPublicKeyFile reads a PEM encoded RSA public key (PKIX or PKCS#1).
+func loadPublicKeyFile(path string) (*rsa.PublicKey, error) {
+	raw, err := os.ReadFile(path) //nolint:gosec // path comes from trusted configuration
+	if err != nil {
+		return nil, err
+	}
+	return parsePublicKeyPEM(raw)
+}
+
+func parsePublicKeyPEM(raw []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if pub, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		rsaPub, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("public key is not RSA")
+		}
+		return rsaPub, nil
+	}
+	return x509.ParsePKCS1PublicKey(block.Bytes)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// loadJWKSFile reads a JSON Web Key Set. RSA keys are used for RS256 and
+// symmetric ("oct") keys for HS256; other key types are skipped.
+func loadJWKSFile(path string) (keySet, error) {
+	raw, err := os.ReadFile(path) //nolint:gosec // path comes from trusted configuration
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(raw)
+}
+
+func parseJWKS(raw []byte) (keySet, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+	ks := keySet{}
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			if k.Alg != "" && k.Alg != AlgRS256 {
+				continue
+			}
+			pub, err := rsaFromJWK(k)
+			if err != nil {
+				return nil, fmt.Errorf("jwks key %q: %w", k.Kid, err)
+			}
+			ks[k.Kid] = verificationKey{alg: AlgRS256, public: pub}
+		case "oct":
+			if k.Alg != "" && k.Alg != AlgHS256 {
+				continue
+			}
+			secret, err := b64.DecodeString(k.K)
+			if err != nil {
+				return nil, fmt.Errorf("jwks key %q: %w", k.Kid, err)
+			}
+			ks[k.Kid] = verificationKey{alg: AlgHS256, secret: secret}
+		}
+	}
+	if len(ks) == 0 {
+		return nil, errors.New("jwks contains no usable signing keys")
+	}
+	return ks, nil
+}
+
+func rsaFromJWK(k jwk) (*rsa.PublicKey, error) {
+	n, err := b64.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := b64.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exp := new(big.Int).SetBytes(e)
+	if !exp.IsInt64() || exp.Int64() > int64(^uint32(0)>>1) {
+		return nil, errors.New("rsa exponent out of range")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+}
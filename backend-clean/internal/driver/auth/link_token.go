@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+)
+
+const linkTokenBytes = 32
+
+// LinkTokens implements port.LinkTokenGenerator with random URL-safe tokens.
+type LinkTokens struct{}
+
+// NewLinkTokens creates LinkTokens.
+func NewLinkTokens() LinkTokens {
+	return LinkTokens{}
+}
+
+// Generate returns a random opaque token carrying 256 bits of entropy.
+func (LinkTokens) Generate(_ context.Context) (string, error) {
+	buf := make([]byte, linkTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return b64.EncodeToString(buf), nil
+}
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+)
+
+// Signer mints compact JWS tokens with a single key.
+type Signer struct {
+	alg     string
+	kid     string
+	secret  []byte
+	private *rsa.PrivateKey
+}
+
+// NewHS256Signer creates a signer using an HMAC shared secret.
+func NewHS256Signer(secret string) *Signer {
+	return &Signer{alg: AlgHS256, secret: []byte(secret)}
+}
+
+// NewRS256Signer creates a signer using an RSA private key. kid is written
+// to the token header so JWKS-based verifiers can select the key.
+func NewRS256Signer(key *rsa.PrivateKey, kid string) *Signer {
+	return &Signer{alg: AlgRS256, kid: kid, private: key}
+}
+
+// Sign encodes and signs the claims.
+func (s *Signer) Sign(claims Claims) (string, error) {
+	h, err := encodeSegment(header{Alg: s.alg, Typ: "JWT", Kid: s.kid})
+	if err != nil {
+		return "", err
+	}
+	c, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+	input := h + "." + c
+
+	var sig []byte
+	switch s.alg {
+	case AlgRS256:
+		digest := sha256.Sum256([]byte(input))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, s.private, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", err
+		}
+	default:
+		sig = signHS256(input, s.secret)
+	}
+	return input + "." + b64.EncodeToString(sig), nil
+}
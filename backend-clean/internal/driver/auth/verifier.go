@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// clockSkew tolerates small clock differences between issuer and API.
+const clockSkew = 30 * time.Second
+
+// Options configures token verification.
+type Options struct {
+	// Algorithm is the only accepted alg header value (HS256 or RS256).
+	Algorithm string
+	// Secret is the HS256 shared secret.
+	Secret string
+	// PublicKeyFile is a PEM encoded RSA public key for RS256.
+	PublicKeyFile string
+	// JWKSFile is a JSON Web Key Set; takes precedence over Secret/PublicKeyFile.
+	JWKSFile string
+	// Issuer, when set, must match the iss claim.
+	Issuer string
+	// Audience, when set, must be contained in the aud claim.
+	Audience string
+}
+
+// Verifier implements port.TokenVerifier for JWTs.
+type Verifier struct {
+	alg      string
+	keys     keySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+// NewVerifier builds a Verifier from options, loading key material eagerly.
+func NewVerifier(opts Options) (*Verifier, error) {
+	alg := strings.ToUpper(strings.TrimSpace(opts.Algorithm))
+	if alg == "" {
+		alg = AlgHS256
+	}
+	if alg != AlgHS256 && alg != AlgRS256 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, opts.Algorithm)
+	}
+
+	var (
+		keys keySet
+		err  error
+	)
+	switch {
+	case opts.JWKSFile != "":
+		keys, err = loadJWKSFile(opts.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+	case alg == AlgHS256 && opts.Secret != "":
+		keys = keySet{"": {alg: AlgHS256, secret: []byte(opts.Secret)}}
+	case alg == AlgRS256 && opts.PublicKeyFile != "":
+		pub, err := loadPublicKeyFile(opts.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load public key: %w", err)
+		}
+		keys = keySet{"": {alg: AlgRS256, public: pub}}
+	default:
+		return nil, errors.New("no verification key configured for " + alg)
+	}
+
+	return &Verifier{
+		alg:      alg,
+		keys:     keys,
+		issuer:   opts.Issuer,
+		audience: opts.Audience,
+		now:      time.Now,
+	}, nil
+}
+
+// Verify checks signature and claims, returning the subject as account ID.
+func (v *Verifier) Verify(_ context.Context, token string) (string, error) {
+	t, err := parse(token)
+	if err != nil {
+		return "", err
+	}
+	if t.header.Alg != v.alg {
+		return "", ErrUnsupportedAlgorithm
+	}
+	key, err := v.keys.lookup(t.header.Kid)
+	if err != nil {
+		return "", err
+	}
+	if key.alg != t.header.Alg {
+		return "", ErrUnsupportedAlgorithm
+	}
+	switch key.alg {
+	case AlgHS256:
+		err = verifyHS256(t.signingInput, t.signature, key.secret)
+	case AlgRS256:
+		err = verifyRS256(t.signingInput, t.signature, key.public)
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := v.validateClaims(t.claims); err != nil {
+		return "", err
+	}
+	return t.claims.Subject, nil
+}
+
+func (v *Verifier) validateClaims(c Claims) error {
+	now := v.now()
+	if c.ExpiresAt == 0 || now.After(time.Unix(c.ExpiresAt, 0).Add(clockSkew)) {
+		return ErrTokenExpired
+	}
+	if c.NotBefore != 0 && now.Add(clockSkew).Before(time.Unix(c.NotBefore, 0)) {
+		return ErrTokenNotYetValid
+	}
+	if strings.TrimSpace(c.Subject) == "" {
+		return ErrInvalidClaims
+	}
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return ErrInvalidClaims
+	}
+	if v.audience != "" && !c.Audience.Contains(v.audience) {
+		return ErrInvalidClaims
+	}
+	return nil
+}
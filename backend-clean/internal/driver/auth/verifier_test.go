@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestVerifier_HS256(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	signer := NewHS256Signer("secret")
+	valid := Claims{Subject: "acc-1", Issuer: "issuer", Audience: Audience{"api"}, ExpiresAt: now.Add(time.Minute).Unix()}
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantID  string
+		wantErr error
+	}{
+		{
+			name:   "[Success] valid token",
+			token:  func() string { return mustSign(t, signer, valid) },
+			wantID: "acc-1",
+		},
+		{
+			name:    "[Fail] wrong secret",
+			token:   func() string { return mustSign(t, NewHS256Signer("other"), valid) },
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "[Fail] expired",
+			token: func() string {
+				c := valid
+				c.ExpiresAt = now.Add(-time.Hour).Unix()
+				return mustSign(t, signer, c)
+			},
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name: "[Fail] not yet valid",
+			token: func() string {
+				c := valid
+				c.NotBefore = now.Add(time.Hour).Unix()
+				return mustSign(t, signer, c)
+			},
+			wantErr: ErrTokenNotYetValid,
+		},
+		{
+			name: "[Fail] audience mismatch",
+			token: func() string {
+				c := valid
+				c.Audience = Audience{"other"}
+				return mustSign(t, signer, c)
+			},
+			wantErr: ErrInvalidClaims,
+		},
+		{
+			name: "[Fail] missing subject",
+			token: func() string {
+				c := valid
+				c.Subject = ""
+				return mustSign(t, signer, c)
+			},
+			wantErr: ErrInvalidClaims,
+		},
+		{
+			name:    "[Fail] malformed",
+			token:   func() string { return "not-a-jwt" },
+			wantErr: ErrMalformedToken,
+		},
+		{
+			name: "[Fail] alg none rejected",
+			token: func() string {
+				h, _ := encodeSegment(header{Alg: "none"})
+				c, _ := encodeSegment(valid)
+				return h + "." + c + "."
+			},
+			wantErr: ErrUnsupportedAlgorithm,
+		},
+	}
+
+	v, err := NewVerifier(Options{Algorithm: AlgHS256, Secret: "secret", Issuer: "issuer", Audience: "api"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	v.now = func() time.Time { return now }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := v.Verify(context.Background(), tt.token())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if id != tt.wantID {
+				t.Fatalf("id = %q, want %q", id, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestVerifier_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	dir := t.TempDir()
+
+	pemPath := filepath.Join(dir, "public.pem")
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	writeFile(t, pemPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	jwksPath := filepath.Join(dir, "jwks.json")
+	jwks, _ := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "k1", "alg": AlgRS256, "use": "sig", "n": b64.EncodeToString(key.N.Bytes()), "e": b64.EncodeToString(big.NewInt(int64(key.E)).Bytes())},
+	}})
+	writeFile(t, jwksPath, jwks)
+
+	claims := Claims{Subject: "acc-1", ExpiresAt: time.Now().Add(time.Minute).Unix()}
+
+	tests := []struct {
+		name    string
+		opts    Options
+		token   string
+		wantErr error
+	}{
+		{name: "[Success] pem public key", opts: Options{Algorithm: AlgRS256, PublicKeyFile: pemPath}, token: mustSign(t, NewRS256Signer(key, ""), claims)},
+		{name: "[Success] jwks with kid", opts: Options{Algorithm: AlgRS256, JWKSFile: jwksPath}, token: mustSign(t, NewRS256Signer(key, "k1"), claims)},
+		{name: "[Fail] unknown kid", opts: Options{Algorithm: AlgRS256, JWKSFile: jwksPath}, token: mustSign(t, NewRS256Signer(key, "k2"), claims), wantErr: ErrUnknownKey},
+		{name: "[Fail] signed by other key", opts: Options{Algorithm: AlgRS256, PublicKeyFile: pemPath}, token: mustSign(t, NewRS256Signer(other, ""), claims), wantErr: ErrInvalidSignature},
+		{name: "[Fail] HS256 token rejected by RS256 verifier", opts: Options{Algorithm: AlgRS256, PublicKeyFile: pemPath}, token: mustSign(t, NewHS256Signer("secret"), claims), wantErr: ErrUnsupportedAlgorithm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewVerifier(tt.opts)
+			if err != nil {
+				t.Fatalf("NewVerifier: %v", err)
+			}
+			_, err = v.Verify(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewVerifier_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "[Fail] unsupported algorithm", opts: Options{Algorithm: "ES256", Secret: "s"}},
+		{name: "[Fail] missing HS256 secret", opts: Options{Algorithm: AlgHS256}},
+		{name: "[Fail] missing RS256 key", opts: Options{Algorithm: AlgRS256, Secret: "s"}},
+		{name: "[Fail] unreadable jwks", opts: Options{Algorithm: AlgRS256, JWKSFile: filepath.Join(t.TempDir(), "missing.json")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewVerifier(tt.opts); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func mustSign(t *testing.T, s *Signer, c Claims) string {
+	t.Helper()
+	token, err := s.Sign(c)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return token
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds all application configuration.
@@ -18,6 +19,73 @@ type Config struct {
 
 	// CORS configuration
 	AllowedOrigins []string
+
+	// Auth configuration
+	Auth AuthConfig
+
+	// TrashRetention is how long trashed notes and templates are kept before the purge job deletes them (0 = job default).
+	TrashRetention time.Duration
+
+	// ReviewRequired makes notes pass the InReview -> Approved workflow before they can be published.
+	ReviewRequired bool
+
+	// ImportMaxBytes bounds the body of an account data import (0 = controller default).
+	ImportMaxBytes int64
+
+	// Relay configuration
+	Relay RelayConfig
+}
+
+// Outbox relay sinks.
+const (
+	RelaySinkStdout  = "stdout"
+	RelaySinkWebhook = "webhook"
+	RelaySinkFile    = "file"
+)
+
+// RelayConfig holds settings of the outbox relay worker.
+type RelayConfig struct {
+	// Sink is where events are delivered: stdout (default), webhook or file.
+	Sink string
+	// WebhookURL receives events as JSON POST requests when Sink is webhook.
+	WebhookURL string
+	// WebhookTimeout bounds each webhook request (0 = sink default).
+	WebhookTimeout time.Duration
+	// FilePath is appended one JSON event per line when Sink is file.
+	FilePath string
+	// PollInterval is how long the relay waits after an empty batch (0 = relay default).
+	PollInterval time.Duration
+	// BatchSize is how many events are claimed per batch (0 = relay default).
+	BatchSize int
+	// DeliveryTimeout bounds each request to a subscribed webhook endpoint (0 = client default).
+	DeliveryTimeout time.Duration
+}
+
+// AuthConfig holds bearer token issuance and verification settings.
+type AuthConfig struct {
+	// JWTAlgorithm is HS256 (default) or RS256.
+	JWTAlgorithm string
+	// JWTSecret is the HS256 shared secret.
+	JWTSecret string
+	// JWTPublicKeyFile is a PEM encoded RSA public key used for RS256.
+	JWTPublicKeyFile string
+	// JWKSFile is a JSON Web Key Set file; overrides the single key settings.
+	JWKSFile string
+	// JWTIssuer is the expected iss claim (optional).
+	JWTIssuer string
+	// JWTAudience is the expected aud claim (optional).
+	JWTAudience string
+	// JWTPrivateKeyFile is a PEM encoded RSA private key used to sign RS256 tokens.
+	JWTPrivateKeyFile string
+	// JWTKeyID is written to the kid header of issued tokens (optional).
+	JWTKeyID string
+	// AccessTokenTTL is the lifetime of issued access tokens (0 = issuer default).
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL is the lifetime of refresh sessions (0 = issuer default).
+	RefreshTokenTTL time.Duration
+	// LoginSecret is shared with the frontend backend only; logins without it are refused.
+	// An empty secret refuses every login.
+	LoginSecret string
 }
 
 // Load reads configuration from environment variables.
@@ -38,13 +106,139 @@ func Load() (*Config, error) {
 
 	origins := parseAllowedOrigins(os.Getenv("CLIENT_ORIGIN"))
 
+	auth, err := loadAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	retention, err := parseDuration("TRASH_RETENTION")
+	if err != nil {
+		return nil, err
+	}
+
+	reviewRequired := false
+	if v := strings.TrimSpace(os.Getenv("NOTE_REVIEW_REQUIRED")); v != "" {
+		reviewRequired, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.New("NOTE_REVIEW_REQUIRED must be a boolean")
+		}
+	}
+
+	var importMaxBytes int64
+	if v := strings.TrimSpace(os.Getenv("IMPORT_MAX_BYTES")); v != "" {
+		importMaxBytes, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || importMaxBytes <= 0 {
+			return nil, errors.New("IMPORT_MAX_BYTES must be a positive integer")
+		}
+	}
+
+	relay, err := loadRelayConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
 		DatabaseURL:    dbURL,
 		ServerPort:     port,
 		AllowedOrigins: origins,
+		Auth:           auth,
+		TrashRetention: retention,
+		ReviewRequired: reviewRequired,
+		ImportMaxBytes: importMaxBytes,
+		Relay:          relay,
 	}, nil
 }
 
+// loadRelayConfig reads RELAY_* variables.
+func loadRelayConfig() (RelayConfig, error) {
+	sink := strings.ToLower(strings.TrimSpace(os.Getenv("RELAY_SINK")))
+	if sink == "" {
+		sink = RelaySinkStdout
+	}
+	cfg := RelayConfig{
+		Sink:       sink,
+		WebhookURL: strings.TrimSpace(os.Getenv("RELAY_WEBHOOK_URL")),
+		FilePath:   strings.TrimSpace(os.Getenv("RELAY_FILE_PATH")),
+	}
+	switch sink {
+	case RelaySinkStdout:
+	case RelaySinkWebhook:
+		if cfg.WebhookURL == "" {
+			return RelayConfig{}, errors.New("RELAY_WEBHOOK_URL is required for the webhook sink")
+		}
+	case RelaySinkFile:
+		if cfg.FilePath == "" {
+			return RelayConfig{}, errors.New("RELAY_FILE_PATH is required for the file sink")
+		}
+	default:
+		return RelayConfig{}, errors.New("RELAY_SINK must be stdout, webhook or file")
+	}
+
+	var err error
+	if cfg.WebhookTimeout, err = parseDuration("RELAY_WEBHOOK_TIMEOUT"); err != nil {
+		return RelayConfig{}, err
+	}
+	if cfg.DeliveryTimeout, err = parseDuration("RELAY_DELIVERY_TIMEOUT"); err != nil {
+		return RelayConfig{}, err
+	}
+	if cfg.PollInterval, err = parseDuration("RELAY_POLL_INTERVAL"); err != nil {
+		return RelayConfig{}, err
+	}
+	if v := strings.TrimSpace(os.Getenv("RELAY_BATCH_SIZE")); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 {
+			return RelayConfig{}, errors.New("RELAY_BATCH_SIZE must be a positive integer")
+		}
+		cfg.BatchSize = size
+	}
+	return cfg, nil
+}
+
+// loadAuthConfig reads AUTH_* variables. Key material is validated when the verifier is built.
+func loadAuthConfig() (AuthConfig, error) {
+	alg := strings.ToUpper(strings.TrimSpace(os.Getenv("AUTH_JWT_ALGORITHM")))
+	if alg == "" {
+		alg = "HS256"
+	}
+	if alg != "HS256" && alg != "RS256" {
+		return AuthConfig{}, errors.New("AUTH_JWT_ALGORITHM must be HS256 or RS256")
+	}
+	accessTTL, err := parseDuration("AUTH_ACCESS_TOKEN_TTL")
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	refreshTTL, err := parseDuration("AUTH_REFRESH_TOKEN_TTL")
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	return AuthConfig{
+		JWTAlgorithm:      alg,
+		JWTSecret:         os.Getenv("AUTH_JWT_SECRET"),
+		JWTPublicKeyFile:  os.Getenv("AUTH_JWT_PUBLIC_KEY_FILE"),
+		JWKSFile:          os.Getenv("AUTH_JWKS_FILE"),
+		JWTIssuer:         os.Getenv("AUTH_JWT_ISSUER"),
+		JWTAudience:       os.Getenv("AUTH_JWT_AUDIENCE"),
+		JWTPrivateKeyFile: os.Getenv("AUTH_JWT_PRIVATE_KEY_FILE"),
+		JWTKeyID:          os.Getenv("AUTH_JWT_KEY_ID"),
+		AccessTokenTTL:    accessTTL,
+		RefreshTokenTTL:   refreshTTL,
+		LoginSecret:       os.Getenv("AUTH_LOGIN_SECRET"),
+	}, nil
+}
+
+// parseDuration reads an optional positive Go duration (e.g. "15m") from the environment.
+func parseDuration(name string) (time.Duration, error) {
+	v := strings.TrimSpace(os.Getenv(name))
+	if v == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, errors.New(name + " must be a positive duration such as 15m or 720h")
+	}
+	return d, nil
+}
+
 // parseAllowedOrigins parses comma-separated allowed origins from environment variable.
 // Returns default localhost origins if empty.
 func parseAllowedOrigins(fromEnv string) []string {
@@ -0,0 +1,94 @@
+// Package event provides the note event feed read from the outbox.
+package event
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+const (
+	// DefaultPollInterval is how often an idle subscriber looks for new events.
+	DefaultPollInterval = time.Second
+	// feedBatchSize is how many events one read takes from the outbox.
+	feedBatchSize = 100
+)
+
+// NoteFeed follows the note events every process records in the outbox, so a watcher
+// sees the writes of every API and gRPC instance. Cursors are positions in the outbox
+// and stay valid across restarts.
+type NoteFeed struct {
+	reader   port.NoteFeedReader
+	interval time.Duration
+}
+
+var _ port.NoteEventSubscriber = (*NoteFeed)(nil)
+
+// NewNoteFeed creates a feed that polls reader every interval; interval <= 0 uses DefaultPollInterval.
+func NewNoteFeed(reader port.NoteFeedReader, interval time.Duration) *NoteFeed {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &NoteFeed{reader: reader, interval: interval}
+}
+
+// Subscribe delivers the events after cursor, then follows new ones until ctx is done.
+// An empty cursor starts with the next event. The channel is closed when ctx is done
+// or the outbox cannot be read; the watcher can resume from the last cursor it saw.
+func (f *NoteFeed) Subscribe(ctx context.Context, cursor string) (<-chan note.Event, error) {
+	var after outbox.FeedCursor
+	var err error
+	if cursor == "" {
+		after, err = f.reader.NoteFeedHead(ctx)
+	} else {
+		after, err = outbox.ParseFeedCursor(cursor)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan note.Event)
+	go func() {
+		defer close(ch)
+		f.follow(ctx, after, ch)
+	}()
+	return ch, nil
+}
+
+// follow sends the events after the given position to ch until ctx is done or a read fails.
+func (f *NoteFeed) follow(ctx context.Context, after outbox.FeedCursor, ch chan<- note.Event) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		messages, err := f.reader.ListNoteFeed(ctx, after, feedBatchSize)
+		if err != nil {
+			return
+		}
+		for _, m := range messages {
+			after = m.Position
+			e, ok, err := outbox.ToNoteEvent(m)
+			if err != nil {
+				return
+			}
+			if !ok {
+				continue
+			}
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if len(messages) == feedBatchSize {
+			continue
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+// fakeFeed is an outbox note feed held in memory; it reads everything appended so far.
+type fakeFeed struct {
+	mu       sync.Mutex
+	messages []outbox.Message
+	listErr  error
+}
+
+func (f *fakeFeed) append(eventType, noteID string) outbox.FeedCursor {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pos := outbox.FeedCursor{TxID: int64(len(f.messages) + 1), Seq: 1}
+	f.messages = append(f.messages, outbox.Message{
+		Type:          eventType,
+		AggregateType: outbox.AggregateNote,
+		AggregateID:   noteID,
+		Payload:       []byte(`{"id":"` + noteID + `","workspace_id":"ws-1"}`),
+		Position:      pos,
+	})
+	return pos
+}
+
+func (f *fakeFeed) NoteFeedHead(context.Context) (outbox.FeedCursor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.messages) == 0 {
+		return outbox.FeedCursor{}, nil
+	}
+	return f.messages[len(f.messages)-1].Position, nil
+}
+
+func (f *fakeFeed) ListNoteFeed(_ context.Context, after outbox.FeedCursor, limit int) ([]outbox.Message, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	var out []outbox.Message
+	for _, m := range f.messages {
+		if m.Position.TxID > after.TxID && len(out) < limit {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func receive(t *testing.T, ch <-chan note.Event, n int) []note.Event {
+	t.Helper()
+	got := make([]note.Event, 0, n)
+	for len(got) < n {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				t.Fatalf("channel closed after %d events", len(got))
+			}
+			got = append(got, e)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after %d events", len(got))
+		}
+	}
+	return got
+}
+
+func TestNoteFeed_Subscribe(t *testing.T) {
+	feed := &fakeFeed{}
+	feed.append(outbox.NoteCreated, "note-1")
+	second := feed.append(outbox.NoteUpdated, "note-2")
+	feed.append(outbox.NoteShared, "note-2")
+	feed.append(outbox.NoteDeleted, "note-3")
+
+	tests := []struct {
+		name      string
+		cursor    string
+		want      []string
+		wantError error
+	}{
+		{name: "[Success] empty cursor starts with the next event", cursor: "", want: []string{"note-4"}},
+		{name: "[Success] resume after the cursor skipping non-note changes", cursor: second.Encode(), want: []string{"note-3", "note-4"}},
+		{name: "[Fail] malformed cursor", cursor: "%%%", wantError: domainerr.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			local := &fakeFeed{messages: append([]outbox.Message(nil), feed.messages...)}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ch, err := NewNoteFeed(local, time.Millisecond).Subscribe(ctx, tt.cursor)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantError != nil {
+				return
+			}
+			local.append(outbox.NoteUpdated, "note-4")
+
+			got := receive(t, ch, len(tt.want))
+			for i, e := range got {
+				if e.NoteID != tt.want[i] {
+					t.Fatalf("event %d = %s, want %s", i, e.NoteID, tt.want[i])
+				}
+				if e.Cursor == "" {
+					t.Fatalf("event %d has no cursor", i)
+				}
+			}
+		})
+	}
+}
+
+func TestNoteFeed_Close(t *testing.T) {
+	t.Run("[Success] closes when ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := NewNoteFeed(&fakeFeed{}, time.Millisecond).Subscribe(ctx, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		cancel()
+		select {
+		case _, ok := <-ch:
+			if ok {
+				t.Fatal("want closed channel")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("channel not closed")
+		}
+	})
+
+	t.Run("[Fail] closes when the outbox cannot be read", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ch, err := NewNoteFeed(&fakeFeed{listErr: errors.New("connection reset")}, time.Millisecond).Subscribe(ctx, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		select {
+		case _, ok := <-ch:
+			if ok {
+				t.Fatal("want closed channel")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("channel not closed")
+		}
+	})
+}
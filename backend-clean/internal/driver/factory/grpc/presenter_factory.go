@@ -1,7 +1,10 @@
 // Package grpc provides factory functions for gRPC adapters.
 package grpc
 
-import grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+import (
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+)
 
 // NewAccountOutputFactory returns a factory for gRPC AccountPresenter.
 func NewAccountOutputFactory() func() *grpcpresenter.AccountPresenter {
@@ -9,3 +12,24 @@ func NewAccountOutputFactory() func() *grpcpresenter.AccountPresenter {
 		return grpcpresenter.NewAccountPresenter()
 	}
 }
+
+// NewTemplateOutputFactory returns a factory for gRPC TemplatePresenter.
+func NewTemplateOutputFactory() func() *grpcpresenter.TemplatePresenter {
+	return func() *grpcpresenter.TemplatePresenter {
+		return grpcpresenter.NewTemplatePresenter()
+	}
+}
+
+// NewNoteOutputFactory returns a factory for gRPC NotePresenter.
+func NewNoteOutputFactory() func() *grpcpresenter.NotePresenter {
+	return func() *grpcpresenter.NotePresenter {
+		return grpcpresenter.NewNotePresenter()
+	}
+}
+
+// NewNoteEventOutputFactory returns a factory for gRPC NoteEventPresenter.
+func NewNoteEventOutputFactory() func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteEventPresenter {
+	return func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteEventPresenter {
+		return grpcpresenter.NewNoteEventPresenter(stream)
+	}
+}
@@ -1,7 +1,10 @@
 // Package http provides factory functions for HTTP adapters.
 package http
 
-import httppresenter "immortal-architecture-clean/backend/internal/adapter/http/presenter"
+import (
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	httppresenter "immortal-architecture-clean/backend/internal/adapter/http/presenter"
+)
 
 // NewAccountOutputFactory returns a factory for HTTP AccountPresenter.
 func NewAccountOutputFactory() func() *httppresenter.AccountPresenter {
@@ -23,3 +26,73 @@ func NewNoteOutputFactory() func() *httppresenter.NotePresenter {
 		return httppresenter.NewNotePresenter()
 	}
 }
+
+// NewNoteReviewOutputFactory returns a factory for HTTP NoteReviewPresenter.
+func NewNoteReviewOutputFactory() func() *httppresenter.NoteReviewPresenter {
+	return func() *httppresenter.NoteReviewPresenter {
+		return httppresenter.NewNoteReviewPresenter()
+	}
+}
+
+// NewSessionOutputFactory returns a factory for HTTP SessionPresenter.
+func NewSessionOutputFactory() func() *httppresenter.SessionPresenter {
+	return func() *httppresenter.SessionPresenter {
+		return httppresenter.NewSessionPresenter()
+	}
+}
+
+// NewTrashOutputFactory returns a factory for HTTP TrashPresenter.
+func NewTrashOutputFactory() func() *httppresenter.TrashPresenter {
+	return func() *httppresenter.TrashPresenter {
+		return httppresenter.NewTrashPresenter()
+	}
+}
+
+// NewWebhookOutputFactory returns a factory for HTTP WebhookPresenter.
+func NewWebhookOutputFactory() func() *httppresenter.WebhookPresenter {
+	return func() *httppresenter.WebhookPresenter {
+		return httppresenter.NewWebhookPresenter()
+	}
+}
+
+// NewShareOutputFactory returns a factory for HTTP SharePresenter.
+func NewShareOutputFactory() func() *httppresenter.SharePresenter {
+	return func() *httppresenter.SharePresenter {
+		return httppresenter.NewSharePresenter()
+	}
+}
+
+// NewWorkspaceOutputFactory returns a factory for HTTP WorkspacePresenter.
+func NewWorkspaceOutputFactory() func() *httppresenter.WorkspacePresenter {
+	return func() *httppresenter.WorkspacePresenter {
+		return httppresenter.NewWorkspacePresenter()
+	}
+}
+
+// NewShareLinkOutputFactory returns a factory for HTTP ShareLinkPresenter.
+func NewShareLinkOutputFactory() func() *httppresenter.ShareLinkPresenter {
+	return func() *httppresenter.ShareLinkPresenter {
+		return httppresenter.NewShareLinkPresenter()
+	}
+}
+
+// NewNoteExportOutputFactory returns a factory for HTTP NoteExportPresenter.
+func NewNoteExportOutputFactory() func(format openapi.ModelsNoteExportFormat) *httppresenter.NoteExportPresenter {
+	return func(format openapi.ModelsNoteExportFormat) *httppresenter.NoteExportPresenter {
+		return httppresenter.NewNoteExportPresenter(format)
+	}
+}
+
+// NewTemplateExportOutputFactory returns a factory for HTTP TemplateExportPresenter.
+func NewTemplateExportOutputFactory() func(format openapi.ModelsTemplateExportFormat) *httppresenter.TemplateExportPresenter {
+	return func(format openapi.ModelsTemplateExportFormat) *httppresenter.TemplateExportPresenter {
+		return httppresenter.NewTemplateExportPresenter(format)
+	}
+}
+
+// NewArchiveOutputFactory returns a factory for HTTP ArchivePresenter.
+func NewArchiveOutputFactory() func() *httppresenter.ArchivePresenter {
+	return func() *httppresenter.ArchivePresenter {
+		return httppresenter.NewArchivePresenter()
+	}
+}
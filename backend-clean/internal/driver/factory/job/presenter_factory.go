@@ -6,6 +6,13 @@ import (
 	"immortal-architecture-clean/backend/internal/port"
 )
 
+// NewArchiveOutputFactory returns a factory for ArchivePresenter.
+func NewArchiveOutputFactory() func() *presenter.ArchivePresenter {
+	return func() *presenter.ArchivePresenter {
+		return presenter.NewArchivePresenter()
+	}
+}
+
 // NewDeactivateOutputFactory returns a factory for DeactivatePresenter.
 func NewDeactivateOutputFactory() func() *presenter.DeactivatePresenter {
 	return func() *presenter.DeactivatePresenter {
@@ -19,3 +26,31 @@ func NewDeactivateOutputPortFactory() func() port.DeactivateJobOutputPort {
 		return presenter.NewDeactivatePresenter()
 	}
 }
+
+// NewPurgeOutputFactory returns a factory for PurgePresenter.
+func NewPurgeOutputFactory() func() *presenter.PurgePresenter {
+	return func() *presenter.PurgePresenter {
+		return presenter.NewPurgePresenter()
+	}
+}
+
+// NewRelayOutputFactory returns a factory for RelayPresenter.
+func NewRelayOutputFactory() func() *presenter.RelayPresenter {
+	return func() *presenter.RelayPresenter {
+		return presenter.NewRelayPresenter()
+	}
+}
+
+// NewScheduledPublishOutputFactory returns a factory for ScheduledPublishPresenter.
+func NewScheduledPublishOutputFactory() func() *presenter.ScheduledPublishPresenter {
+	return func() *presenter.ScheduledPublishPresenter {
+		return presenter.NewScheduledPublishPresenter()
+	}
+}
+
+// NewWebhookDeliveryOutputFactory returns a factory for WebhookDeliveryPresenter.
+func NewWebhookDeliveryOutputFactory() func() *presenter.WebhookDeliveryPresenter {
+	return func() *presenter.WebhookDeliveryPresenter {
+		return presenter.NewWebhookDeliveryPresenter()
+	}
+}
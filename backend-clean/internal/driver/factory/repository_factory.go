@@ -41,3 +41,73 @@ func NewNoteRepoFactory(pool *pgxpool.Pool) func() port.NoteRepository {
 		return sqlc.NewNoteRepository(pool)
 	}
 }
+
+// NewSessionRepoFactory returns a factory that creates SessionRepository.
+func NewSessionRepoFactory(pool *pgxpool.Pool) func() port.SessionRepository {
+	return func() port.SessionRepository {
+		return sqlc.NewSessionRepository(pool)
+	}
+}
+
+// NewEventPublisherFactory returns a factory that creates EventPublisher writing to the outbox.
+func NewEventPublisherFactory(pool *pgxpool.Pool) func() port.EventPublisher {
+	return func() port.EventPublisher {
+		return sqlc.NewOutboxRepository(pool)
+	}
+}
+
+// NewOutboxRepoFactory returns a factory that creates OutboxRepository.
+func NewOutboxRepoFactory(pool *pgxpool.Pool) func() port.OutboxRepository {
+	return func() port.OutboxRepository {
+		return sqlc.NewOutboxRepository(pool)
+	}
+}
+
+// NewNoteFeedReaderFactory returns a factory that creates NoteFeedReader reading the outbox.
+func NewNoteFeedReaderFactory(pool *pgxpool.Pool) func() port.NoteFeedReader {
+	return func() port.NoteFeedReader {
+		return sqlc.NewOutboxRepository(pool)
+	}
+}
+
+// NewWebhookRepoFactory returns a factory that creates WebhookRepository.
+func NewWebhookRepoFactory(pool *pgxpool.Pool) func() port.WebhookRepository {
+	return func() port.WebhookRepository {
+		return sqlc.NewWebhookRepository(pool)
+	}
+}
+
+// NewWebhookDeliveryRepoFactory returns a factory that creates WebhookDeliveryRepository.
+func NewWebhookDeliveryRepoFactory(pool *pgxpool.Pool) func() port.WebhookDeliveryRepository {
+	return func() port.WebhookDeliveryRepository {
+		return sqlc.NewWebhookRepository(pool)
+	}
+}
+
+// NewNoteReviewRepoFactory returns a factory that creates NoteReviewRepository.
+func NewNoteReviewRepoFactory(pool *pgxpool.Pool) func() port.NoteReviewRepository {
+	return func() port.NoteReviewRepository {
+		return sqlc.NewNoteReviewRepository(pool)
+	}
+}
+
+// NewShareRepoFactory returns a factory that creates ShareRepository.
+func NewShareRepoFactory(pool *pgxpool.Pool) func() port.ShareRepository {
+	return func() port.ShareRepository {
+		return sqlc.NewShareRepository(pool)
+	}
+}
+
+// NewWorkspaceRepoFactory returns a factory that creates WorkspaceRepository.
+func NewWorkspaceRepoFactory(pool *pgxpool.Pool) func() port.WorkspaceRepository {
+	return func() port.WorkspaceRepository {
+		return sqlc.NewWorkspaceRepository(pool)
+	}
+}
+
+// NewShareLinkRepoFactory returns a factory that creates ShareLinkRepository.
+func NewShareLinkRepoFactory(pool *pgxpool.Pool) func() port.ShareLinkRepository {
+	return func() port.ShareLinkRepository {
+		return sqlc.NewShareLinkRepository(pool)
+	}
+}
@@ -0,0 +1,18 @@
+// Package factory provides constructors for driver-level wiring.
+package factory
+
+import "immortal-architecture-clean/backend/internal/port"
+
+// NewTokenIssuerFactory returns a factory that provides TokenIssuer.
+func NewTokenIssuerFactory(issuer port.TokenIssuer) func() port.TokenIssuer {
+	return func() port.TokenIssuer {
+		return issuer
+	}
+}
+
+// NewLinkTokenFactory returns a factory that provides LinkTokenGenerator.
+func NewLinkTokenFactory(tokens port.LinkTokenGenerator) func() port.LinkTokenGenerator {
+	return func() port.LinkTokenGenerator {
+		return tokens
+	}
+}
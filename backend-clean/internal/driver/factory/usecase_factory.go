@@ -2,34 +2,138 @@
 package factory
 
 import (
+	"immortal-architecture-clean/backend/internal/domain/note"
 	"immortal-architecture-clean/backend/internal/port"
 	"immortal-architecture-clean/backend/internal/usecase"
 )
 
-// NewAccountInputFactory returns a factory for AccountInteractor.
-func NewAccountInputFactory() func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
+// NewAccountInputFactory returns a factory for AccountInteractor recording events through publisherFactory.
+func NewAccountInputFactory(txFactory func() port.TxManager, publisherFactory func() port.EventPublisher) func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
 	return func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
-		return usecase.NewAccountInteractor(repo, output)
+		return usecase.NewAccountInteractor(repo, txFactory(), publisherFactory(), output)
 	}
 }
 
-// NewTemplateInputFactory returns a factory for TemplateInteractor.
-func NewTemplateInputFactory() func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
-	return func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
-		return usecase.NewTemplateInteractor(repo, tx, output)
+// NewTemplateInputFactory returns a factory for TemplateInteractor recording events through publisherFactory,
+// authorizing changes with the grants from shareRepoFactory and scoping templates by the memberships from workspaceRepoFactory.
+func NewTemplateInputFactory(publisherFactory func() port.EventPublisher, shareRepoFactory func() port.ShareRepository, workspaceRepoFactory func() port.WorkspaceRepository) func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+	return func(repo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+		return usecase.NewTemplateInteractor(repo, accountRepo, shareRepoFactory(), workspaceRepoFactory(), tx, publisherFactory(), output)
 	}
 }
 
-// NewNoteInputFactory returns a factory for NoteInteractor.
-func NewNoteInputFactory() func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
-	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
-		return usecase.NewNoteInteractor(noteRepo, tplRepo, tx, output)
+// NewNoteInputFactory returns a factory for NoteInteractor that records events through publisherFactory,
+// authorizes changes with the grants from shareRepoFactory, scopes notes by the memberships from workspaceRepoFactory,
+// revokes public links from shareLinkRepoFactory on unpublish and changes statuses following workflow.
+func NewNoteInputFactory(publisherFactory func() port.EventPublisher, shareRepoFactory func() port.ShareRepository, workspaceRepoFactory func() port.WorkspaceRepository, shareLinkRepoFactory func() port.ShareLinkRepository, workflow note.Workflow) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+		return usecase.NewNoteInteractor(noteRepo, tplRepo, accountRepo, shareRepoFactory(), workspaceRepoFactory(), shareLinkRepoFactory(), tx, publisherFactory(), workflow, output)
 	}
 }
 
-// NewDeactivateJobInputFactory returns a factory for DeactivateInteractor.
-func NewDeactivateJobInputFactory() func(repo port.AccountRepository, output port.DeactivateJobOutputPort) port.DeactivateJobInputPort {
+// NewNoteReviewInputFactory returns a factory for NoteReviewInteractor that records events through publisherFactory.
+func NewNoteReviewInputFactory(publisherFactory func() port.EventPublisher) func(noteRepo port.NoteRepository, reviewRepo port.NoteReviewRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteReviewOutputPort) port.NoteReviewInputPort {
+	return func(noteRepo port.NoteRepository, reviewRepo port.NoteReviewRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteReviewOutputPort) port.NoteReviewInputPort {
+		return usecase.NewNoteReviewInteractor(noteRepo, reviewRepo, accountRepo, tx, publisherFactory(), output)
+	}
+}
+
+// NewShareInputFactory returns a factory for ShareInteractor recording grant changes through publisherFactory
+// and limiting grantees to the memberships from workspaceRepoFactory.
+func NewShareInputFactory(publisherFactory func() port.EventPublisher, workspaceRepoFactory func() port.WorkspaceRepository) func(shareRepo port.ShareRepository, noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ShareOutputPort) port.ShareInputPort {
+	return func(shareRepo port.ShareRepository, noteRepo port.NoteRepository, tplRepo port.TemplateRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ShareOutputPort) port.ShareInputPort {
+		return usecase.NewShareInteractor(shareRepo, noteRepo, tplRepo, accountRepo, workspaceRepoFactory(), tx, publisherFactory(), output)
+	}
+}
+
+// NewShareLinkInputFactory returns a factory for ShareLinkInteractor recording link changes through publisherFactory,
+// authorizing owners with the grants from shareRepoFactory and the memberships from workspaceRepoFactory.
+func NewShareLinkInputFactory(publisherFactory func() port.EventPublisher, shareRepoFactory func() port.ShareRepository, workspaceRepoFactory func() port.WorkspaceRepository) func(linkRepo port.ShareLinkRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tokens port.LinkTokenGenerator, tx port.TxManager, output port.ShareLinkOutputPort) port.ShareLinkInputPort {
+	return func(linkRepo port.ShareLinkRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tokens port.LinkTokenGenerator, tx port.TxManager, output port.ShareLinkOutputPort) port.ShareLinkInputPort {
+		return usecase.NewShareLinkInteractor(linkRepo, noteRepo, accountRepo, shareRepoFactory(), workspaceRepoFactory(), tokens, tx, publisherFactory(), output)
+	}
+}
+
+// NewArchiveInputFactory returns a factory for ArchiveInteractor recording created templates and notes through publisherFactory.
+func NewArchiveInputFactory(publisherFactory func() port.EventPublisher, workspaceRepoFactory func() port.WorkspaceRepository) func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort {
+	return func(tplRepo port.TemplateRepository, noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort {
+		return usecase.NewArchiveInteractor(tplRepo, noteRepo, accountRepo, workspaceRepoFactory(), tx, publisherFactory(), output)
+	}
+}
+
+// NewWorkspaceInputFactory returns a factory for WorkspaceInteractor recording membership changes through publisherFactory.
+func NewWorkspaceInputFactory(publisherFactory func() port.EventPublisher) func(repo port.WorkspaceRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+	return func(repo port.WorkspaceRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+		return usecase.NewWorkspaceInteractor(repo, accountRepo, tx, publisherFactory(), output)
+	}
+}
+
+// NewNoteWatchInputFactory returns a factory for NoteWatchInteractor following events
+// and scoping them by the memberships from workspaceRepoFactory.
+func NewNoteWatchInputFactory(events port.NoteEventSubscriber, workspaceRepoFactory func() port.WorkspaceRepository) func(output port.NoteWatchOutputPort) port.NoteWatchInputPort {
+	return func(output port.NoteWatchOutputPort) port.NoteWatchInputPort {
+		return usecase.NewNoteWatchInteractor(events, workspaceRepoFactory(), output)
+	}
+}
+
+// NewTrashInputFactory returns a factory for TrashInteractor.
+func NewTrashInputFactory() func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.TrashOutputPort) port.TrashInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.TrashOutputPort) port.TrashInputPort {
+		return usecase.NewTrashInteractor(noteRepo, tplRepo, output)
+	}
+}
+
+// NewDeactivateJobInputFactory returns a factory for DeactivateInteractor recording events through publisherFactory.
+func NewDeactivateJobInputFactory(txFactory func() port.TxManager, publisherFactory func() port.EventPublisher) func(repo port.AccountRepository, output port.DeactivateJobOutputPort) port.DeactivateJobInputPort {
 	return func(repo port.AccountRepository, output port.DeactivateJobOutputPort) port.DeactivateJobInputPort {
-		return usecase.NewDeactivateInteractor(repo, output)
+		return usecase.NewDeactivateInteractor(repo, txFactory(), publisherFactory(), output)
+	}
+}
+
+// NewPurgeJobInputFactory returns a factory for PurgeInteractor.
+func NewPurgeJobInputFactory() func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.PurgeJobOutputPort) port.PurgeJobInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, output port.PurgeJobOutputPort) port.PurgeJobInputPort {
+		return usecase.NewPurgeInteractor(noteRepo, tplRepo, output)
+	}
+}
+
+// NewScheduledPublishJobInputFactory returns a factory for ScheduledPublishInteractor recording events through publisherFactory
+// and revoking the public links from shareLinkRepoFactory of the notes it unpublishes.
+func NewScheduledPublishJobInputFactory(txFactory func() port.TxManager, publisherFactory func() port.EventPublisher, shareLinkRepoFactory func() port.ShareLinkRepository) func(noteRepo port.NoteRepository, output port.ScheduledPublishJobOutputPort) port.ScheduledPublishJobInputPort {
+	return func(noteRepo port.NoteRepository, output port.ScheduledPublishJobOutputPort) port.ScheduledPublishJobInputPort {
+		return usecase.NewScheduledPublishInteractor(noteRepo, shareLinkRepoFactory(), txFactory(), publisherFactory(), output)
+	}
+}
+
+// NewSessionInputFactory returns a factory for SessionInteractor recording events through publisherFactory.
+func NewSessionInputFactory(publisherFactory func() port.EventPublisher) func(accounts port.AccountRepository, sessions port.SessionRepository, tokens port.TokenIssuer, tx port.TxManager, output port.SessionOutputPort) port.SessionInputPort {
+	return func(accounts port.AccountRepository, sessions port.SessionRepository, tokens port.TokenIssuer, tx port.TxManager, output port.SessionOutputPort) port.SessionInputPort {
+		return usecase.NewSessionInteractor(accounts, sessions, tokens, tx, publisherFactory(), output)
+	}
+}
+
+// NewOutboxRelayInputFactory returns a factory for OutboxRelayInteractor.
+func NewOutboxRelayInputFactory() func(repo port.OutboxRepository, sink port.EventSink, output port.OutboxRelayOutputPort) port.OutboxRelayInputPort {
+	return func(repo port.OutboxRepository, sink port.EventSink, output port.OutboxRelayOutputPort) port.OutboxRelayInputPort {
+		return usecase.NewOutboxRelayInteractor(repo, sink, output)
+	}
+}
+
+// NewWebhookInputFactory returns a factory for WebhookInteractor.
+func NewWebhookInputFactory() func(repo port.WebhookRepository, accountRepo port.AccountRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+	return func(repo port.WebhookRepository, accountRepo port.AccountRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+		return usecase.NewWebhookInteractor(repo, accountRepo, output)
+	}
+}
+
+// NewWebhookDispatchSink returns the event sink that enqueues webhook deliveries for relayed events.
+func NewWebhookDispatchSink(repoFactory func() port.WebhookRepository) port.EventSink {
+	return usecase.NewWebhookDispatchInteractor(repoFactory())
+}
+
+// NewWebhookDeliveryInputFactory returns a factory for WebhookDeliveryInteractor.
+func NewWebhookDeliveryInputFactory() func(repo port.WebhookDeliveryRepository, sender port.WebhookSender, output port.WebhookDeliveryOutputPort) port.WebhookDeliveryInputPort {
+	return func(repo port.WebhookDeliveryRepository, sender port.WebhookSender, output port.WebhookDeliveryOutputPort) port.WebhookDeliveryInputPort {
+		return usecase.NewWebhookDeliveryInteractor(repo, sender, output)
 	}
 }
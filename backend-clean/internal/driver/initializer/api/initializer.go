@@ -9,12 +9,28 @@ import (
 
 	httpcontroller "immortal-architecture-clean/backend/internal/adapter/http/controller"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	httpmiddleware "immortal-architecture-clean/backend/internal/adapter/http/middleware"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/driver/auth"
 	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	httpfactory "immortal-architecture-clean/backend/internal/driver/factory/http"
 )
 
+// PublicPaths are routes served without a bearer token.
+var PublicPaths = []string{
+	"/api/accounts/auth",
+	"/api/accounts/refresh",
+	"/api/accounts/logout",
+	"/public/notes/:token",
+}
+
+// LoginPaths are routes only the frontend server may call; they require the login secret.
+var LoginPaths = []string{
+	"/api/accounts/auth",
+}
+
 // BuildServer composes all dependencies and returns an Echo server, config, and cleanup function.
 func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error) {
 	cfg, err := config.Load()
@@ -22,6 +38,32 @@ func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error
 		return nil, nil, func() {}, err
 	}
 
+	verifier, err := auth.NewVerifier(auth.Options{
+		Algorithm:     cfg.Auth.JWTAlgorithm,
+		Secret:        cfg.Auth.JWTSecret,
+		PublicKeyFile: cfg.Auth.JWTPublicKeyFile,
+		JWKSFile:      cfg.Auth.JWKSFile,
+		Issuer:        cfg.Auth.JWTIssuer,
+		Audience:      cfg.Auth.JWTAudience,
+	})
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
+	issuer, err := auth.NewIssuer(auth.IssuerOptions{
+		Algorithm:      cfg.Auth.JWTAlgorithm,
+		Secret:         cfg.Auth.JWTSecret,
+		PrivateKeyFile: cfg.Auth.JWTPrivateKeyFile,
+		KeyID:          cfg.Auth.JWTKeyID,
+		Issuer:         cfg.Auth.JWTIssuer,
+		Audience:       cfg.Auth.JWTAudience,
+		AccessTTL:      cfg.Auth.AccessTokenTTL,
+		RefreshTTL:     cfg.Auth.RefreshTokenTTL,
+	})
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
 	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
 	if err != nil {
 		return nil, nil, func() {}, err
@@ -35,15 +77,43 @@ func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error
 	accountRepoFactory := factory.NewAccountRepoFactory(pool)
 	templateRepoFactory := factory.NewTemplateRepoFactory(pool)
 	noteRepoFactory := factory.NewNoteRepoFactory(pool)
+	sessionRepoFactory := factory.NewSessionRepoFactory(pool)
+	webhookRepoFactory := factory.NewWebhookRepoFactory(pool)
+	reviewRepoFactory := factory.NewNoteReviewRepoFactory(pool)
+	shareRepoFactory := factory.NewShareRepoFactory(pool)
+	workspaceRepoFactory := factory.NewWorkspaceRepoFactory(pool)
+	shareLinkRepoFactory := factory.NewShareLinkRepoFactory(pool)
 	txFactory := factory.NewTxFactory(txMgr)
+	tokenFactory := factory.NewTokenIssuerFactory(issuer)
+	linkTokenFactory := factory.NewLinkTokenFactory(auth.NewLinkTokens())
+	eventPublisherFactory := factory.NewEventPublisherFactory(pool)
 
 	accountOutputFactory := httpfactory.NewAccountOutputFactory()
 	templateOutputFactory := httpfactory.NewTemplateOutputFactory()
 	noteOutputFactory := httpfactory.NewNoteOutputFactory()
+	noteExportOutputFactory := httpfactory.NewNoteExportOutputFactory()
+	templateExportOutputFactory := httpfactory.NewTemplateExportOutputFactory()
+	sessionOutputFactory := httpfactory.NewSessionOutputFactory()
+	trashOutputFactory := httpfactory.NewTrashOutputFactory()
+	webhookOutputFactory := httpfactory.NewWebhookOutputFactory()
+	reviewOutputFactory := httpfactory.NewNoteReviewOutputFactory()
+	shareOutputFactory := httpfactory.NewShareOutputFactory()
+	workspaceOutputFactory := httpfactory.NewWorkspaceOutputFactory()
+	shareLinkOutputFactory := httpfactory.NewShareLinkOutputFactory()
+	archiveOutputFactory := httpfactory.NewArchiveOutputFactory()
 
-	accountInputFactory := factory.NewAccountInputFactory()
-	templateInputFactory := factory.NewTemplateInputFactory()
-	noteInputFactory := factory.NewNoteInputFactory()
+	accountInputFactory := factory.NewAccountInputFactory(txFactory, eventPublisherFactory)
+	templateInputFactory := factory.NewTemplateInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory)
+	// Note changes reach watchers in the gRPC server through the outbox.
+	noteInputFactory := factory.NewNoteInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory, shareLinkRepoFactory, note.Workflow{RequireReview: cfg.ReviewRequired})
+	sessionInputFactory := factory.NewSessionInputFactory(eventPublisherFactory)
+	trashInputFactory := factory.NewTrashInputFactory()
+	webhookInputFactory := factory.NewWebhookInputFactory()
+	reviewInputFactory := factory.NewNoteReviewInputFactory(eventPublisherFactory)
+	shareInputFactory := factory.NewShareInputFactory(eventPublisherFactory, workspaceRepoFactory)
+	workspaceInputFactory := factory.NewWorkspaceInputFactory(eventPublisherFactory)
+	shareLinkInputFactory := factory.NewShareLinkInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory)
+	archiveInputFactory := factory.NewArchiveInputFactory(eventPublisherFactory, workspaceRepoFactory)
 
 	e := echo.New()
 
@@ -58,11 +128,21 @@ func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error
 			echo.HeaderAuthorization,
 		},
 	}))
+	e.Use(httpmiddleware.Auth(verifier, PublicPaths...))
+	e.Use(httpmiddleware.LoginSecret(cfg.Auth.LoginSecret, LoginPaths...))
 
 	ac := httpcontroller.NewAccountController(accountInputFactory, accountOutputFactory, accountRepoFactory)
-	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
-	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateRepoFactory, txFactory)
-	server := httpcontroller.NewServer(ac, nc, tc)
+	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteExportOutputFactory, noteRepoFactory, templateRepoFactory, accountRepoFactory, txFactory)
+	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateExportOutputFactory, templateRepoFactory, accountRepoFactory, txFactory)
+	sc := httpcontroller.NewSessionController(sessionInputFactory, sessionOutputFactory, accountRepoFactory, sessionRepoFactory, tokenFactory, txFactory)
+	trc := httpcontroller.NewTrashController(trashInputFactory, trashOutputFactory, noteRepoFactory, templateRepoFactory)
+	wc := httpcontroller.NewWebhookController(webhookInputFactory, webhookOutputFactory, webhookRepoFactory, accountRepoFactory)
+	rc := httpcontroller.NewNoteReviewController(reviewInputFactory, reviewOutputFactory, noteRepoFactory, reviewRepoFactory, accountRepoFactory, txFactory)
+	shc := httpcontroller.NewShareController(shareInputFactory, shareOutputFactory, shareRepoFactory, noteRepoFactory, templateRepoFactory, accountRepoFactory, txFactory)
+	wsc := httpcontroller.NewWorkspaceController(workspaceInputFactory, workspaceOutputFactory, workspaceRepoFactory, accountRepoFactory, txFactory)
+	slc := httpcontroller.NewShareLinkController(shareLinkInputFactory, shareLinkOutputFactory, shareLinkRepoFactory, noteRepoFactory, accountRepoFactory, linkTokenFactory, txFactory)
+	arc := httpcontroller.NewArchiveController(archiveInputFactory, archiveOutputFactory, templateRepoFactory, noteRepoFactory, accountRepoFactory, txFactory, cfg.ImportMaxBytes)
+	server := httpcontroller.NewServer(ac, nc, tc, sc, trc, wc, rc, shc, wsc, slc, arc)
 	openapi.RegisterHandlers(e, server)
 
 	return e, cfg, cleanup, nil
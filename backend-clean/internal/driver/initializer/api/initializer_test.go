@@ -8,6 +8,7 @@ import (
 
 	httpcontroller "immortal-architecture-clean/backend/internal/adapter/http/controller"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	httpfactory "immortal-architecture-clean/backend/internal/driver/factory/http"
 )
@@ -17,25 +18,99 @@ func TestNewServer_Wiring(t *testing.T) {
 	// use nil pool since factories are functional closures; server wiring should not panic
 	var pool *pgxpool.Pool
 	ac := httpcontroller.NewAccountController(
-		factory.NewAccountInputFactory(),
+		factory.NewAccountInputFactory(factory.NewTxFactory(nil), factory.NewEventPublisherFactory(pool)),
 		httpfactory.NewAccountOutputFactory(),
 		factory.NewAccountRepoFactory(pool),
 	)
 	tc := httpcontroller.NewTemplateController(
-		factory.NewTemplateInputFactory(),
+		factory.NewTemplateInputFactory(factory.NewEventPublisherFactory(pool), factory.NewShareRepoFactory(pool), factory.NewWorkspaceRepoFactory(pool)),
 		httpfactory.NewTemplateOutputFactory(),
+		httpfactory.NewTemplateExportOutputFactory(),
 		factory.NewTemplateRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
 		factory.NewTxFactory(nil),
 	)
 	nc := httpcontroller.NewNoteController(
-		factory.NewNoteInputFactory(),
+		factory.NewNoteInputFactory(factory.NewEventPublisherFactory(pool), factory.NewShareRepoFactory(pool), factory.NewWorkspaceRepoFactory(pool), factory.NewShareLinkRepoFactory(pool), note.Workflow{}),
 		httpfactory.NewNoteOutputFactory(),
+		httpfactory.NewNoteExportOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	sc := httpcontroller.NewSessionController(
+		factory.NewSessionInputFactory(factory.NewEventPublisherFactory(pool)),
+		httpfactory.NewSessionOutputFactory(),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewSessionRepoFactory(pool),
+		factory.NewTokenIssuerFactory(nil),
+		factory.NewTxFactory(nil),
+	)
+
+	trc := httpcontroller.NewTrashController(
+		factory.NewTrashInputFactory(),
+		httpfactory.NewTrashOutputFactory(),
 		factory.NewNoteRepoFactory(pool),
 		factory.NewTemplateRepoFactory(pool),
+	)
+
+	wc := httpcontroller.NewWebhookController(
+		factory.NewWebhookInputFactory(),
+		httpfactory.NewWebhookOutputFactory(),
+		factory.NewWebhookRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+	)
+
+	rc := httpcontroller.NewNoteReviewController(
+		factory.NewNoteReviewInputFactory(factory.NewEventPublisherFactory(pool)),
+		httpfactory.NewNoteReviewOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewNoteReviewRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	shc := httpcontroller.NewShareController(
+		factory.NewShareInputFactory(factory.NewEventPublisherFactory(pool), factory.NewWorkspaceRepoFactory(pool)),
+		httpfactory.NewShareOutputFactory(),
+		factory.NewShareRepoFactory(pool),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	wsc := httpcontroller.NewWorkspaceController(
+		factory.NewWorkspaceInputFactory(factory.NewEventPublisherFactory(pool)),
+		httpfactory.NewWorkspaceOutputFactory(),
+		factory.NewWorkspaceRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	slc := httpcontroller.NewShareLinkController(
+		factory.NewShareLinkInputFactory(factory.NewEventPublisherFactory(pool), factory.NewShareRepoFactory(pool), factory.NewWorkspaceRepoFactory(pool)),
+		httpfactory.NewShareLinkOutputFactory(),
+		factory.NewShareLinkRepoFactory(pool),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewLinkTokenFactory(nil),
+		factory.NewTxFactory(nil),
+	)
+
+	arc := httpcontroller.NewArchiveController(
+		factory.NewArchiveInputFactory(factory.NewEventPublisherFactory(pool), factory.NewWorkspaceRepoFactory(pool)),
+		httpfactory.NewArchiveOutputFactory(),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
 		factory.NewTxFactory(nil),
+		0,
 	)
 
-	srv := httpcontroller.NewServer(ac, nc, tc)
+	srv := httpcontroller.NewServer(ac, nc, tc, sc, trc, wc, rc, shc, wsc, slc, arc)
 	if srv == nil {
 		t.Fatalf("server is nil")
 	}
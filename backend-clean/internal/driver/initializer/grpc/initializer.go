@@ -10,12 +10,28 @@ import (
 
 	grpccontroller "immortal-architecture-clean/backend/internal/adapter/grpc/controller"
 	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/accountpb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/driver/auth"
 	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	driverevent "immortal-architecture-clean/backend/internal/driver/event"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	grpcfactory "immortal-architecture-clean/backend/internal/driver/factory/grpc"
 )
 
+// PublicMethods are methods served without a bearer token.
+var PublicMethods = []string{
+	accountpb.AccountService_CreateOrGetAccount_FullMethodName,
+}
+
+// LoginMethods are methods only the frontend server may call; they require the login secret.
+var LoginMethods = []string{
+	accountpb.AccountService_CreateOrGetAccount_FullMethodName,
+}
+
 // BuildServer composes all dependencies and returns a gRPC server, config, and cleanup function.
 func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), error) {
 	cfg, err := config.Load()
@@ -23,6 +39,18 @@ func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), err
 		return nil, nil, func() {}, err
 	}
 
+	verifier, err := auth.NewVerifier(auth.Options{
+		Algorithm:     cfg.Auth.JWTAlgorithm,
+		Secret:        cfg.Auth.JWTSecret,
+		PublicKeyFile: cfg.Auth.JWTPublicKeyFile,
+		JWKSFile:      cfg.Auth.JWKSFile,
+		Issuer:        cfg.Auth.JWTIssuer,
+		Audience:      cfg.Auth.JWTAudience,
+	})
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
 	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
 	if err != nil {
 		return nil, nil, func() {}, err
@@ -31,12 +59,37 @@ func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), err
 		pool.Close()
 	}
 
+	txMgr := driverdb.NewTxManager(pool)
+
 	accountRepoFactory := factory.NewAccountRepoFactory(pool)
-	accountInputFactory := factory.NewAccountInputFactory()
+	templateRepoFactory := factory.NewTemplateRepoFactory(pool)
+	noteRepoFactory := factory.NewNoteRepoFactory(pool)
+	shareRepoFactory := factory.NewShareRepoFactory(pool)
+	workspaceRepoFactory := factory.NewWorkspaceRepoFactory(pool)
+	shareLinkRepoFactory := factory.NewShareLinkRepoFactory(pool)
+	txFactory := factory.NewTxFactory(txMgr)
+	eventPublisherFactory := factory.NewEventPublisherFactory(pool)
+	noteFeedReaderFactory := factory.NewNoteFeedReaderFactory(pool)
+
+	accountInputFactory := factory.NewAccountInputFactory(txFactory, eventPublisherFactory)
+	templateInputFactory := factory.NewTemplateInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory)
+	noteFeed := driverevent.NewNoteFeed(noteFeedReaderFactory(), driverevent.DefaultPollInterval)
+	noteInputFactory := factory.NewNoteInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory, shareLinkRepoFactory, note.Workflow{RequireReview: cfg.ReviewRequired})
+	noteWatchInputFactory := factory.NewNoteWatchInputFactory(noteFeed, workspaceRepoFactory)
+
 	accountOutputFactory := grpcfactory.NewAccountOutputFactory()
+	templateOutputFactory := grpcfactory.NewTemplateOutputFactory()
+	noteOutputFactory := grpcfactory.NewNoteOutputFactory()
+	noteEventOutputFactory := grpcfactory.NewNoteEventOutputFactory()
 
 	// Create gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryAuth(verifier, PublicMethods...),
+			interceptor.UnaryLoginSecret(cfg.Auth.LoginSecret, LoginMethods...),
+		),
+		grpc.StreamInterceptor(interceptor.StreamAuth(verifier, PublicMethods...)),
+	)
 
 	// Register account service
 	accountController := grpccontroller.NewAccountController(
@@ -46,6 +99,29 @@ func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), err
 	)
 	accountpb.RegisterAccountServiceServer(s, accountController)
 
+	// Register template service
+	templateController := grpccontroller.NewTemplateController(
+		templateInputFactory,
+		templateOutputFactory,
+		templateRepoFactory,
+		accountRepoFactory,
+		txFactory,
+	)
+	templatepb.RegisterTemplateServiceServer(s, templateController)
+
+	// Register note service
+	noteController := grpccontroller.NewNoteController(
+		noteInputFactory,
+		noteOutputFactory,
+		noteRepoFactory,
+		templateRepoFactory,
+		accountRepoFactory,
+		txFactory,
+		noteWatchInputFactory,
+		noteEventOutputFactory,
+	)
+	notepb.RegisterNoteServiceServer(s, noteController)
+
 	return s, cfg, cleanup, nil
 }
 
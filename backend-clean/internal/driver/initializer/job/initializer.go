@@ -2,14 +2,22 @@
 package job
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"os"
+	"sync"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/eventsink"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/webhookclient"
 	jobctrl "immortal-architecture-clean/backend/internal/adapter/job/controller"
+	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	jobfactory "immortal-architecture-clean/backend/internal/driver/factory/job"
+	"immortal-architecture-clean/backend/internal/port"
 )
 
 // RunDeactivateInactiveUsers initializes dependencies and runs the deactivation job.
@@ -26,7 +34,8 @@ func RunDeactivateInactiveUsers(ctx context.Context) (int, error) {
 	defer pool.Close()
 
 	accountRepoFactory := factory.NewAccountRepoFactory(pool)
-	deactivateInputFactory := factory.NewDeactivateJobInputFactory()
+	txFactory := factory.NewTxFactory(driverdb.NewTxManager(pool))
+	deactivateInputFactory := factory.NewDeactivateJobInputFactory(txFactory, factory.NewEventPublisherFactory(pool))
 	deactivateOutputFactory := jobfactory.NewDeactivateOutputFactory()
 
 	controller := jobctrl.NewDeactivateController(
@@ -37,3 +46,183 @@ func RunDeactivateInactiveUsers(ctx context.Context) (int, error) {
 
 	return controller.Run(ctx)
 }
+
+// RunPurgeTrash initializes dependencies and runs the trash purge job.
+// It returns the number of purged notes and templates.
+func RunPurgeTrash(ctx context.Context) (int, int, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pool.Close()
+
+	controller := jobctrl.NewPurgeController(
+		factory.NewPurgeJobInputFactory(),
+		jobfactory.NewPurgeOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+	)
+
+	return controller.Run(ctx, cfg.TrashRetention)
+}
+
+// RunPublishScheduledNotes initializes dependencies and runs the scheduled publishing job.
+// It returns the number of published and unpublished notes.
+func RunPublishScheduledNotes(ctx context.Context) (int, int, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pool.Close()
+
+	txFactory := factory.NewTxFactory(driverdb.NewTxManager(pool))
+	controller := jobctrl.NewScheduledPublishController(
+		factory.NewScheduledPublishJobInputFactory(txFactory, factory.NewEventPublisherFactory(pool), factory.NewShareLinkRepoFactory(pool)),
+		jobfactory.NewScheduledPublishOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+	)
+
+	return controller.Run(ctx)
+}
+
+// RunExportAccount initializes dependencies and writes the archive of accountID to the file at path.
+// It returns the number of exported templates and notes. No file is left behind when the export fails.
+func RunExportAccount(ctx context.Context, accountID, path string) (int, int, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pool.Close()
+
+	f, err := os.Create(path) //nolint:gosec // path is given by the operator running the job
+	if err != nil {
+		return 0, 0, err
+	}
+	w := bufio.NewWriter(f)
+	templates, notes, err := newArchiveController(pool).Export(ctx, accountID, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return 0, 0, err
+	}
+	return templates, notes, nil
+}
+
+// RunImportAccount initializes dependencies and imports the archive in the file at path under accountID.
+// It returns the number of created templates and notes.
+func RunImportAccount(ctx context.Context, accountID, path string) (int, int, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	f, err := os.Open(path) //nolint:gosec // path is given by the operator running the job
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pool.Close()
+
+	return newArchiveController(pool).Import(ctx, accountID, bufio.NewReader(f))
+}
+
+func newArchiveController(pool *pgxpool.Pool) *jobctrl.ArchiveController {
+	publisherFactory := factory.NewEventPublisherFactory(pool)
+	return jobctrl.NewArchiveController(
+		factory.NewArchiveInputFactory(publisherFactory, factory.NewWorkspaceRepoFactory(pool)),
+		jobfactory.NewArchiveOutputFactory(),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewTxFactory(driverdb.NewTxManager(pool)),
+	)
+}
+
+// RunOutboxRelay initializes dependencies and relays outbox events to the configured sink until ctx is done.
+// Relayed events are also enqueued for subscribed webhooks, whose deliveries are sent concurrently.
+func RunOutboxRelay(ctx context.Context) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	sink, closeSink, err := newEventSink(cfg.Relay)
+	if err != nil {
+		return err
+	}
+	defer closeSink()
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	// Enqueueing is idempotent per event, so it runs first: a retry after a failing
+	// sink does not duplicate webhook deliveries.
+	relaySink := eventsink.NewFanout(factory.NewWebhookDispatchSink(factory.NewWebhookRepoFactory(pool)), sink)
+
+	relay := jobctrl.NewRelayController(
+		factory.NewOutboxRelayInputFactory(),
+		jobfactory.NewRelayOutputFactory(),
+		factory.NewOutboxRepoFactory(pool),
+		relaySink,
+	)
+	delivery := jobctrl.NewWebhookDeliveryController(
+		factory.NewWebhookDeliveryInputFactory(),
+		jobfactory.NewWebhookDeliveryOutputFactory(),
+		factory.NewWebhookDeliveryRepoFactory(pool),
+		webhookclient.New(cfg.Relay.DeliveryTimeout),
+	)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		delivery.Run(ctx, cfg.Relay.BatchSize, cfg.Relay.PollInterval)
+	}()
+	relay.Run(ctx, cfg.Relay.BatchSize, cfg.Relay.PollInterval)
+	wg.Wait()
+	return nil
+}
+
+// newEventSink builds the sink selected by cfg and a function releasing it.
+func newEventSink(cfg config.RelayConfig) (port.EventSink, func(), error) {
+	switch cfg.Sink {
+	case config.RelaySinkWebhook:
+		return eventsink.NewWebhookSink(cfg.WebhookURL, cfg.WebhookTimeout), func() {}, nil
+	case config.RelaySinkFile:
+		sink, err := eventsink.OpenFileSink(cfg.FilePath)
+		if err != nil {
+			return nil, func() {}, err
+		}
+		return sink, func() { _ = sink.Close() }, nil
+	default:
+		return eventsink.NewWriterSink(os.Stdout), func() {}, nil
+	}
+}
@@ -25,7 +25,10 @@ type AccountRepository interface {
 	UpsertOAuthAccount(ctx context.Context, input account.OAuthAccountInput) (*account.Account, error)
 	GetByID(ctx context.Context, id string) (*account.Account, error)
 	GetByEmail(ctx context.Context, email string) (*account.Account, error)
+	// Reactivate marks a deactivated account active again and records the reactivation.
+	// Returns ErrNotFound if the account does not exist or is already active.
+	Reactivate(ctx context.Context, id string, at time.Time) (*account.Account, error)
 	// DeactivateByLastLoginBefore deactivates active users who last logged in before the given time.
-	// Returns the number of affected rows.
-	DeactivateByLastLoginBefore(ctx context.Context, before time.Time) (int, error)
+	// Returns the IDs of the deactivated accounts.
+	DeactivateByLastLoginBefore(ctx context.Context, before time.Time) ([]string, error)
 }
@@ -0,0 +1,29 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+)
+
+// ArchiveInputPort defines use case inputs for moving all templates and notes of an account.
+type ArchiveInputPort interface {
+	// Export collects the templates and notes accountID owns, trash excluded, into an archive.
+	Export(ctx context.Context, accountID string) error
+	// Import recreates the templates and notes of an archive under a new owner with new IDs.
+	Import(ctx context.Context, input ArchiveImportInput) error
+}
+
+// ArchiveOutputPort defines archive presenters.
+type ArchiveOutputPort interface {
+	PresentArchive(ctx context.Context, a *archive.Archive) error
+	// PresentArchiveImported presents how many templates and notes an import created.
+	PresentArchiveImported(ctx context.Context, templates, notes int) error
+}
+
+// ArchiveImportInput imports an archive into the personal workspace of OwnerID.
+type ArchiveImportInput struct {
+	Archive archive.Archive
+	OwnerID string
+}
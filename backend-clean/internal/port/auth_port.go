@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// TokenVerifier validates bearer tokens and resolves the account they were issued for.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (accountID string, err error)
+}
+
+// TokenIssuer mints access tokens and opaque refresh tokens.
+type TokenIssuer interface {
+	IssueAccessToken(ctx context.Context, accountID string) (token string, expiresAt time.Time, err error)
+	// IssueRefreshToken returns a random token, the hash to persist and its expiry.
+	IssueRefreshToken(ctx context.Context) (token, hash string, expiresAt time.Time, err error)
+	HashRefreshToken(token string) string
+}
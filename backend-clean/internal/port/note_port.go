@@ -3,37 +3,78 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/template"
 )
 
 // NoteInputPort defines note use case inputs.
 type NoteInputPort interface {
 	List(ctx context.Context, filters note.Filters) error
-	Get(ctx context.Context, id string) error
+	// Get returns a note to members of its workspace; an empty actorID skips the workspace check.
+	Get(ctx context.Context, id, actorID string) error
 	Create(ctx context.Context, input NoteCreateInput) error
 	Update(ctx context.Context, input NoteUpdateInput) error
 	ChangeStatus(ctx context.Context, input NoteStatusChangeInput) error
-	Delete(ctx context.Context, id, ownerID string) error
+	Delete(ctx context.Context, input NoteDeleteInput) error
+	ListRevisions(ctx context.Context, noteID, actorID string) error
+	DiffRevisions(ctx context.Context, input NoteRevisionDiffInput) error
+	RestoreRevision(ctx context.Context, input NoteRevisionRestoreInput) error
+	Upgrade(ctx context.Context, input NoteUpgradeInput) error
+	Restore(ctx context.Context, input NoteRestoreInput) error
+	// Duplicate creates a draft copy of a note; content the target template cannot hold is presented as unmapped.
+	Duplicate(ctx context.Context, input NoteDuplicateInput) error
 }
 
 // NoteOutputPort defines note presenters.
 type NoteOutputPort interface {
-	PresentNoteList(ctx context.Context, notes []note.WithMeta) error
+	PresentNoteList(ctx context.Context, notes []note.WithMeta, page pagination.Info) error
 	PresentNote(ctx context.Context, note *note.WithMeta) error
 	PresentNoteDeleted(ctx context.Context) error
+	PresentNoteRevisions(ctx context.Context, revisions []note.Revision) error
+	PresentNoteRevisionDiff(ctx context.Context, diff note.RevisionDiff) error
+	PresentNoteDuplicated(ctx context.Context, note *note.WithMeta, unmapped []note.UnmappedSection) error
 }
 
 // NoteRepository abstracts note persistence.
 type NoteRepository interface {
+	// List returns at most filters.Limit notes after filters.Cursor in (updated_at DESC, id DESC) order.
 	List(ctx context.Context, filters note.Filters) ([]note.WithMeta, error)
 	Get(ctx context.Context, id string) (*note.WithMeta, error)
 	Create(ctx context.Context, n note.Note) (*note.Note, error)
+	// Update writes the title and bumps the version if the stored version still equals n.Version.
+	// It returns ErrVersionConflict when another write got there first.
 	Update(ctx context.Context, n note.Note) (*note.Note, error)
-	UpdateStatus(ctx context.Context, id string, status note.NoteStatus) (*note.Note, error)
-	Delete(ctx context.Context, id string) error
+	// UpdateStatus sets the status and replaces the schedule; conditional on version like Update.
+	UpdateStatus(ctx context.Context, id string, status note.NoteStatus, schedule note.Schedule, version int) (*note.Note, error)
+	// PublishDue publishes scheduled notes whose publish time is at or before now and returns them.
+	// Published notes no longer match, so repeated calls publish each note once.
+	PublishDue(ctx context.Context, now time.Time) ([]note.Note, error)
+	// UnpublishDue moves published notes whose unpublish time is at or before now back to draft and returns them.
+	UnpublishDue(ctx context.Context, now time.Time) ([]note.Note, error)
+	// UpdateSchemaVersion pins the note to another schema version of its template; conditional on version like Update.
+	UpdateSchemaVersion(ctx context.Context, id string, schemaVersion, version int) (*note.Note, error)
+	// Delete moves the note to the trash; conditional on version like Update.
+	// Notes in the trash are left out of List and Get.
+	Delete(ctx context.Context, id string, version int) error
+	// ListTrashed returns the owner's notes in the trash, most recently deleted first.
+	ListTrashed(ctx context.Context, ownerID string) ([]note.WithMeta, error)
+	// GetTrashed returns a note in the trash; notes outside the trash are ErrNotFound.
+	GetTrashed(ctx context.Context, id string) (*note.WithMeta, error)
+	// Restore takes the note out of the trash; conditional on version like Update.
+	Restore(ctx context.Context, id string, version int) (*note.Note, error)
+	// PurgeDeletedBefore permanently deletes notes moved to the trash before the given time.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
+	// ReplaceSections makes sections the full set of the note's sections.
+	// Sections with an ID are updated in place, the rest are created, and sections not listed are removed.
 	ReplaceSections(ctx context.Context, noteID string, sections []note.Section) error
+	// AppendRevision stores rev as the next revision number of its note.
+	AppendRevision(ctx context.Context, rev note.Revision) (*note.Revision, error)
+	// ListRevisions returns all revisions of a note, newest first.
+	ListRevisions(ctx context.Context, noteID string) ([]note.Revision, error)
+	GetRevision(ctx context.Context, noteID string, number int) (*note.Revision, error)
 }
 
 // NoteCreateInput is input for creating notes.
@@ -51,10 +92,12 @@ type SectionInput struct {
 }
 
 // NoteUpdateInput is input for updating notes.
+// Version is the version the client last read (If-Match); 0 skips the check.
 type NoteUpdateInput struct {
 	ID       string
 	Title    string
 	OwnerID  string
+	Version  int
 	Sections []SectionUpdateInput
 }
 
@@ -65,10 +108,60 @@ type SectionUpdateInput struct {
 }
 
 // NoteStatusChangeInput is input for status changes.
+// Schedule carries the publish time for StatusScheduled and an optional unpublish time.
 type NoteStatusChangeInput struct {
+	ID       string
+	OwnerID  string
+	Status   note.NoteStatus
+	Schedule note.Schedule
+	Version  int
+}
+
+// NoteDeleteInput is input for deleting notes.
+type NoteDeleteInput struct {
 	ID      string
 	OwnerID string
-	Status  note.NoteStatus
+	Version int
+}
+
+// NoteRestoreInput is input for taking a note out of the trash.
+// Version is the version listed in the trash (If-Match); 0 skips the check.
+type NoteRestoreInput struct {
+	ID      string
+	OwnerID string
+	Version int
+}
+
+// NoteRevisionDiffInput selects the two revisions to compare.
+type NoteRevisionDiffInput struct {
+	NoteID  string
+	From    int
+	To      int
+	ActorID string
+}
+
+// NoteRevisionRestoreInput is input for restoring a prior revision.
+type NoteRevisionRestoreInput struct {
+	NoteID   string
+	Revision int
+	OwnerID  string
+}
+
+// NoteUpgradeInput is input for moving a note to the latest schema version of its template.
+// Sections optionally set content by field ID of the latest version, e.g. for newly required fields.
+type NoteUpgradeInput struct {
+	ID       string
+	OwnerID  string
+	Version  int
+	Sections []SectionInput
+}
+
+// NoteDuplicateInput is input for copying a note into a new draft.
+// TemplateID is the template of the copy; empty means the template of the source note.
+type NoteDuplicateInput struct {
+	ID         string
+	OwnerID    string
+	TemplateID string
 }
 
 // NoteFilters aliases domain note.Filters
@@ -0,0 +1,59 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteReviewInputPort defines note review workflow use case inputs.
+type NoteReviewInputPort interface {
+	// Get returns the reviewers and review history of a note.
+	Get(ctx context.Context, noteID, actorID string) error
+	AssignReviewers(ctx context.Context, input NoteReviewersInput) error
+	Submit(ctx context.Context, input NoteReviewSubmitInput) error
+	Approve(ctx context.Context, input NoteReviewDecisionInput) error
+	Reject(ctx context.Context, input NoteReviewDecisionInput) error
+}
+
+// NoteReviewOutputPort defines note review presenters.
+type NoteReviewOutputPort interface {
+	PresentReviewState(ctx context.Context, state note.ReviewState) error
+	PresentNote(ctx context.Context, note *note.WithMeta) error
+}
+
+// NoteReviewRepository abstracts persistence of note reviewers and review decisions.
+type NoteReviewRepository interface {
+	// ListReviewers returns the reviewers assigned to a note in assignment order.
+	ListReviewers(ctx context.Context, noteID string) ([]string, error)
+	// ReplaceReviewers makes reviewerIDs the full set of the note's reviewers.
+	ReplaceReviewers(ctx context.Context, noteID string, reviewerIDs []string) error
+	AppendReview(ctx context.Context, r note.Review) (*note.Review, error)
+	// ListReviews returns the review decisions of a note, newest first.
+	ListReviews(ctx context.Context, noteID string) ([]note.Review, error)
+}
+
+// NoteReviewersInput replaces the reviewers of a note.
+type NoteReviewersInput struct {
+	NoteID      string
+	OwnerID     string
+	ReviewerIDs []string
+}
+
+// NoteReviewSubmitInput submits a note to its reviewers.
+// Version is the version the client last read (If-Match); 0 skips the check.
+type NoteReviewSubmitInput struct {
+	NoteID  string
+	OwnerID string
+	Version int
+}
+
+// NoteReviewDecisionInput approves or rejects a note in review.
+// Comment is required when rejecting.
+type NoteReviewDecisionInput struct {
+	NoteID     string
+	ReviewerID string
+	Comment    string
+	Version    int
+}
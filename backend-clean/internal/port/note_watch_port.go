@@ -0,0 +1,36 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteWatchInputPort defines the note change feed use case.
+type NoteWatchInputPort interface {
+	// Watch presents matching note events until ctx is done.
+	Watch(ctx context.Context, input NoteWatchInput) error
+}
+
+// NoteWatchOutputPort delivers note events to a watcher.
+type NoteWatchOutputPort interface {
+	PresentNoteEvent(ctx context.Context, event note.Event) error
+}
+
+// NoteEventSubscriber follows committed note changes.
+type NoteEventSubscriber interface {
+	// Subscribe delivers the events after cursor, then follows new ones until ctx is done.
+	// An empty cursor starts with the next event. The channel is closed early if the feed
+	// cannot be read. It returns ErrInvalidCursor when cursor is malformed.
+	Subscribe(ctx context.Context, cursor string) (<-chan note.Event, error)
+}
+
+// NoteWatchInput selects the events to watch.
+// Only events of workspaces ActorID is a member of are delivered.
+// Cursor is the cursor of the last event the watcher saw; empty starts from now.
+type NoteWatchInput struct {
+	ActorID string
+	Filter  note.EventFilter
+	Cursor  string
+}
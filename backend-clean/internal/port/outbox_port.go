@@ -0,0 +1,55 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+// EventPublisher records domain events in the outbox.
+// Call it with the transaction context of the change so the events commit or roll back with it.
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...outbox.Event) error
+}
+
+// OutboxRepository reads and settles recorded events for the relay.
+type OutboxRepository interface {
+	// ClaimPending leases up to limit undelivered messages due at now, oldest first.
+	// The claim commits on its own: the messages are not due again until leaseUntil,
+	// so they can be delivered and settled without holding a transaction.
+	ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]outbox.Message, error)
+	// MarkDelivered settles a message as delivered.
+	MarkDelivered(ctx context.Context, id string, at time.Time) error
+	// MarkRetry records a failed delivery and schedules the next attempt.
+	MarkRetry(ctx context.Context, id string, attempts int, next time.Time, lastError string) error
+	// MarkFailed records the last failed delivery and gives up on the message.
+	MarkFailed(ctx context.Context, id string, attempts int, at time.Time, lastError string) error
+}
+
+// NoteFeedReader reads the note feed, the note events of the outbox in commit order.
+// Only events of finished transactions are read, so a position once passed never gains earlier events.
+type NoteFeedReader interface {
+	// NoteFeedHead returns the position of the latest readable event; the zero cursor when there is none.
+	NoteFeedHead(ctx context.Context) (outbox.FeedCursor, error)
+	// ListNoteFeed returns up to limit events after the given position, in feed order.
+	ListNoteFeed(ctx context.Context, after outbox.FeedCursor, limit int) ([]outbox.Message, error)
+}
+
+// EventSink delivers an outbox message to its destination.
+// Delivery is at least once, so sinks and their consumers must tolerate duplicates of a message ID.
+type EventSink interface {
+	Deliver(ctx context.Context, msg outbox.Message) error
+}
+
+// OutboxRelayInputPort defines the input port for the outbox relay.
+type OutboxRelayInputPort interface {
+	// Execute delivers one batch of at most batchSize due messages.
+	Execute(ctx context.Context, batchSize int) error
+}
+
+// OutboxRelayOutputPort defines the output port for the outbox relay.
+type OutboxRelayOutputPort interface {
+	PresentResult(ctx context.Context, result outbox.RelayResult) error
+}
@@ -0,0 +1,18 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeJobInputPort defines the input port for the trash purge job.
+type PurgeJobInputPort interface {
+	// Execute purges items that have been in the trash for longer than retention (0 = default).
+	Execute(ctx context.Context, retention time.Duration) error
+}
+
+// PurgeJobOutputPort defines the output port for the trash purge job.
+type PurgeJobOutputPort interface {
+	PresentResult(ctx context.Context, purgedNotes, purgedTemplates int) error
+}
@@ -0,0 +1,15 @@
+// Package port defines application ports (interfaces).
+package port
+
+import "context"
+
+// ScheduledPublishJobInputPort defines the input port for the scheduled publishing job.
+type ScheduledPublishJobInputPort interface {
+	// Execute publishes scheduled notes and unpublishes notes whose unpublish time has passed.
+	Execute(ctx context.Context) error
+}
+
+// ScheduledPublishJobOutputPort defines the output port for the scheduled publishing job.
+type ScheduledPublishJobOutputPort interface {
+	PresentResult(ctx context.Context, published, unpublished int) error
+}
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/session"
+)
+
+// SessionInputPort defines login session use cases.
+type SessionInputPort interface {
+	Login(ctx context.Context, input account.OAuthAccountInput) error
+	Refresh(ctx context.Context, refreshToken string) error
+	Logout(ctx context.Context, refreshToken string) error
+}
+
+// SessionOutputPort defines presenter for sessions.
+type SessionOutputPort interface {
+	PresentSession(ctx context.Context, account *account.Account, tokens *session.Tokens) error
+	PresentLogout(ctx context.Context) error
+}
+
+// SessionRepository abstracts refresh session persistence.
+type SessionRepository interface {
+	Create(ctx context.Context, s session.Session) (*session.Session, error)
+	GetByTokenHash(ctx context.Context, hash string) (*session.Session, error)
+	// Revoke marks a live session revoked. Returns ErrNotFound if it was already revoked.
+	Revoke(ctx context.Context, id string, at time.Time) error
+	RevokeAllByAccount(ctx context.Context, accountID string, at time.Time) error
+}
@@ -0,0 +1,62 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+)
+
+// ShareLinkInputPort defines use case inputs for public read-only links to published notes.
+type ShareLinkInputPort interface {
+	Create(ctx context.Context, input ShareLinkCreateInput) error
+	// List returns the links of a note, revoked and expired ones included, to its owners.
+	List(ctx context.Context, noteID, actorID string) error
+	Revoke(ctx context.Context, input ShareLinkRevokeInput) error
+	// View returns the published note a token links to; no account is needed.
+	View(ctx context.Context, token string) error
+}
+
+// ShareLinkOutputPort defines public link presenters.
+type ShareLinkOutputPort interface {
+	// PresentShareLink presents a created link with its raw token, which is never shown again.
+	PresentShareLink(ctx context.Context, link *sharelink.Link, token string) error
+	PresentShareLinks(ctx context.Context, links []sharelink.Link) error
+	PresentShareLinkRevoked(ctx context.Context) error
+	PresentPublicNote(ctx context.Context, n *note.WithMeta) error
+}
+
+// ShareLinkRepository abstracts persistence of public note links.
+type ShareLinkRepository interface {
+	Create(ctx context.Context, link sharelink.Link) (*sharelink.Link, error)
+	// GetByTokenHash returns a link by the hash of its token, or errors.ErrNotFound.
+	GetByTokenHash(ctx context.Context, hash string) (*sharelink.Link, error)
+	// List returns the links of a note, newest first.
+	List(ctx context.Context, noteID string) ([]sharelink.Link, error)
+	// Revoke marks a live link of a note revoked; errors.ErrNotFound when there is none.
+	Revoke(ctx context.Context, noteID, id string, at time.Time) error
+	// RevokeByNote marks every live link of a note revoked.
+	RevokeByNote(ctx context.Context, noteID string, at time.Time) error
+}
+
+// LinkTokenGenerator mints the opaque tokens of public links.
+type LinkTokenGenerator interface {
+	Generate(ctx context.Context) (string, error)
+}
+
+// ShareLinkCreateInput creates a public link to a published note.
+type ShareLinkCreateInput struct {
+	NoteID string
+	// ExpiresAt is optional; links without it work until revoked or the note is unpublished.
+	ExpiresAt *time.Time
+	ActorID   string
+}
+
+// ShareLinkRevokeInput revokes one link of a note.
+type ShareLinkRevokeInput struct {
+	NoteID  string
+	LinkID  string
+	ActorID string
+}
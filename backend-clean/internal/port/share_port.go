@@ -0,0 +1,60 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/share"
+)
+
+// ShareInputPort defines use case inputs for sharing notes and templates with other accounts.
+type ShareInputPort interface {
+	// List returns the grants on a resource to anyone holding a role on it.
+	List(ctx context.Context, input ShareListInput) error
+	Grant(ctx context.Context, input ShareGrantInput) error
+	Revoke(ctx context.Context, input ShareRevokeInput) error
+}
+
+// ShareOutputPort defines share presenters.
+type ShareOutputPort interface {
+	PresentShares(ctx context.Context, grants []share.Grant) error
+	PresentShare(ctx context.Context, grant *share.Grant) error
+	PresentShareRevoked(ctx context.Context) error
+}
+
+// ShareRepository abstracts persistence of grants on notes and templates.
+type ShareRepository interface {
+	// List returns the grants on a resource, oldest first.
+	List(ctx context.Context, resourceType share.ResourceType, resourceID string) ([]share.Grant, error)
+	// Get returns the grant of accountID on a resource, or errors.ErrNotFound when there is none.
+	Get(ctx context.Context, resourceType share.ResourceType, resourceID, accountID string) (*share.Grant, error)
+	// Upsert creates the grant or changes the role of an existing one.
+	Upsert(ctx context.Context, grant share.Grant) (*share.Grant, error)
+	// Delete removes the grant of accountID on a resource; errors.ErrNotFound when there is none.
+	Delete(ctx context.Context, resourceType share.ResourceType, resourceID, accountID string) error
+}
+
+// ShareListInput lists the grants on a resource.
+type ShareListInput struct {
+	ResourceType share.ResourceType
+	ResourceID   string
+	ActorID      string
+}
+
+// ShareGrantInput gives AccountID a role on a resource, replacing any role it had.
+type ShareGrantInput struct {
+	ResourceType share.ResourceType
+	ResourceID   string
+	AccountID    string
+	Role         share.Role
+	ActorID      string
+}
+
+// ShareRevokeInput removes the grant of AccountID on a resource.
+// Grantees may revoke their own grant to leave a shared resource.
+type ShareRevokeInput struct {
+	ResourceType share.ResourceType
+	ResourceID   string
+	AccountID    string
+	ActorID      string
+}
@@ -3,47 +3,121 @@ package port
 
 import (
 	"context"
+	"time"
 
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/template"
 )
 
 // TemplateInputPort defines template use case inputs.
 type TemplateInputPort interface {
 	List(ctx context.Context, filters template.Filters) error
-	Get(ctx context.Context, id string) error
+	// Get returns a template to members of its workspace; an empty actorID skips the workspace check.
+	Get(ctx context.Context, id, actorID string) error
 	Create(ctx context.Context, input TemplateCreateInput) error
 	Update(ctx context.Context, input TemplateUpdateInput) error
-	Delete(ctx context.Context, id, ownerID string) error
+	Delete(ctx context.Context, input TemplateDeleteInput) error
+	Restore(ctx context.Context, input TemplateRestoreInput) error
+	// Import creates a template from a portable document.
+	Import(ctx context.Context, input TemplateImportInput) error
+	// Clone copies a public template, or one of the actor's workspaces, into a new template the actor owns.
+	Clone(ctx context.Context, input TemplateCloneInput) error
+	// SetVisibility lists the template in the gallery or takes it out.
+	SetVisibility(ctx context.Context, input TemplateVisibilityInput) error
 }
 
 // TemplateOutputPort defines template presenters.
 type TemplateOutputPort interface {
-	PresentTemplateList(ctx context.Context, templates []template.WithUsage) error
+	PresentTemplateList(ctx context.Context, templates []template.WithUsage, page pagination.Info) error
 	PresentTemplate(ctx context.Context, template *template.WithUsage) error
 	PresentTemplateDeleted(ctx context.Context) error
 }
 
 // TemplateRepository abstracts template persistence.
 type TemplateRepository interface {
+	// List returns at most filters.Limit templates after filters.Cursor in (updated_at DESC, id DESC) order.
 	List(ctx context.Context, filters template.Filters) ([]template.WithUsage, error)
 	Get(ctx context.Context, id string) (*template.WithUsage, error)
 	Create(ctx context.Context, tpl template.Template) (*template.Template, error)
+	// Update writes the name and bumps the version if the stored version still equals tpl.Version.
+	// It returns ErrVersionConflict when another write got there first.
 	Update(ctx context.Context, tpl template.Template) (*template.Template, error)
-	Delete(ctx context.Context, id string) error
-	ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error
+	// Delete moves the template to the trash; conditional on version like Update.
+	// Templates in the trash are left out of List and Get.
+	Delete(ctx context.Context, id string, version int) error
+	// ListTrashed returns the owner's templates in the trash, most recently deleted first.
+	ListTrashed(ctx context.Context, ownerID string) ([]template.Template, error)
+	// GetTrashed returns a template in the trash; templates outside the trash are ErrNotFound.
+	GetTrashed(ctx context.Context, id string) (*template.Template, error)
+	// Restore takes the template out of the trash; conditional on version like Update.
+	Restore(ctx context.Context, id string, version int) (*template.Template, error)
+	// SetPublic lists the template in the gallery or takes it out; conditional on version like Update.
+	SetPublic(ctx context.Context, id string, isPublic bool, version int) (*template.Template, error)
+	// PurgeDeletedBefore permanently deletes templates moved to the trash before the given time
+	// that no note, trashed or not, refers to anymore.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
+	// ReplaceFields stores fields as schemaVersion of the template and makes it the latest.
+	// Fields of earlier versions are kept for the notes still pinned to them.
+	ReplaceFields(ctx context.Context, templateID string, schemaVersion int, fields []template.Field) error
+	// ListFields returns the fields of one schema version of a template.
+	ListFields(ctx context.Context, templateID string, schemaVersion int) ([]template.Field, error)
 }
 
 // TemplateCreateInput is input for creating templates.
+// WorkspaceID is where the template is created; empty means the owner's personal workspace.
 type TemplateCreateInput struct {
-	Name    string
-	OwnerID string
-	Fields  []template.Field
+	Name        string
+	OwnerID     string
+	WorkspaceID string
+	Fields      []template.Field
 }
 
 // TemplateUpdateInput is input for updating templates.
+// Version is the version the client last read (If-Match); 0 skips the check.
 type TemplateUpdateInput struct {
 	ID      string
 	Name    string
 	Fields  []template.Field
 	OwnerID string
+	Version int
+}
+
+// TemplateDeleteInput is input for deleting templates.
+type TemplateDeleteInput struct {
+	ID      string
+	OwnerID string
+	Version int
+}
+
+// TemplateRestoreInput is input for taking a template out of the trash.
+// Version is the version listed in the trash (If-Match); 0 skips the check.
+type TemplateRestoreInput struct {
+	ID      string
+	OwnerID string
+	Version int
+}
+
+// TemplateImportInput is input for creating a template from a portable document.
+// WorkspaceID is where the template is created; empty means the owner's personal workspace.
+type TemplateImportInput struct {
+	Document    template.Document
+	OwnerID     string
+	WorkspaceID string
+}
+
+// TemplateCloneInput is input for copying a template into a new one.
+// WorkspaceID is where the copy is created; empty means the owner's personal workspace.
+type TemplateCloneInput struct {
+	ID          string
+	OwnerID     string
+	WorkspaceID string
+}
+
+// TemplateVisibilityInput is input for listing a template in the gallery or taking it out.
+// Version is the version the client last read (If-Match); 0 skips the check.
+type TemplateVisibilityInput struct {
+	ID       string
+	IsPublic bool
+	OwnerID  string
+	Version  int
 }
@@ -0,0 +1,19 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// TrashInputPort defines trash use case inputs.
+type TrashInputPort interface {
+	List(ctx context.Context, ownerID string) error
+}
+
+// TrashOutputPort defines trash presenters.
+type TrashOutputPort interface {
+	PresentTrash(ctx context.Context, notes []note.WithMeta, templates []template.Template) error
+}
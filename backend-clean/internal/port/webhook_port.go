@@ -0,0 +1,107 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+// WebhookInputPort defines webhook subscription use case inputs.
+type WebhookInputPort interface {
+	List(ctx context.Context, ownerID string) error
+	Get(ctx context.Context, id, ownerID string) error
+	Create(ctx context.Context, input WebhookCreateInput) error
+	Update(ctx context.Context, input WebhookUpdateInput) error
+	Delete(ctx context.Context, id, ownerID string) error
+	ListDeliveries(ctx context.Context, webhookID, ownerID string) error
+	Replay(ctx context.Context, input WebhookReplayInput) error
+}
+
+// WebhookOutputPort defines webhook presenters.
+type WebhookOutputPort interface {
+	PresentWebhookList(ctx context.Context, subs []webhook.Subscription) error
+	PresentWebhook(ctx context.Context, sub *webhook.Subscription) error
+	PresentWebhookDeleted(ctx context.Context) error
+	PresentDeliveryList(ctx context.Context, deliveries []webhook.Delivery) error
+	PresentDelivery(ctx context.Context, delivery *webhook.Delivery) error
+}
+
+// WebhookRepository abstracts webhook subscription and delivery persistence.
+type WebhookRepository interface {
+	ListByOwner(ctx context.Context, ownerID string) ([]webhook.Subscription, error)
+	// ListForEvent returns the owner's subscriptions to eventType.
+	ListForEvent(ctx context.Context, ownerID, eventType string) ([]webhook.Subscription, error)
+	Get(ctx context.Context, id string) (*webhook.Subscription, error)
+	Create(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error)
+	Update(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error)
+	// Delete removes the subscription together with its delivery log.
+	Delete(ctx context.Context, id string) error
+	// ListDeliveries returns the latest limit deliveries of a subscription, newest first.
+	ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhook.Delivery, error)
+	GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error)
+	// EnqueueDelivery records a pending delivery. Enqueuing an event a subscription
+	// already has a delivery for is a no-op, so relaying a message twice is harmless.
+	EnqueueDelivery(ctx context.Context, d webhook.Delivery) error
+	// CreateDelivery records a pending delivery unconditionally; it is used for replays.
+	CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error)
+}
+
+// WebhookDeliveryRepository claims and settles pending deliveries for the delivery worker.
+type WebhookDeliveryRepository interface {
+	// ClaimDue leases up to limit pending deliveries due at now, together with their target.
+	// The claim commits on its own: the deliveries are not due again until leaseUntil,
+	// so they can be sent and settled without holding a transaction.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhook.DueDelivery, error)
+	// MarkSucceeded settles a delivery the receiver accepted.
+	MarkSucceeded(ctx context.Context, id string, attempts, responseStatus int, at time.Time) error
+	// MarkRetry records a failed attempt and schedules the next one.
+	MarkRetry(ctx context.Context, id string, attempts, responseStatus int, next time.Time, lastError string) error
+	// MarkFailed records the last failed attempt and gives up on the delivery.
+	MarkFailed(ctx context.Context, id string, attempts, responseStatus int, at time.Time, lastError string) error
+}
+
+// WebhookSender posts signed requests to receivers.
+type WebhookSender interface {
+	// Send posts req and returns the response status, 0 when no response arrived.
+	// Any status outside 2xx is returned together with an error.
+	Send(ctx context.Context, req webhook.Request) (int, error)
+}
+
+// WebhookDeliveryInputPort defines the input port for the webhook delivery worker.
+type WebhookDeliveryInputPort interface {
+	// Execute sends one batch of at most batchSize due deliveries.
+	Execute(ctx context.Context, batchSize int) error
+}
+
+// WebhookDeliveryOutputPort defines the output port for the webhook delivery worker.
+type WebhookDeliveryOutputPort interface {
+	PresentResult(ctx context.Context, result outbox.RelayResult) error
+}
+
+// WebhookCreateInput is input for creating webhook subscriptions.
+type WebhookCreateInput struct {
+	OwnerID    string
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+// WebhookUpdateInput is input for updating webhook subscriptions.
+// A nil Secret keeps the current one.
+type WebhookUpdateInput struct {
+	ID         string
+	OwnerID    string
+	URL        string
+	Secret     *string
+	EventTypes []string
+}
+
+// WebhookReplayInput is input for re-sending a past delivery.
+type WebhookReplayInput struct {
+	WebhookID  string
+	DeliveryID string
+	OwnerID    string
+}
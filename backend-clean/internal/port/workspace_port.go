@@ -0,0 +1,86 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+// WorkspaceInputPort defines use case inputs for workspaces and their members.
+type WorkspaceInputPort interface {
+	// List returns the workspaces actorID is a member of, creating their personal workspace on first use.
+	List(ctx context.Context, actorID string) error
+	Create(ctx context.Context, input WorkspaceCreateInput) error
+	// ListMembers returns the members of a workspace to any of its members.
+	ListMembers(ctx context.Context, workspaceID, actorID string) error
+	Invite(ctx context.Context, input WorkspaceInviteInput) error
+	// ListInvitations returns the pending invitations addressed to actorID's email.
+	ListInvitations(ctx context.Context, actorID string) error
+	Accept(ctx context.Context, input WorkspaceAcceptInput) error
+	RemoveMember(ctx context.Context, input WorkspaceRemoveMemberInput) error
+}
+
+// WorkspaceOutputPort defines workspace presenters.
+type WorkspaceOutputPort interface {
+	PresentWorkspaces(ctx context.Context, workspaces []workspace.WithRole) error
+	PresentWorkspace(ctx context.Context, ws workspace.WithRole) error
+	PresentMembers(ctx context.Context, members []workspace.Member) error
+	PresentInvitation(ctx context.Context, inv *workspace.Invitation) error
+	PresentInvitations(ctx context.Context, invs []workspace.Invitation) error
+	PresentMemberRemoved(ctx context.Context) error
+}
+
+// WorkspaceRepository abstracts persistence of workspaces, memberships and invitations.
+type WorkspaceRepository interface {
+	// ListByMember returns the workspaces of accountID with its role, personal workspace first.
+	ListByMember(ctx context.Context, accountID string) ([]workspace.WithRole, error)
+	Get(ctx context.Context, id string) (*workspace.Workspace, error)
+	// EnsurePersonal returns the personal workspace of ownerID, creating it on first use.
+	EnsurePersonal(ctx context.Context, ownerID string) (*workspace.Workspace, error)
+	// Create inserts a workspace and makes its owner a member with workspace.RoleOwner.
+	Create(ctx context.Context, ws workspace.Workspace) (*workspace.Workspace, error)
+	// GetMember returns the membership of accountID, or errors.ErrNotFound when it is not a member.
+	GetMember(ctx context.Context, workspaceID, accountID string) (*workspace.Member, error)
+	// ListMembers returns the members of a workspace in the order they joined.
+	ListMembers(ctx context.Context, workspaceID string) ([]workspace.Member, error)
+	// AddMember adds a member; an existing member keeps its role.
+	AddMember(ctx context.Context, m workspace.Member) (*workspace.Member, error)
+	// RemoveMember deletes a membership; errors.ErrNotFound when there is none.
+	RemoveMember(ctx context.Context, workspaceID, accountID string) error
+	// UpsertInvitation creates an invitation or renews the pending one for the same email.
+	UpsertInvitation(ctx context.Context, inv workspace.Invitation) (*workspace.Invitation, error)
+	GetInvitation(ctx context.Context, id string) (*workspace.Invitation, error)
+	// ListPendingInvitations returns the unaccepted invitations for email, newest first.
+	ListPendingInvitations(ctx context.Context, email string) ([]workspace.Invitation, error)
+	// AcceptInvitation marks an invitation used; errors.ErrInvitationAccepted when it already was.
+	AcceptInvitation(ctx context.Context, id string) (*workspace.Invitation, error)
+}
+
+// WorkspaceCreateInput creates a shared workspace owned by ActorID.
+type WorkspaceCreateInput struct {
+	Name    string
+	ActorID string
+}
+
+// WorkspaceInviteInput invites Email to a workspace with Role.
+type WorkspaceInviteInput struct {
+	WorkspaceID string
+	Email       string
+	Role        workspace.Role
+	ActorID     string
+}
+
+// WorkspaceAcceptInput accepts an invitation addressed to ActorID's email.
+type WorkspaceAcceptInput struct {
+	InvitationID string
+	ActorID      string
+}
+
+// WorkspaceRemoveMemberInput removes AccountID from a workspace.
+// Members may remove themselves to leave a workspace.
+type WorkspaceRemoveMemberInput struct {
+	WorkspaceID string
+	AccountID   string
+	ActorID     string
+}
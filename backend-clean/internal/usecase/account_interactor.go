@@ -3,42 +3,41 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // AccountInteractor handles account use cases.
 type AccountInteractor struct {
-	repo   port.AccountRepository
-	output port.AccountOutputPort
+	repo      port.AccountRepository
+	tx        port.TxManager
+	publisher port.EventPublisher
+	output    port.AccountOutputPort
 }
 
 var _ port.AccountInputPort = (*AccountInteractor)(nil)
 
 // NewAccountInteractor creates AccountInteractor.
-func NewAccountInteractor(repo port.AccountRepository, output port.AccountOutputPort) *AccountInteractor {
-	return &AccountInteractor{repo: repo, output: output}
+func NewAccountInteractor(repo port.AccountRepository, tx port.TxManager, publisher port.EventPublisher, output port.AccountOutputPort) *AccountInteractor {
+	return &AccountInteractor{repo: repo, tx: tx, publisher: publisher, output: output}
 }
 
 // CreateOrGet handles upsert/get of OAuth account.
 func (u *AccountInteractor) CreateOrGet(ctx context.Context, input account.OAuthAccountInput) error {
-	email, err := account.ParseEmail(input.Email)
-	if err != nil {
-		return err
-	}
-	acc := account.Account{
-		Email:             email,
-		FirstName:         input.FirstName,
-		LastName:          input.LastName,
-		Provider:          input.Provider,
-		ProviderAccountID: input.ProviderAccountID,
-		Thumbnail:         valueOrEmpty(input.Thumbnail),
-	}
-	if err := account.Validate(acc); err != nil {
-		return err
-	}
-	a, err := u.repo.UpsertOAuthAccount(ctx, input)
+	var a *account.Account
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		upserted, err := upsertOAuthAccount(txCtx, u.repo, u.publisher, input)
+		if err != nil {
+			return err
+		}
+		a = upserted
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -69,6 +68,60 @@ func (u *AccountInteractor) GetByEmail(ctx context.Context, email string) error
 	return u.output.PresentAccount(ctx, a)
 }
 
+// upsertOAuthAccount validates the OAuth payload and upserts the account.
+// A deactivated account is refused unless the login explicitly asks to reactivate it.
+// Reactivation is recorded to publisher, so callers run it within a transaction.
+// Shared by CreateOrGet and SessionInteractor.Login.
+func upsertOAuthAccount(ctx context.Context, repo port.AccountRepository, publisher port.EventPublisher, input account.OAuthAccountInput) (*account.Account, error) {
+	email, err := account.ParseEmail(input.Email)
+	if err != nil {
+		return nil, err
+	}
+	acc := account.Account{
+		Email:             email,
+		FirstName:         input.FirstName,
+		LastName:          input.LastName,
+		Provider:          input.Provider,
+		ProviderAccountID: input.ProviderAccountID,
+		Thumbnail:         valueOrEmpty(input.Thumbnail),
+	}
+	if err := account.Validate(acc); err != nil {
+		return nil, err
+	}
+	a, err := repo.UpsertOAuthAccount(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	reactivate, err := account.NeedsReactivation(*a, input.Reactivate)
+	if err != nil {
+		return nil, err
+	}
+	if !reactivate {
+		return a, nil
+	}
+	now := time.Now()
+	reactivated, err := repo.Reactivate(ctx, a.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := publisher.Publish(ctx, outbox.AccountEvent(outbox.AccountReactivated, reactivated.ID, now)); err != nil {
+		return nil, err
+	}
+	return reactivated, nil
+}
+
+// ensureActiveActor rejects requests made on behalf of a missing or deactivated account.
+func ensureActiveActor(ctx context.Context, repo port.AccountRepository, accountID string) error {
+	a, err := repo.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerr.ErrNotFound) {
+			return domainerr.ErrUnauthorized
+		}
+		return err
+	}
+	return account.EnsureActive(*a)
+}
+
 func valueOrEmpty(s *string) string {
 	if s == nil {
 		return ""
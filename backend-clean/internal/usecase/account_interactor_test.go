@@ -9,17 +9,19 @@ import (
 
 	"immortal-architecture-clean/backend/internal/domain/account"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
 )
 
 func TestAccountInteractor_CreateOrGet(t *testing.T) {
 	tests := []struct {
-		name      string
-		input     account.OAuthAccountInput
-		repoAcc   *account.Account
-		repoErr   error
-		wantError error
+		name        string
+		input       account.OAuthAccountInput
+		repoAcc     *account.Account
+		reactivated *account.Account
+		repoErr     error
+		wantError   error
 	}{
 		{
 			name: "[Success] upsert account",
@@ -30,7 +32,7 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 				Provider:          "google",
 				ProviderAccountID: "pid",
 			},
-			repoAcc: &account.Account{ID: "acc-1"},
+			repoAcc: &account.Account{ID: "acc-1", IsActive: true},
 		},
 		{
 			name: "[Success] return existing",
@@ -41,7 +43,30 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 				Provider:          "google",
 				ProviderAccountID: "pid",
 			},
-			repoAcc: &account.Account{ID: "acc-1", FirstName: "Hanako"},
+			repoAcc: &account.Account{ID: "acc-1", FirstName: "Hanako", IsActive: true},
+		},
+		{
+			name: "[Success] reactivate inactive account on request",
+			input: account.OAuthAccountInput{
+				Email:             "user@example.com",
+				FirstName:         "Taro",
+				Provider:          "google",
+				ProviderAccountID: "pid",
+				Reactivate:        true,
+			},
+			repoAcc:     &account.Account{ID: "acc-1", IsActive: false},
+			reactivated: &account.Account{ID: "acc-1", IsActive: true},
+		},
+		{
+			name: "[Fail] inactive account without reactivation",
+			input: account.OAuthAccountInput{
+				Email:             "user@example.com",
+				FirstName:         "Taro",
+				Provider:          "google",
+				ProviderAccountID: "pid",
+			},
+			repoAcc:   &account.Account{ID: "acc-1", IsActive: false},
+			wantError: domainerr.ErrAccountInactive,
 		},
 		{
 			name: "[Fail] invalid email",
@@ -81,17 +106,26 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockAccountRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
 			out := mockusecase.NewMockAccountOutputPort(ctrl)
 
-			shouldCallRepo := tt.wantError == nil || (tt.repoErr != nil && tt.wantError.Error() == tt.repoErr.Error())
+			passThroughTx(tx)
+			shouldCallRepo := tt.repoAcc != nil || tt.repoErr != nil
 			if shouldCallRepo {
 				repo.EXPECT().UpsertOAuthAccount(gomock.Any(), tt.input).Return(tt.repoAcc, tt.repoErr)
 			}
+			presented := tt.repoAcc
+			if tt.reactivated != nil {
+				repo.EXPECT().Reactivate(gomock.Any(), tt.repoAcc.ID, gomock.Any()).Return(tt.reactivated, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.AccountReactivated, tt.repoAcc.ID)).Return(nil)
+				presented = tt.reactivated
+			}
 			if tt.wantError == nil && tt.repoErr == nil {
-				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
+				out.EXPECT().PresentAccount(gomock.Any(), presented).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, tx, publisher, out)
 			err := interactor.CreateOrGet(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -138,7 +172,7 @@ func TestAccountInteractor_GetByID(t *testing.T) {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, nil, nil, out)
 			err := interactor.GetByID(context.Background(), tt.id)
 
 			if tt.wantError == nil && err != nil {
@@ -197,7 +231,7 @@ func TestAccountInteractor_GetByEmail(t *testing.T) {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, nil, nil, out)
 			err := interactor.GetByEmail(context.Background(), tt.email)
 
 			if tt.wantError == nil && err != nil {
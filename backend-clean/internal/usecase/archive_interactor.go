@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchiveInteractor exports all templates and notes of an account and imports them under another.
+type ArchiveInteractor struct {
+	templates  port.TemplateRepository
+	notes      port.NoteRepository
+	accounts   port.AccountRepository
+	workspaces port.WorkspaceRepository
+	tx         port.TxManager
+	publisher  port.EventPublisher
+	output     port.ArchiveOutputPort
+}
+
+var _ port.ArchiveInputPort = (*ArchiveInteractor)(nil)
+
+// NewArchiveInteractor creates ArchiveInteractor.
+// An import is written in one transaction and records a created event for every template and note to publisher.
+func NewArchiveInteractor(templates port.TemplateRepository, notes port.NoteRepository, accounts port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, publisher port.EventPublisher, output port.ArchiveOutputPort) *ArchiveInteractor {
+	return &ArchiveInteractor{
+		templates:  templates,
+		notes:      notes,
+		accounts:   accounts,
+		workspaces: workspaces,
+		tx:         tx,
+		publisher:  publisher,
+		output:     output,
+	}
+}
+
+// Export presents the templates and notes accountID owns in every workspace.
+// Deactivated accounts can still be exported.
+func (u *ArchiveInteractor) Export(ctx context.Context, accountID string) error {
+	if accountID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if _, err := u.accounts.GetByID(ctx, accountID); err != nil {
+		return err
+	}
+	templates, err := u.ownedTemplates(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	notes, err := u.ownedNotes(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	// Notes can use templates of other members of a shared workspace; those templates
+	// are archived too so that every note can be recreated.
+	archived := make(map[string]bool, len(templates))
+	for _, t := range templates {
+		archived[t.ID] = true
+	}
+	for _, n := range notes {
+		if archived[n.TemplateID] {
+			continue
+		}
+		tpl, err := u.templates.Get(ctx, n.TemplateID)
+		if err != nil {
+			return err
+		}
+		archived[n.TemplateID] = true
+		templates = append(templates, tpl.Template)
+	}
+	return u.output.PresentArchive(ctx, &archive.Archive{
+		Version:    archive.Version,
+		AccountID:  accountID,
+		ExportedAt: time.Now(),
+		Templates:  templates,
+		Notes:      notes,
+	})
+}
+
+// Import recreates the templates and notes of the archive in the owner's personal workspace.
+// Everything is validated before anything is written, so a rejected archive leaves no data behind.
+func (u *ArchiveInteractor) Import(ctx context.Context, input port.ArchiveImportInput) error {
+	if input.OwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	a := input.Archive
+	if err := archive.Validate(a); err != nil {
+		return err
+	}
+	archived := make(map[string]template.Template, len(a.Templates))
+	for i, t := range a.Templates {
+		t.OwnerID = input.OwnerID
+		if err := template.ValidateTemplate(t); err != nil {
+			return fmt.Errorf("templates[%d]: %w", i, err)
+		}
+		archived[t.ID] = t
+	}
+	for i, n := range a.Notes {
+		tpl := archived[n.TemplateID]
+		sections := note.FillDefaults(tpl.Fields, n.SectionsFor(tpl.Fields))
+		if err := note.ValidateNoteForCreate(n.Title, tpl, sections); err != nil {
+			return fmt.Errorf("notes[%d]: %w", i, err)
+		}
+	}
+	// ルール: 取り込んだテンプレートとノートは取り込み先アカウントの個人ワークスペースに作られる
+	personal, err := u.workspaces.EnsurePersonal(ctx, input.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		created := make(map[string]*template.Template, len(a.Templates))
+		for _, t := range a.Templates {
+			tpl, err := u.createTemplate(txCtx, t, input.OwnerID, personal.ID)
+			if err != nil {
+				return err
+			}
+			created[t.ID] = tpl
+		}
+		for _, n := range a.Notes {
+			if err := u.createNote(txCtx, n, *created[n.TemplateID], input.OwnerID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentArchiveImported(ctx, len(a.Templates), len(a.Notes))
+}
+
+// createTemplate creates t with new IDs and returns it with its stored fields.
+// Field keys are kept, so archived sections find their new fields by key.
+func (u *ArchiveInteractor) createTemplate(ctx context.Context, t template.Template, ownerID, workspaceID string) (*template.Template, error) {
+	tpl, err := u.templates.Create(ctx, template.Template{Name: t.Name, OwnerID: ownerID, WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]template.Field, len(t.Fields))
+	for i, f := range t.Fields {
+		f.ID = ""
+		fields[i] = f
+	}
+	if len(fields) > 0 {
+		if err := u.templates.ReplaceFields(ctx, tpl.ID, tpl.SchemaVersion, fields); err != nil {
+			return nil, err
+		}
+	}
+	if tpl.Fields, err = u.templates.ListFields(ctx, tpl.ID, tpl.SchemaVersion); err != nil {
+		return nil, err
+	}
+	if err := u.publisher.Publish(ctx, outbox.TemplateEvent(outbox.TemplateCreated, *tpl, time.Now())); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+func (u *ArchiveInteractor) createNote(ctx context.Context, n archive.Note, tpl template.Template, ownerID string) error {
+	sections := note.FillDefaults(tpl.Fields, n.SectionsFor(tpl.Fields))
+	created, err := u.notes.Create(ctx, note.Note{
+		Title:      n.Title,
+		TemplateID: tpl.ID,
+		OwnerID:    ownerID,
+		// ルール: 取り込んだノートは元の状態にかかわらず下書きとして作られる
+		Status:        note.StatusDraft,
+		Sections:      sections,
+		SchemaVersion: tpl.SchemaVersion,
+		WorkspaceID:   tpl.WorkspaceID,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range sections {
+		sections[i].NoteID = created.ID
+	}
+	if err := u.notes.ReplaceSections(ctx, created.ID, sections); err != nil {
+		return err
+	}
+	if _, err := u.notes.AppendRevision(ctx, note.NewRevision(created.ID, ownerID, n.Title, sections)); err != nil {
+		return err
+	}
+	return u.publisher.Publish(ctx, outbox.NoteEvent(outbox.NoteCreated, *created, time.Now()))
+}
+
+// ownedTemplates returns every template ownerID owns with its latest fields.
+func (u *ArchiveInteractor) ownedTemplates(ctx context.Context, ownerID string) ([]template.Template, error) {
+	filters := template.Filters{OwnerID: &ownerID, Limit: pagination.MaxLimit}
+	var out []template.Template
+	for {
+		page, err := u.templates.List(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range page {
+			// List leaves out fields; Get carries them.
+			tpl, err := u.templates.Get(ctx, t.Template.ID)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, tpl.Template)
+		}
+		if len(page) < filters.Limit {
+			return out, nil
+		}
+		cursor := page[len(page)-1].PageCursor()
+		filters.Cursor = &cursor
+	}
+}
+
+// ownedNotes returns every note ownerID owns with its sections.
+func (u *ArchiveInteractor) ownedNotes(ctx context.Context, ownerID string) ([]archive.Note, error) {
+	filters := note.Filters{OwnerID: &ownerID, Limit: pagination.MaxLimit}
+	var out []archive.Note
+	for {
+		page, err := u.notes.List(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range page {
+			// List leaves out sections; Get carries them.
+			full, err := u.notes.Get(ctx, n.Note.ID)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, archive.FromNote(*full))
+		}
+		if len(page) < filters.Limit {
+			return out, nil
+		}
+		cursor := page[len(page)-1].PageCursor()
+		filters.Cursor = &cursor
+	}
+}
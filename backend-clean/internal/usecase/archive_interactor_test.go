@@ -0,0 +1,250 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestArchiveInteractor_Export(t *testing.T) {
+	tests := []struct {
+		name          string
+		accountErr    error
+		templatePages [][]template.WithUsage
+		notes         []note.WithMeta
+		listErr       error
+		wantTemplates []string
+		wantNotes     int
+		wantError     error
+	}{
+		{
+			name:          "[Success] owned templates and notes",
+			templatePages: [][]template.WithUsage{{{Template: template.Template{ID: "tpl-1"}}}},
+			notes:         []note.WithMeta{{Note: note.Note{ID: "note-1", TemplateID: "tpl-1"}}},
+			wantTemplates: []string{"tpl-1"},
+			wantNotes:     1,
+		},
+		{
+			name:          "[Success] template of another member is archived with the note",
+			templatePages: [][]template.WithUsage{{}},
+			notes:         []note.WithMeta{{Note: note.Note{ID: "note-1", TemplateID: "shared-tpl"}}, {Note: note.Note{ID: "note-2", TemplateID: "shared-tpl"}}},
+			wantTemplates: []string{"shared-tpl"},
+			wantNotes:     2,
+		},
+		{
+			name:          "[Success] follows the cursor past a full page",
+			templatePages: [][]template.WithUsage{templatePage(pagination.MaxLimit), {{Template: template.Template{ID: "tpl-last"}}}},
+			wantTemplates: append(templateIDs(pagination.MaxLimit), "tpl-last"),
+		},
+		{name: "[Fail] unknown account", accountErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] list error", listErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockArchiveOutputPort(ctrl)
+
+			accounts.EXPECT().GetByID(gomock.Any(), "owner-1").Return(nil, tt.accountErr)
+			if tt.accountErr == nil && tt.listErr != nil {
+				templates.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, tt.listErr)
+			}
+			for i, page := range tt.templatePages {
+				wantCursor := i > 0
+				templates.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, f template.Filters) ([]template.WithUsage, error) {
+						if *f.OwnerID != "owner-1" || (f.Cursor != nil) != wantCursor {
+							t.Fatalf("filters = %+v", f)
+						}
+						return page, nil
+					},
+				)
+			}
+			if tt.templatePages != nil {
+				notes.EXPECT().List(gomock.Any(), gomock.Any()).Return(tt.notes, nil)
+			}
+			templates.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, id string) (*template.WithUsage, error) {
+					return &template.WithUsage{Template: template.Template{ID: id}}, nil
+				},
+			).AnyTimes()
+			for _, n := range tt.notes {
+				notes.EXPECT().Get(gomock.Any(), n.Note.ID).Return(&n, nil)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentArchive(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, a *archive.Archive) error {
+						if a.Version != archive.Version || a.AccountID != "owner-1" || len(a.Templates) != len(tt.wantTemplates) || len(a.Notes) != tt.wantNotes {
+							t.Fatalf("archive = %+v", a)
+						}
+						for i, id := range tt.wantTemplates {
+							if a.Templates[i].ID != id {
+								t.Fatalf("templates[%d] = %q, want %q", i, a.Templates[i].ID, id)
+							}
+						}
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewArchiveInteractor(templates, notes, accounts, allMembers(ctrl), mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), out)
+			err := interactor.Export(context.Background(), "owner-1")
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || (!errors.Is(err, tt.wantError) && tt.wantError.Error() != err.Error())) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestArchiveInteractor_Import(t *testing.T) {
+	archivedTemplate := template.Template{ID: "old-tpl", Name: "Weekly", Fields: []template.Field{
+		{ID: "old-f-1", Key: "key-1", Label: "Summary", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+		{ID: "old-f-2", Key: "key-2", Label: "Mood", Order: 2, Type: template.FieldTypeText, DefaultContent: "good"},
+	}}
+	archivedNote := archive.Note{ID: "old-note", TemplateID: "old-tpl", Title: "Week 42", Status: note.StatusPublish, Sections: []archive.Section{
+		{ID: "old-s-1", FieldID: "old-f-1", FieldKey: "key-1", Content: "shipped"},
+	}}
+	withNote := func(mutate func(n *archive.Note)) archive.Archive {
+		n := archivedNote
+		mutate(&n)
+		return archive.Archive{Version: archive.Version, Templates: []template.Template{archivedTemplate}, Notes: []archive.Note{n}}
+	}
+
+	tests := []struct {
+		name      string
+		archive   archive.Archive
+		ownerID   string
+		createErr error
+		wantWrite bool
+		wantError error
+	}{
+		{
+			name:      "[Success] recreates templates and notes with new IDs",
+			archive:   withNote(func(*archive.Note) {}),
+			ownerID:   "owner-2",
+			wantWrite: true,
+		},
+		{name: "[Fail] owner missing", archive: withNote(func(*archive.Note) {}), wantError: domainerr.ErrOwnerRequired},
+		{name: "[Fail] other archive version", archive: archive.Archive{Version: 2}, ownerID: "owner-2", wantError: domainerr.ErrArchiveVersionUnsupported},
+		{
+			name:      "[Fail] invalid template",
+			archive:   archive.Archive{Version: archive.Version, Templates: []template.Template{{ID: "old-tpl", Fields: archivedTemplate.Fields}}},
+			ownerID:   "owner-2",
+			wantError: domainerr.ErrTemplateNameRequired,
+		},
+		{
+			name:      "[Fail] note without title",
+			archive:   withNote(func(n *archive.Note) { n.Title = "" }),
+			ownerID:   "owner-2",
+			wantError: domainerr.ErrTitleRequired,
+		},
+		{
+			name:      "[Fail] required section missing",
+			archive:   withNote(func(n *archive.Note) { n.Sections = nil }),
+			ownerID:   "owner-2",
+			wantError: domainerr.ErrRequiredFieldEmpty,
+		},
+		{
+			name:      "[Fail] create error",
+			archive:   withNote(func(*archive.Note) {}),
+			ownerID:   "owner-2",
+			createErr: errors.New("db err"),
+			wantWrite: true,
+			wantError: errors.New("db err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockArchiveOutputPort(ctrl)
+
+			// Strict mocks: nothing is written unless the whole archive is valid.
+			if tt.wantWrite {
+				passThroughTx(tx)
+				templates.EXPECT().Create(gomock.Any(), template.Template{Name: "Weekly", OwnerID: "owner-2", WorkspaceID: "ws-owner-2"}).
+					Return(&template.Template{ID: "new-tpl", Name: "Weekly", OwnerID: "owner-2", WorkspaceID: "ws-owner-2", SchemaVersion: 1}, tt.createErr)
+			}
+			if tt.wantWrite && tt.createErr == nil {
+				templates.EXPECT().ReplaceFields(gomock.Any(), "new-tpl", 1, []template.Field{
+					{Key: "key-1", Label: "Summary", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+					{Key: "key-2", Label: "Mood", Order: 2, Type: template.FieldTypeText, DefaultContent: "good"},
+				}).Return(nil)
+				templates.EXPECT().ListFields(gomock.Any(), "new-tpl", 1).Return([]template.Field{
+					{ID: "new-f-1", Key: "key-1", Label: "Summary", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+					{ID: "new-f-2", Key: "key-2", Label: "Mood", Order: 2, Type: template.FieldTypeText, DefaultContent: "good"},
+				}, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.TemplateCreated, "new-tpl")).Return(nil)
+				sections := []note.Section{{FieldID: "new-f-1", Content: "shipped"}, {FieldID: "new-f-2", Content: "good"}}
+				notes.EXPECT().Create(gomock.Any(), note.Note{
+					Title:         "Week 42",
+					TemplateID:    "new-tpl",
+					OwnerID:       "owner-2",
+					Status:        note.StatusDraft,
+					Sections:      sections,
+					SchemaVersion: 1,
+					WorkspaceID:   "ws-owner-2",
+				}).Return(&note.Note{ID: "new-note", TemplateID: "new-tpl", OwnerID: "owner-2", Status: note.StatusDraft}, nil)
+				notes.EXPECT().ReplaceSections(gomock.Any(), "new-note", []note.Section{
+					{NoteID: "new-note", FieldID: "new-f-1", Content: "shipped"},
+					{NoteID: "new-note", FieldID: "new-f-2", Content: "good"},
+				}).Return(nil)
+				notes.EXPECT().AppendRevision(gomock.Any(), gomock.Any()).Return(&note.Revision{}, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.NoteCreated, "new-note")).Return(nil)
+				out.EXPECT().PresentArchiveImported(gomock.Any(), 1, 1).Return(nil)
+			}
+
+			interactor := uc.NewArchiveInteractor(templates, notes, activeAccounts(ctrl), allMembers(ctrl), tx, publisher, out)
+			err := interactor.Import(context.Background(), port.ArchiveImportInput{Archive: tt.archive, OwnerID: tt.ownerID})
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || (!errors.Is(err, tt.wantError) && tt.wantError.Error() != err.Error())) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func templatePage(n int) []template.WithUsage {
+	page := make([]template.WithUsage, 0, n)
+	for _, id := range templateIDs(n) {
+		page = append(page, template.WithUsage{Template: template.Template{ID: id}})
+	}
+	return page
+}
+
+func templateIDs(n int) []string {
+	ids := make([]string, 0, n)
+	for i := range n {
+		ids = append(ids, fmt.Sprintf("tpl-%d", i))
+	}
+	return ids
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"time"
 
+	"immortal-architecture-clean/backend/internal/domain/outbox"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -12,24 +13,45 @@ const defaultInactiveDays = 90
 
 // DeactivateInteractor handles the deactivation of inactive users.
 type DeactivateInteractor struct {
-	repo   port.AccountRepository
-	output port.DeactivateJobOutputPort
+	repo      port.AccountRepository
+	tx        port.TxManager
+	publisher port.EventPublisher
+	output    port.DeactivateJobOutputPort
 }
 
 var _ port.DeactivateJobInputPort = (*DeactivateInteractor)(nil)
 
 // NewDeactivateInteractor creates a new DeactivateInteractor.
-func NewDeactivateInteractor(repo port.AccountRepository, output port.DeactivateJobOutputPort) *DeactivateInteractor {
+func NewDeactivateInteractor(repo port.AccountRepository, tx port.TxManager, publisher port.EventPublisher, output port.DeactivateJobOutputPort) *DeactivateInteractor {
 	return &DeactivateInteractor{
-		repo:   repo,
-		output: output,
+		repo:      repo,
+		tx:        tx,
+		publisher: publisher,
+		output:    output,
 	}
 }
 
 // Execute deactivates users who haven't logged in for more than 90 days.
+// Each deactivated account is recorded to the outbox in the same transaction.
 func (u *DeactivateInteractor) Execute(ctx context.Context) error {
-	before := time.Now().AddDate(0, 0, -defaultInactiveDays)
-	count, err := u.repo.DeactivateByLastLoginBefore(ctx, before)
+	now := time.Now()
+	before := now.AddDate(0, 0, -defaultInactiveDays)
+	var count int
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		ids, err := u.repo.DeactivateByLastLoginBefore(txCtx, before)
+		if err != nil {
+			return err
+		}
+		events := make([]outbox.Event, 0, len(ids))
+		for _, id := range ids {
+			events = append(events, outbox.AccountEvent(outbox.AccountDeactivated, id, now))
+		}
+		if err := u.publisher.Publish(txCtx, events...); err != nil {
+			return err
+		}
+		count = len(ids)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
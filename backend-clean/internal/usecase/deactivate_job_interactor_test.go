@@ -3,12 +3,14 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"immortal-architecture-clean/backend/internal/domain/outbox"
 	"immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
 )
@@ -18,7 +20,7 @@ func TestDeactivateInteractor_Execute(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		mockReturn   int
+		mockReturn   []string
 		mockErr      error
 		presenterErr error
 		wantCount    int
@@ -26,35 +28,35 @@ func TestDeactivateInteractor_Execute(t *testing.T) {
 	}{
 		{
 			name:       "正常系: 10件更新",
-			mockReturn: 10,
+			mockReturn: accountIDs(10),
 			mockErr:    nil,
 			wantCount:  10,
 			wantErr:    false,
 		},
 		{
 			name:       "正常系: 0件更新（対象なし）",
-			mockReturn: 0,
+			mockReturn: nil,
 			mockErr:    nil,
 			wantCount:  0,
 			wantErr:    false,
 		},
 		{
 			name:       "正常系: 大量更新（1000件）",
-			mockReturn: 1000,
+			mockReturn: accountIDs(1000),
 			mockErr:    nil,
 			wantCount:  1000,
 			wantErr:    false,
 		},
 		{
 			name:       "異常系: DBエラー",
-			mockReturn: 0,
+			mockReturn: nil,
 			mockErr:    errors.New("db connection error"),
 			wantCount:  0,
 			wantErr:    true,
 		},
 		{
 			name:         "異常系: Presenterエラー",
-			mockReturn:   5,
+			mockReturn:   accountIDs(5),
 			mockErr:      nil,
 			presenterErr: errors.New("presenter error"),
 			wantCount:    0,
@@ -74,7 +76,9 @@ func TestDeactivateInteractor_Execute(t *testing.T) {
 				Err: tt.presenterErr,
 			}
 
-			interactor := usecase.NewDeactivateInteractor(mockRepo, mockOutput)
+			mockPublisher := &mockusecase.SimpleEventPublisher{}
+
+			interactor := usecase.NewDeactivateInteractor(mockRepo, mockusecase.SimpleTxManager{}, mockPublisher, mockOutput)
 			err := interactor.Execute(context.Background())
 
 			// エラーの検証
@@ -87,9 +91,39 @@ func TestDeactivateInteractor_Execute(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, tt.wantCount, mockOutput.UpdatedCount)
 
+			// 無効化したアカウントごとにイベントが記録されたか検証
+			require.Len(t, mockPublisher.Events, tt.wantCount)
+			for i, e := range mockPublisher.Events {
+				assert.Equal(t, outbox.AccountDeactivated, e.Type)
+				assert.Equal(t, tt.mockReturn[i], e.AggregateID)
+			}
+
 			// 90日前の日時で呼び出されたか検証（1秒の誤差を許容）
 			expectedBefore := time.Now().AddDate(0, 0, -90)
 			assert.WithinDuration(t, expectedBefore, mockRepo.DeactivateCalledAt, time.Second)
 		})
 	}
 }
+
+func TestDeactivateInteractor_Execute_PublishError(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := &mockusecase.SimpleAccountRepository{DeactivateReturn: accountIDs(2)}
+	mockOutput := &mockusecase.MockDeactivateJobOutputPort{}
+	mockPublisher := &mockusecase.SimpleEventPublisher{Err: errors.New("outbox error")}
+
+	interactor := usecase.NewDeactivateInteractor(mockRepo, mockusecase.SimpleTxManager{}, mockPublisher, mockOutput)
+	err := interactor.Execute(context.Background())
+
+	// イベントを記録できなければ無効化ごと失敗する
+	require.Error(t, err)
+	assert.Equal(t, 0, mockOutput.UpdatedCount)
+}
+
+func accountIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("acc-%d", i)
+	}
+	return ids
+}
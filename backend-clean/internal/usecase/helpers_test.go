@@ -1,5 +1,18 @@
 package usecase_test
 
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
 // b2i converts bool to int for gomock Times().
 func b2i(b bool) int {
 	if b {
@@ -10,3 +23,83 @@ func b2i(b bool) int {
 
 // strPtr helper for optional string pointers.
 func strPtr(s string) *string { return &s }
+
+// txCtxKey marks the context a test transaction hands to its callback, so expectations can require it.
+type txCtxKey struct{}
+
+// activeAccounts returns an account repository whose accounts are all active.
+func activeAccounts(ctrl *gomock.Controller) *mockusecase.MockAccountRepository {
+	repo := mockusecase.NewMockAccountRepository(ctrl)
+	repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&account.Account{ID: "owner", IsActive: true}, nil).AnyTimes()
+	return repo
+}
+
+// noShares returns a share repository in which nothing is shared with anyone.
+func noShares(ctrl *gomock.Controller) *mockusecase.MockShareRepository {
+	repo := mockusecase.NewMockShareRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domainerr.ErrNotFound).AnyTimes()
+	return repo
+}
+
+// allMembers returns a workspace repository in which every account is a member of every workspace.
+func allMembers(ctrl *gomock.Controller) *mockusecase.MockWorkspaceRepository {
+	repo := mockusecase.NewMockWorkspaceRepository(ctrl)
+	repo.EXPECT().GetMember(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, workspaceID, accountID string) (*workspace.Member, error) {
+			return &workspace.Member{WorkspaceID: workspaceID, AccountID: accountID, Role: workspace.RoleMember}, nil
+		},
+	).AnyTimes()
+	repo.EXPECT().EnsurePersonal(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, ownerID string) (*workspace.Workspace, error) {
+			return &workspace.Workspace{ID: "ws-" + ownerID, OwnerID: ownerID, Personal: true}, nil
+		},
+	).AnyTimes()
+	return repo
+}
+
+// anyLinks returns a public link repository that accepts every revocation.
+func anyLinks(ctrl *gomock.Controller) *mockusecase.MockShareLinkRepository {
+	repo := mockusecase.NewMockShareLinkRepository(ctrl)
+	repo.EXPECT().RevokeByNote(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return repo
+}
+
+// violation builds the validation error for a single broken rule.
+func violation(path, code string, err error) error {
+	return &domainerr.ValidationError{Violations: []domainerr.Violation{{Path: path, Code: code, Err: err}}}
+}
+
+// passThroughTx expects one transaction and runs its body.
+func passThroughTx(tx *mockusecase.MockTxManager) {
+	tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		},
+	)
+}
+
+// anyOutbox returns an outbox publisher that accepts every event.
+func anyOutbox(ctrl *gomock.Controller) *mockusecase.MockEventPublisher {
+	publisher := mockusecase.NewMockEventPublisher(ctrl)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return publisher
+}
+
+// outboxOf matches an outbox event by type and aggregate, ignoring its payload.
+func outboxOf(eventType, aggregateID string) gomock.Matcher {
+	return outboxMatcher{eventType: eventType, aggregateID: aggregateID}
+}
+
+type outboxMatcher struct {
+	eventType   string
+	aggregateID string
+}
+
+func (m outboxMatcher) Matches(x any) bool {
+	e, ok := x.(outbox.Event)
+	return ok && e.Type == m.eventType && e.AggregateID == m.aggregateID
+}
+
+func (m outboxMatcher) String() string {
+	return fmt.Sprintf("%s outbox event of %s", m.eventType, m.aggregateID)
+}
@@ -72,10 +72,23 @@ func (mr *MockAccountRepositoryMockRecorder) GetByEmail(ctx, email any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockAccountRepository)(nil).GetByEmail), ctx, email)
 }
 
-func (m *MockAccountRepository) DeactivateByLastLoginBefore(ctx context.Context, before time.Time) (int, error) {
+func (m *MockAccountRepository) Reactivate(ctx context.Context, id string, at time.Time) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", ctx, id, at)
+	res0, _ := ret[0].(*account.Account)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockAccountRepositoryMockRecorder) Reactivate(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockAccountRepository)(nil).Reactivate), ctx, id, at)
+}
+
+func (m *MockAccountRepository) DeactivateByLastLoginBefore(ctx context.Context, before time.Time) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeactivateByLastLoginBefore", ctx, before)
-	res0, _ := ret[0].(int)
+	res0, _ := ret[0].([]string)
 	res1, _ := ret[1].(error)
 	return res0, res1
 }
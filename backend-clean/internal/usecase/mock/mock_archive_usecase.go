@@ -0,0 +1,105 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockArchiveInputPort is a mock of port.ArchiveInputPort.
+type MockArchiveInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveInputPortMockRecorder
+}
+
+// MockArchiveInputPortMockRecorder records invocations.
+type MockArchiveInputPortMockRecorder struct {
+	mock *MockArchiveInputPort
+}
+
+// NewMockArchiveInputPort creates a new mock.
+func NewMockArchiveInputPort(ctrl *gomock.Controller) *MockArchiveInputPort {
+	mock := &MockArchiveInputPort{ctrl: ctrl}
+	mock.recorder = &MockArchiveInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockArchiveInputPort) EXPECT() *MockArchiveInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockArchiveInputPort) Export(ctx context.Context, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, accountID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockArchiveInputPortMockRecorder) Export(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockArchiveInputPort)(nil).Export), ctx, accountID)
+}
+
+func (m *MockArchiveInputPort) Import(ctx context.Context, input port.ArchiveImportInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockArchiveInputPortMockRecorder) Import(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockArchiveInputPort)(nil).Import), ctx, input)
+}
+
+// MockArchiveOutputPort is a mock of port.ArchiveOutputPort.
+type MockArchiveOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveOutputPortMockRecorder
+}
+
+// MockArchiveOutputPortMockRecorder records invocations.
+type MockArchiveOutputPortMockRecorder struct {
+	mock *MockArchiveOutputPort
+}
+
+// NewMockArchiveOutputPort creates a new mock.
+func NewMockArchiveOutputPort(ctrl *gomock.Controller) *MockArchiveOutputPort {
+	mock := &MockArchiveOutputPort{ctrl: ctrl}
+	mock.recorder = &MockArchiveOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockArchiveOutputPort) EXPECT() *MockArchiveOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockArchiveOutputPort) PresentArchive(ctx context.Context, a *archive.Archive) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentArchive", ctx, a)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockArchiveOutputPortMockRecorder) PresentArchive(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentArchive", reflect.TypeOf((*MockArchiveOutputPort)(nil).PresentArchive), ctx, a)
+}
+
+func (m *MockArchiveOutputPort) PresentArchiveImported(ctx context.Context, templates int, notes int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentArchiveImported", ctx, templates, notes)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockArchiveOutputPortMockRecorder) PresentArchiveImported(ctx, templates, notes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentArchiveImported", reflect.TypeOf((*MockArchiveOutputPort)(nil).PresentArchiveImported), ctx, templates, notes)
+}
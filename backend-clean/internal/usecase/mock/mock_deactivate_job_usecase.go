@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
 )
 
 // MockDeactivateJobOutputPort is a mock of port.DeactivateJobOutputPort.
@@ -21,7 +22,7 @@ func (m *MockDeactivateJobOutputPort) PresentResult(_ context.Context, updatedCo
 
 // SimpleAccountRepository is a simple mock for job testing (without gomock).
 type SimpleAccountRepository struct {
-	DeactivateReturn   int
+	DeactivateReturn   []string
 	DeactivateErr      error
 	DeactivateCalledAt time.Time
 }
@@ -41,8 +42,64 @@ func (m *SimpleAccountRepository) GetByEmail(_ context.Context, _ string) (*acco
 	return nil, nil
 }
 
+// Reactivate is not used in job tests.
+func (m *SimpleAccountRepository) Reactivate(_ context.Context, _ string, _ time.Time) (*account.Account, error) {
+	return nil, nil
+}
+
 // DeactivateByLastLoginBefore mocks the deactivation of inactive users.
-func (m *SimpleAccountRepository) DeactivateByLastLoginBefore(_ context.Context, before time.Time) (int, error) {
+func (m *SimpleAccountRepository) DeactivateByLastLoginBefore(_ context.Context, before time.Time) ([]string, error) {
 	m.DeactivateCalledAt = before
 	return m.DeactivateReturn, m.DeactivateErr
 }
+
+// SimpleTxManager is a simple mock of port.TxManager that runs fn directly.
+type SimpleTxManager struct{}
+
+// WithinTransaction runs fn with ctx.
+func (SimpleTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// SimpleEventPublisher is a simple mock of port.EventPublisher recording published events.
+type SimpleEventPublisher struct {
+	Events []outbox.Event
+	Err    error
+}
+
+// Publish records the events.
+func (m *SimpleEventPublisher) Publish(_ context.Context, events ...outbox.Event) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Events = append(m.Events, events...)
+	return nil
+}
+
+// MockPurgeJobOutputPort is a mock of port.PurgeJobOutputPort.
+type MockPurgeJobOutputPort struct {
+	PurgedNotes     int
+	PurgedTemplates int
+	Err             error
+}
+
+// PresentResult stores the result.
+func (m *MockPurgeJobOutputPort) PresentResult(_ context.Context, purgedNotes, purgedTemplates int) error {
+	m.PurgedNotes = purgedNotes
+	m.PurgedTemplates = purgedTemplates
+	return m.Err
+}
+
+// MockScheduledPublishJobOutputPort is a mock of port.ScheduledPublishJobOutputPort.
+type MockScheduledPublishJobOutputPort struct {
+	Published   int
+	Unpublished int
+	Err         error
+}
+
+// PresentResult stores the result.
+func (m *MockScheduledPublishJobOutputPort) PresentResult(_ context.Context, published, unpublished int) error {
+	m.Published = published
+	m.Unpublished = unpublished
+	return m.Err
+}
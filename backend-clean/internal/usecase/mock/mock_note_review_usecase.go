@@ -0,0 +1,215 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockNoteReviewInputPort is a mock of port.NoteReviewInputPort.
+type MockNoteReviewInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteReviewInputPortMockRecorder
+}
+
+// MockNoteReviewInputPortMockRecorder records invocations.
+type MockNoteReviewInputPortMockRecorder struct {
+	mock *MockNoteReviewInputPort
+}
+
+// NewMockNoteReviewInputPort creates a new mock.
+func NewMockNoteReviewInputPort(ctrl *gomock.Controller) *MockNoteReviewInputPort {
+	mock := &MockNoteReviewInputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteReviewInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteReviewInputPort) EXPECT() *MockNoteReviewInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteReviewInputPort) Get(ctx context.Context, noteID string, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, noteID, actorID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewInputPortMockRecorder) Get(ctx, noteID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNoteReviewInputPort)(nil).Get), ctx, noteID, actorID)
+}
+
+func (m *MockNoteReviewInputPort) AssignReviewers(ctx context.Context, input port.NoteReviewersInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignReviewers", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewInputPortMockRecorder) AssignReviewers(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignReviewers", reflect.TypeOf((*MockNoteReviewInputPort)(nil).AssignReviewers), ctx, input)
+}
+
+func (m *MockNoteReviewInputPort) Submit(ctx context.Context, input port.NoteReviewSubmitInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewInputPortMockRecorder) Submit(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockNoteReviewInputPort)(nil).Submit), ctx, input)
+}
+
+func (m *MockNoteReviewInputPort) Approve(ctx context.Context, input port.NoteReviewDecisionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewInputPortMockRecorder) Approve(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockNoteReviewInputPort)(nil).Approve), ctx, input)
+}
+
+func (m *MockNoteReviewInputPort) Reject(ctx context.Context, input port.NoteReviewDecisionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewInputPortMockRecorder) Reject(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockNoteReviewInputPort)(nil).Reject), ctx, input)
+}
+
+// MockNoteReviewOutputPort is a mock of port.NoteReviewOutputPort.
+type MockNoteReviewOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteReviewOutputPortMockRecorder
+}
+
+// MockNoteReviewOutputPortMockRecorder records invocations.
+type MockNoteReviewOutputPortMockRecorder struct {
+	mock *MockNoteReviewOutputPort
+}
+
+// NewMockNoteReviewOutputPort creates a new mock.
+func NewMockNoteReviewOutputPort(ctrl *gomock.Controller) *MockNoteReviewOutputPort {
+	mock := &MockNoteReviewOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteReviewOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteReviewOutputPort) EXPECT() *MockNoteReviewOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteReviewOutputPort) PresentReviewState(ctx context.Context, state note.ReviewState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentReviewState", ctx, state)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewOutputPortMockRecorder) PresentReviewState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentReviewState", reflect.TypeOf((*MockNoteReviewOutputPort)(nil).PresentReviewState), ctx, state)
+}
+
+func (m *MockNoteReviewOutputPort) PresentNote(ctx context.Context, n *note.WithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNote", ctx, n)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewOutputPortMockRecorder) PresentNote(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNote", reflect.TypeOf((*MockNoteReviewOutputPort)(nil).PresentNote), ctx, n)
+}
+
+// MockNoteReviewRepository is a mock of port.NoteReviewRepository.
+type MockNoteReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteReviewRepositoryMockRecorder
+}
+
+// MockNoteReviewRepositoryMockRecorder records invocations.
+type MockNoteReviewRepositoryMockRecorder struct {
+	mock *MockNoteReviewRepository
+}
+
+// NewMockNoteReviewRepository creates a new mock.
+func NewMockNoteReviewRepository(ctrl *gomock.Controller) *MockNoteReviewRepository {
+	mock := &MockNoteReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockNoteReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteReviewRepository) EXPECT() *MockNoteReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteReviewRepository) ListReviewers(ctx context.Context, noteID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewers", ctx, noteID)
+	res0, _ := ret[0].([]string)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteReviewRepositoryMockRecorder) ListReviewers(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewers", reflect.TypeOf((*MockNoteReviewRepository)(nil).ListReviewers), ctx, noteID)
+}
+
+func (m *MockNoteReviewRepository) ReplaceReviewers(ctx context.Context, noteID string, reviewerIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceReviewers", ctx, noteID, reviewerIDs)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteReviewRepositoryMockRecorder) ReplaceReviewers(ctx, noteID, reviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReviewers", reflect.TypeOf((*MockNoteReviewRepository)(nil).ReplaceReviewers), ctx, noteID, reviewerIDs)
+}
+
+func (m *MockNoteReviewRepository) AppendReview(ctx context.Context, r note.Review) (*note.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendReview", ctx, r)
+	res0, _ := ret[0].(*note.Review)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteReviewRepositoryMockRecorder) AppendReview(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendReview", reflect.TypeOf((*MockNoteReviewRepository)(nil).AppendReview), ctx, r)
+}
+
+func (m *MockNoteReviewRepository) ListReviews(ctx context.Context, noteID string) ([]note.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviews", ctx, noteID)
+	res0, _ := ret[0].([]note.Review)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteReviewRepositoryMockRecorder) ListReviews(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockNoteReviewRepository)(nil).ListReviews), ctx, noteID)
+}
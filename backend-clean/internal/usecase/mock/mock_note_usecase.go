@@ -3,10 +3,12 @@ package mockusecase
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 )
 
 // MockNoteRepository is a mock of port.NoteRepository.
@@ -84,29 +86,120 @@ func (mr *MockNoteRepositoryMockRecorder) Update(ctx, n any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNoteRepository)(nil).Update), ctx, n)
 }
 
-func (m *MockNoteRepository) UpdateStatus(ctx context.Context, id string, status note.NoteStatus) (*note.Note, error) {
+func (m *MockNoteRepository) UpdateStatus(ctx context.Context, id string, status note.NoteStatus, schedule note.Schedule, version int) (*note.Note, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status, schedule, version)
 	res0, _ := ret[0].(*note.Note)
 	res1, _ := ret[1].(error)
 	return res0, res1
 }
 
-func (mr *MockNoteRepositoryMockRecorder) UpdateStatus(ctx, id, status any) *gomock.Call {
+func (mr *MockNoteRepositoryMockRecorder) UpdateStatus(ctx, id, status, schedule, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockNoteRepository)(nil).UpdateStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockNoteRepository)(nil).UpdateStatus), ctx, id, status, schedule, version)
 }
 
-func (m *MockNoteRepository) Delete(ctx context.Context, id string) error {
+func (m *MockNoteRepository) PublishDue(ctx context.Context, now time.Time) ([]note.Note, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "PublishDue", ctx, now)
+	res0, _ := ret[0].([]note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) PublishDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockNoteRepository)(nil).PublishDue), ctx, now)
+}
+
+func (m *MockNoteRepository) UnpublishDue(ctx context.Context, now time.Time) ([]note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishDue", ctx, now)
+	res0, _ := ret[0].([]note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) UnpublishDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishDue", reflect.TypeOf((*MockNoteRepository)(nil).UnpublishDue), ctx, now)
+}
+
+func (m *MockNoteRepository) UpdateSchemaVersion(ctx context.Context, id string, schemaVersion, version int) (*note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchemaVersion", ctx, id, schemaVersion, version)
+	res0, _ := ret[0].(*note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) UpdateSchemaVersion(ctx, id, schemaVersion, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchemaVersion", reflect.TypeOf((*MockNoteRepository)(nil).UpdateSchemaVersion), ctx, id, schemaVersion, version)
+}
+
+func (m *MockNoteRepository) Delete(ctx context.Context, id string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	res0, _ := ret[0].(error)
 	return res0
 }
 
-func (mr *MockNoteRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockNoteRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNoteRepository)(nil).Delete), ctx, id, version)
+}
+
+func (m *MockNoteRepository) ListTrashed(ctx context.Context, ownerID string) ([]note.WithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashed", ctx, ownerID)
+	res0, _ := ret[0].([]note.WithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) ListTrashed(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashed", reflect.TypeOf((*MockNoteRepository)(nil).ListTrashed), ctx, ownerID)
+}
+
+func (m *MockNoteRepository) GetTrashed(ctx context.Context, id string) (*note.WithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashed", ctx, id)
+	res0, _ := ret[0].(*note.WithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) GetTrashed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashed", reflect.TypeOf((*MockNoteRepository)(nil).GetTrashed), ctx, id)
+}
+
+func (m *MockNoteRepository) Restore(ctx context.Context, id string, version int) (*note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, version)
+	res0, _ := ret[0].(*note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) Restore(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockNoteRepository)(nil).Restore), ctx, id, version)
+}
+
+func (m *MockNoteRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	res0, _ := ret[0].(int)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) PurgeDeletedBefore(ctx, before any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNoteRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockNoteRepository)(nil).PurgeDeletedBefore), ctx, before)
 }
 
 func (m *MockNoteRepository) ReplaceSections(ctx context.Context, noteID string, sections []note.Section) error {
@@ -121,6 +214,45 @@ func (mr *MockNoteRepositoryMockRecorder) ReplaceSections(ctx, noteID, sections
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSections", reflect.TypeOf((*MockNoteRepository)(nil).ReplaceSections), ctx, noteID, sections)
 }
 
+func (m *MockNoteRepository) AppendRevision(ctx context.Context, rev note.Revision) (*note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendRevision", ctx, rev)
+	res0, _ := ret[0].(*note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) AppendRevision(ctx, rev any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendRevision", reflect.TypeOf((*MockNoteRepository)(nil).AppendRevision), ctx, rev)
+}
+
+func (m *MockNoteRepository) ListRevisions(ctx context.Context, noteID string) ([]note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, noteID)
+	res0, _ := ret[0].([]note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) ListRevisions(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockNoteRepository)(nil).ListRevisions), ctx, noteID)
+}
+
+func (m *MockNoteRepository) GetRevision(ctx context.Context, noteID string, number int) (*note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, noteID, number)
+	res0, _ := ret[0].(*note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) GetRevision(ctx, noteID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockNoteRepository)(nil).GetRevision), ctx, noteID, number)
+}
+
 // MockNoteOutputPort is a mock of port.NoteOutputPort.
 type MockNoteOutputPort struct {
 	ctrl     *gomock.Controller
@@ -144,16 +276,16 @@ func (m *MockNoteOutputPort) EXPECT() *MockNoteOutputPortMockRecorder {
 	return m.recorder
 }
 
-func (m *MockNoteOutputPort) PresentNoteList(ctx context.Context, notes []note.WithMeta) error {
+func (m *MockNoteOutputPort) PresentNoteList(ctx context.Context, notes []note.WithMeta, page pagination.Info) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PresentNoteList", ctx, notes)
+	ret := m.ctrl.Call(m, "PresentNoteList", ctx, notes, page)
 	res0, _ := ret[0].(error)
 	return res0
 }
 
-func (mr *MockNoteOutputPortMockRecorder) PresentNoteList(ctx, notes any) *gomock.Call {
+func (mr *MockNoteOutputPortMockRecorder) PresentNoteList(ctx, notes, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteList", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteList), ctx, notes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteList", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteList), ctx, notes, page)
 }
 
 func (m *MockNoteOutputPort) PresentNote(ctx context.Context, n *note.WithMeta) error {
@@ -179,3 +311,39 @@ func (mr *MockNoteOutputPortMockRecorder) PresentNoteDeleted(ctx any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteDeleted", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteDeleted), ctx)
 }
+
+func (m *MockNoteOutputPort) PresentNoteRevisions(ctx context.Context, revisions []note.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteRevisions", ctx, revisions)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteOutputPortMockRecorder) PresentNoteRevisions(ctx, revisions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteRevisions", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteRevisions), ctx, revisions)
+}
+
+func (m *MockNoteOutputPort) PresentNoteRevisionDiff(ctx context.Context, diff note.RevisionDiff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteRevisionDiff", ctx, diff)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteOutputPortMockRecorder) PresentNoteRevisionDiff(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteRevisionDiff", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteRevisionDiff), ctx, diff)
+}
+
+func (m *MockNoteOutputPort) PresentNoteDuplicated(ctx context.Context, n *note.WithMeta, unmapped []note.UnmappedSection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteDuplicated", ctx, n, unmapped)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteOutputPortMockRecorder) PresentNoteDuplicated(ctx, n, unmapped any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteDuplicated", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteDuplicated), ctx, n, unmapped)
+}
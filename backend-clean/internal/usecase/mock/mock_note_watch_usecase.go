@@ -0,0 +1,81 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteEventSubscriber is a mock of port.NoteEventSubscriber.
+type MockNoteEventSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteEventSubscriberMockRecorder
+}
+
+// MockNoteEventSubscriberMockRecorder records invocations.
+type MockNoteEventSubscriberMockRecorder struct {
+	mock *MockNoteEventSubscriber
+}
+
+// NewMockNoteEventSubscriber creates a new mock.
+func NewMockNoteEventSubscriber(ctrl *gomock.Controller) *MockNoteEventSubscriber {
+	mock := &MockNoteEventSubscriber{ctrl: ctrl}
+	mock.recorder = &MockNoteEventSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteEventSubscriber) EXPECT() *MockNoteEventSubscriberMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteEventSubscriber) Subscribe(ctx context.Context, cursor string) (<-chan note.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, cursor)
+	res0, _ := ret[0].(<-chan note.Event)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteEventSubscriberMockRecorder) Subscribe(ctx, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNoteEventSubscriber)(nil).Subscribe), ctx, cursor)
+}
+
+// MockNoteWatchOutputPort is a mock of port.NoteWatchOutputPort.
+type MockNoteWatchOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteWatchOutputPortMockRecorder
+}
+
+// MockNoteWatchOutputPortMockRecorder records invocations.
+type MockNoteWatchOutputPortMockRecorder struct {
+	mock *MockNoteWatchOutputPort
+}
+
+// NewMockNoteWatchOutputPort creates a new mock.
+func NewMockNoteWatchOutputPort(ctrl *gomock.Controller) *MockNoteWatchOutputPort {
+	mock := &MockNoteWatchOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteWatchOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteWatchOutputPort) EXPECT() *MockNoteWatchOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteWatchOutputPort) PresentNoteEvent(ctx context.Context, event note.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteEvent", ctx, event)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteWatchOutputPortMockRecorder) PresentNoteEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteEvent", reflect.TypeOf((*MockNoteWatchOutputPort)(nil).PresentNoteEvent), ctx, event)
+}
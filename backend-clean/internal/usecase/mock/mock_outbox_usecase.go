@@ -0,0 +1,193 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+)
+
+// MockEventPublisher is a mock of port.EventPublisher.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+}
+
+// MockEventPublisherMockRecorder records invocations.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, events ...outbox.Event) error {
+	m.ctrl.T.Helper()
+	args := []any{ctx}
+	for _, e := range events {
+		args = append(args, e)
+	}
+	ret := m.ctrl.Call(m, "Publish", args...)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockEventPublisherMockRecorder) Publish(ctx any, events ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	args := append([]any{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), args...)
+}
+
+// MockOutboxRepository is a mock of port.OutboxRepository.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+}
+
+// MockOutboxRepositoryMockRecorder records invocations.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockOutboxRepository) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]outbox.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, now, leaseUntil, limit)
+	res0, _ := ret[0].([]outbox.Message)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) ClaimPending(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimPending), ctx, now, leaseUntil, limit)
+}
+
+func (m *MockOutboxRepository) MarkDelivered(ctx context.Context, id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, id, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) MarkDelivered(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockOutboxRepository)(nil).MarkDelivered), ctx, id, at)
+}
+
+func (m *MockOutboxRepository) MarkRetry(ctx context.Context, id string, attempts int, next time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRetry", ctx, id, attempts, next, lastError)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) MarkRetry(ctx, id, attempts, next, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRetry", reflect.TypeOf((*MockOutboxRepository)(nil).MarkRetry), ctx, id, attempts, next, lastError)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id string, attempts int, at time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, attempts, at, lastError)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) MarkFailed(ctx, id, attempts, at, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockOutboxRepository)(nil).MarkFailed), ctx, id, attempts, at, lastError)
+}
+
+// MockEventSink is a mock of port.EventSink.
+type MockEventSink struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventSinkMockRecorder
+}
+
+// MockEventSinkMockRecorder records invocations.
+type MockEventSinkMockRecorder struct {
+	mock *MockEventSink
+}
+
+// NewMockEventSink creates a new mock.
+func NewMockEventSink(ctrl *gomock.Controller) *MockEventSink {
+	mock := &MockEventSink{ctrl: ctrl}
+	mock.recorder = &MockEventSinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockEventSink) EXPECT() *MockEventSinkMockRecorder {
+	return m.recorder
+}
+
+func (m *MockEventSink) Deliver(ctx context.Context, msg outbox.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, msg)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockEventSinkMockRecorder) Deliver(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockEventSink)(nil).Deliver), ctx, msg)
+}
+
+// MockOutboxRelayOutputPort is a mock of port.OutboxRelayOutputPort.
+type MockOutboxRelayOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRelayOutputPortMockRecorder
+}
+
+// MockOutboxRelayOutputPortMockRecorder records invocations.
+type MockOutboxRelayOutputPortMockRecorder struct {
+	mock *MockOutboxRelayOutputPort
+}
+
+// NewMockOutboxRelayOutputPort creates a new mock.
+func NewMockOutboxRelayOutputPort(ctrl *gomock.Controller) *MockOutboxRelayOutputPort {
+	mock := &MockOutboxRelayOutputPort{ctrl: ctrl}
+	mock.recorder = &MockOutboxRelayOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockOutboxRelayOutputPort) EXPECT() *MockOutboxRelayOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockOutboxRelayOutputPort) PresentResult(ctx context.Context, result outbox.RelayResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentResult", ctx, result)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRelayOutputPortMockRecorder) PresentResult(ctx, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentResult", reflect.TypeOf((*MockOutboxRelayOutputPort)(nil).PresentResult), ctx, result)
+}
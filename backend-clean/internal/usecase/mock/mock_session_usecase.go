@@ -0,0 +1,196 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/session"
+)
+
+// MockSessionRepository is a mock of port.SessionRepository.
+type MockSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryMockRecorder
+}
+
+// MockSessionRepositoryMockRecorder records invocations.
+type MockSessionRepositoryMockRecorder struct {
+	mock *MockSessionRepository
+}
+
+// NewMockSessionRepository creates a new mock.
+func NewMockSessionRepository(ctrl *gomock.Controller) *MockSessionRepository {
+	mock := &MockSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, s session.Session) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	res0, _ := ret[0].(*session.Session)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockSessionRepositoryMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepository)(nil).Create), ctx, s)
+}
+
+func (m *MockSessionRepository) GetByTokenHash(ctx context.Context, hash string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, hash)
+	res0, _ := ret[0].(*session.Session)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockSessionRepositoryMockRecorder) GetByTokenHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockSessionRepository)(nil).GetByTokenHash), ctx, hash)
+}
+
+func (m *MockSessionRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockSessionRepositoryMockRecorder) Revoke(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionRepository)(nil).Revoke), ctx, id, at)
+}
+
+func (m *MockSessionRepository) RevokeAllByAccount(ctx context.Context, accountID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByAccount", ctx, accountID, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockSessionRepositoryMockRecorder) RevokeAllByAccount(ctx, accountID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByAccount", reflect.TypeOf((*MockSessionRepository)(nil).RevokeAllByAccount), ctx, accountID, at)
+}
+
+// MockTokenIssuer is a mock of port.TokenIssuer.
+type MockTokenIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenIssuerMockRecorder
+}
+
+// MockTokenIssuerMockRecorder records invocations.
+type MockTokenIssuerMockRecorder struct {
+	mock *MockTokenIssuer
+}
+
+// NewMockTokenIssuer creates a new mock.
+func NewMockTokenIssuer(ctrl *gomock.Controller) *MockTokenIssuer {
+	mock := &MockTokenIssuer{ctrl: ctrl}
+	mock.recorder = &MockTokenIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockTokenIssuer) EXPECT() *MockTokenIssuerMockRecorder {
+	return m.recorder
+}
+
+func (m *MockTokenIssuer) IssueAccessToken(ctx context.Context, accountID string) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueAccessToken", ctx, accountID)
+	res0, _ := ret[0].(string)
+	res1, _ := ret[1].(time.Time)
+	res2, _ := ret[2].(error)
+	return res0, res1, res2
+}
+
+func (mr *MockTokenIssuerMockRecorder) IssueAccessToken(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueAccessToken", reflect.TypeOf((*MockTokenIssuer)(nil).IssueAccessToken), ctx, accountID)
+}
+
+func (m *MockTokenIssuer) IssueRefreshToken(ctx context.Context) (string, string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueRefreshToken", ctx)
+	res0, _ := ret[0].(string)
+	res1, _ := ret[1].(string)
+	res2, _ := ret[2].(time.Time)
+	res3, _ := ret[3].(error)
+	return res0, res1, res2, res3
+}
+
+func (mr *MockTokenIssuerMockRecorder) IssueRefreshToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueRefreshToken", reflect.TypeOf((*MockTokenIssuer)(nil).IssueRefreshToken), ctx)
+}
+
+func (m *MockTokenIssuer) HashRefreshToken(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRefreshToken", token)
+	res0, _ := ret[0].(string)
+	return res0
+}
+
+func (mr *MockTokenIssuerMockRecorder) HashRefreshToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRefreshToken", reflect.TypeOf((*MockTokenIssuer)(nil).HashRefreshToken), token)
+}
+
+// MockSessionOutputPort is a mock of port.SessionOutputPort.
+type MockSessionOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionOutputPortMockRecorder
+}
+
+// MockSessionOutputPortMockRecorder records invocations.
+type MockSessionOutputPortMockRecorder struct {
+	mock *MockSessionOutputPort
+}
+
+// NewMockSessionOutputPort creates a new mock.
+func NewMockSessionOutputPort(ctrl *gomock.Controller) *MockSessionOutputPort {
+	mock := &MockSessionOutputPort{ctrl: ctrl}
+	mock.recorder = &MockSessionOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockSessionOutputPort) EXPECT() *MockSessionOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockSessionOutputPort) PresentSession(ctx context.Context, acc *account.Account, tokens *session.Tokens) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentSession", ctx, acc, tokens)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockSessionOutputPortMockRecorder) PresentSession(ctx, acc, tokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentSession", reflect.TypeOf((*MockSessionOutputPort)(nil).PresentSession), ctx, acc, tokens)
+}
+
+func (m *MockSessionOutputPort) PresentLogout(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentLogout", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockSessionOutputPortMockRecorder) PresentLogout(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentLogout", reflect.TypeOf((*MockSessionOutputPort)(nil).PresentLogout), ctx)
+}
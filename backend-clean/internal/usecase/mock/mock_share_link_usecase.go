@@ -0,0 +1,277 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockShareLinkInputPort is a mock of port.ShareLinkInputPort.
+type MockShareLinkInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkInputPortMockRecorder
+}
+
+// MockShareLinkInputPortMockRecorder records invocations.
+type MockShareLinkInputPortMockRecorder struct {
+	mock *MockShareLinkInputPort
+}
+
+// NewMockShareLinkInputPort creates a new mock.
+func NewMockShareLinkInputPort(ctrl *gomock.Controller) *MockShareLinkInputPort {
+	mock := &MockShareLinkInputPort{ctrl: ctrl}
+	mock.recorder = &MockShareLinkInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockShareLinkInputPort) EXPECT() *MockShareLinkInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockShareLinkInputPort) Create(ctx context.Context, input port.ShareLinkCreateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkInputPortMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareLinkInputPort)(nil).Create), ctx, input)
+}
+
+func (m *MockShareLinkInputPort) List(ctx context.Context, noteID string, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, noteID, actorID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkInputPortMockRecorder) List(ctx, noteID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShareLinkInputPort)(nil).List), ctx, noteID, actorID)
+}
+
+func (m *MockShareLinkInputPort) Revoke(ctx context.Context, input port.ShareLinkRevokeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkInputPortMockRecorder) Revoke(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareLinkInputPort)(nil).Revoke), ctx, input)
+}
+
+func (m *MockShareLinkInputPort) View(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "View", ctx, token)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkInputPortMockRecorder) View(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "View", reflect.TypeOf((*MockShareLinkInputPort)(nil).View), ctx, token)
+}
+
+// MockShareLinkOutputPort is a mock of port.ShareLinkOutputPort.
+type MockShareLinkOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkOutputPortMockRecorder
+}
+
+// MockShareLinkOutputPortMockRecorder records invocations.
+type MockShareLinkOutputPortMockRecorder struct {
+	mock *MockShareLinkOutputPort
+}
+
+// NewMockShareLinkOutputPort creates a new mock.
+func NewMockShareLinkOutputPort(ctrl *gomock.Controller) *MockShareLinkOutputPort {
+	mock := &MockShareLinkOutputPort{ctrl: ctrl}
+	mock.recorder = &MockShareLinkOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockShareLinkOutputPort) EXPECT() *MockShareLinkOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockShareLinkOutputPort) PresentShareLink(ctx context.Context, link *sharelink.Link, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentShareLink", ctx, link, token)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkOutputPortMockRecorder) PresentShareLink(ctx, link, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentShareLink", reflect.TypeOf((*MockShareLinkOutputPort)(nil).PresentShareLink), ctx, link, token)
+}
+
+func (m *MockShareLinkOutputPort) PresentShareLinks(ctx context.Context, links []sharelink.Link) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentShareLinks", ctx, links)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkOutputPortMockRecorder) PresentShareLinks(ctx, links any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentShareLinks", reflect.TypeOf((*MockShareLinkOutputPort)(nil).PresentShareLinks), ctx, links)
+}
+
+func (m *MockShareLinkOutputPort) PresentShareLinkRevoked(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentShareLinkRevoked", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkOutputPortMockRecorder) PresentShareLinkRevoked(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentShareLinkRevoked", reflect.TypeOf((*MockShareLinkOutputPort)(nil).PresentShareLinkRevoked), ctx)
+}
+
+func (m *MockShareLinkOutputPort) PresentPublicNote(ctx context.Context, n *note.WithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentPublicNote", ctx, n)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkOutputPortMockRecorder) PresentPublicNote(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentPublicNote", reflect.TypeOf((*MockShareLinkOutputPort)(nil).PresentPublicNote), ctx, n)
+}
+
+// MockShareLinkRepository is a mock of port.ShareLinkRepository.
+type MockShareLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkRepositoryMockRecorder
+}
+
+// MockShareLinkRepositoryMockRecorder records invocations.
+type MockShareLinkRepositoryMockRecorder struct {
+	mock *MockShareLinkRepository
+}
+
+// NewMockShareLinkRepository creates a new mock.
+func NewMockShareLinkRepository(ctrl *gomock.Controller) *MockShareLinkRepository {
+	mock := &MockShareLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockShareLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockShareLinkRepository) EXPECT() *MockShareLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockShareLinkRepository) Create(ctx context.Context, link sharelink.Link) (*sharelink.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, link)
+	res0, _ := ret[0].(*sharelink.Link)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockShareLinkRepositoryMockRecorder) Create(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareLinkRepository)(nil).Create), ctx, link)
+}
+
+func (m *MockShareLinkRepository) GetByTokenHash(ctx context.Context, hash string) (*sharelink.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, hash)
+	res0, _ := ret[0].(*sharelink.Link)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockShareLinkRepositoryMockRecorder) GetByTokenHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockShareLinkRepository)(nil).GetByTokenHash), ctx, hash)
+}
+
+func (m *MockShareLinkRepository) List(ctx context.Context, noteID string) ([]sharelink.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, noteID)
+	res0, _ := ret[0].([]sharelink.Link)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockShareLinkRepositoryMockRecorder) List(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShareLinkRepository)(nil).List), ctx, noteID)
+}
+
+func (m *MockShareLinkRepository) Revoke(ctx context.Context, noteID string, id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, noteID, id, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkRepositoryMockRecorder) Revoke(ctx, noteID, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareLinkRepository)(nil).Revoke), ctx, noteID, id, at)
+}
+
+func (m *MockShareLinkRepository) RevokeByNote(ctx context.Context, noteID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByNote", ctx, noteID, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareLinkRepositoryMockRecorder) RevokeByNote(ctx, noteID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByNote", reflect.TypeOf((*MockShareLinkRepository)(nil).RevokeByNote), ctx, noteID, at)
+}
+
+// MockLinkTokenGenerator is a mock of port.LinkTokenGenerator.
+type MockLinkTokenGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkTokenGeneratorMockRecorder
+}
+
+// MockLinkTokenGeneratorMockRecorder records invocations.
+type MockLinkTokenGeneratorMockRecorder struct {
+	mock *MockLinkTokenGenerator
+}
+
+// NewMockLinkTokenGenerator creates a new mock.
+func NewMockLinkTokenGenerator(ctrl *gomock.Controller) *MockLinkTokenGenerator {
+	mock := &MockLinkTokenGenerator{ctrl: ctrl}
+	mock.recorder = &MockLinkTokenGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockLinkTokenGenerator) EXPECT() *MockLinkTokenGeneratorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockLinkTokenGenerator) Generate(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", ctx)
+	res0, _ := ret[0].(string)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockLinkTokenGeneratorMockRecorder) Generate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockLinkTokenGenerator)(nil).Generate), ctx)
+}
@@ -0,0 +1,203 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockShareInputPort is a mock of port.ShareInputPort.
+type MockShareInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareInputPortMockRecorder
+}
+
+// MockShareInputPortMockRecorder records invocations.
+type MockShareInputPortMockRecorder struct {
+	mock *MockShareInputPort
+}
+
+// NewMockShareInputPort creates a new mock.
+func NewMockShareInputPort(ctrl *gomock.Controller) *MockShareInputPort {
+	mock := &MockShareInputPort{ctrl: ctrl}
+	mock.recorder = &MockShareInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockShareInputPort) EXPECT() *MockShareInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockShareInputPort) List(ctx context.Context, input port.ShareListInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareInputPortMockRecorder) List(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShareInputPort)(nil).List), ctx, input)
+}
+
+func (m *MockShareInputPort) Grant(ctx context.Context, input port.ShareGrantInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grant", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareInputPortMockRecorder) Grant(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockShareInputPort)(nil).Grant), ctx, input)
+}
+
+func (m *MockShareInputPort) Revoke(ctx context.Context, input port.ShareRevokeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareInputPortMockRecorder) Revoke(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareInputPort)(nil).Revoke), ctx, input)
+}
+
+// MockShareOutputPort is a mock of port.ShareOutputPort.
+type MockShareOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareOutputPortMockRecorder
+}
+
+// MockShareOutputPortMockRecorder records invocations.
+type MockShareOutputPortMockRecorder struct {
+	mock *MockShareOutputPort
+}
+
+// NewMockShareOutputPort creates a new mock.
+func NewMockShareOutputPort(ctrl *gomock.Controller) *MockShareOutputPort {
+	mock := &MockShareOutputPort{ctrl: ctrl}
+	mock.recorder = &MockShareOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockShareOutputPort) EXPECT() *MockShareOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockShareOutputPort) PresentShares(ctx context.Context, grants []share.Grant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentShares", ctx, grants)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareOutputPortMockRecorder) PresentShares(ctx, grants any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentShares", reflect.TypeOf((*MockShareOutputPort)(nil).PresentShares), ctx, grants)
+}
+
+func (m *MockShareOutputPort) PresentShare(ctx context.Context, grant *share.Grant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentShare", ctx, grant)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareOutputPortMockRecorder) PresentShare(ctx, grant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentShare", reflect.TypeOf((*MockShareOutputPort)(nil).PresentShare), ctx, grant)
+}
+
+func (m *MockShareOutputPort) PresentShareRevoked(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentShareRevoked", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareOutputPortMockRecorder) PresentShareRevoked(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentShareRevoked", reflect.TypeOf((*MockShareOutputPort)(nil).PresentShareRevoked), ctx)
+}
+
+// MockShareRepository is a mock of port.ShareRepository.
+type MockShareRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareRepositoryMockRecorder
+}
+
+// MockShareRepositoryMockRecorder records invocations.
+type MockShareRepositoryMockRecorder struct {
+	mock *MockShareRepository
+}
+
+// NewMockShareRepository creates a new mock.
+func NewMockShareRepository(ctrl *gomock.Controller) *MockShareRepository {
+	mock := &MockShareRepository{ctrl: ctrl}
+	mock.recorder = &MockShareRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockShareRepository) EXPECT() *MockShareRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockShareRepository) List(ctx context.Context, resourceType share.ResourceType, resourceID string) ([]share.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceType, resourceID)
+	res0, _ := ret[0].([]share.Grant)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockShareRepositoryMockRecorder) List(ctx, resourceType, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShareRepository)(nil).List), ctx, resourceType, resourceID)
+}
+
+func (m *MockShareRepository) Get(ctx context.Context, resourceType share.ResourceType, resourceID string, accountID string) (*share.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceType, resourceID, accountID)
+	res0, _ := ret[0].(*share.Grant)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockShareRepositoryMockRecorder) Get(ctx, resourceType, resourceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockShareRepository)(nil).Get), ctx, resourceType, resourceID, accountID)
+}
+
+func (m *MockShareRepository) Upsert(ctx context.Context, grant share.Grant) (*share.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, grant)
+	res0, _ := ret[0].(*share.Grant)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockShareRepositoryMockRecorder) Upsert(ctx, grant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockShareRepository)(nil).Upsert), ctx, grant)
+}
+
+func (m *MockShareRepository) Delete(ctx context.Context, resourceType share.ResourceType, resourceID string, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceType, resourceID, accountID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockShareRepositoryMockRecorder) Delete(ctx, resourceType, resourceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockShareRepository)(nil).Delete), ctx, resourceType, resourceID, accountID)
+}
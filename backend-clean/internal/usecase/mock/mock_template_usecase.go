@@ -4,9 +4,11 @@ package mockusecase
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/template"
 )
 
@@ -85,28 +87,106 @@ func (mr *MockTemplateRepositoryMockRecorder) Update(ctx, tpl any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTemplateRepository)(nil).Update), ctx, tpl)
 }
 
-func (m *MockTemplateRepository) Delete(ctx context.Context, id string) error {
+func (m *MockTemplateRepository) Delete(ctx context.Context, id string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	res0, _ := ret[0].(error)
 	return res0
 }
 
-func (mr *MockTemplateRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockTemplateRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateRepository)(nil).Delete), ctx, id, version)
 }
 
-func (m *MockTemplateRepository) ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error {
+func (m *MockTemplateRepository) ListTrashed(ctx context.Context, ownerID string) ([]template.Template, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceFields", ctx, templateID, fields)
+	ret := m.ctrl.Call(m, "ListTrashed", ctx, ownerID)
+	res0, _ := ret[0].([]template.Template)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) ListTrashed(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashed", reflect.TypeOf((*MockTemplateRepository)(nil).ListTrashed), ctx, ownerID)
+}
+
+func (m *MockTemplateRepository) GetTrashed(ctx context.Context, id string) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashed", ctx, id)
+	res0, _ := ret[0].(*template.Template)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) GetTrashed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashed", reflect.TypeOf((*MockTemplateRepository)(nil).GetTrashed), ctx, id)
+}
+
+func (m *MockTemplateRepository) Restore(ctx context.Context, id string, version int) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, version)
+	res0, _ := ret[0].(*template.Template)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) Restore(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTemplateRepository)(nil).Restore), ctx, id, version)
+}
+
+func (m *MockTemplateRepository) SetPublic(ctx context.Context, id string, isPublic bool, version int) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPublic", ctx, id, isPublic, version)
+	res0, _ := ret[0].(*template.Template)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) SetPublic(ctx, id, isPublic, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockTemplateRepository)(nil).SetPublic), ctx, id, isPublic, version)
+}
+
+func (m *MockTemplateRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	res0, _ := ret[0].(int)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) PurgeDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockTemplateRepository)(nil).PurgeDeletedBefore), ctx, before)
+}
+
+func (m *MockTemplateRepository) ReplaceFields(ctx context.Context, templateID string, schemaVersion int, fields []template.Field) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceFields", ctx, templateID, schemaVersion, fields)
 	res0, _ := ret[0].(error)
 	return res0
 }
 
-func (mr *MockTemplateRepositoryMockRecorder) ReplaceFields(ctx, templateID, fields any) *gomock.Call {
+func (mr *MockTemplateRepositoryMockRecorder) ReplaceFields(ctx, templateID, schemaVersion, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFields", reflect.TypeOf((*MockTemplateRepository)(nil).ReplaceFields), ctx, templateID, schemaVersion, fields)
+}
+
+func (m *MockTemplateRepository) ListFields(ctx context.Context, templateID string, schemaVersion int) ([]template.Field, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFields", ctx, templateID, schemaVersion)
+	res0, _ := ret[0].([]template.Field)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) ListFields(ctx, templateID, schemaVersion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFields", reflect.TypeOf((*MockTemplateRepository)(nil).ReplaceFields), ctx, templateID, fields)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFields", reflect.TypeOf((*MockTemplateRepository)(nil).ListFields), ctx, templateID, schemaVersion)
 }
 
 // MockTxManager is a mock of port.TxManager.
@@ -167,16 +247,16 @@ func (m *MockTemplateOutputPort) EXPECT() *MockTemplateOutputPortMockRecorder {
 	return m.recorder
 }
 
-func (m *MockTemplateOutputPort) PresentTemplateList(ctx context.Context, templates []template.WithUsage) error {
+func (m *MockTemplateOutputPort) PresentTemplateList(ctx context.Context, templates []template.WithUsage, page pagination.Info) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PresentTemplateList", ctx, templates)
+	ret := m.ctrl.Call(m, "PresentTemplateList", ctx, templates, page)
 	res0, _ := ret[0].(error)
 	return res0
 }
 
-func (mr *MockTemplateOutputPortMockRecorder) PresentTemplateList(ctx, templates any) *gomock.Call {
+func (mr *MockTemplateOutputPortMockRecorder) PresentTemplateList(ctx, templates, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTemplateList", reflect.TypeOf((*MockTemplateOutputPort)(nil).PresentTemplateList), ctx, templates)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTemplateList", reflect.TypeOf((*MockTemplateOutputPort)(nil).PresentTemplateList), ctx, templates, page)
 }
 
 func (m *MockTemplateOutputPort) PresentTemplate(ctx context.Context, tpl *template.WithUsage) error {
@@ -0,0 +1,47 @@
+// Code generated manually for gomock-based tests.
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// MockTrashOutputPort is a mock of port.TrashOutputPort.
+type MockTrashOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashOutputPortMockRecorder
+}
+
+// MockTrashOutputPortMockRecorder records calls.
+type MockTrashOutputPortMockRecorder struct {
+	mock *MockTrashOutputPort
+}
+
+// NewMockTrashOutputPort creates a new mock.
+func NewMockTrashOutputPort(ctrl *gomock.Controller) *MockTrashOutputPort {
+	mock := &MockTrashOutputPort{ctrl: ctrl}
+	mock.recorder = &MockTrashOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockTrashOutputPort) EXPECT() *MockTrashOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockTrashOutputPort) PresentTrash(ctx context.Context, notes []note.WithMeta, templates []template.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentTrash", ctx, notes, templates)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTrashOutputPortMockRecorder) PresentTrash(ctx, notes, templates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTrash", reflect.TypeOf((*MockTrashOutputPort)(nil).PresentTrash), ctx, notes, templates)
+}
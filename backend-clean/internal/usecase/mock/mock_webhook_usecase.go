@@ -0,0 +1,497 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockWebhookInputPort is a mock of port.WebhookInputPort.
+type MockWebhookInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookInputPortMockRecorder
+}
+
+// MockWebhookInputPortMockRecorder records invocations.
+type MockWebhookInputPortMockRecorder struct {
+	mock *MockWebhookInputPort
+}
+
+// NewMockWebhookInputPort creates a new mock.
+func NewMockWebhookInputPort(ctrl *gomock.Controller) *MockWebhookInputPort {
+	mock := &MockWebhookInputPort{ctrl: ctrl}
+	mock.recorder = &MockWebhookInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookInputPort) EXPECT() *MockWebhookInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookInputPort) List(ctx context.Context, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) List(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookInputPort)(nil).List), ctx, ownerID)
+}
+
+func (m *MockWebhookInputPort) Get(ctx context.Context, id string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, ownerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) Get(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookInputPort)(nil).Get), ctx, id, ownerID)
+}
+
+func (m *MockWebhookInputPort) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookInputPort)(nil).Create), ctx, input)
+}
+
+func (m *MockWebhookInputPort) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookInputPort)(nil).Update), ctx, input)
+}
+
+func (m *MockWebhookInputPort) Delete(ctx context.Context, id string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, ownerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) Delete(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookInputPort)(nil).Delete), ctx, id, ownerID)
+}
+
+func (m *MockWebhookInputPort) ListDeliveries(ctx context.Context, webhookID string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, webhookID, ownerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) ListDeliveries(ctx, webhookID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookInputPort)(nil).ListDeliveries), ctx, webhookID, ownerID)
+}
+
+func (m *MockWebhookInputPort) Replay(ctx context.Context, input port.WebhookReplayInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookInputPortMockRecorder) Replay(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockWebhookInputPort)(nil).Replay), ctx, input)
+}
+
+// MockWebhookOutputPort is a mock of port.WebhookOutputPort.
+type MockWebhookOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookOutputPortMockRecorder
+}
+
+// MockWebhookOutputPortMockRecorder records invocations.
+type MockWebhookOutputPortMockRecorder struct {
+	mock *MockWebhookOutputPort
+}
+
+// NewMockWebhookOutputPort creates a new mock.
+func NewMockWebhookOutputPort(ctrl *gomock.Controller) *MockWebhookOutputPort {
+	mock := &MockWebhookOutputPort{ctrl: ctrl}
+	mock.recorder = &MockWebhookOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookOutputPort) EXPECT() *MockWebhookOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookList(ctx context.Context, subs []webhook.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookList", ctx, subs)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookList(ctx, subs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookList", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookList), ctx, subs)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhook(ctx context.Context, sub *webhook.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhook", ctx, sub)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhook(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhook", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhook), ctx, sub)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookDeleted", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookDeleted), ctx)
+}
+
+func (m *MockWebhookOutputPort) PresentDeliveryList(ctx context.Context, deliveries []webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentDeliveryList", ctx, deliveries)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentDeliveryList(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDeliveryList", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentDeliveryList), ctx, deliveries)
+}
+
+func (m *MockWebhookOutputPort) PresentDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentDelivery", ctx, delivery)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDelivery", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentDelivery), ctx, delivery)
+}
+
+// MockWebhookRepository is a mock of port.WebhookRepository.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder records invocations.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookRepository) ListByOwner(ctx context.Context, ownerID string) ([]webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwner", ctx, ownerID)
+	res0, _ := ret[0].([]webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ListByOwner(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockWebhookRepository)(nil).ListByOwner), ctx, ownerID)
+}
+
+func (m *MockWebhookRepository) ListForEvent(ctx context.Context, ownerID string, eventType string) ([]webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForEvent", ctx, ownerID, eventType)
+	res0, _ := ret[0].([]webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ListForEvent(ctx, ownerID, eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForEvent", reflect.TypeOf((*MockWebhookRepository)(nil).ListForEvent), ctx, ownerID, eventType)
+}
+
+func (m *MockWebhookRepository) Get(ctx context.Context, id string) (*webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, sub)
+	res0, _ := ret[0].(*webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Create(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), ctx, sub)
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, sub)
+	res0, _ := ret[0].(*webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Update(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), ctx, sub)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, subscriptionID, limit)
+	res0, _ := ret[0].([]webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ListDeliveries(ctx, subscriptionID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListDeliveries), ctx, subscriptionID, limit)
+}
+
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	res0, _ := ret[0].(*webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) GetDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetDelivery), ctx, id)
+}
+
+func (m *MockWebhookRepository) EnqueueDelivery(ctx context.Context, d webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueDelivery", ctx, d)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) EnqueueDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).EnqueueDelivery), ctx, d)
+}
+
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, d)
+	res0, _ := ret[0].(*webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) CreateDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDelivery), ctx, d)
+}
+
+// MockWebhookDeliveryRepository is a mock of port.WebhookDeliveryRepository.
+type MockWebhookDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryRepositoryMockRecorder
+}
+
+// MockWebhookDeliveryRepositoryMockRecorder records invocations.
+type MockWebhookDeliveryRepositoryMockRecorder struct {
+	mock *MockWebhookDeliveryRepository
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock.
+func NewMockWebhookDeliveryRepository(ctrl *gomock.Controller) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookDeliveryRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhook.DueDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, leaseUntil, limit)
+	res0, _ := ret[0].([]webhook.DueDelivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ClaimDue(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ClaimDue), ctx, now, leaseUntil, limit)
+}
+
+func (m *MockWebhookDeliveryRepository) MarkSucceeded(ctx context.Context, id string, attempts int, responseStatus int, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSucceeded", ctx, id, attempts, responseStatus, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) MarkSucceeded(ctx, id, attempts, responseStatus, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSucceeded", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).MarkSucceeded), ctx, id, attempts, responseStatus, at)
+}
+
+func (m *MockWebhookDeliveryRepository) MarkRetry(ctx context.Context, id string, attempts int, responseStatus int, next time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRetry", ctx, id, attempts, responseStatus, next, lastError)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) MarkRetry(ctx, id, attempts, responseStatus, next, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRetry", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).MarkRetry), ctx, id, attempts, responseStatus, next, lastError)
+}
+
+func (m *MockWebhookDeliveryRepository) MarkFailed(ctx context.Context, id string, attempts int, responseStatus int, at time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, attempts, responseStatus, at, lastError)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) MarkFailed(ctx, id, attempts, responseStatus, at, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).MarkFailed), ctx, id, attempts, responseStatus, at, lastError)
+}
+
+// MockWebhookSender is a mock of port.WebhookSender.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder records invocations.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookSender) Send(ctx context.Context, req webhook.Request) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, req)
+	res0, _ := ret[0].(int)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, req)
+}
+
+// MockWebhookDeliveryOutputPort is a mock of port.WebhookDeliveryOutputPort.
+type MockWebhookDeliveryOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryOutputPortMockRecorder
+}
+
+// MockWebhookDeliveryOutputPortMockRecorder records invocations.
+type MockWebhookDeliveryOutputPortMockRecorder struct {
+	mock *MockWebhookDeliveryOutputPort
+}
+
+// NewMockWebhookDeliveryOutputPort creates a new mock.
+func NewMockWebhookDeliveryOutputPort(ctrl *gomock.Controller) *MockWebhookDeliveryOutputPort {
+	mock := &MockWebhookDeliveryOutputPort{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookDeliveryOutputPort) EXPECT() *MockWebhookDeliveryOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookDeliveryOutputPort) PresentResult(ctx context.Context, result outbox.RelayResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentResult", ctx, result)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookDeliveryOutputPortMockRecorder) PresentResult(ctx, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentResult", reflect.TypeOf((*MockWebhookDeliveryOutputPort)(nil).PresentResult), ctx, result)
+}
@@ -0,0 +1,391 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockWorkspaceInputPort is a mock of port.WorkspaceInputPort.
+type MockWorkspaceInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceInputPortMockRecorder
+}
+
+// MockWorkspaceInputPortMockRecorder records invocations.
+type MockWorkspaceInputPortMockRecorder struct {
+	mock *MockWorkspaceInputPort
+}
+
+// NewMockWorkspaceInputPort creates a new mock.
+func NewMockWorkspaceInputPort(ctrl *gomock.Controller) *MockWorkspaceInputPort {
+	mock := &MockWorkspaceInputPort{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWorkspaceInputPort) EXPECT() *MockWorkspaceInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWorkspaceInputPort) List(ctx context.Context, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, actorID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) List(ctx, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWorkspaceInputPort)(nil).List), ctx, actorID)
+}
+
+func (m *MockWorkspaceInputPort) Create(ctx context.Context, input port.WorkspaceCreateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceInputPort)(nil).Create), ctx, input)
+}
+
+func (m *MockWorkspaceInputPort) ListMembers(ctx context.Context, workspaceID string, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, workspaceID, actorID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) ListMembers(ctx, workspaceID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceInputPort)(nil).ListMembers), ctx, workspaceID, actorID)
+}
+
+func (m *MockWorkspaceInputPort) Invite(ctx context.Context, input port.WorkspaceInviteInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) Invite(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockWorkspaceInputPort)(nil).Invite), ctx, input)
+}
+
+func (m *MockWorkspaceInputPort) ListInvitations(ctx context.Context, actorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, actorID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) ListInvitations(ctx, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockWorkspaceInputPort)(nil).ListInvitations), ctx, actorID)
+}
+
+func (m *MockWorkspaceInputPort) Accept(ctx context.Context, input port.WorkspaceAcceptInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) Accept(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockWorkspaceInputPort)(nil).Accept), ctx, input)
+}
+
+func (m *MockWorkspaceInputPort) RemoveMember(ctx context.Context, input port.WorkspaceRemoveMemberInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInputPortMockRecorder) RemoveMember(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockWorkspaceInputPort)(nil).RemoveMember), ctx, input)
+}
+
+// MockWorkspaceOutputPort is a mock of port.WorkspaceOutputPort.
+type MockWorkspaceOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceOutputPortMockRecorder
+}
+
+// MockWorkspaceOutputPortMockRecorder records invocations.
+type MockWorkspaceOutputPortMockRecorder struct {
+	mock *MockWorkspaceOutputPort
+}
+
+// NewMockWorkspaceOutputPort creates a new mock.
+func NewMockWorkspaceOutputPort(ctrl *gomock.Controller) *MockWorkspaceOutputPort {
+	mock := &MockWorkspaceOutputPort{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWorkspaceOutputPort) EXPECT() *MockWorkspaceOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaces(ctx context.Context, workspaces []workspace.WithRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaces", ctx, workspaces)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaces(ctx, workspaces any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaces", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaces), ctx, workspaces)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspace(ctx context.Context, ws workspace.WithRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspace", ctx, ws)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspace(ctx, ws any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspace", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspace), ctx, ws)
+}
+
+func (m *MockWorkspaceOutputPort) PresentMembers(ctx context.Context, members []workspace.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMembers", ctx, members)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentMembers(ctx, members any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMembers", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentMembers), ctx, members)
+}
+
+func (m *MockWorkspaceOutputPort) PresentInvitation(ctx context.Context, inv *workspace.Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentInvitation", ctx, inv)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentInvitation(ctx, inv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentInvitation", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentInvitation), ctx, inv)
+}
+
+func (m *MockWorkspaceOutputPort) PresentInvitations(ctx context.Context, invs []workspace.Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentInvitations", ctx, invs)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentInvitations(ctx, invs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentInvitations", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentInvitations), ctx, invs)
+}
+
+func (m *MockWorkspaceOutputPort) PresentMemberRemoved(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMemberRemoved", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentMemberRemoved(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMemberRemoved", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentMemberRemoved), ctx)
+}
+
+// MockWorkspaceRepository is a mock of port.WorkspaceRepository.
+type MockWorkspaceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceRepositoryMockRecorder
+}
+
+// MockWorkspaceRepositoryMockRecorder records invocations.
+type MockWorkspaceRepositoryMockRecorder struct {
+	mock *MockWorkspaceRepository
+}
+
+// NewMockWorkspaceRepository creates a new mock.
+func NewMockWorkspaceRepository(ctrl *gomock.Controller) *MockWorkspaceRepository {
+	mock := &MockWorkspaceRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWorkspaceRepository) EXPECT() *MockWorkspaceRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWorkspaceRepository) ListByMember(ctx context.Context, accountID string) ([]workspace.WithRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByMember", ctx, accountID)
+	res0, _ := ret[0].([]workspace.WithRole)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) ListByMember(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListByMember), ctx, accountID)
+}
+
+func (m *MockWorkspaceRepository) Get(ctx context.Context, id string) (*workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*workspace.Workspace)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkspaceRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockWorkspaceRepository) EnsurePersonal(ctx context.Context, ownerID string) (*workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsurePersonal", ctx, ownerID)
+	res0, _ := ret[0].(*workspace.Workspace)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) EnsurePersonal(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsurePersonal", reflect.TypeOf((*MockWorkspaceRepository)(nil).EnsurePersonal), ctx, ownerID)
+}
+
+func (m *MockWorkspaceRepository) Create(ctx context.Context, ws workspace.Workspace) (*workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ws)
+	res0, _ := ret[0].(*workspace.Workspace)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) Create(ctx, ws any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceRepository)(nil).Create), ctx, ws)
+}
+
+func (m *MockWorkspaceRepository) GetMember(ctx context.Context, workspaceID string, accountID string) (*workspace.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, workspaceID, accountID)
+	res0, _ := ret[0].(*workspace.Member)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) GetMember(ctx, workspaceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).GetMember), ctx, workspaceID, accountID)
+}
+
+func (m *MockWorkspaceRepository) ListMembers(ctx context.Context, workspaceID string) ([]workspace.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, workspaceID)
+	res0, _ := ret[0].([]workspace.Member)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) ListMembers(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListMembers), ctx, workspaceID)
+}
+
+func (m *MockWorkspaceRepository) AddMember(ctx context.Context, member workspace.Member) (*workspace.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, member)
+	res0, _ := ret[0].(*workspace.Member)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) AddMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).AddMember), ctx, member)
+}
+
+func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, workspaceID string, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, workspaceID, accountID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) RemoveMember(ctx, workspaceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).RemoveMember), ctx, workspaceID, accountID)
+}
+
+func (m *MockWorkspaceRepository) UpsertInvitation(ctx context.Context, inv workspace.Invitation) (*workspace.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertInvitation", ctx, inv)
+	res0, _ := ret[0].(*workspace.Invitation)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) UpsertInvitation(ctx, inv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertInvitation", reflect.TypeOf((*MockWorkspaceRepository)(nil).UpsertInvitation), ctx, inv)
+}
+
+func (m *MockWorkspaceRepository) GetInvitation(ctx context.Context, id string) (*workspace.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitation", ctx, id)
+	res0, _ := ret[0].(*workspace.Invitation)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) GetInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitation", reflect.TypeOf((*MockWorkspaceRepository)(nil).GetInvitation), ctx, id)
+}
+
+func (m *MockWorkspaceRepository) ListPendingInvitations(ctx context.Context, email string) ([]workspace.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingInvitations", ctx, email)
+	res0, _ := ret[0].([]workspace.Invitation)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) ListPendingInvitations(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingInvitations", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListPendingInvitations), ctx, email)
+}
+
+func (m *MockWorkspaceRepository) AcceptInvitation(ctx context.Context, id string) (*workspace.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, id)
+	res0, _ := ret[0].(*workspace.Invitation)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) AcceptInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockWorkspaceRepository)(nil).AcceptInvitation), ctx, id)
+}
@@ -3,50 +3,80 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/service"
+	"immortal-architecture-clean/backend/internal/domain/share"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // NoteInteractor handles note use cases.
 type NoteInteractor struct {
-	notes     port.NoteRepository
-	templates port.TemplateRepository
-	tx        port.TxManager
-	output    port.NoteOutputPort
+	notes      port.NoteRepository
+	templates  port.TemplateRepository
+	accounts   port.AccountRepository
+	shares     port.ShareRepository
+	workspaces port.WorkspaceRepository
+	links      port.ShareLinkRepository
+	tx         port.TxManager
+	publisher  port.EventPublisher
+	workflow   note.Workflow
+	output     port.NoteOutputPort
 }
 
 var _ port.NoteInputPort = (*NoteInteractor)(nil)
 
 // NewNoteInteractor creates NoteInteractor.
-func NewNoteInteractor(notes port.NoteRepository, templates port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) *NoteInteractor {
+// Changes are recorded to publisher within their transaction, which also feeds note watchers;
+// status changes follow workflow. Who may change a note is decided by the roles in shares,
+// and only members of the note's workspace may see or change it. Unpublishing revokes the note's public links.
+func NewNoteInteractor(notes port.NoteRepository, templates port.TemplateRepository, accounts port.AccountRepository, shares port.ShareRepository, workspaces port.WorkspaceRepository, links port.ShareLinkRepository, tx port.TxManager, publisher port.EventPublisher, workflow note.Workflow, output port.NoteOutputPort) *NoteInteractor {
 	return &NoteInteractor{
-		notes:     notes,
-		templates: templates,
-		tx:        tx,
-		output:    output,
+		notes:      notes,
+		templates:  templates,
+		accounts:   accounts,
+		shares:     shares,
+		workspaces: workspaces,
+		links:      links,
+		tx:         tx,
+		publisher:  publisher,
+		workflow:   workflow,
+		output:     output,
 	}
 }
 
-// List returns notes by filters.
+// List returns one page of notes by filters.
 func (u *NoteInteractor) List(ctx context.Context, filters note.Filters) error {
+	limit, err := pagination.NormalizeLimit(filters.Limit)
+	if err != nil {
+		return err
+	}
+	// Fetch one extra row to learn whether another page follows.
+	filters.Limit = limit + 1
 	notes, err := u.notes.List(ctx, filters)
 	if err != nil {
 		return err
 	}
-	return u.output.PresentNoteList(ctx, notes)
+	notes, page := pagination.Trim(notes, limit, note.WithMeta.PageCursor)
+	return u.output.PresentNoteList(ctx, notes, page)
 }
 
 // Get returns note by ID.
-func (u *NoteInteractor) Get(ctx context.Context, id string) error {
+func (u *NoteInteractor) Get(ctx context.Context, id, actorID string) error {
 	n, err := u.notes.Get(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err := ensureMember(ctx, u.workspaces, n.Note.WorkspaceID, actorID); err != nil {
+		return err
+	}
 	return u.output.PresentNote(ctx, n)
 }
 
@@ -55,43 +85,25 @@ func (u *NoteInteractor) Create(ctx context.Context, input port.NoteCreateInput)
 	if input.OwnerID == "" {
 		return domainerr.ErrOwnerRequired
 	}
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
 
 	tpl, err := u.templates.Get(ctx, input.TemplateID)
 	if err != nil {
 		return err
 	}
-
-	sections, err := buildSections("", input.Sections)
-	if err != nil {
+	// ルール: ノートはテンプレートと同じワークスペースに作られ、そのメンバーだけが作成できる
+	if err := ensureMember(ctx, u.workspaces, tpl.Template.WorkspaceID, input.OwnerID); err != nil {
 		return err
 	}
+
+	sections := note.FillDefaults(tpl.Template.Fields, buildSections(input.Sections))
 	if err := note.ValidateNoteForCreate(input.Title, tpl.Template, sections); err != nil {
 		return err
 	}
 
-	var noteID string
-	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		newNote := note.Note{
-			Title:      input.Title,
-			TemplateID: tpl.Template.ID,
-			OwnerID:    input.OwnerID,
-			Status:     note.StatusDraft,
-			Sections:   sections,
-		}
-		nn, err := u.notes.Create(txCtx, newNote)
-		if err != nil {
-			return err
-		}
-		noteID = nn.ID
-		sectionsWithID, err := buildSections(noteID, input.Sections)
-		if err != nil {
-			return err
-		}
-		if err := note.ValidateSections(tpl.Template.Fields, sectionsWithID); err != nil {
-			return err
-		}
-		return u.notes.ReplaceSections(txCtx, noteID, sectionsWithID)
-	})
+	noteID, err := u.insert(ctx, input.Title, tpl.Template, input.OwnerID, sections)
 	if err != nil {
 		return err
 	}
@@ -102,13 +114,69 @@ func (u *NoteInteractor) Create(ctx context.Context, input port.NoteCreateInput)
 	return u.output.PresentNote(ctx, n)
 }
 
-// Update updates a note.
+// Duplicate creates a draft copy of a note owned by the actor, on the note's template or another one.
+// Content the target template has no field for is reported alongside the new note.
+func (u *NoteInteractor) Duplicate(ctx context.Context, input port.NoteDuplicateInput) error {
+	if input.OwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	source, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, source.Note.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	templateID := input.TemplateID
+	if templateID == "" {
+		templateID = source.Note.TemplateID
+	}
+	tpl, err := u.templates.Get(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	// ルール: 複製は移行先テンプレートのワークスペースに作られ、そのメンバーだけが作成できる
+	if err := ensureMember(ctx, u.workspaces, tpl.Template.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+
+	sections, unmapped := note.MapSections(source.Sections, tpl.Template.Fields)
+	if err := note.ValidateNoteForCreate(source.Note.Title, tpl.Template, sections); err != nil {
+		return err
+	}
+	noteID, err := u.insert(ctx, source.Note.Title, tpl.Template, input.OwnerID, sections)
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteDuplicated(ctx, n, unmapped)
+}
+
+// Update updates a note. Notes in review or approved are locked until they are back in draft.
 func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
 	current, err := u.notes.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceNote, input.ID, current.Note.OwnerID, input.OwnerID, share.RoleEditor); err != nil {
+		return err
+	}
+	if err := current.Note.Status.CanEdit(); err != nil {
+		return err
+	}
+	if err := current.Note.CheckVersion(input.Version); err != nil {
 		return err
 	}
 	if strings.TrimSpace(input.Title) == "" {
@@ -116,30 +184,35 @@ func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput)
 	}
 
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		_, err := u.notes.Update(txCtx, note.Note{
-			ID:    input.ID,
-			Title: input.Title,
+		updated, err := u.notes.Update(txCtx, note.Note{
+			ID:      input.ID,
+			Title:   input.Title,
+			Version: current.Note.Version,
 		})
 		if err != nil {
 			return err
 		}
+		sections := note.SnapshotSections(current.Sections)
 		if input.Sections != nil {
-			tpl, err := u.templates.Get(ctx, current.Note.TemplateID)
+			fields, err := u.templates.ListFields(txCtx, current.Note.TemplateID, current.Note.SchemaVersion)
 			if err != nil {
 				return err
 			}
-			sections, err := buildSectionsForUpdate(current.Sections, tpl.Template.Fields, input.Sections, current.Note.ID)
+			sections, err = buildSectionsForUpdate(current.Sections, fields, input.Sections, current.Note.ID)
 			if err != nil {
 				return err
 			}
-			if err := note.ValidateSections(tpl.Template.Fields, sections); err != nil {
+			if err := note.ValidateSections(fields, sections); err != nil {
 				return err
 			}
 			if err := u.notes.ReplaceSections(txCtx, input.ID, sections); err != nil {
 				return err
 			}
 		}
-		return nil
+		if _, err := u.notes.AppendRevision(txCtx, note.NewRevision(input.ID, input.OwnerID, input.Title, sections)); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteUpdated, *updated, time.Now()))
 	})
 	if err != nil {
 		return err
@@ -153,31 +226,68 @@ func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput)
 
 // ChangeStatus changes note status.
 func (u *NoteInteractor) ChangeStatus(ctx context.Context, input port.NoteStatusChangeInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
 	current, err := u.notes.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	role, err := roleOn(ctx, u.shares, share.ResourceNote, input.ID, current.Note.OwnerID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	if !role.Includes(share.RoleOwner) {
+		return domainerr.ErrUnauthorized
+	}
+	if err := current.Note.CheckVersion(input.Version); err != nil {
 		return err
 	}
 	if err := input.Status.Validate(); err != nil {
 		return err
 	}
-	// domain service handles owner check + transition rule
-	if input.Status == note.StatusPublish {
-		if err := service.CanPublish(current.Note, input.OwnerID); err != nil {
+	// domain service handles role check + transition rule + schedule
+	now := time.Now()
+	switch input.Status {
+	case note.StatusPublish, note.StatusScheduled:
+		if err := service.CanPublish(current.Note, role, input.Status, input.Schedule, now); err != nil {
+			return err
+		}
+	case note.StatusDraft:
+		if err := service.CanUnpublish(current.Note, role); err != nil {
 			return err
 		}
-	} else {
-		if err := service.CanUnpublish(current.Note, input.OwnerID); err != nil {
+		if err := input.Schedule.Validate(input.Status, now); err != nil {
 			return err
 		}
+	default:
+		// InReview and Approved are entered through the review workflow only.
+		return domainerr.ErrInvalidStatusChange
 	}
-	if err := note.CanChangeStatus(current.Note.Status, input.Status); err != nil {
+	if err := u.workflow.CanChangeStatus(current.Note.Status, input.Status); err != nil {
 		return err
 	}
 
-	if _, err := u.notes.UpdateStatus(ctx, input.ID, input.Status); err != nil {
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.UpdateStatus(txCtx, input.ID, input.Status, input.Schedule, current.Note.Version)
+		if err != nil {
+			return err
+		}
+		if current.Note.Status == input.Status {
+			return nil
+		}
+		// Public links stop working for good once the note leaves Publish; republishing needs new links.
+		if current.Note.Status == note.StatusPublish {
+			if err := u.links.RevokeByNote(txCtx, input.ID, now); err != nil {
+				return err
+			}
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteStatusEvent(current.Note.Status, input.Status), *updated, now))
+	})
+	if err != nil {
 		return err
 	}
 	n, err := u.notes.Get(ctx, input.ID)
@@ -188,34 +298,282 @@ func (u *NoteInteractor) ChangeStatus(ctx context.Context, input port.NoteStatus
 }
 
 // Delete deletes a note.
-func (u *NoteInteractor) Delete(ctx context.Context, id, ownerID string) error {
-	current, err := u.notes.Get(ctx, id)
+func (u *NoteInteractor) Delete(ctx context.Context, input port.NoteDeleteInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.notes.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	if err := note.ValidateNoteOwnership(current.Note.OwnerID, ownerID); err != nil {
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, input.OwnerID); err != nil {
 		return err
 	}
-	if err := u.notes.Delete(ctx, id); err != nil {
+	if err := authorize(ctx, u.shares, share.ResourceNote, input.ID, current.Note.OwnerID, input.OwnerID, share.RoleOwner); err != nil {
+		return err
+	}
+	if err := current.Note.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.notes.Delete(txCtx, input.ID, current.Note.Version); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteDeleted, current.Note, time.Now()))
+	})
+	if err != nil {
 		return err
 	}
 	return u.output.PresentNoteDeleted(ctx)
 }
 
-func buildSections(noteID string, inputs []port.SectionInput) ([]note.Section, error) {
-	if len(inputs) == 0 {
-		return nil, domainerr.ErrSectionsMissing
+// Restore takes a note out of the trash.
+func (u *NoteInteractor) Restore(ctx context.Context, input port.NoteRestoreInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	trashed, err := u.notes.GetTrashed(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, trashed.Note.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceNote, input.ID, trashed.Note.OwnerID, input.OwnerID, share.RoleOwner); err != nil {
+		return err
+	}
+	if err := trashed.Note.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	// ルール: テンプレートがゴミ箱にある間はノートを復元できない
+	if _, err := u.templates.Get(ctx, trashed.Note.TemplateID); err != nil {
+		if errors.Is(err, domainerr.ErrNotFound) {
+			return domainerr.ErrTemplateTrashed
+		}
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		restored, err := u.notes.Restore(txCtx, input.ID, trashed.Note.Version)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteRestored, *restored, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
 	}
+	return u.output.PresentNote(ctx, n)
+}
 
+// ListRevisions returns the revision history of a note, newest first.
+func (u *NoteInteractor) ListRevisions(ctx context.Context, noteID, actorID string) error {
+	current, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, actorID); err != nil {
+		return err
+	}
+	revisions, err := u.notes.ListRevisions(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteRevisions(ctx, revisions)
+}
+
+// DiffRevisions compares two revisions of a note field by field.
+func (u *NoteInteractor) DiffRevisions(ctx context.Context, input port.NoteRevisionDiffInput) error {
+	current, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, input.ActorID); err != nil {
+		return err
+	}
+	from, err := u.notes.GetRevision(ctx, input.NoteID, input.From)
+	if err != nil {
+		return err
+	}
+	to, err := u.notes.GetRevision(ctx, input.NoteID, input.To)
+	if err != nil {
+		return err
+	}
+	fields, err := u.templates.ListFields(ctx, current.Note.TemplateID, current.Note.SchemaVersion)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteRevisionDiff(ctx, note.DiffRevisions(*from, *to, fields))
+}
+
+// RestoreRevision writes the content of a prior revision back as a new revision.
+func (u *NoteInteractor) RestoreRevision(ctx context.Context, input port.NoteRevisionRestoreInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceNote, input.NoteID, current.Note.OwnerID, input.OwnerID, share.RoleEditor); err != nil {
+		return err
+	}
+	if err := current.Note.Status.CanEdit(); err != nil {
+		return err
+	}
+	rev, err := u.notes.GetRevision(ctx, input.NoteID, input.Revision)
+	if err != nil {
+		return err
+	}
+	fields, err := u.templates.ListFields(ctx, current.Note.TemplateID, current.Note.SchemaVersion)
+	if err != nil {
+		return err
+	}
+	// ルール: 復元内容も通常の更新と同じくテンプレートに対して検証する
+	if strings.TrimSpace(rev.Title) == "" {
+		return domainerr.ErrTitleRequired
+	}
+	sections := rev.RestoreSections(current.Sections)
+	if err := note.ValidateSections(fields, sections); err != nil {
+		return err
+	}
+
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.Update(txCtx, note.Note{ID: input.NoteID, Title: rev.Title, Version: current.Note.Version})
+		if err != nil {
+			return err
+		}
+		if err := u.notes.ReplaceSections(txCtx, input.NoteID, sections); err != nil {
+			return err
+		}
+		if _, err := u.notes.AppendRevision(txCtx, note.NewRevision(input.NoteID, input.OwnerID, rev.Title, sections)); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteUpdated, *updated, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNote(ctx, n)
+}
+
+// Upgrade moves a note to the latest schema version of its template, carrying sections over by field key.
+func (u *NoteInteractor) Upgrade(ctx context.Context, input port.NoteUpgradeInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, current.Note.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceNote, input.ID, current.Note.OwnerID, input.OwnerID, share.RoleEditor); err != nil {
+		return err
+	}
+	if err := current.Note.Status.CanEdit(); err != nil {
+		return err
+	}
+	if err := current.Note.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	tpl, err := u.templates.Get(ctx, current.Note.TemplateID)
+	if err != nil {
+		return err
+	}
+	if current.Note.SchemaVersion == tpl.Template.SchemaVersion {
+		return u.output.PresentNote(ctx, current)
+	}
+
+	content := make(map[string]string, len(input.Sections))
+	for _, s := range input.Sections {
+		content[s.FieldID] = s.Content
+	}
+	sections, err := note.UpgradeSections(current.Note.ID, current.Sections, tpl.Template.Fields, content)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateSections(tpl.Template.Fields, sections); err != nil {
+		return err
+	}
+
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.UpdateSchemaVersion(txCtx, input.ID, tpl.Template.SchemaVersion, current.Note.Version)
+		if err != nil {
+			return err
+		}
+		if err := u.notes.ReplaceSections(txCtx, input.ID, sections); err != nil {
+			return err
+		}
+		if _, err := u.notes.AppendRevision(txCtx, note.NewRevision(input.ID, input.OwnerID, current.Note.Title, sections)); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteUpdated, *updated, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNote(ctx, n)
+}
+
+// insert stores a new draft note on tpl with its sections and first revision in one transaction
+// and returns its ID. Callers validate the note first; the created event is recorded in the same transaction.
+func (u *NoteInteractor) insert(ctx context.Context, title string, tpl template.Template, ownerID string, sections []note.Section) (string, error) {
+	var noteID string
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		newNote := note.Note{
+			Title:      title,
+			TemplateID: tpl.ID,
+			OwnerID:    ownerID,
+			Status:     note.StatusDraft,
+			Sections:   sections,
+			// ルール: ノートは作成時点のテンプレートのスキーマバージョンに固定される
+			SchemaVersion: tpl.SchemaVersion,
+			WorkspaceID:   tpl.WorkspaceID,
+		}
+		nn, err := u.notes.Create(txCtx, newNote)
+		if err != nil {
+			return err
+		}
+		noteID = nn.ID
+		for i := range sections {
+			sections[i].NoteID = noteID
+		}
+		if err := u.notes.ReplaceSections(txCtx, noteID, sections); err != nil {
+			return err
+		}
+		if _, err := u.notes.AppendRevision(txCtx, note.NewRevision(noteID, ownerID, title, sections)); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteCreated, *nn, time.Now()))
+	})
+	return noteID, err
+}
+
+func buildSections(inputs []port.SectionInput) []note.Section {
 	sections := make([]note.Section, 0, len(inputs))
 	for _, s := range inputs {
 		sections = append(sections, note.Section{
 			FieldID: s.FieldID,
-			NoteID:  noteID,
 			Content: s.Content,
 		})
 	}
-	return sections, nil
+	return sections
 }
 
 // buildSectionsForUpdate maps update inputs to sections using existing sections' field IDs.
@@ -3,34 +3,66 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/account"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/share"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
 	"immortal-architecture-clean/backend/internal/port"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
 )
 
 func TestNoteInteractor_List(t *testing.T) {
+	now := time.Now()
+	page := []note.WithMeta{
+		{Note: note.Note{ID: "n1", UpdatedAt: now}},
+		{Note: note.Note{ID: "n2", UpdatedAt: now.Add(-time.Minute)}},
+		{Note: note.Note{ID: "n3", UpdatedAt: now.Add(-2 * time.Minute)}},
+	}
 	tests := []struct {
-		name      string
-		filters   note.Filters
-		result    []note.WithMeta
-		repoErr   error
-		wantError error
+		name        string
+		filters     note.Filters
+		repoLimit   int
+		result      []note.WithMeta
+		repoErr     error
+		wantCount   int
+		wantHasMore bool
+		wantError   error
 	}{
 		{
-			name:    "[Success] list notes",
-			filters: note.Filters{OwnerID: strPtr("owner")},
-			result:  []note.WithMeta{{Note: note.Note{ID: "n1"}}},
+			name:      "[Success] default page size",
+			filters:   note.Filters{OwnerID: strPtr("owner")},
+			repoLimit: pagination.DefaultLimit + 1,
+			result:    page[:1],
+			wantCount: 1,
+		},
+		{
+			name:        "[Success] extra row sets hasMore",
+			filters:     note.Filters{Limit: 2},
+			repoLimit:   3,
+			result:      page,
+			wantCount:   2,
+			wantHasMore: true,
+		},
+		{
+			name:      "[Fail] limit above max",
+			filters:   note.Filters{Limit: pagination.MaxLimit + 1},
+			wantError: domainerr.ErrInvalidPageLimit,
 		},
 		{
 			name:      "[Fail] repo error",
 			filters:   note.Filters{},
+			repoLimit: pagination.DefaultLimit + 1,
 			repoErr:   errors.New("repo err"),
 			wantError: errors.New("repo err"),
 		},
@@ -46,12 +78,26 @@ func TestNoteInteractor_List(t *testing.T) {
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
-			notes.EXPECT().List(gomock.Any(), tt.filters).Return(tt.result, tt.repoErr)
-			if tt.repoErr == nil {
-				out.EXPECT().PresentNoteList(gomock.Any(), tt.result).Return(nil)
+			if tt.repoLimit > 0 {
+				want := tt.filters
+				want.Limit = tt.repoLimit
+				notes.EXPECT().List(gomock.Any(), want).Return(tt.result, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteList(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, got []note.WithMeta, info pagination.Info) error {
+						if len(got) != tt.wantCount || info.HasMore != tt.wantHasMore {
+							t.Fatalf("got %d notes hasMore=%v", len(got), info.HasMore)
+						}
+						if info.HasMore && (info.NextCursor == nil || info.NextCursor.ID != got[len(got)-1].Note.ID) {
+							t.Fatalf("next cursor must point at the last item: %+v", info.NextCursor)
+						}
+						return nil
+					},
+				)
 			}
 
-			interactor := uc.NewNoteInteractor(notes, templates, tx, out)
+			interactor := uc.NewNoteInteractor(notes, templates, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
 			err := interactor.List(context.Background(), tt.filters)
 
 			if tt.wantError == nil && err != nil {
@@ -68,18 +114,34 @@ func TestNoteInteractor_Get(t *testing.T) {
 	tests := []struct {
 		name      string
 		id        string
+		actorID   string
 		result    *note.WithMeta
 		repoErr   error
 		wantError error
 	}{
 		{
-			name:   "[Success] get note",
-			id:     "n1",
-			result: &note.WithMeta{Note: note.Note{ID: "n1"}},
+			name:    "[Success] get note",
+			id:      "n1",
+			actorID: "owner",
+			result:  &note.WithMeta{Note: note.Note{ID: "n1", WorkspaceID: "ws-1"}},
+		},
+		{
+			name:      "[Fail] no actor",
+			id:        "n1",
+			result:    &note.WithMeta{Note: note.Note{ID: "n1", WorkspaceID: "ws-1"}},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] not a workspace member",
+			id:        "n1",
+			actorID:   "outsider",
+			result:    &note.WithMeta{Note: note.Note{ID: "n1", WorkspaceID: "ws-1"}},
+			wantError: domainerr.ErrNotFound,
 		},
 		{
 			name:      "[Fail] not found",
 			id:        "missing",
+			actorID:   "owner",
 			repoErr:   domainerr.ErrNotFound,
 			wantError: domainerr.ErrNotFound,
 		},
@@ -95,13 +157,25 @@ func TestNoteInteractor_Get(t *testing.T) {
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+
 			notes.EXPECT().Get(gomock.Any(), tt.id).Return(tt.result, tt.repoErr)
-			if tt.repoErr == nil {
+			if tt.repoErr == nil && tt.actorID != "" {
+				var member *workspace.Member
+				var memberErr error
+				if tt.wantError == nil {
+					member = &workspace.Member{WorkspaceID: "ws-1", AccountID: tt.actorID, Role: workspace.RoleMember}
+				} else {
+					memberErr = domainerr.ErrNotFound
+				}
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", tt.actorID).Return(member, memberErr)
+			}
+			if tt.wantError == nil {
 				out.EXPECT().PresentNote(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notes, templates, tx, out)
-			err := interactor.Get(context.Background(), tt.id)
+			interactor := uc.NewNoteInteractor(notes, templates, activeAccounts(ctrl), noShares(ctrl), workspaces, anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.Get(context.Background(), tt.id, tt.actorID)
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -123,8 +197,11 @@ func TestNoteInteractor_Create(t *testing.T) {
 		getTplErr   error
 		createErr   error
 		replaceErr  error
+		revisionErr error
 		wantError   error
 		expectTxRun bool
+		// wantSections, when set, is what ReplaceSections must receive.
+		wantSections []note.Section
 	}{
 		{
 			name: "[Success] create with sections",
@@ -135,12 +212,34 @@ func TestNoteInteractor_Create(t *testing.T) {
 				Sections:   validSections,
 			},
 			tpl: &template.WithUsage{
-				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields},
+				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields, SchemaVersion: 3},
+			},
+			expectTxRun: true,
+		},
+		{
+			name: "[Success] omitted sections take field defaults",
+			input: port.NoteCreateInput{
+				Title:      "Hello",
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-1",
+				Sections:   []port.SectionInput{{FieldID: "f1", Content: "given"}},
+			},
+			tpl: &template.WithUsage{
+				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: []template.Field{
+					{ID: "f1", Label: "Title", Order: 1, DefaultContent: "unused"},
+					{ID: "f2", Label: "Status", Order: 2, IsRequired: true, DefaultContent: "open"},
+					{ID: "f3", Label: "Notes", Order: 3},
+				}},
 			},
 			expectTxRun: true,
+			wantSections: []note.Section{
+				{FieldID: "f1", NoteID: "note-1", Content: "given"},
+				{FieldID: "f2", NoteID: "note-1", Content: "open"},
+				{FieldID: "f3", NoteID: "note-1", Content: ""},
+			},
 		},
 		{
-			name: "[Fail] sections missing",
+			name: "[Fail] omitted required field without default",
 			input: port.NoteCreateInput{
 				Title:      "Hello",
 				TemplateID: "tpl-1",
@@ -148,9 +247,9 @@ func TestNoteInteractor_Create(t *testing.T) {
 				Sections:   nil,
 			},
 			tpl: &template.WithUsage{
-				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields},
+				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: []template.Field{{ID: "f1", Label: "Title", Order: 1, IsRequired: true}}},
 			},
-			wantError: domainerr.ErrSectionsMissing,
+			wantError: violation("sections[f1].content", domainerr.CodeRequired, domainerr.ErrRequiredFieldEmpty),
 		},
 		{
 			name: "[Fail] template get error",
@@ -200,6 +299,19 @@ func TestNoteInteractor_Create(t *testing.T) {
 			wantError:   errors.New("replace err"),
 			expectTxRun: true,
 		},
+		{
+			name: "[Fail] revision error",
+			input: port.NoteCreateInput{
+				Title:      "Hello",
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-1",
+				Sections:   validSections,
+			},
+			tpl:         &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields}},
+			revisionErr: errors.New("revision err"),
+			wantError:   errors.New("revision err"),
+			expectTxRun: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -221,9 +333,33 @@ func TestNoteInteractor_Create(t *testing.T) {
 				)
 			}
 			if tt.getTplErr == nil && tt.expectTxRun {
-				notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&note.Note{ID: "note-1", TemplateID: tt.input.TemplateID, OwnerID: tt.input.OwnerID}, tt.createErr)
+				notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, n note.Note) (*note.Note, error) {
+						if n.SchemaVersion != tt.tpl.Template.SchemaVersion {
+							t.Fatalf("note must be pinned to the template schema version: got %d, want %d", n.SchemaVersion, tt.tpl.Template.SchemaVersion)
+						}
+						return &note.Note{ID: "note-1", TemplateID: tt.input.TemplateID, OwnerID: tt.input.OwnerID, SchemaVersion: n.SchemaVersion}, tt.createErr
+					},
+				)
 				if tt.createErr == nil {
-					notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", gomock.Any()).Return(tt.replaceErr)
+					notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ string, sections []note.Section) error {
+							if tt.wantSections != nil && !reflect.DeepEqual(sections, tt.wantSections) {
+								t.Fatalf("sections = %+v, want %+v", sections, tt.wantSections)
+							}
+							return tt.replaceErr
+						},
+					)
+				}
+				if tt.createErr == nil && tt.replaceErr == nil {
+					notesRepo.EXPECT().AppendRevision(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, rev note.Revision) (*note.Revision, error) {
+							if rev.NoteID != "note-1" || rev.ActorID != tt.input.OwnerID || rev.Title != tt.input.Title || len(rev.Sections) != len(tt.tpl.Template.Fields) {
+								t.Fatalf("unexpected revision: %+v", rev)
+							}
+							return &rev, tt.revisionErr
+						},
+					)
 				}
 			}
 			if tt.getTplErr == nil && tt.createErr == nil && tt.replaceErr == nil && tt.wantError == nil {
@@ -231,7 +367,7 @@ func TestNoteInteractor_Create(t *testing.T) {
 				out.EXPECT().PresentNote(gomock.Any(), gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
 			err := interactor.Create(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -259,6 +395,7 @@ func TestNoteInteractor_Update(t *testing.T) {
 		name         string
 		input        port.NoteUpdateInput
 		current      *note.WithMeta
+		grant        *share.Grant
 		getErr       error
 		updateErr    error
 		replaceErr   error
@@ -298,6 +435,43 @@ func TestNoteInteractor_Update(t *testing.T) {
 			expectTxRun:  true,
 			withSections: true,
 		},
+		{
+			name: "[Success] matching If-Match version",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				Version: 3,
+			},
+			current: &note.WithMeta{
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Version: 3},
+				Sections: existingSections,
+			},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] stale If-Match version",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				Version: 2,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Version: 3}},
+			wantError: domainerr.ErrVersionConflict,
+		},
+		{
+			name: "[Fail] concurrent write between read and update",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+			},
+			current:     &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Version: 3}},
+			updateErr:   domainerr.ErrVersionConflict,
+			wantError:   domainerr.ErrVersionConflict,
+			expectTxRun: true,
+		},
 		{
 			name: "[Fail] owner mismatch",
 			input: port.NoteUpdateInput{
@@ -308,6 +482,51 @@ func TestNoteInteractor_Update(t *testing.T) {
 			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
 			wantError: domainerr.ErrUnauthorized,
 		},
+		{
+			name: "[Success] editor of a shared note",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "editor-1",
+			},
+			current:     &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"}},
+			grant:       &share.Grant{AccountID: "editor-1", Role: share.RoleEditor},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] viewer of a shared note",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "viewer-1",
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
+			grant:     &share.Grant{AccountID: "viewer-1", Role: share.RoleViewer},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name: "[Fail] note in review",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusInReview}},
+			wantError: domainerr.ErrNoteLockedForReview,
+		},
+		{
+			name: "[Fail] approved note",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				Sections: []port.SectionUpdateInput{
+					{SectionID: "sec1", Content: "updated"},
+				},
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusApproved}, Sections: existingSections},
+			wantError: domainerr.ErrNoteLockedForReview,
+		},
 		{
 			name: "[Fail] empty title",
 			input: port.NoteUpdateInput{
@@ -373,24 +592,48 @@ func TestNoteInteractor_Update(t *testing.T) {
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
+			txCtx := context.WithValue(context.Background(), txCtxKey{}, "tx")
 			if tt.getErr == nil && tt.expectTxRun {
 				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(_ context.Context, fn func(context.Context) error) error {
-						return fn(context.Background())
+						return fn(txCtx)
+					},
+				)
+				notesRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, n note.Note) (*note.Note, error) {
+						if n.Version != tt.current.Note.Version {
+							t.Fatalf("update must be conditional on the read version: got %d, want %d", n.Version, tt.current.Note.Version)
+						}
+						return &tt.current.Note, tt.updateErr
 					},
 				)
-				notesRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&tt.current.Note, tt.updateErr)
 				if tt.updateErr == nil && tt.withSections {
-					tplRepo.EXPECT().Get(gomock.Any(), tt.current.Note.TemplateID).Return(tt.tpl, nil)
+					tplRepo.EXPECT().ListFields(txCtx, tt.current.Note.TemplateID, tt.current.Note.SchemaVersion).Return(tt.tpl.Template.Fields, nil)
 					notesRepo.EXPECT().ReplaceSections(gomock.Any(), tt.input.ID, gomock.Any()).Return(tt.replaceErr)
 				}
+				if tt.updateErr == nil && (!tt.withSections || tt.replaceErr == nil) {
+					notesRepo.EXPECT().AppendRevision(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, rev note.Revision) (*note.Revision, error) {
+							if rev.Title != tt.input.Title || rev.ActorID != tt.input.OwnerID || len(rev.Sections) != len(tt.current.Sections) {
+								t.Fatalf("revision must snapshot the full note: %+v", rev)
+							}
+							return &rev, nil
+						},
+					)
+				}
 			}
 			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && (!tt.withSections || tt.replaceErr == nil) {
 				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
 				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			shares := noShares(ctrl)
+			if tt.grant != nil {
+				shares = mockusecase.NewMockShareRepository(ctrl)
+				shares.EXPECT().Get(gomock.Any(), share.ResourceNote, tt.input.ID, tt.input.OwnerID).Return(tt.grant, nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), shares, allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
 			err := interactor.Update(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -404,14 +647,59 @@ func TestNoteInteractor_Update(t *testing.T) {
 }
 
 func TestNoteInteractor_ChangeStatus(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour)
 	tests := []struct {
 		name      string
 		input     port.NoteStatusChangeInput
 		current   *note.WithMeta
+		workflow  note.Workflow
 		getErr    error
 		updateErr error
+		wantEvent string
 		wantError error
 	}{
+		{
+			name: "[Success] publish approved note when review is required",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusPublish,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusApproved}},
+			workflow:  note.Workflow{RequireReview: true},
+			wantEvent: outbox.NotePublished,
+		},
+		{
+			name: "[Success] withdraw from review",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusDraft,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusInReview}},
+			wantEvent: outbox.NoteReviewWithdrawn,
+		},
+		{
+			name: "[Fail] draft cannot skip required review",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusPublish,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft}},
+			workflow:  note.Workflow{RequireReview: true},
+			wantError: domainerr.ErrReviewRequired,
+		},
+		{
+			name: "[Fail] review statuses are not set directly",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusApproved,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusInReview}},
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
 		{
 			name: "[Success] publish",
 			input: port.NoteStatusChangeInput{
@@ -419,7 +707,60 @@ func TestNoteInteractor_ChangeStatus(t *testing.T) {
 				OwnerID: "owner-1",
 				Status:  note.StatusPublish,
 			},
-			current: &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft}},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft}},
+			wantEvent: outbox.NotePublished,
+		},
+		{
+			name: "[Success] unpublish revokes public links",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusDraft,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusPublish}},
+			wantEvent: outbox.NoteUnpublished,
+		},
+		{
+			name: "[Success] schedule",
+			input: port.NoteStatusChangeInput{
+				ID:       "note-1",
+				OwnerID:  "owner-1",
+				Status:   note.StatusScheduled,
+				Schedule: note.Schedule{PublishAt: &publishAt},
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft}},
+			wantEvent: outbox.NoteScheduled,
+		},
+		{
+			name: "[Success] cancel schedule",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusDraft,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusScheduled, PublishAt: &publishAt}},
+			wantEvent: outbox.NoteUnscheduled,
+		},
+		{
+			name: "[Fail] schedule without publish time",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusScheduled,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft}},
+			wantError: errors.New("publishAt: " + domainerr.ErrPublishAtRequired.Error()),
+		},
+		{
+			name: "[Fail] unpublish time on a draft",
+			input: port.NoteStatusChangeInput{
+				ID:       "note-1",
+				OwnerID:  "owner-1",
+				Status:   note.StatusDraft,
+				Schedule: note.Schedule{UnpublishAt: &publishAt},
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusPublish}},
+			wantError: errors.New("unpublishAt: " + domainerr.ErrScheduleNotAllowed.Error()),
 		},
 		{
 			name: "[Fail] owner mismatch",
@@ -441,6 +782,17 @@ func TestNoteInteractor_ChangeStatus(t *testing.T) {
 			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft}},
 			wantError: domainerr.ErrInvalidStatus,
 		},
+		{
+			name: "[Fail] stale If-Match version",
+			input: port.NoteStatusChangeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Status:  note.StatusPublish,
+				Version: 1,
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft, Version: 2}},
+			wantError: domainerr.ErrVersionConflict,
+		},
 		{
 			name: "[Fail] update status error",
 			input: port.NoteStatusChangeInput{
@@ -466,15 +818,22 @@ func TestNoteInteractor_ChangeStatus(t *testing.T) {
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
 			shouldUpdate := tt.getErr == nil && (tt.wantError == nil || (tt.updateErr != nil && tt.wantError.Error() == tt.updateErr.Error()))
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
 			if shouldUpdate {
-				notesRepo.EXPECT().UpdateStatus(gomock.Any(), tt.input.ID, tt.input.Status).Return(&tt.current.Note, tt.updateErr)
+				passThroughTx(tx)
+				notesRepo.EXPECT().UpdateStatus(gomock.Any(), tt.input.ID, tt.input.Status, tt.input.Schedule, tt.current.Note.Version).Return(&tt.current.Note, tt.updateErr)
+			}
+			links := mockusecase.NewMockShareLinkRepository(ctrl)
+			if shouldUpdate && tt.updateErr == nil && tt.current.Note.Status == note.StatusPublish && tt.input.Status != note.StatusPublish {
+				links.EXPECT().RevokeByNote(gomock.Any(), tt.input.ID, gomock.Any()).Return(nil)
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.updateErr == nil {
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(tt.wantEvent, tt.input.ID)).Return(nil)
 				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
 				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), links, tx, publisher, tt.workflow, out)
 			err := interactor.ChangeStatus(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -492,6 +851,7 @@ func TestNoteInteractor_Delete(t *testing.T) {
 		name      string
 		id        string
 		ownerID   string
+		version   int
 		current   *note.WithMeta
 		getErr    error
 		deleteErr error
@@ -519,6 +879,14 @@ func TestNoteInteractor_Delete(t *testing.T) {
 			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
 			wantError: domainerr.ErrUnauthorized,
 		},
+		{
+			name:      "[Fail] stale If-Match version",
+			id:        "note-1",
+			ownerID:   "owner-1",
+			version:   4,
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Version: 5}},
+			wantError: domainerr.ErrVersionConflict,
+		},
 		{
 			name:      "[Fail] delete error",
 			id:        "note-1",
@@ -541,15 +909,124 @@ func TestNoteInteractor_Delete(t *testing.T) {
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.current, tt.getErr)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
 			if tt.getErr == nil && tt.expectDel {
-				notesRepo.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.deleteErr)
+				passThroughTx(tx)
+				notesRepo.EXPECT().Delete(gomock.Any(), tt.id, tt.current.Note.Version).Return(tt.deleteErr)
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.deleteErr == nil {
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.NoteDeleted, tt.id)).Return(nil)
 				out.EXPECT().PresentNoteDeleted(gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
-			err := interactor.Delete(context.Background(), tt.id, tt.ownerID)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, publisher, note.Workflow{}, out)
+			err := interactor.Delete(context.Background(), port.NoteDeleteInput{ID: tt.id, OwnerID: tt.ownerID, Version: tt.version})
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_Restore(t *testing.T) {
+	trashed := func() *note.WithMeta {
+		return &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Version: 3}}
+	}
+	tests := []struct {
+		name        string
+		ownerID     string
+		version     int
+		current     *note.WithMeta
+		getErr      error
+		templateErr error
+		restoreErr  error
+		wantError   error
+		expectTpl   bool
+		expectRest  bool
+	}{
+		{
+			name:       "[Success] restore",
+			ownerID:    "owner-1",
+			current:    trashed(),
+			expectTpl:  true,
+			expectRest: true,
+		},
+		{
+			name:       "[Success] restore with matching If-Match version",
+			ownerID:    "owner-1",
+			version:    3,
+			current:    trashed(),
+			expectTpl:  true,
+			expectRest: true,
+		},
+		{
+			name:      "[Fail] not in trash",
+			ownerID:   "owner-1",
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] owner mismatch",
+			ownerID:   "other",
+			current:   trashed(),
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] stale If-Match version",
+			ownerID:   "owner-1",
+			version:   2,
+			current:   trashed(),
+			wantError: domainerr.ErrVersionConflict,
+		},
+		{
+			name:        "[Fail] template in trash",
+			ownerID:     "owner-1",
+			current:     trashed(),
+			templateErr: domainerr.ErrNotFound,
+			wantError:   domainerr.ErrTemplateTrashed,
+			expectTpl:   true,
+		},
+		{
+			name:       "[Fail] restore error",
+			ownerID:    "owner-1",
+			current:    trashed(),
+			restoreErr: errors.New("restore err"),
+			wantError:  errors.New("restore err"),
+			expectTpl:  true,
+			expectRest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().GetTrashed(gomock.Any(), "note-1").Return(tt.current, tt.getErr)
+			if tt.expectTpl {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(&template.WithUsage{Template: template.Template{ID: "tpl-1"}}, tt.templateErr)
+			}
+			if tt.expectRest {
+				passThroughTx(tx)
+				notesRepo.EXPECT().Restore(gomock.Any(), "note-1", 3).Return(&note.Note{ID: "note-1", Version: 4}, tt.restoreErr)
+			}
+			if tt.wantError == nil {
+				restored := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Version: 4}}
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(restored, nil)
+				out.EXPECT().PresentNote(gomock.Any(), restored).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.Restore(context.Background(), port.NoteRestoreInput{ID: "note-1", OwnerID: tt.ownerID, Version: tt.version})
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -562,3 +1039,489 @@ func TestNoteInteractor_Delete(t *testing.T) {
 }
 
 // b2i converts bool to int for Times() convenience.
+
+func TestNoteInteractor_InactiveActor(t *testing.T) {
+	tests := []struct {
+		name      string
+		account   *account.Account
+		repoErr   error
+		call      func(u *uc.NoteInteractor) error
+		wantError error
+	}{
+		{
+			name:    "[Fail] create by inactive account",
+			account: &account.Account{ID: "owner", IsActive: false},
+			call: func(u *uc.NoteInteractor) error {
+				return u.Create(context.Background(), port.NoteCreateInput{Title: "t", TemplateID: "tpl", OwnerID: "owner"})
+			},
+			wantError: domainerr.ErrAccountInactive,
+		},
+		{
+			name:    "[Fail] update by inactive account",
+			account: &account.Account{ID: "owner", IsActive: false},
+			call: func(u *uc.NoteInteractor) error {
+				return u.Update(context.Background(), port.NoteUpdateInput{ID: "n1", Title: "t", OwnerID: "owner"})
+			},
+			wantError: domainerr.ErrAccountInactive,
+		},
+		{
+			name:    "[Fail] publish by inactive account",
+			account: &account.Account{ID: "owner", IsActive: false},
+			call: func(u *uc.NoteInteractor) error {
+				return u.ChangeStatus(context.Background(), port.NoteStatusChangeInput{ID: "n1", Status: note.StatusPublish, OwnerID: "owner"})
+			},
+			wantError: domainerr.ErrAccountInactive,
+		},
+		{
+			name:    "[Fail] delete by inactive account",
+			account: &account.Account{ID: "owner", IsActive: false},
+			call: func(u *uc.NoteInteractor) error {
+				return u.Delete(context.Background(), port.NoteDeleteInput{ID: "n1", OwnerID: "owner"})
+			},
+			wantError: domainerr.ErrAccountInactive,
+		},
+		{
+			name:    "[Fail] unknown account",
+			repoErr: domainerr.ErrNotFound,
+			call: func(u *uc.NoteInteractor) error {
+				return u.Delete(context.Background(), port.NoteDeleteInput{ID: "n1", OwnerID: "owner"})
+			},
+			wantError: domainerr.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			accounts.EXPECT().GetByID(gomock.Any(), "owner").Return(tt.account, tt.repoErr)
+
+			// No note, template or transaction calls are expected once the actor is refused.
+			interactor := uc.NewNoteInteractor(
+				mockusecase.NewMockNoteRepository(ctrl),
+				mockusecase.NewMockTemplateRepository(ctrl),
+				accounts,
+				mockusecase.NewMockShareRepository(ctrl),
+				mockusecase.NewMockWorkspaceRepository(ctrl),
+				mockusecase.NewMockShareLinkRepository(ctrl),
+				mockusecase.NewMockTxManager(ctrl),
+				mockusecase.NewMockEventPublisher(ctrl),
+				note.Workflow{},
+				mockusecase.NewMockNoteOutputPort(ctrl),
+			)
+			if err := tt.call(interactor); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_ListRevisions(t *testing.T) {
+	tests := []struct {
+		name      string
+		getErr    error
+		listErr   error
+		wantError error
+	}{
+		{name: "[Success] list revisions"},
+		{name: "[Fail] note not found", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] repo error", listErr: errors.New("list err"), wantError: errors.New("list err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+			revisions := []note.Revision{{NoteID: "note-1", Number: 2}, {NoteID: "note-1", Number: 1}}
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1"}}, tt.getErr)
+			if tt.getErr == nil {
+				notesRepo.EXPECT().ListRevisions(gomock.Any(), "note-1").Return(revisions, tt.listErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteRevisions(gomock.Any(), revisions).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, nil, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), nil, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.ListRevisions(context.Background(), "note-1", "owner")
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_DiffRevisions(t *testing.T) {
+	fields := []template.Field{{ID: "f1", Label: "Body", Order: 1}}
+	rev1 := &note.Revision{Number: 1, Title: "a", Sections: []note.RevisionSection{{FieldID: "f1", Content: "x"}}}
+	rev2 := &note.Revision{Number: 2, Title: "a", Sections: []note.RevisionSection{{FieldID: "f1", Content: "y"}}}
+	tests := []struct {
+		name      string
+		toErr     error
+		wantError error
+	}{
+		{name: "[Success] diff two revisions"},
+		{name: "[Fail] unknown revision", toErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", TemplateID: "tpl-1", SchemaVersion: 1}}, nil)
+			notesRepo.EXPECT().GetRevision(gomock.Any(), "note-1", 1).Return(rev1, nil)
+			notesRepo.EXPECT().GetRevision(gomock.Any(), "note-1", 2).Return(rev2, tt.toErr)
+			if tt.wantError == nil {
+				tplRepo.EXPECT().ListFields(gomock.Any(), "tpl-1", 1).Return(fields, nil)
+				out.EXPECT().PresentNoteRevisionDiff(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, diff note.RevisionDiff) error {
+						if diff.Title.Changed || len(diff.Sections) != 1 || !diff.Sections[0].Changed || diff.Sections[0].FieldLabel != "Body" {
+							t.Fatalf("unexpected diff: %+v", diff)
+						}
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), nil, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.DiffRevisions(context.Background(), port.NoteRevisionDiffInput{NoteID: "note-1", From: 1, To: 2, ActorID: "owner-1"})
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_RestoreRevision(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f1", Label: "Body", Order: 1, IsRequired: true},
+	}
+	current := &note.WithMeta{
+		Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Title: "now"},
+		Sections: []note.SectionWithField{{Section: note.Section{ID: "sec1", NoteID: "note-1", FieldID: "f1", Content: "now"}}},
+	}
+	tests := []struct {
+		name       string
+		ownerID    string
+		revision   *note.Revision
+		tplFields  []template.Field
+		replaceErr error
+		wantError  error
+	}{
+		{
+			name:      "[Success] restore prior content",
+			ownerID:   "owner-1",
+			revision:  &note.Revision{NoteID: "note-1", Number: 1, Title: "then", Sections: []note.RevisionSection{{FieldID: "f1", Content: "then"}}},
+			tplFields: fields,
+		},
+		{
+			name:      "[Fail] not owner",
+			ownerID:   "other",
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] required field empty in revision",
+			ownerID:   "owner-1",
+			revision:  &note.Revision{NoteID: "note-1", Number: 1, Title: "then", Sections: []note.RevisionSection{{FieldID: "f1", Content: ""}}},
+			tplFields: fields,
+			wantError: violation("sections[f1].content", domainerr.CodeRequired, domainerr.ErrRequiredFieldEmpty),
+		},
+		{
+			name:      "[Fail] template changed since revision",
+			ownerID:   "owner-1",
+			revision:  &note.Revision{NoteID: "note-1", Number: 1, Title: "then", Sections: []note.RevisionSection{{FieldID: "f1", Content: "then"}}},
+			tplFields: []template.Field{{ID: "f2", Label: "New", Order: 1}},
+			wantError: &domainerr.ValidationError{Violations: []domainerr.Violation{
+				{Path: "sections[f1]", Code: domainerr.CodeInvalid, Err: domainerr.ErrSectionsMissing},
+				{Path: "sections[f2]", Code: domainerr.CodeRequired, Err: domainerr.ErrSectionsMissing},
+			}},
+		},
+		{
+			name:       "[Fail] replace sections error",
+			ownerID:    "owner-1",
+			revision:   &note.Revision{NoteID: "note-1", Number: 1, Title: "then", Sections: []note.RevisionSection{{FieldID: "f1", Content: "then"}}},
+			tplFields:  fields,
+			replaceErr: errors.New("replace err"),
+			wantError:  errors.New("replace err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			if tt.revision != nil {
+				notesRepo.EXPECT().GetRevision(gomock.Any(), "note-1", 1).Return(tt.revision, nil)
+				tplRepo.EXPECT().ListFields(gomock.Any(), "tpl-1", current.Note.SchemaVersion).Return(tt.tplFields, nil)
+			}
+			validRestore := tt.revision != nil && (tt.wantError == nil || tt.replaceErr != nil)
+			if validRestore {
+				passThroughTx(tx)
+				notesRepo.EXPECT().Update(gomock.Any(), note.Note{ID: "note-1", Title: "then"}).Return(&current.Note, nil)
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", []note.Section{{ID: "sec1", NoteID: "note-1", FieldID: "f1", Content: "then"}}).Return(tt.replaceErr)
+			}
+			if validRestore && tt.replaceErr == nil {
+				notesRepo.EXPECT().AppendRevision(gomock.Any(), gomock.Any()).Return(&note.Revision{Number: 3}, nil)
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+				out.EXPECT().PresentNote(gomock.Any(), current).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.RestoreRevision(context.Background(), port.NoteRevisionRestoreInput{NoteID: "note-1", Revision: 1, OwnerID: tt.ownerID})
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_Upgrade(t *testing.T) {
+	current := &note.WithMeta{
+		Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Title: "now", Version: 4, SchemaVersion: 1},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "sec1", NoteID: "note-1", FieldID: "f1", Content: "background"}, FieldKey: "k1"},
+			{Section: note.Section{ID: "sec2", NoteID: "note-1", FieldID: "f2", Content: "dropped"}, FieldKey: "k2"},
+		},
+	}
+	latest := &template.WithUsage{Template: template.Template{ID: "tpl-1", SchemaVersion: 2, Fields: []template.Field{
+		{ID: "f1-v2", Key: "k1", Label: "Background", Order: 1, IsRequired: true},
+		{ID: "f3", Key: "k3", Label: "Decision", Order: 2, IsRequired: true},
+	}}}
+	tests := []struct {
+		name       string
+		input      port.NoteUpgradeInput
+		tpl        *template.WithUsage
+		replaceErr error
+		want       []note.Section
+		wantError  error
+	}{
+		{
+			name:  "[Success] map sections by field key",
+			input: port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1", Version: 4, Sections: []port.SectionInput{{FieldID: "f3", Content: "ship it"}}},
+			tpl:   latest,
+			want: []note.Section{
+				{ID: "sec1", NoteID: "note-1", FieldID: "f1-v2", Content: "background"},
+				{NoteID: "note-1", FieldID: "f3", Content: "ship it"},
+			},
+		},
+		{
+			name:  "[Success] already on the latest schema version",
+			input: port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1"},
+			tpl:   &template.WithUsage{Template: template.Template{ID: "tpl-1", SchemaVersion: 1}},
+		},
+		{
+			name:      "[Fail] not owner",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "other"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] stale If-Match version",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1", Version: 3},
+			wantError: domainerr.ErrVersionConflict,
+		},
+		{
+			name:      "[Fail] new required field left empty",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1"},
+			tpl:       latest,
+			wantError: violation("sections[f3].content", domainerr.CodeRequired, domainerr.ErrRequiredFieldEmpty),
+		},
+		{
+			name:      "[Fail] content for unknown field",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1", Sections: []port.SectionInput{{FieldID: "f2", Content: "x"}}},
+			tpl:       latest,
+			wantError: domainerr.ErrSectionsMissing,
+		},
+		{
+			name:  "[Fail] replace sections error",
+			input: port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1", Sections: []port.SectionInput{{FieldID: "f3", Content: "ship it"}}},
+			tpl:   latest,
+			want: []note.Section{
+				{ID: "sec1", NoteID: "note-1", FieldID: "f1-v2", Content: "background"},
+				{NoteID: "note-1", FieldID: "f3", Content: "ship it"},
+			},
+			replaceErr: errors.New("replace err"),
+			wantError:  errors.New("replace err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			if tt.tpl != nil {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tt.tpl, nil)
+			}
+			if tt.want != nil {
+				passThroughTx(tx)
+				notesRepo.EXPECT().UpdateSchemaVersion(gomock.Any(), "note-1", 2, 4).Return(&current.Note, nil)
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", tt.want).Return(tt.replaceErr)
+			}
+			if tt.want != nil && tt.replaceErr == nil {
+				notesRepo.EXPECT().AppendRevision(gomock.Any(), gomock.Any()).Return(&note.Revision{Number: 5}, nil)
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNote(gomock.Any(), current).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.Upgrade(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_Duplicate(t *testing.T) {
+	source := &note.WithMeta{
+		Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", WorkspaceID: "ws-team", Title: "Week 42", Status: note.StatusPublish, Version: 7},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "sec1", NoteID: "note-1", FieldID: "f1", Content: "shipped"}, FieldKey: "k1", FieldLabel: "Summary", FieldOrder: 1},
+			{Section: note.Section{ID: "sec2", NoteID: "note-1", FieldID: "f2", Content: "none"}, FieldKey: "k2", FieldLabel: "Risks", FieldOrder: 2},
+		},
+	}
+	sameTpl := &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "Weekly", OwnerID: "owner-1", WorkspaceID: "ws-team", SchemaVersion: 3, Fields: []template.Field{
+		{ID: "f1", Key: "k1", Label: "Summary", Order: 1, IsRequired: true},
+		{ID: "f2", Key: "k2", Label: "Risks", Order: 2},
+	}}}
+	otherTpl := &template.WithUsage{Template: template.Template{ID: "tpl-2", Name: "Retro", OwnerID: "owner-2", WorkspaceID: "ws-owner", SchemaVersion: 1, Fields: []template.Field{
+		{ID: "g1", Key: "x1", Label: "summary", Order: 1, IsRequired: true},
+		{ID: "g2", Key: "x2", Label: "Next steps", Order: 3, DefaultContent: "tbd"},
+	}}}
+	strictTpl := &template.WithUsage{Template: template.Template{ID: "tpl-3", Name: "Decision", OwnerID: "owner-2", WorkspaceID: "ws-owner", Fields: []template.Field{
+		{ID: "h1", Key: "y1", Label: "Decision", Order: 5, IsRequired: true},
+	}}}
+	created := &note.WithMeta{Note: note.Note{ID: "note-2", OwnerID: "owner", Status: note.StatusDraft, Version: 1}}
+
+	tests := []struct {
+		name         string
+		input        port.NoteDuplicateInput
+		tpl          *template.WithUsage
+		wantNote     *note.Note
+		want         []note.Section
+		wantUnmapped []note.UnmappedSection
+		wantError    error
+	}{
+		{
+			name:     "[Success] copy onto the same template",
+			input:    port.NoteDuplicateInput{ID: "note-1", OwnerID: "owner"},
+			tpl:      sameTpl,
+			wantNote: &note.Note{Title: "Week 42", TemplateID: "tpl-1", OwnerID: "owner", Status: note.StatusDraft, SchemaVersion: 3, WorkspaceID: "ws-team"},
+			want:     []note.Section{{NoteID: "note-2", FieldID: "f1", Content: "shipped"}, {NoteID: "note-2", FieldID: "f2", Content: "none"}},
+		},
+		{
+			name:         "[Success] copy into another template reports unmapped content",
+			input:        port.NoteDuplicateInput{ID: "note-1", OwnerID: "owner", TemplateID: "tpl-2"},
+			tpl:          otherTpl,
+			wantNote:     &note.Note{Title: "Week 42", TemplateID: "tpl-2", OwnerID: "owner", Status: note.StatusDraft, SchemaVersion: 1, WorkspaceID: "ws-owner"},
+			want:         []note.Section{{NoteID: "note-2", FieldID: "g1", Content: "shipped"}, {NoteID: "note-2", FieldID: "g2", Content: "tbd"}},
+			wantUnmapped: []note.UnmappedSection{{FieldLabel: "Risks", Content: "none"}},
+		},
+		{
+			name:      "[Fail] owner missing",
+			input:     port.NoteDuplicateInput{ID: "note-1"},
+			wantError: domainerr.ErrOwnerRequired,
+		},
+		{
+			name:      "[Fail] required field of the target left empty",
+			input:     port.NoteDuplicateInput{ID: "note-1", OwnerID: "owner", TemplateID: "tpl-3"},
+			tpl:       strictTpl,
+			wantError: violation("sections[h1].content", domainerr.CodeRequired, domainerr.ErrRequiredFieldEmpty),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			if tt.input.OwnerID != "" {
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(source, nil)
+			}
+			if tt.tpl != nil {
+				tplRepo.EXPECT().Get(gomock.Any(), tt.tpl.Template.ID).Return(tt.tpl, nil)
+			}
+			if tt.wantNote != nil {
+				passThroughTx(tx)
+				notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n note.Note) (*note.Note, error) {
+					n.Sections = nil
+					if !reflect.DeepEqual(n, *tt.wantNote) {
+						t.Fatalf("note = %+v, want %+v", n, *tt.wantNote)
+					}
+					return &created.Note, nil
+				})
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-2", tt.want).Return(nil)
+				notesRepo.EXPECT().AppendRevision(gomock.Any(), gomock.Any()).Return(&note.Revision{Number: 1}, nil)
+				notesRepo.EXPECT().Get(gomock.Any(), "note-2").Return(created, nil)
+				out.EXPECT().PresentNoteDuplicated(gomock.Any(), created, tt.wantUnmapped).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), anyLinks(ctrl), tx, anyOutbox(ctrl), note.Workflow{}, out)
+			err := interactor.Duplicate(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_Duplicate_NotMemberOfTargetWorkspace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+	tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+	workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+	notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", TemplateID: "tpl-1", WorkspaceID: "ws-team"}}, nil)
+	tplRepo.EXPECT().Get(gomock.Any(), "tpl-2").Return(&template.WithUsage{Template: template.Template{ID: "tpl-2", WorkspaceID: "ws-other"}}, nil)
+	workspaces.EXPECT().GetMember(gomock.Any(), "ws-team", "owner").Return(&workspace.Member{WorkspaceID: "ws-team", AccountID: "owner"}, nil)
+	workspaces.EXPECT().GetMember(gomock.Any(), "ws-other", "owner").Return(nil, domainerr.ErrNotFound)
+
+	interactor := uc.NewNoteInteractor(notesRepo, tplRepo, activeAccounts(ctrl), noShares(ctrl), workspaces, anyLinks(ctrl), mockusecase.NewMockTxManager(ctrl), anyOutbox(ctrl), note.Workflow{}, mockusecase.NewMockNoteOutputPort(ctrl))
+	err := interactor.Duplicate(context.Background(), port.NoteDuplicateInput{ID: "note-1", OwnerID: "owner", TemplateID: "tpl-2"})
+	if !errors.Is(err, domainerr.ErrNotFound) {
+		t.Fatalf("want %v, got %v", domainerr.ErrNotFound, err)
+	}
+}
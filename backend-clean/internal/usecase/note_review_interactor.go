@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/service"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteReviewInteractor handles the review workflow of notes: Draft -> InReview -> Approved.
+type NoteReviewInteractor struct {
+	notes     port.NoteRepository
+	reviews   port.NoteReviewRepository
+	accounts  port.AccountRepository
+	tx        port.TxManager
+	publisher port.EventPublisher
+	output    port.NoteReviewOutputPort
+}
+
+var _ port.NoteReviewInputPort = (*NoteReviewInteractor)(nil)
+
+// NewNoteReviewInteractor creates NoteReviewInteractor.
+// Status changes are recorded to publisher within their transaction.
+func NewNoteReviewInteractor(notes port.NoteRepository, reviews port.NoteReviewRepository, accounts port.AccountRepository, tx port.TxManager, publisher port.EventPublisher, output port.NoteReviewOutputPort) *NoteReviewInteractor {
+	return &NoteReviewInteractor{
+		notes:     notes,
+		reviews:   reviews,
+		accounts:  accounts,
+		tx:        tx,
+		publisher: publisher,
+		output:    output,
+	}
+}
+
+// Get returns the reviewers and review history of a note to its owner or one of its reviewers.
+func (u *NoteReviewInteractor) Get(ctx context.Context, noteID, actorID string) error {
+	current, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	reviewers, err := u.reviews.ListReviewers(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := service.CanViewReviews(current.Note, actorID, reviewers); err != nil {
+		return err
+	}
+	return u.presentState(ctx, current.Note, reviewers)
+}
+
+// AssignReviewers replaces the reviewers of a draft or a note in review.
+func (u *NoteReviewInteractor) AssignReviewers(ctx context.Context, input port.NoteReviewersInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := service.CanAssignReviewers(current.Note, input.OwnerID, input.ReviewerIDs); err != nil {
+		return err
+	}
+	if err := u.ensureReviewersActive(ctx, input.ReviewerIDs); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		return u.reviews.ReplaceReviewers(txCtx, input.NoteID, input.ReviewerIDs)
+	})
+	if err != nil {
+		return err
+	}
+	return u.presentState(ctx, current.Note, input.ReviewerIDs)
+}
+
+// Submit moves a draft to InReview so its reviewers can decide on it.
+func (u *NoteReviewInteractor) Submit(ctx context.Context, input port.NoteReviewSubmitInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := current.Note.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	reviewers, err := u.reviews.ListReviewers(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := service.CanSubmitForReview(current.Note, input.OwnerID, reviewers); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.UpdateStatus(txCtx, input.NoteID, note.StatusInReview, note.Schedule{}, current.Note.Version)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.NoteEvent(outbox.NoteSubmitted, *updated, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.presentNote(ctx, input.NoteID)
+}
+
+// Approve moves a note in review to Approved; only an assigned reviewer may approve.
+func (u *NoteReviewInteractor) Approve(ctx context.Context, input port.NoteReviewDecisionInput) error {
+	return u.decide(ctx, input, note.ReviewApproved)
+}
+
+// Reject sends a note in review back to Draft with the reviewer's comment.
+func (u *NoteReviewInteractor) Reject(ctx context.Context, input port.NoteReviewDecisionInput) error {
+	return u.decide(ctx, input, note.ReviewRejected)
+}
+
+func (u *NoteReviewInteractor) decide(ctx context.Context, input port.NoteReviewDecisionInput, decision note.ReviewDecision) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ReviewerID); err != nil {
+		return err
+	}
+	current, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := current.Note.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	reviewers, err := u.reviews.ListReviewers(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := service.CanDecideReview(current.Note, input.ReviewerID, reviewers); err != nil {
+		return err
+	}
+	review, err := note.NewReview(input.NoteID, input.ReviewerID, decision, input.Comment)
+	if err != nil {
+		return err
+	}
+	to, eventType := note.StatusApproved, outbox.NoteApproved
+	if decision == note.ReviewRejected {
+		to, eventType = note.StatusDraft, outbox.NoteRejected
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.UpdateStatus(txCtx, input.NoteID, to, note.Schedule{}, current.Note.Version)
+		if err != nil {
+			return err
+		}
+		if _, err := u.reviews.AppendReview(txCtx, review); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.ReviewEvent(eventType, *updated, review, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.presentNote(ctx, input.NoteID)
+}
+
+// ensureReviewersActive reports reviewers that are unknown or deactivated accounts as violations.
+func (u *NoteReviewInteractor) ensureReviewersActive(ctx context.Context, reviewerIDs []string) error {
+	verr := &domainerr.ValidationError{}
+	for i, id := range reviewerIDs {
+		a, err := u.accounts.GetByID(ctx, id)
+		if err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+			return err
+		}
+		if err != nil || account.EnsureActive(*a) != nil {
+			verr.Add("reviewerIds["+strconv.Itoa(i)+"]", domainerr.CodeInvalid, domainerr.ErrUnknownReviewer)
+		}
+	}
+	return verr.Err()
+}
+
+func (u *NoteReviewInteractor) presentState(ctx context.Context, n note.Note, reviewers []string) error {
+	reviews, err := u.reviews.ListReviews(ctx, n.ID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentReviewState(ctx, note.ReviewState{Note: n, Reviewers: reviewers, Reviews: reviews})
+}
+
+// presentNote re-reads the note after a status change, and presents it.
+func (u *NoteReviewInteractor) presentNote(ctx context.Context, noteID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNote(ctx, n)
+}
@@ -0,0 +1,327 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteReviewInteractor_Get(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		wantError error
+	}{
+		{name: "[Success] owner", actorID: "owner-1"},
+		{name: "[Success] assigned reviewer", actorID: "reviewer-1"},
+		{name: "[Fail] other account", actorID: "other", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			reviewRepo := mockusecase.NewMockNoteReviewRepository(ctrl)
+			out := mockusecase.NewMockNoteReviewOutputPort(ctrl)
+
+			current := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusInReview}}
+			reviews := []note.Review{{ID: "review-1", NoteID: "note-1", ReviewerID: "reviewer-1", Decision: note.ReviewRejected, Comment: "fix"}}
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			reviewRepo.EXPECT().ListReviewers(gomock.Any(), "note-1").Return([]string{"reviewer-1"}, nil)
+			if tt.wantError == nil {
+				reviewRepo.EXPECT().ListReviews(gomock.Any(), "note-1").Return(reviews, nil)
+				out.EXPECT().PresentReviewState(gomock.Any(), note.ReviewState{Note: current.Note, Reviewers: []string{"reviewer-1"}, Reviews: reviews}).Return(nil)
+			}
+
+			interactor := uc.NewNoteReviewInteractor(notesRepo, reviewRepo, activeAccounts(ctrl), mockusecase.NewMockTxManager(ctrl), anyOutbox(ctrl), out)
+			err := interactor.Get(context.Background(), "note-1", tt.actorID)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteReviewInteractor_AssignReviewers(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      port.NoteReviewersInput
+		status     note.NoteStatus
+		reviewer   *account.Account
+		replaceErr error
+		wantError  error
+	}{
+		{
+			name:     "[Success] assign reviewers",
+			input:    port.NoteReviewersInput{NoteID: "note-1", OwnerID: "owner-1", ReviewerIDs: []string{"reviewer-1"}},
+			status:   note.StatusDraft,
+			reviewer: &account.Account{ID: "reviewer-1", IsActive: true},
+		},
+		{
+			name:      "[Fail] not owner",
+			input:     port.NoteReviewersInput{NoteID: "note-1", OwnerID: "other", ReviewerIDs: []string{"reviewer-1"}},
+			status:    note.StatusDraft,
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] approved note is locked",
+			input:     port.NoteReviewersInput{NoteID: "note-1", OwnerID: "owner-1", ReviewerIDs: []string{"reviewer-1"}},
+			status:    note.StatusApproved,
+			wantError: domainerr.ErrReviewersLocked,
+		},
+		{
+			name:      "[Fail] owner reviews own note",
+			input:     port.NoteReviewersInput{NoteID: "note-1", OwnerID: "owner-1", ReviewerIDs: []string{"owner-1"}},
+			status:    note.StatusDraft,
+			wantError: violation("reviewerIds[0]", domainerr.CodeInvalid, domainerr.ErrSelfReview),
+		},
+		{
+			name:      "[Fail] deactivated reviewer",
+			input:     port.NoteReviewersInput{NoteID: "note-1", OwnerID: "owner-1", ReviewerIDs: []string{"reviewer-1"}},
+			status:    note.StatusDraft,
+			reviewer:  &account.Account{ID: "reviewer-1", IsActive: false},
+			wantError: violation("reviewerIds[0]", domainerr.CodeInvalid, domainerr.ErrUnknownReviewer),
+		},
+		{
+			name:       "[Fail] replace error",
+			input:      port.NoteReviewersInput{NoteID: "note-1", OwnerID: "owner-1", ReviewerIDs: []string{"reviewer-1"}},
+			status:     note.StatusDraft,
+			reviewer:   &account.Account{ID: "reviewer-1", IsActive: true},
+			replaceErr: errors.New("replace err"),
+			wantError:  errors.New("replace err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			reviewRepo := mockusecase.NewMockNoteReviewRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteReviewOutputPort(ctrl)
+
+			current := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: tt.status}}
+			accounts.EXPECT().GetByID(gomock.Any(), tt.input.OwnerID).Return(&account.Account{ID: tt.input.OwnerID, IsActive: true}, nil)
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			if tt.reviewer != nil {
+				accounts.EXPECT().GetByID(gomock.Any(), tt.reviewer.ID).Return(tt.reviewer, nil)
+			}
+			if tt.reviewer != nil && tt.reviewer.IsActive {
+				passThroughTx(tx)
+				reviewRepo.EXPECT().ReplaceReviewers(gomock.Any(), "note-1", tt.input.ReviewerIDs).Return(tt.replaceErr)
+			}
+			if tt.wantError == nil {
+				reviewRepo.EXPECT().ListReviews(gomock.Any(), "note-1").Return(nil, nil)
+				out.EXPECT().PresentReviewState(gomock.Any(), note.ReviewState{Note: current.Note, Reviewers: tt.input.ReviewerIDs}).Return(nil)
+			}
+
+			interactor := uc.NewNoteReviewInteractor(notesRepo, reviewRepo, accounts, tx, anyOutbox(ctrl), out)
+			err := interactor.AssignReviewers(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteReviewInteractor_Submit(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.NoteReviewSubmitInput
+		status    note.NoteStatus
+		reviewers []string
+		wantError error
+	}{
+		{
+			name:      "[Success] submit draft",
+			input:     port.NoteReviewSubmitInput{NoteID: "note-1", OwnerID: "owner-1", Version: 3},
+			status:    note.StatusDraft,
+			reviewers: []string{"reviewer-1"},
+		},
+		{
+			name:      "[Fail] no reviewers",
+			input:     port.NoteReviewSubmitInput{NoteID: "note-1", OwnerID: "owner-1"},
+			status:    note.StatusDraft,
+			wantError: domainerr.ErrReviewerRequired,
+		},
+		{
+			name:      "[Fail] reviewer cannot submit",
+			input:     port.NoteReviewSubmitInput{NoteID: "note-1", OwnerID: "reviewer-1"},
+			status:    note.StatusDraft,
+			reviewers: []string{"reviewer-1"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] already in review",
+			input:     port.NoteReviewSubmitInput{NoteID: "note-1", OwnerID: "owner-1"},
+			status:    note.StatusInReview,
+			reviewers: []string{"reviewer-1"},
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name:      "[Fail] stale version",
+			input:     port.NoteReviewSubmitInput{NoteID: "note-1", OwnerID: "owner-1", Version: 2},
+			status:    note.StatusDraft,
+			reviewers: []string{"reviewer-1"},
+			wantError: domainerr.ErrVersionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			reviewRepo := mockusecase.NewMockNoteReviewRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockNoteReviewOutputPort(ctrl)
+
+			current := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: tt.status, Version: 3}}
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			if !errors.Is(tt.wantError, domainerr.ErrVersionConflict) {
+				reviewRepo.EXPECT().ListReviewers(gomock.Any(), "note-1").Return(tt.reviewers, nil)
+			}
+			if tt.wantError == nil {
+				submitted := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusInReview, Version: 4}}
+				passThroughTx(tx)
+				notesRepo.EXPECT().UpdateStatus(gomock.Any(), "note-1", note.StatusInReview, note.Schedule{}, 3).Return(&submitted.Note, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.NoteSubmitted, "note-1")).Return(nil)
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(submitted, nil)
+				out.EXPECT().PresentNote(gomock.Any(), submitted).Return(nil)
+			}
+
+			interactor := uc.NewNoteReviewInteractor(notesRepo, reviewRepo, activeAccounts(ctrl), tx, publisher, out)
+			err := interactor.Submit(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteReviewInteractor_Decide(t *testing.T) {
+	tests := []struct {
+		name       string
+		reject     bool
+		input      port.NoteReviewDecisionInput
+		status     note.NoteStatus
+		wantStatus note.NoteStatus
+		wantEvent  string
+		appendErr  error
+		wantError  error
+	}{
+		{
+			name:       "[Success] approve",
+			input:      port.NoteReviewDecisionInput{NoteID: "note-1", ReviewerID: "reviewer-1"},
+			status:     note.StatusInReview,
+			wantStatus: note.StatusApproved,
+			wantEvent:  outbox.NoteApproved,
+		},
+		{
+			name:       "[Success] reject with comment",
+			reject:     true,
+			input:      port.NoteReviewDecisionInput{NoteID: "note-1", ReviewerID: "reviewer-1", Comment: "  needs sources  "},
+			status:     note.StatusInReview,
+			wantStatus: note.StatusDraft,
+			wantEvent:  outbox.NoteRejected,
+		},
+		{
+			name:      "[Fail] reject without comment",
+			reject:    true,
+			input:     port.NoteReviewDecisionInput{NoteID: "note-1", ReviewerID: "reviewer-1", Comment: " "},
+			status:    note.StatusInReview,
+			wantError: violation("comment", domainerr.CodeRequired, domainerr.ErrReviewCommentRequired),
+		},
+		{
+			name:      "[Fail] owner cannot approve",
+			input:     port.NoteReviewDecisionInput{NoteID: "note-1", ReviewerID: "owner-1"},
+			status:    note.StatusInReview,
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] not in review",
+			input:     port.NoteReviewDecisionInput{NoteID: "note-1", ReviewerID: "reviewer-1"},
+			status:    note.StatusDraft,
+			wantError: domainerr.ErrInvalidStatusChange,
+		},
+		{
+			name:       "[Fail] append review error",
+			input:      port.NoteReviewDecisionInput{NoteID: "note-1", ReviewerID: "reviewer-1"},
+			status:     note.StatusInReview,
+			wantStatus: note.StatusApproved,
+			appendErr:  errors.New("append err"),
+			wantError:  errors.New("append err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			reviewRepo := mockusecase.NewMockNoteReviewRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockNoteReviewOutputPort(ctrl)
+
+			current := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: tt.status, Version: 5}}
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			reviewRepo.EXPECT().ListReviewers(gomock.Any(), "note-1").Return([]string{"reviewer-1"}, nil)
+			if tt.wantStatus != "" {
+				decided := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: tt.wantStatus, Version: 6}}
+				passThroughTx(tx)
+				notesRepo.EXPECT().UpdateStatus(gomock.Any(), "note-1", tt.wantStatus, note.Schedule{}, 5).Return(&decided.Note, nil)
+				reviewRepo.EXPECT().AppendReview(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, r note.Review) (*note.Review, error) {
+						if r.ReviewerID != "reviewer-1" || (tt.reject && r.Comment != "needs sources") {
+							t.Errorf("unexpected review %+v", r)
+						}
+						return &r, tt.appendErr
+					},
+				)
+				if tt.appendErr == nil {
+					publisher.EXPECT().Publish(gomock.Any(), outboxOf(tt.wantEvent, "note-1")).Return(nil)
+					notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(decided, nil)
+					out.EXPECT().PresentNote(gomock.Any(), decided).Return(nil)
+				}
+			}
+
+			interactor := uc.NewNoteReviewInteractor(notesRepo, reviewRepo, activeAccounts(ctrl), tx, publisher, out)
+			var err error
+			if tt.reject {
+				err = interactor.Reject(context.Background(), tt.input)
+			} else {
+				err = interactor.Approve(context.Background(), tt.input)
+			}
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package usecase provides application use cases.
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteWatchInteractor streams committed note changes to a watcher.
+type NoteWatchInteractor struct {
+	events     port.NoteEventSubscriber
+	workspaces port.WorkspaceRepository
+	output     port.NoteWatchOutputPort
+}
+
+var _ port.NoteWatchInputPort = (*NoteWatchInteractor)(nil)
+
+// NewNoteWatchInteractor creates NoteWatchInteractor.
+// Watchers only see events of workspaces they are a member of.
+func NewNoteWatchInteractor(events port.NoteEventSubscriber, workspaces port.WorkspaceRepository, output port.NoteWatchOutputPort) *NoteWatchInteractor {
+	return &NoteWatchInteractor{
+		events:     events,
+		workspaces: workspaces,
+		output:     output,
+	}
+}
+
+// Watch presents events matching input.Filter, starting after input.Cursor, until ctx is done.
+// Membership is checked for every event, so a watcher removed from a workspace stops seeing it.
+func (u *NoteWatchInteractor) Watch(ctx context.Context, input port.NoteWatchInput) error {
+	if input.ActorID == "" {
+		return domainerr.ErrUnauthorized
+	}
+	if input.Filter.Status != nil {
+		if err := input.Filter.Status.Validate(); err != nil {
+			return err
+		}
+	}
+	events, err := u.events.Subscribe(ctx, input.Cursor)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return domainerr.ErrWatchInterrupted
+			}
+			if !input.Filter.Matches(e) {
+				continue
+			}
+			err := ensureMember(ctx, u.workspaces, e.WorkspaceID, input.ActorID)
+			if errors.Is(err, domainerr.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := u.output.PresentNoteEvent(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteWatchInteractor_Watch(t *testing.T) {
+	owner := "owner-1"
+	archived := note.NoteStatus("Archived")
+	mine := note.Event{Cursor: "c-2", Type: note.EventUpdated, NoteID: "note-1", OwnerID: owner, WorkspaceID: "ws-1"}
+	other := note.Event{Cursor: "c-3", Type: note.EventUpdated, NoteID: "note-2", OwnerID: "owner-2", WorkspaceID: "ws-1"}
+	outside := note.Event{Cursor: "c-4", Type: note.EventUpdated, NoteID: "note-3", OwnerID: owner, WorkspaceID: "ws-2"}
+
+	tests := []struct {
+		name         string
+		input        port.NoteWatchInput
+		subscribeErr error
+		events       []note.Event
+		// closeEarly closes the event channel before ctx is done, as the feed does when it cannot be read.
+		closeEarly bool
+		presentErr error
+		want       []note.Event
+		wantError  error
+	}{
+		{
+			name:   "[Success] present matching events until cancelled",
+			input:  port.NoteWatchInput{ActorID: owner, Filter: note.EventFilter{OwnerID: &owner}, Cursor: "c-1"},
+			events: []note.Event{mine, other},
+			want:   []note.Event{mine},
+		},
+		{
+			name:   "[Success] skip events of workspaces the watcher is not a member of",
+			input:  port.NoteWatchInput{ActorID: owner},
+			events: []note.Event{outside, mine},
+			want:   []note.Event{mine},
+		},
+		{
+			name:      "[Fail] no actor",
+			input:     port.NoteWatchInput{},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] invalid status filter",
+			input:     port.NoteWatchInput{ActorID: owner, Filter: note.EventFilter{Status: &archived}},
+			wantError: domainerr.ErrInvalidStatus,
+		},
+		{
+			name:         "[Fail] malformed cursor",
+			input:        port.NoteWatchInput{ActorID: owner, Cursor: "%%%"},
+			subscribeErr: domainerr.ErrInvalidCursor,
+			wantError:    domainerr.ErrInvalidCursor,
+		},
+		{
+			name:       "[Fail] feed interrupted",
+			input:      port.NoteWatchInput{ActorID: owner},
+			events:     []note.Event{mine},
+			closeEarly: true,
+			want:       []note.Event{mine},
+			wantError:  domainerr.ErrWatchInterrupted,
+		},
+		{
+			name:       "[Fail] send error",
+			input:      port.NoteWatchInput{ActorID: owner},
+			events:     []note.Event{mine, other},
+			presentErr: errors.New("stream closed"),
+			want:       []note.Event{mine},
+			wantError:  errors.New("stream closed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events := mockusecase.NewMockNoteEventSubscriber(ctrl)
+			out := mockusecase.NewMockNoteWatchOutputPort(ctrl)
+
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			workspaces.EXPECT().GetMember(gomock.Any(), gomock.Any(), owner).DoAndReturn(
+				func(_ context.Context, workspaceID, accountID string) (*workspace.Member, error) {
+					if workspaceID != "ws-1" {
+						return nil, domainerr.ErrNotFound
+					}
+					return &workspace.Member{WorkspaceID: workspaceID, AccountID: accountID, Role: workspace.RoleMember}, nil
+				},
+			).AnyTimes()
+
+			if !errors.Is(tt.wantError, domainerr.ErrInvalidStatus) && !errors.Is(tt.wantError, domainerr.ErrUnauthorized) {
+				ch := make(chan note.Event, len(tt.events))
+				for _, e := range tt.events {
+					ch <- e
+				}
+				if tt.closeEarly {
+					close(ch)
+				}
+				var sub <-chan note.Event = ch
+				if tt.subscribeErr != nil {
+					sub = nil
+				}
+				events.EXPECT().Subscribe(gomock.Any(), tt.input.Cursor).Return(sub, tt.subscribeErr)
+			}
+			var got []note.Event
+			out.EXPECT().PresentNoteEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e note.Event) error {
+				got = append(got, e)
+				if tt.presentErr != nil {
+					return tt.presentErr
+				}
+				if len(got) == len(tt.want) && !tt.closeEarly {
+					cancel()
+				}
+				return nil
+			}).Times(len(tt.want))
+
+			err := uc.NewNoteWatchInteractor(events, workspaces, out).Watch(ctx, tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || err.Error() != tt.wantError.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("presented %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// OutboxRelayInteractor delivers recorded outbox events to a sink.
+type OutboxRelayInteractor struct {
+	repo   port.OutboxRepository
+	sink   port.EventSink
+	output port.OutboxRelayOutputPort
+}
+
+var _ port.OutboxRelayInputPort = (*OutboxRelayInteractor)(nil)
+
+// NewOutboxRelayInteractor creates OutboxRelayInteractor.
+func NewOutboxRelayInteractor(repo port.OutboxRepository, sink port.EventSink, output port.OutboxRelayOutputPort) *OutboxRelayInteractor {
+	return &OutboxRelayInteractor{
+		repo:   repo,
+		sink:   sink,
+		output: output,
+	}
+}
+
+// Execute claims one batch of due messages and delivers each of them.
+// The claim is a lease rather than a lock held open, so no transaction spans a delivery;
+// each outcome is recorded on its own. A message is only settled after the sink accepted it,
+// so a crash mid-batch leaves it to be claimed again once the lease runs out: delivery is at least once.
+func (u *OutboxRelayInteractor) Execute(ctx context.Context, batchSize int) error {
+	now := time.Now()
+	leaseUntil := now.Add(outbox.ClaimLease)
+	messages, err := u.repo.ClaimPending(ctx, now, leaseUntil, batchSize)
+	if err != nil {
+		return err
+	}
+	var result outbox.RelayResult
+	for _, msg := range messages {
+		// The rest of the batch may already be claimed by another relay.
+		if !time.Now().Before(leaseUntil) {
+			break
+		}
+		if err := u.relay(ctx, msg, &result); err != nil {
+			return err
+		}
+	}
+	return u.output.PresentResult(ctx, result)
+}
+
+func (u *OutboxRelayInteractor) relay(ctx context.Context, msg outbox.Message, result *outbox.RelayResult) error {
+	deliverErr := u.sink.Deliver(ctx, msg)
+	now := time.Now()
+	if deliverErr == nil {
+		result.Delivered++
+		return u.repo.MarkDelivered(ctx, msg.ID, now)
+	}
+	attempts := msg.Attempts + 1
+	delay, retry := outbox.NextAttempt(attempts)
+	if !retry {
+		result.Failed++
+		return u.repo.MarkFailed(ctx, msg.ID, attempts, now, deliverErr.Error())
+	}
+	result.Retried++
+	return u.repo.MarkRetry(ctx, msg.ID, attempts, now.Add(delay), deliverErr.Error())
+}
@@ -0,0 +1,103 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestOutboxRelayInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		messages   []outbox.Message
+		claimErr   error
+		deliverErr error
+		settleErr  error
+		wantResult outbox.RelayResult
+		wantError  error
+	}{
+		{
+			name:       "[Success] deliver batch",
+			messages:   []outbox.Message{{ID: "m1"}, {ID: "m2"}},
+			wantResult: outbox.RelayResult{Delivered: 2},
+		},
+		{
+			name:       "[Success] nothing pending",
+			wantResult: outbox.RelayResult{},
+		},
+		{
+			name:       "[Success] schedule retry after failed delivery",
+			messages:   []outbox.Message{{ID: "m1", Attempts: 2}},
+			deliverErr: errors.New("sink down"),
+			wantResult: outbox.RelayResult{Retried: 1},
+		},
+		{
+			name:       "[Success] give up after the last attempt",
+			messages:   []outbox.Message{{ID: "m1", Attempts: outbox.MaxAttempts - 1}},
+			deliverErr: errors.New("sink down"),
+			wantResult: outbox.RelayResult{Failed: 1},
+		},
+		{
+			name:      "[Fail] claim error",
+			claimErr:  errors.New("claim err"),
+			wantError: errors.New("claim err"),
+		},
+		{
+			name:      "[Fail] settle error",
+			messages:  []outbox.Message{{ID: "m1"}},
+			settleErr: errors.New("settle err"),
+			wantError: errors.New("settle err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockOutboxRepository(ctrl)
+			sink := mockusecase.NewMockEventSink(ctrl)
+			out := mockusecase.NewMockOutboxRelayOutputPort(ctrl)
+
+			repo.EXPECT().ClaimPending(gomock.Any(), gomock.Any(), gomock.Any(), 50).DoAndReturn(
+				func(_ context.Context, now, leaseUntil time.Time, _ int) ([]outbox.Message, error) {
+					if leaseUntil.Sub(now) != outbox.ClaimLease {
+						t.Fatalf("lease until %v, want %v after %v", leaseUntil, outbox.ClaimLease, now)
+					}
+					return tt.messages, tt.claimErr
+				},
+			)
+			for _, msg := range tt.messages {
+				sink.EXPECT().Deliver(gomock.Any(), msg).Return(tt.deliverErr)
+				switch {
+				case tt.deliverErr == nil:
+					repo.EXPECT().MarkDelivered(gomock.Any(), msg.ID, gomock.Any()).Return(tt.settleErr)
+				case msg.Attempts+1 >= outbox.MaxAttempts:
+					repo.EXPECT().MarkFailed(gomock.Any(), msg.ID, msg.Attempts+1, gomock.Any(), tt.deliverErr.Error()).Return(tt.settleErr)
+				default:
+					repo.EXPECT().MarkRetry(gomock.Any(), msg.ID, msg.Attempts+1, gomock.Any(), tt.deliverErr.Error()).Return(tt.settleErr)
+				}
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentResult(gomock.Any(), tt.wantResult).Return(nil)
+			}
+
+			interactor := uc.NewOutboxRelayInteractor(repo, sink, out)
+			err := interactor.Execute(context.Background(), 50)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Package usecase provides application use cases.
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+const defaultTrashRetention = 30 * 24 * time.Hour
+
+// PurgeInteractor permanently deletes items that have stayed in the trash too long.
+type PurgeInteractor struct {
+	notes     port.NoteRepository
+	templates port.TemplateRepository
+	output    port.PurgeJobOutputPort
+}
+
+var _ port.PurgeJobInputPort = (*PurgeInteractor)(nil)
+
+// NewPurgeInteractor creates a new PurgeInteractor.
+func NewPurgeInteractor(notes port.NoteRepository, templates port.TemplateRepository, output port.PurgeJobOutputPort) *PurgeInteractor {
+	return &PurgeInteractor{
+		notes:     notes,
+		templates: templates,
+		output:    output,
+	}
+}
+
+// Execute purges notes, then templates, trashed more than retention ago (30 days when 0).
+// Notes go first so that a template whose last notes were just purged can go in the same run.
+func (u *PurgeInteractor) Execute(ctx context.Context, retention time.Duration) error {
+	if retention <= 0 {
+		retention = defaultTrashRetention
+	}
+	before := time.Now().Add(-retention)
+	purgedNotes, err := u.notes.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return err
+	}
+	purgedTemplates, err := u.templates.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentResult(ctx, purgedNotes, purgedTemplates)
+}
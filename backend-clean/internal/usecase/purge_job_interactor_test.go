@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestPurgeInteractor_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		retention     time.Duration
+		wantRetention time.Duration
+		notes         int
+		notesErr      error
+		templates     int
+		templatesErr  error
+		presenterErr  error
+		wantErr       bool
+	}{
+		{
+			name:          "正常系: 既定の保持期間（30日）",
+			wantRetention: 30 * 24 * time.Hour,
+			notes:         3,
+			templates:     1,
+		},
+		{
+			name:          "正常系: 保持期間を指定",
+			retention:     7 * 24 * time.Hour,
+			wantRetention: 7 * 24 * time.Hour,
+			notes:         0,
+			templates:     0,
+		},
+		{
+			name:          "異常系: ノート削除でDBエラー",
+			wantRetention: 30 * 24 * time.Hour,
+			notesErr:      errors.New("db connection error"),
+			wantErr:       true,
+		},
+		{
+			name:          "異常系: テンプレート削除でDBエラー",
+			wantRetention: 30 * 24 * time.Hour,
+			notes:         2,
+			templatesErr:  errors.New("db connection error"),
+			wantErr:       true,
+		},
+		{
+			name:          "異常系: Presenterエラー",
+			wantRetention: 30 * 24 * time.Hour,
+			notes:         1,
+			templates:     1,
+			presenterErr:  errors.New("presenter error"),
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			mockOutput := &mockusecase.MockPurgeJobOutputPort{Err: tt.presenterErr}
+
+			var notesBefore, templatesBefore time.Time
+			notes.EXPECT().PurgeDeletedBefore(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, before time.Time) (int, error) {
+					notesBefore = before
+					return tt.notes, tt.notesErr
+				})
+			if tt.notesErr == nil {
+				templates.EXPECT().PurgeDeletedBefore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, before time.Time) (int, error) {
+						templatesBefore = before
+						return tt.templates, tt.templatesErr
+					})
+			}
+
+			interactor := usecase.NewPurgeInteractor(notes, templates, mockOutput)
+			err := interactor.Execute(context.Background(), tt.retention)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.notes, mockOutput.PurgedNotes)
+			assert.Equal(t, tt.templates, mockOutput.PurgedTemplates)
+
+			// ノートとテンプレートは同じ基準時刻で削除される
+			assert.WithinDuration(t, time.Now().Add(-tt.wantRetention), notesBefore, time.Second)
+			assert.Equal(t, notesBefore, templatesBefore)
+		})
+	}
+}
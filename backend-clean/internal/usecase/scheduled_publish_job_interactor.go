@@ -0,0 +1,72 @@
+// Package usecase provides application use cases.
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ScheduledPublishInteractor moves notes whose schedule has come due.
+type ScheduledPublishInteractor struct {
+	notes     port.NoteRepository
+	links     port.ShareLinkRepository
+	tx        port.TxManager
+	publisher port.EventPublisher
+	output    port.ScheduledPublishJobOutputPort
+}
+
+var _ port.ScheduledPublishJobInputPort = (*ScheduledPublishInteractor)(nil)
+
+// NewScheduledPublishInteractor creates a new ScheduledPublishInteractor.
+// Notes it unpublishes lose their public links in links.
+func NewScheduledPublishInteractor(notes port.NoteRepository, links port.ShareLinkRepository, tx port.TxManager, publisher port.EventPublisher, output port.ScheduledPublishJobOutputPort) *ScheduledPublishInteractor {
+	return &ScheduledPublishInteractor{
+		notes:     notes,
+		links:     links,
+		tx:        tx,
+		publisher: publisher,
+		output:    output,
+	}
+}
+
+// Execute publishes scheduled notes whose publish time has passed, then unpublishes published
+// notes whose unpublish time has passed, recording each transition to the outbox in the same
+// transaction and revoking the public links of unpublished notes. Transitioned notes no longer
+// match, so running the job again is a no-op; a note whose publish and unpublish times both
+// passed is published and unpublished in one run.
+func (u *ScheduledPublishInteractor) Execute(ctx context.Context) error {
+	now := time.Now()
+	var published, unpublished int
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		due, err := u.notes.PublishDue(txCtx, now)
+		if err != nil {
+			return err
+		}
+		events := make([]outbox.Event, 0, len(due))
+		for _, n := range due {
+			events = append(events, outbox.NoteEvent(outbox.NotePublished, n, now))
+		}
+		expired, err := u.notes.UnpublishDue(txCtx, now)
+		if err != nil {
+			return err
+		}
+		for _, n := range expired {
+			if err := u.links.RevokeByNote(txCtx, n.ID, now); err != nil {
+				return err
+			}
+			events = append(events, outbox.NoteEvent(outbox.NoteUnpublished, n, now))
+		}
+		if err := u.publisher.Publish(txCtx, events...); err != nil {
+			return err
+		}
+		published, unpublished = len(due), len(expired)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentResult(ctx, published, unpublished)
+}
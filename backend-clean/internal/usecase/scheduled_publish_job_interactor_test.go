@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestScheduledPublishInteractor_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		due          []note.Note
+		dueErr       error
+		expired      []note.Note
+		expiredErr   error
+		revokeErr    error
+		publishErr   error
+		presenterErr error
+		wantEvents   []string
+		wantErr      bool
+	}{
+		{
+			name:       "正常系: 公開と公開停止",
+			due:        []note.Note{{ID: "n1", Status: note.StatusPublish}, {ID: "n2", Status: note.StatusPublish}},
+			expired:    []note.Note{{ID: "n3", Status: note.StatusDraft}},
+			wantEvents: []string{outbox.NotePublished, outbox.NotePublished, outbox.NoteUnpublished},
+		},
+		{
+			name:       "正常系: 対象なし（再実行）",
+			wantEvents: []string{},
+		},
+		{
+			name:    "異常系: 公開でDBエラー",
+			dueErr:  errors.New("db connection error"),
+			wantErr: true,
+		},
+		{
+			name:       "異常系: 公開停止でDBエラー",
+			due:        []note.Note{{ID: "n1"}},
+			expiredErr: errors.New("db connection error"),
+			wantErr:    true,
+		},
+		{
+			name:      "異常系: 公開リンク失効でDBエラー",
+			expired:   []note.Note{{ID: "n3"}},
+			revokeErr: errors.New("db connection error"),
+			wantErr:   true,
+		},
+		{
+			name:       "異常系: outbox 記録エラー",
+			due:        []note.Note{{ID: "n1"}},
+			publishErr: errors.New("outbox error"),
+			wantErr:    true,
+		},
+		{
+			name:         "異常系: Presenterエラー",
+			due:          []note.Note{{ID: "n1"}},
+			presenterErr: errors.New("presenter error"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			links := mockusecase.NewMockShareLinkRepository(ctrl)
+			publisher := &mockusecase.SimpleEventPublisher{Err: tt.publishErr}
+			mockOutput := &mockusecase.MockScheduledPublishJobOutputPort{Err: tt.presenterErr}
+
+			var publishNow, unpublishNow time.Time
+			notes.EXPECT().PublishDue(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, now time.Time) ([]note.Note, error) {
+					publishNow = now
+					return tt.due, tt.dueErr
+				})
+			if tt.dueErr == nil {
+				notes.EXPECT().UnpublishDue(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, now time.Time) ([]note.Note, error) {
+						unpublishNow = now
+						return tt.expired, tt.expiredErr
+					})
+			}
+			if tt.expiredErr == nil {
+				// 公開停止したノートの公開リンクは同じトランザクションで失効する
+				for _, n := range tt.expired {
+					links.EXPECT().RevokeByNote(gomock.Any(), n.ID, gomock.Any()).Return(tt.revokeErr)
+				}
+			}
+
+			interactor := usecase.NewScheduledPublishInteractor(notes, links, mockusecase.SimpleTxManager{}, publisher, mockOutput)
+			err := interactor.Execute(context.Background())
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.due), mockOutput.Published)
+			assert.Equal(t, len(tt.expired), mockOutput.Unpublished)
+			// 公開と公開停止は同じ基準時刻で判定される
+			assert.WithinDuration(t, time.Now(), publishNow, time.Second)
+			assert.Equal(t, publishNow, unpublishNow)
+
+			types := make([]string, 0, len(publisher.Events))
+			for _, e := range publisher.Events {
+				types = append(types, e.Type)
+			}
+			assert.Equal(t, tt.wantEvents, types)
+		})
+	}
+}
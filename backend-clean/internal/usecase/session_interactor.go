@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/session"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SessionInteractor handles login, token refresh and logout.
+type SessionInteractor struct {
+	accounts  port.AccountRepository
+	sessions  port.SessionRepository
+	tokens    port.TokenIssuer
+	tx        port.TxManager
+	publisher port.EventPublisher
+	output    port.SessionOutputPort
+}
+
+var _ port.SessionInputPort = (*SessionInteractor)(nil)
+
+// NewSessionInteractor creates SessionInteractor.
+func NewSessionInteractor(
+	accounts port.AccountRepository,
+	sessions port.SessionRepository,
+	tokens port.TokenIssuer,
+	tx port.TxManager,
+	publisher port.EventPublisher,
+	output port.SessionOutputPort,
+) *SessionInteractor {
+	return &SessionInteractor{
+		accounts:  accounts,
+		sessions:  sessions,
+		tokens:    tokens,
+		tx:        tx,
+		publisher: publisher,
+		output:    output,
+	}
+}
+
+// Login upserts the OAuth account and issues a new session for it.
+func (u *SessionInteractor) Login(ctx context.Context, input account.OAuthAccountInput) error {
+	var (
+		acc    *account.Account
+		tokens *session.Tokens
+	)
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		a, err := upsertOAuthAccount(txCtx, u.accounts, u.publisher, input)
+		if err != nil {
+			return err
+		}
+		if err := session.CanIssue(*a); err != nil {
+			return err
+		}
+		t, err := u.issue(txCtx, a.ID)
+		if err != nil {
+			return err
+		}
+		acc, tokens = a, t
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentSession(ctx, acc, tokens)
+}
+
+// Refresh rotates the refresh token: the presented session is revoked and a new one issued.
+// Presenting an already revoked token revokes every session of the account, since it
+// indicates the token was leaked and replayed.
+func (u *SessionInteractor) Refresh(ctx context.Context, refreshToken string) error {
+	now := time.Now()
+	current, err := u.lookup(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	if err := session.CanRefresh(*current, now); err != nil {
+		if current.IsRevoked() {
+			if rErr := u.sessions.RevokeAllByAccount(ctx, current.AccountID, now); rErr != nil {
+				return rErr
+			}
+		}
+		return err
+	}
+	acc, err := u.accounts.GetByID(ctx, current.AccountID)
+	if err != nil {
+		return err
+	}
+	if err := session.CanIssue(*acc); err != nil {
+		return err
+	}
+
+	var tokens *session.Tokens
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.sessions.Revoke(txCtx, current.ID, now); err != nil {
+			// Lost a race with a concurrent refresh/logout of the same token.
+			if errors.Is(err, domainerr.ErrNotFound) {
+				return domainerr.ErrSessionInvalid
+			}
+			return err
+		}
+		t, err := u.issue(txCtx, acc.ID)
+		if err != nil {
+			return err
+		}
+		tokens = t
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentSession(ctx, acc, tokens)
+}
+
+// Logout revokes the session bound to the refresh token. Unknown or already
+// revoked tokens are treated as logged out.
+func (u *SessionInteractor) Logout(ctx context.Context, refreshToken string) error {
+	current, err := u.lookup(ctx, refreshToken)
+	if err != nil && !errors.Is(err, domainerr.ErrSessionInvalid) {
+		return err
+	}
+	if current != nil && !current.IsRevoked() {
+		if err := u.sessions.Revoke(ctx, current.ID, time.Now()); err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+			return err
+		}
+	}
+	return u.output.PresentLogout(ctx)
+}
+
+func (u *SessionInteractor) lookup(ctx context.Context, refreshToken string) (*session.Session, error) {
+	if refreshToken == "" {
+		return nil, domainerr.ErrSessionInvalid
+	}
+	s, err := u.sessions.GetByTokenHash(ctx, u.tokens.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domainerr.ErrNotFound) {
+			return nil, domainerr.ErrSessionInvalid
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (u *SessionInteractor) issue(ctx context.Context, accountID string) (*session.Tokens, error) {
+	refresh, hash, refreshExp, err := u.tokens.IssueRefreshToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.sessions.Create(ctx, session.Session{
+		AccountID:        accountID,
+		RefreshTokenHash: hash,
+		ExpiresAt:        refreshExp,
+	}); err != nil {
+		return nil, err
+	}
+	access, accessExp, err := u.tokens.IssueAccessToken(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &session.Tokens{
+		AccessToken:           access,
+		AccessTokenExpiresAt:  accessExp,
+		RefreshToken:          refresh,
+		RefreshTokenExpiresAt: refreshExp,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/session"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func expectIssue(sessions *mockusecase.MockSessionRepository, tokens *mockusecase.MockTokenIssuer, accountID string) {
+	exp := time.Now().Add(time.Hour)
+	tokens.EXPECT().IssueRefreshToken(gomock.Any()).Return("new-refresh", "new-hash", exp, nil)
+	sessions.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, s session.Session) (*session.Session, error) {
+			if s.AccountID != accountID || s.RefreshTokenHash != "new-hash" {
+				return nil, errors.New("unexpected session")
+			}
+			s.ID = "sess-new"
+			return &s, nil
+		},
+	)
+	tokens.EXPECT().IssueAccessToken(gomock.Any(), accountID).Return("new-access", exp, nil)
+}
+
+func TestSessionInteractor_Login(t *testing.T) {
+	input := account.OAuthAccountInput{
+		Email:             "user@example.com",
+		FirstName:         "Taro",
+		Provider:          "google",
+		ProviderAccountID: "pid",
+	}
+	tests := []struct {
+		name      string
+		repoAcc   *account.Account
+		wantError error
+	}{
+		{name: "[Success] issue session", repoAcc: &account.Account{ID: "acc-1", IsActive: true}},
+		{name: "[Fail] inactive account", repoAcc: &account.Account{ID: "acc-1", IsActive: false}, wantError: domainerr.ErrAccountInactive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			sessions := mockusecase.NewMockSessionRepository(ctrl)
+			tokens := mockusecase.NewMockTokenIssuer(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			output := mockusecase.NewMockSessionOutputPort(ctrl)
+
+			passThroughTx(tx)
+			accounts.EXPECT().UpsertOAuthAccount(gomock.Any(), gomock.Any()).Return(tt.repoAcc, nil)
+			if tt.wantError == nil {
+				expectIssue(sessions, tokens, tt.repoAcc.ID)
+				output.EXPECT().PresentSession(gomock.Any(), tt.repoAcc, gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewSessionInteractor(accounts, sessions, tokens, tx, anyOutbox(ctrl), output)
+			err := interactor.Login(context.Background(), input)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("err = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestSessionInteractor_Refresh(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+	live := &session.Session{ID: "sess-1", AccountID: "acc-1", ExpiresAt: now.Add(time.Hour)}
+	tests := []struct {
+		name      string
+		current   *session.Session
+		lookupErr error
+		acc       *account.Account
+		revokeErr error
+		wantError error
+	}{
+		{
+			name:    "[Success] rotate session",
+			current: live,
+			acc:     &account.Account{ID: "acc-1", IsActive: true},
+		},
+		{
+			name:      "[Fail] unknown token",
+			lookupErr: domainerr.ErrNotFound,
+			wantError: domainerr.ErrSessionInvalid,
+		},
+		{
+			name:      "[Fail] expired session",
+			current:   &session.Session{ID: "sess-1", AccountID: "acc-1", ExpiresAt: now.Add(-time.Hour)},
+			wantError: domainerr.ErrSessionExpired,
+		},
+		{
+			name:      "[Fail] reused token revokes all sessions",
+			current:   &session.Session{ID: "sess-1", AccountID: "acc-1", ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			wantError: domainerr.ErrSessionInvalid,
+		},
+		{
+			name:      "[Fail] account deactivated since login",
+			current:   live,
+			acc:       &account.Account{ID: "acc-1", IsActive: false},
+			wantError: domainerr.ErrAccountInactive,
+		},
+		{
+			name:      "[Fail] concurrent rotation",
+			current:   live,
+			acc:       &account.Account{ID: "acc-1", IsActive: true},
+			revokeErr: domainerr.ErrNotFound,
+			wantError: domainerr.ErrSessionInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			sessions := mockusecase.NewMockSessionRepository(ctrl)
+			tokens := mockusecase.NewMockTokenIssuer(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			output := mockusecase.NewMockSessionOutputPort(ctrl)
+
+			tokens.EXPECT().HashRefreshToken("old-refresh").Return("old-hash")
+			sessions.EXPECT().GetByTokenHash(gomock.Any(), "old-hash").Return(tt.current, tt.lookupErr)
+			if tt.current != nil && tt.current.IsRevoked() {
+				sessions.EXPECT().RevokeAllByAccount(gomock.Any(), "acc-1", gomock.Any()).Return(nil)
+			}
+			if tt.acc != nil {
+				accounts.EXPECT().GetByID(gomock.Any(), "acc-1").Return(tt.acc, nil)
+			}
+			if tt.acc != nil && tt.acc.IsActive {
+				passThroughTx(tx)
+				sessions.EXPECT().Revoke(gomock.Any(), "sess-1", gomock.Any()).Return(tt.revokeErr)
+			}
+			if tt.wantError == nil {
+				expectIssue(sessions, tokens, "acc-1")
+				output.EXPECT().PresentSession(gomock.Any(), tt.acc, gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewSessionInteractor(accounts, sessions, tokens, tx, anyOutbox(ctrl), output)
+			err := interactor.Refresh(context.Background(), "old-refresh")
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("err = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestSessionInteractor_Logout(t *testing.T) {
+	revokedAt := time.Now()
+	tests := []struct {
+		name       string
+		current    *session.Session
+		lookupErr  error
+		wantRevoke bool
+	}{
+		{name: "[Success] revoke live session", current: &session.Session{ID: "sess-1"}, wantRevoke: true},
+		{name: "[Success] unknown token is a no-op", lookupErr: domainerr.ErrNotFound},
+		{name: "[Success] already revoked is a no-op", current: &session.Session{ID: "sess-1", RevokedAt: &revokedAt}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessions := mockusecase.NewMockSessionRepository(ctrl)
+			tokens := mockusecase.NewMockTokenIssuer(ctrl)
+			output := mockusecase.NewMockSessionOutputPort(ctrl)
+
+			tokens.EXPECT().HashRefreshToken("old-refresh").Return("old-hash")
+			sessions.EXPECT().GetByTokenHash(gomock.Any(), "old-hash").Return(tt.current, tt.lookupErr)
+			sessions.EXPECT().Revoke(gomock.Any(), "sess-1", gomock.Any()).Return(nil).Times(b2i(tt.wantRevoke))
+			output.EXPECT().PresentLogout(gomock.Any()).Return(nil)
+
+			interactor := uc.NewSessionInteractor(nil, sessions, tokens, nil, nil, output)
+			if err := interactor.Logout(context.Background(), "old-refresh"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
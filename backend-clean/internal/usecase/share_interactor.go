@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/service"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareInteractor handles granting other accounts access to notes and templates.
+type ShareInteractor struct {
+	shares     port.ShareRepository
+	notes      port.NoteRepository
+	templates  port.TemplateRepository
+	accounts   port.AccountRepository
+	workspaces port.WorkspaceRepository
+	tx         port.TxManager
+	publisher  port.EventPublisher
+	output     port.ShareOutputPort
+}
+
+var _ port.ShareInputPort = (*ShareInteractor)(nil)
+
+// NewShareInteractor creates ShareInteractor.
+// Grant changes are recorded to publisher within their transaction.
+// Resources are shared only among members of their workspace.
+func NewShareInteractor(shares port.ShareRepository, notes port.NoteRepository, templates port.TemplateRepository, accounts port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, publisher port.EventPublisher, output port.ShareOutputPort) *ShareInteractor {
+	return &ShareInteractor{
+		shares:     shares,
+		notes:      notes,
+		templates:  templates,
+		accounts:   accounts,
+		workspaces: workspaces,
+		tx:         tx,
+		publisher:  publisher,
+		output:     output,
+	}
+}
+
+// List returns the grants on a resource to its owner and the accounts it is shared with.
+func (u *ShareInteractor) List(ctx context.Context, input port.ShareListInput) error {
+	ownerID, workspaceID, err := u.ownerOf(ctx, input.ResourceType, input.ResourceID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, workspaceID, input.ActorID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, input.ResourceType, input.ResourceID, ownerID, input.ActorID, share.RoleViewer); err != nil {
+		return err
+	}
+	grants, err := u.shares.List(ctx, input.ResourceType, input.ResourceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentShares(ctx, grants)
+}
+
+// Grant gives an active account a role on a resource, or changes the role it already has.
+func (u *ShareInteractor) Grant(ctx context.Context, input port.ShareGrantInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	ownerID, workspaceID, err := u.ownerOf(ctx, input.ResourceType, input.ResourceID)
+	if err != nil {
+		return err
+	}
+	if err := ensureMember(ctx, u.workspaces, workspaceID, input.ActorID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, input.ResourceType, input.ResourceID, ownerID, input.ActorID, share.RoleOwner); err != nil {
+		return err
+	}
+	grant := share.Grant{
+		ResourceType: input.ResourceType,
+		ResourceID:   input.ResourceID,
+		AccountID:    input.AccountID,
+		Role:         input.Role,
+		GrantedBy:    input.ActorID,
+	}
+	if err := share.ValidateGrant(ownerID, grant); err != nil {
+		return err
+	}
+	if err := u.ensureGranteeActive(ctx, input.AccountID); err != nil {
+		return err
+	}
+	if err := u.ensureGranteeMember(ctx, workspaceID, input.AccountID); err != nil {
+		return err
+	}
+	var saved *share.Grant
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		saved, err = u.shares.Upsert(txCtx, grant)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.ShareEvent(sharedEvent(input.ResourceType), *saved, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentShare(ctx, saved)
+}
+
+// Revoke removes a grant. Owners can revoke any grant; grantees only their own.
+func (u *ShareInteractor) Revoke(ctx context.Context, input port.ShareRevokeInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	ownerID, workspaceID, err := u.ownerOf(ctx, input.ResourceType, input.ResourceID)
+	if err != nil {
+		return err
+	}
+	// ルール: 共有の解除はオーナーのみ。ただし共有された本人は自分の共有を外せる
+	if input.AccountID != input.ActorID {
+		if err := ensureMember(ctx, u.workspaces, workspaceID, input.ActorID); err != nil {
+			return err
+		}
+		if err := authorize(ctx, u.shares, input.ResourceType, input.ResourceID, ownerID, input.ActorID, share.RoleOwner); err != nil {
+			return err
+		}
+	}
+	grant := share.Grant{ResourceType: input.ResourceType, ResourceID: input.ResourceID, AccountID: input.AccountID}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.shares.Delete(txCtx, input.ResourceType, input.ResourceID, input.AccountID); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.ShareEvent(unsharedEvent(input.ResourceType), grant, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentShareRevoked(ctx)
+}
+
+// ownerOf returns the owner and workspace of a live note or template; resources in the trash cannot be shared.
+func (u *ShareInteractor) ownerOf(ctx context.Context, resourceType share.ResourceType, resourceID string) (string, string, error) {
+	switch resourceType {
+	case share.ResourceNote:
+		n, err := u.notes.Get(ctx, resourceID)
+		if err != nil {
+			return "", "", err
+		}
+		return n.Note.OwnerID, n.Note.WorkspaceID, nil
+	case share.ResourceTemplate:
+		t, err := u.templates.Get(ctx, resourceID)
+		if err != nil {
+			return "", "", err
+		}
+		return t.Template.OwnerID, t.Template.WorkspaceID, nil
+	}
+	return "", "", domainerr.ErrNotFound
+}
+
+// ensureGranteeActive reports a grantee that is an unknown or deactivated account as a violation.
+func (u *ShareInteractor) ensureGranteeActive(ctx context.Context, accountID string) error {
+	a, err := u.accounts.GetByID(ctx, accountID)
+	if err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+		return err
+	}
+	if err != nil || account.EnsureActive(*a) != nil {
+		verr := &domainerr.ValidationError{}
+		verr.Add("accountId", domainerr.CodeInvalid, domainerr.ErrUnknownShareAccount)
+		return verr.Err()
+	}
+	return nil
+}
+
+// ensureGranteeMember reports a grantee outside the resource's workspace as a violation.
+func (u *ShareInteractor) ensureGranteeMember(ctx context.Context, workspaceID, accountID string) error {
+	// ルール: 共有できるのはリソースと同じワークスペースのメンバーだけ
+	_, err := u.workspaces.GetMember(ctx, workspaceID, accountID)
+	if errors.Is(err, domainerr.ErrNotFound) {
+		verr := &domainerr.ValidationError{}
+		verr.Add("accountId", domainerr.CodeInvalid, domainerr.ErrNotWorkspaceMember)
+		return verr.Err()
+	}
+	return err
+}
+
+func sharedEvent(resourceType share.ResourceType) string {
+	if resourceType == share.ResourceTemplate {
+		return outbox.TemplateShared
+	}
+	return outbox.NoteShared
+}
+
+func unsharedEvent(resourceType share.ResourceType) string {
+	if resourceType == share.ResourceTemplate {
+		return outbox.TemplateUnshared
+	}
+	return outbox.NoteUnshared
+}
+
+// grantOf returns the grant of actorID on a resource owned by ownerID, nil when there is none.
+// The owner needs no grant, so the lookup is skipped for them; an empty actorID is rejected.
+func grantOf(ctx context.Context, shares port.ShareRepository, resourceType share.ResourceType, resourceID, ownerID, actorID string) (*share.Grant, error) {
+	if actorID == "" {
+		return nil, domainerr.ErrUnauthorized
+	}
+	if actorID == ownerID {
+		return nil, nil
+	}
+	g, err := shares.Get(ctx, resourceType, resourceID, actorID)
+	if errors.Is(err, domainerr.ErrNotFound) {
+		return nil, nil
+	}
+	return g, err
+}
+
+// roleOn resolves the role actorID holds on a resource owned by ownerID.
+func roleOn(ctx context.Context, shares port.ShareRepository, resourceType share.ResourceType, resourceID, ownerID, actorID string) (share.Role, error) {
+	grant, err := grantOf(ctx, shares, resourceType, resourceID, ownerID, actorID)
+	if err != nil {
+		return "", err
+	}
+	return service.RoleOn(ownerID, actorID, grant), nil
+}
+
+// authorize checks actorID holds at least the required role on a resource owned by ownerID.
+func authorize(ctx context.Context, shares port.ShareRepository, resourceType share.ResourceType, resourceID, ownerID, actorID string, required share.Role) error {
+	grant, err := grantOf(ctx, shares, resourceType, resourceID, ownerID, actorID)
+	if err != nil {
+		return err
+	}
+	return service.Authorize(ownerID, actorID, grant, required)
+}
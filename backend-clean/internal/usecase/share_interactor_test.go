@@ -0,0 +1,230 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestShareInteractor_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		grant     *share.Grant
+		wantError error
+	}{
+		{name: "[Success] owner", actorID: "owner-1"},
+		{name: "[Success] viewer", actorID: "viewer-1", grant: &share.Grant{AccountID: "viewer-1", Role: share.RoleViewer}},
+		{name: "[Fail] not shared", actorID: "other", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			shares := mockusecase.NewMockShareRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockShareOutputPort(ctrl)
+
+			grants := []share.Grant{{ResourceType: share.ResourceNote, ResourceID: "note-1", AccountID: "viewer-1", Role: share.RoleViewer}}
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}}, nil)
+			if tt.actorID != "owner-1" {
+				var getErr error
+				if tt.grant == nil {
+					getErr = domainerr.ErrNotFound
+				}
+				shares.EXPECT().Get(gomock.Any(), share.ResourceNote, "note-1", tt.actorID).Return(tt.grant, getErr)
+			}
+			if tt.wantError == nil {
+				shares.EXPECT().List(gomock.Any(), share.ResourceNote, "note-1").Return(grants, nil)
+				out.EXPECT().PresentShares(gomock.Any(), grants).Return(nil)
+			}
+
+			interactor := uc.NewShareInteractor(shares, notesRepo, mockusecase.NewMockTemplateRepository(ctrl), activeAccounts(ctrl), allMembers(ctrl), mockusecase.NewMockTxManager(ctrl), anyOutbox(ctrl), out)
+			err := interactor.List(context.Background(), port.ShareListInput{ResourceType: share.ResourceNote, ResourceID: "note-1", ActorID: tt.actorID})
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestShareInteractor_Grant(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.ShareGrantInput
+		actorRole share.Role
+		grantee   *account.Account
+		outsider  bool
+		upsertErr error
+		wantError error
+	}{
+		{
+			name:    "[Success] owner shares template as editor",
+			input:   port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "editor-1", Role: share.RoleEditor, ActorID: "owner-1"},
+			grantee: &account.Account{ID: "editor-1", IsActive: true},
+		},
+		{
+			name:      "[Success] co-owner shares",
+			input:     port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "viewer-1", Role: share.RoleViewer, ActorID: "co-owner"},
+			actorRole: share.RoleOwner,
+			grantee:   &account.Account{ID: "viewer-1", IsActive: true},
+		},
+		{
+			name:      "[Fail] editor cannot share",
+			input:     port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "viewer-1", Role: share.RoleViewer, ActorID: "editor-1"},
+			actorRole: share.RoleEditor,
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] invalid role",
+			input:     port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "viewer-1", Role: "admin", ActorID: "owner-1"},
+			wantError: violation("role", domainerr.CodeInvalid, domainerr.ErrInvalidShareRole),
+		},
+		{
+			name:      "[Fail] deactivated grantee",
+			input:     port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "viewer-1", Role: share.RoleViewer, ActorID: "owner-1"},
+			grantee:   &account.Account{ID: "viewer-1", IsActive: false},
+			wantError: violation("accountId", domainerr.CodeInvalid, domainerr.ErrUnknownShareAccount),
+		},
+		{
+			name:      "[Fail] grantee outside the workspace",
+			input:     port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "outsider", Role: share.RoleViewer, ActorID: "owner-1"},
+			grantee:   &account.Account{ID: "outsider", IsActive: true},
+			outsider:  true,
+			wantError: violation("accountId", domainerr.CodeInvalid, domainerr.ErrNotWorkspaceMember),
+		},
+		{
+			name:      "[Fail] upsert error",
+			input:     port.ShareGrantInput{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: "viewer-1", Role: share.RoleViewer, ActorID: "owner-1"},
+			grantee:   &account.Account{ID: "viewer-1", IsActive: true},
+			upsertErr: errors.New("upsert err"),
+			wantError: errors.New("upsert err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			shares := mockusecase.NewMockShareRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockShareOutputPort(ctrl)
+
+			accounts.EXPECT().GetByID(gomock.Any(), tt.input.ActorID).Return(&account.Account{ID: tt.input.ActorID, IsActive: true}, nil)
+			templates.EXPECT().Get(gomock.Any(), "tpl-1").Return(&template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", WorkspaceID: "ws-1"}}, nil)
+			workspaces := allMembers(ctrl)
+			if tt.outsider {
+				workspaces = mockusecase.NewMockWorkspaceRepository(ctrl)
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", tt.input.ActorID).Return(&workspace.Member{Role: workspace.RoleOwner}, nil)
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", tt.input.AccountID).Return(nil, domainerr.ErrNotFound)
+			}
+			if tt.input.ActorID != "owner-1" {
+				shares.EXPECT().Get(gomock.Any(), share.ResourceTemplate, "tpl-1", tt.input.ActorID).Return(&share.Grant{AccountID: tt.input.ActorID, Role: tt.actorRole}, nil)
+			}
+			if tt.grantee != nil {
+				accounts.EXPECT().GetByID(gomock.Any(), tt.grantee.ID).Return(tt.grantee, nil)
+			}
+			if tt.grantee != nil && tt.grantee.IsActive && !tt.outsider {
+				passThroughTx(tx)
+				saved := &share.Grant{ResourceType: share.ResourceTemplate, ResourceID: "tpl-1", AccountID: tt.input.AccountID, Role: tt.input.Role, GrantedBy: tt.input.ActorID}
+				shares.EXPECT().Upsert(gomock.Any(), *saved).Return(saved, tt.upsertErr)
+				if tt.upsertErr == nil {
+					publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.TemplateShared, "tpl-1")).Return(nil)
+					out.EXPECT().PresentShare(gomock.Any(), saved).Return(nil)
+				}
+			}
+
+			interactor := uc.NewShareInteractor(shares, mockusecase.NewMockNoteRepository(ctrl), templates, accounts, workspaces, tx, publisher, out)
+			err := interactor.Grant(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestShareInteractor_Revoke(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.ShareRevokeInput
+		actorRole share.Role
+		deleteErr error
+		wantError error
+	}{
+		{
+			name:  "[Success] owner revokes",
+			input: port.ShareRevokeInput{ResourceType: share.ResourceNote, ResourceID: "note-1", AccountID: "viewer-1", ActorID: "owner-1"},
+		},
+		{
+			name:  "[Success] grantee leaves",
+			input: port.ShareRevokeInput{ResourceType: share.ResourceNote, ResourceID: "note-1", AccountID: "viewer-1", ActorID: "viewer-1"},
+		},
+		{
+			name:      "[Fail] viewer cannot revoke others",
+			input:     port.ShareRevokeInput{ResourceType: share.ResourceNote, ResourceID: "note-1", AccountID: "editor-1", ActorID: "viewer-1"},
+			actorRole: share.RoleViewer,
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] grant not found",
+			input:     port.ShareRevokeInput{ResourceType: share.ResourceNote, ResourceID: "note-1", AccountID: "viewer-1", ActorID: "owner-1"},
+			deleteErr: domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			shares := mockusecase.NewMockShareRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockShareOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}}, nil)
+			if tt.actorRole != "" {
+				shares.EXPECT().Get(gomock.Any(), share.ResourceNote, "note-1", tt.input.ActorID).Return(&share.Grant{AccountID: tt.input.ActorID, Role: tt.actorRole}, nil)
+			}
+			if !errors.Is(tt.wantError, domainerr.ErrUnauthorized) {
+				passThroughTx(tx)
+				shares.EXPECT().Delete(gomock.Any(), share.ResourceNote, "note-1", tt.input.AccountID).Return(tt.deleteErr)
+			}
+			if tt.wantError == nil {
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.NoteUnshared, "note-1")).Return(nil)
+				out.EXPECT().PresentShareRevoked(gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewShareInteractor(shares, notesRepo, mockusecase.NewMockTemplateRepository(ctrl), activeAccounts(ctrl), allMembers(ctrl), tx, publisher, out)
+			err := interactor.Revoke(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
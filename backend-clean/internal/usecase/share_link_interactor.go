@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/share"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ShareLinkInteractor handles public read-only links to published notes.
+type ShareLinkInteractor struct {
+	links      port.ShareLinkRepository
+	notes      port.NoteRepository
+	accounts   port.AccountRepository
+	shares     port.ShareRepository
+	workspaces port.WorkspaceRepository
+	tokens     port.LinkTokenGenerator
+	tx         port.TxManager
+	publisher  port.EventPublisher
+	output     port.ShareLinkOutputPort
+}
+
+var _ port.ShareLinkInputPort = (*ShareLinkInteractor)(nil)
+
+// NewShareLinkInteractor creates ShareLinkInteractor.
+// Links are managed by the owners of a note, decided by the roles in shares, and changes
+// are recorded to publisher within their transaction.
+func NewShareLinkInteractor(links port.ShareLinkRepository, notes port.NoteRepository, accounts port.AccountRepository, shares port.ShareRepository, workspaces port.WorkspaceRepository, tokens port.LinkTokenGenerator, tx port.TxManager, publisher port.EventPublisher, output port.ShareLinkOutputPort) *ShareLinkInteractor {
+	return &ShareLinkInteractor{
+		links:      links,
+		notes:      notes,
+		accounts:   accounts,
+		shares:     shares,
+		workspaces: workspaces,
+		tokens:     tokens,
+		tx:         tx,
+		publisher:  publisher,
+		output:     output,
+	}
+}
+
+// Create issues a new link to a published note. The raw token is presented once.
+func (u *ShareLinkInteractor) Create(ctx context.Context, input port.ShareLinkCreateInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	n, err := u.ownedNote(ctx, input.NoteID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	link := sharelink.Link{NoteID: input.NoteID, CreatedBy: input.ActorID, ExpiresAt: input.ExpiresAt}
+	now := time.Now()
+	if err := sharelink.ValidateLink(n.Note, link, now); err != nil {
+		return err
+	}
+	token, err := u.tokens.Generate(ctx)
+	if err != nil {
+		return err
+	}
+	link.TokenHash = sharelink.HashToken(token)
+
+	var saved *sharelink.Link
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		saved, err = u.links.Create(txCtx, link)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.LinkEvent(outbox.NoteLinkCreated, *saved, now))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentShareLink(ctx, saved, token)
+}
+
+// List returns every link of a note to its owners.
+func (u *ShareLinkInteractor) List(ctx context.Context, noteID, actorID string) error {
+	if _, err := u.ownedNote(ctx, noteID, actorID); err != nil {
+		return err
+	}
+	links, err := u.links.List(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentShareLinks(ctx, links)
+}
+
+// Revoke stops a link from working.
+func (u *ShareLinkInteractor) Revoke(ctx context.Context, input port.ShareLinkRevokeInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	if _, err := u.ownedNote(ctx, input.NoteID, input.ActorID); err != nil {
+		return err
+	}
+	now := time.Now()
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.links.Revoke(txCtx, input.NoteID, input.LinkID, now); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.LinkEvent(outbox.NoteLinkRevoked, sharelink.Link{ID: input.LinkID, NoteID: input.NoteID}, now))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentShareLinkRevoked(ctx)
+}
+
+// View returns the note a link points to while the link works and the note stays published.
+// Anything else is reported as errors.ErrNotFound.
+func (u *ShareLinkInteractor) View(ctx context.Context, token string) error {
+	if token == "" {
+		return domainerr.ErrNotFound
+	}
+	link, err := u.links.GetByTokenHash(ctx, sharelink.HashToken(token))
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, link.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := sharelink.CanView(*link, n.Note, time.Now()); err != nil {
+		return err
+	}
+	return u.output.PresentPublicNote(ctx, n)
+}
+
+// ownedNote returns a note the actor holds the owner role on; links are managed by owners only.
+func (u *ShareLinkInteractor) ownedNote(ctx context.Context, noteID, actorID string) (*note.WithMeta, error) {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureMember(ctx, u.workspaces, n.Note.WorkspaceID, actorID); err != nil {
+		return nil, err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceNote, noteID, n.Note.OwnerID, actorID, share.RoleOwner); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
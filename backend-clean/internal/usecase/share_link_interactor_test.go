@@ -0,0 +1,173 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/sharelink"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestShareLinkInteractor_Create(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		actorID   string
+		status    note.NoteStatus
+		expiresAt *time.Time
+		tokenErr  error
+		createErr error
+		wantError error
+	}{
+		{name: "[Success] owner links published note", actorID: "owner-1", status: note.StatusPublish},
+		{name: "[Success] link with expiry", actorID: "owner-1", status: note.StatusPublish, expiresAt: &future},
+		{name: "[Fail] not the owner", actorID: "other", status: note.StatusPublish, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] draft note", actorID: "owner-1", status: note.StatusDraft, wantError: domainerr.ErrShareLinkNotPublished},
+		{name: "[Fail] expiry in the past", actorID: "owner-1", status: note.StatusPublish, expiresAt: &past, wantError: domainerr.ErrShareLinkExpiryInPast},
+		{name: "[Fail] token error", actorID: "owner-1", status: note.StatusPublish, tokenErr: errors.New("rand err"), wantError: errors.New("rand err")},
+		{name: "[Fail] create error", actorID: "owner-1", status: note.StatusPublish, createErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			links := mockusecase.NewMockShareLinkRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tokens := mockusecase.NewMockLinkTokenGenerator(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockShareLinkOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", WorkspaceID: "ws-1", Status: tt.status}}, nil)
+			validated := tt.actorID == "owner-1" && tt.status == note.StatusPublish && tt.expiresAt != &past
+			if validated {
+				tokens.EXPECT().Generate(gomock.Any()).Return("raw-token", tt.tokenErr)
+			}
+			if validated && tt.tokenErr == nil {
+				passThroughTx(tx)
+				want := sharelink.Link{NoteID: "note-1", TokenHash: sharelink.HashToken("raw-token"), CreatedBy: "owner-1", ExpiresAt: tt.expiresAt}
+				saved := want
+				saved.ID = "link-1"
+				links.EXPECT().Create(gomock.Any(), want).Return(&saved, tt.createErr)
+				if tt.createErr == nil {
+					publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.NoteLinkCreated, "note-1")).Return(nil)
+					out.EXPECT().PresentShareLink(gomock.Any(), &saved, "raw-token").Return(nil)
+				}
+			}
+
+			interactor := uc.NewShareLinkInteractor(links, notesRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tokens, tx, publisher, out)
+			err := interactor.Create(context.Background(), port.ShareLinkCreateInput{NoteID: "note-1", ExpiresAt: tt.expiresAt, ActorID: tt.actorID})
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || (!errors.Is(err, tt.wantError) && tt.wantError.Error() != err.Error())) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestShareLinkInteractor_Revoke(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		revokeErr error
+		wantError error
+	}{
+		{name: "[Success] owner revokes", actorID: "owner-1"},
+		{name: "[Fail] not the owner", actorID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] already revoked", actorID: "owner-1", revokeErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			links := mockusecase.NewMockShareLinkRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockShareLinkOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusPublish}}, nil)
+			if tt.actorID == "owner-1" {
+				passThroughTx(tx)
+				links.EXPECT().Revoke(gomock.Any(), "note-1", "link-1", gomock.Any()).Return(tt.revokeErr)
+				if tt.revokeErr == nil {
+					publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.NoteLinkRevoked, "note-1")).Return(nil)
+					out.EXPECT().PresentShareLinkRevoked(gomock.Any()).Return(nil)
+				}
+			}
+
+			interactor := uc.NewShareLinkInteractor(links, notesRepo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), mockusecase.NewMockLinkTokenGenerator(ctrl), tx, publisher, out)
+			err := interactor.Revoke(context.Background(), port.ShareLinkRevokeInput{NoteID: "note-1", LinkID: "link-1", ActorID: tt.actorID})
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestShareLinkInteractor_View(t *testing.T) {
+	revoked := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name      string
+		token     string
+		link      *sharelink.Link
+		status    note.NoteStatus
+		wantError error
+	}{
+		{name: "[Success] live link", token: "raw-token", link: &sharelink.Link{ID: "link-1", NoteID: "note-1"}, status: note.StatusPublish},
+		{name: "[Fail] empty token", token: "", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] unknown token", token: "nope", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] revoked link", token: "raw-token", link: &sharelink.Link{ID: "link-1", NoteID: "note-1", RevokedAt: &revoked}, status: note.StatusPublish, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] note no longer published", token: "raw-token", link: &sharelink.Link{ID: "link-1", NoteID: "note-1"}, status: note.StatusDraft, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			links := mockusecase.NewMockShareLinkRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockShareLinkOutputPort(ctrl)
+
+			if tt.token != "" {
+				var getErr error
+				if tt.link == nil {
+					getErr = domainerr.ErrNotFound
+				}
+				links.EXPECT().GetByTokenHash(gomock.Any(), sharelink.HashToken(tt.token)).Return(tt.link, getErr)
+			}
+			n := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: tt.status}}
+			if tt.link != nil {
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(n, nil)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentPublicNote(gomock.Any(), n).Return(nil)
+			}
+
+			// No account is involved: strict mocks fail the test if they are used.
+			interactor := uc.NewShareLinkInteractor(links, notesRepo, mockusecase.NewMockAccountRepository(ctrl), mockusecase.NewMockShareRepository(ctrl), mockusecase.NewMockWorkspaceRepository(ctrl), mockusecase.NewMockLinkTokenGenerator(ctrl), mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), out)
+			err := interactor.View(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -3,41 +3,61 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
+	"immortal-architecture-clean/backend/internal/domain/share"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // TemplateInteractor handles template use cases.
 type TemplateInteractor struct {
-	repo   port.TemplateRepository
-	tx     port.TxManager
-	output port.TemplateOutputPort
+	repo       port.TemplateRepository
+	accounts   port.AccountRepository
+	shares     port.ShareRepository
+	workspaces port.WorkspaceRepository
+	tx         port.TxManager
+	publisher  port.EventPublisher
+	output     port.TemplateOutputPort
 }
 
 var _ port.TemplateInputPort = (*TemplateInteractor)(nil)
 
 // NewTemplateInteractor creates TemplateInteractor.
-func NewTemplateInteractor(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) *TemplateInteractor {
-	return &TemplateInteractor{repo: repo, tx: tx, output: output}
+// Changes are recorded to publisher within their transaction; who may change a template is decided by the roles in shares,
+// and only members of the template's workspace may see or change it.
+func NewTemplateInteractor(repo port.TemplateRepository, accounts port.AccountRepository, shares port.ShareRepository, workspaces port.WorkspaceRepository, tx port.TxManager, publisher port.EventPublisher, output port.TemplateOutputPort) *TemplateInteractor {
+	return &TemplateInteractor{repo: repo, accounts: accounts, shares: shares, workspaces: workspaces, tx: tx, publisher: publisher, output: output}
 }
 
-// List returns templates by filters.
+// List returns one page of templates by filters.
 func (u *TemplateInteractor) List(ctx context.Context, filters template.Filters) error {
+	limit, err := pagination.NormalizeLimit(filters.Limit)
+	if err != nil {
+		return err
+	}
+	filters.Limit = limit + 1
 	templates, err := u.repo.List(ctx, filters)
 	if err != nil {
 		return err
 	}
-	return u.output.PresentTemplateList(ctx, templates)
+	templates, page := pagination.Trim(templates, limit, template.WithUsage.PageCursor)
+	return u.output.PresentTemplateList(ctx, templates, page)
 }
 
 // Get returns template by ID.
-func (u *TemplateInteractor) Get(ctx context.Context, id string) error {
+func (u *TemplateInteractor) Get(ctx context.Context, id, actorID string) error {
 	tpl, err := u.repo.Get(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err := u.ensureReadable(ctx, tpl.Template, actorID); err != nil {
+		return err
+	}
 	return u.output.PresentTemplate(ctx, tpl)
 }
 
@@ -50,23 +70,31 @@ func (u *TemplateInteractor) Create(ctx context.Context, input port.TemplateCrea
 	}); err != nil {
 		return err
 	}
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	workspaceID, err := u.workspaceFor(ctx, input)
+	if err != nil {
+		return err
+	}
 
 	var createdID string
-	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
 		tpl, err := u.repo.Create(txCtx, template.Template{
-			Name:    input.Name,
-			OwnerID: input.OwnerID,
+			Name:        input.Name,
+			OwnerID:     input.OwnerID,
+			WorkspaceID: workspaceID,
 		})
 		if err != nil {
 			return err
 		}
 		createdID = tpl.ID
 		if len(input.Fields) > 0 {
-			if err := u.repo.ReplaceFields(txCtx, tpl.ID, input.Fields); err != nil {
+			if err := u.repo.ReplaceFields(txCtx, tpl.ID, tpl.SchemaVersion, input.Fields); err != nil {
 				return err
 			}
 		}
-		return nil
+		return u.publisher.Publish(txCtx, outbox.TemplateEvent(outbox.TemplateCreated, *tpl, time.Now()))
 	})
 	if err != nil {
 		return err
@@ -80,13 +108,26 @@ func (u *TemplateInteractor) Create(ctx context.Context, input port.TemplateCrea
 
 // Update updates a template.
 func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpdateInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
 	current, err := u.repo.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	if err := template.ValidateTemplateOwnership(current.Template.OwnerID, input.OwnerID); err != nil {
+	if strings.TrimSpace(input.OwnerID) == "" {
+		return domainerr.ErrTemplateOwnerRequired
+	}
+	if err := ensureMember(ctx, u.workspaces, current.Template.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceTemplate, input.ID, current.Template.OwnerID, input.OwnerID, share.RoleEditor); err != nil {
+		return err
+	}
+	if err := current.Template.CheckVersion(input.Version); err != nil {
 		return err
 	}
+	var fields []template.Field
 	if input.Fields != nil {
 		if err := template.ValidateTemplate(template.Template{
 			ID:      input.ID,
@@ -96,11 +137,15 @@ func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpda
 		}); err != nil {
 			return err
 		}
+		if fields, err = template.CarryFieldKeys(current.Template.Fields, input.Fields); err != nil {
+			return err
+		}
 	}
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		_, err := u.repo.Update(txCtx, template.Template{
-			ID:   input.ID,
-			Name: input.Name,
+		updated, err := u.repo.Update(txCtx, template.Template{
+			ID:      input.ID,
+			Name:    input.Name,
+			Version: current.Template.Version,
 		})
 		if err != nil {
 			return err
@@ -109,11 +154,15 @@ func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpda
 			if len(input.Fields) == 0 {
 				return domainerr.ErrInvalidTemplateField
 			}
-			if err := u.repo.ReplaceFields(txCtx, input.ID, input.Fields); err != nil {
-				return err
+			// ルール: フィールド構成が変わったときだけ新しいスキーマバージョンを作る
+			if template.FieldsChanged(current.Template.Fields, fields) {
+				if err := u.repo.ReplaceFields(txCtx, input.ID, current.Template.SchemaVersion+1, fields); err != nil {
+					return err
+				}
+				updated.SchemaVersion = current.Template.SchemaVersion + 1
 			}
 		}
-		return nil
+		return u.publisher.Publish(txCtx, outbox.TemplateEvent(outbox.TemplateUpdated, *updated, time.Now()))
 	})
 	if err != nil {
 		return err
@@ -126,19 +175,173 @@ func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpda
 }
 
 // Delete deletes a template.
-func (u *TemplateInteractor) Delete(ctx context.Context, id, ownerID string) error {
-	tpl, err := u.repo.Get(ctx, id)
+func (u *TemplateInteractor) Delete(ctx context.Context, input port.TemplateDeleteInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	tpl, err := u.repo.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	if err := template.ValidateTemplateOwnership(tpl.Template.OwnerID, ownerID); err != nil {
+	if strings.TrimSpace(input.OwnerID) == "" {
+		return domainerr.ErrTemplateOwnerRequired
+	}
+	if err := ensureMember(ctx, u.workspaces, tpl.Template.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceTemplate, input.ID, tpl.Template.OwnerID, input.OwnerID, share.RoleOwner); err != nil {
+		return err
+	}
+	if err := tpl.Template.CheckVersion(input.Version); err != nil {
 		return err
 	}
 	if err := template.CanDeleteTemplate(tpl.IsUsed); err != nil {
 		return err
 	}
-	if err := u.repo.Delete(ctx, id); err != nil {
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.repo.Delete(txCtx, input.ID, tpl.Template.Version); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.TemplateEvent(outbox.TemplateDeleted, tpl.Template, time.Now()))
+	})
+	if err != nil {
 		return err
 	}
 	return u.output.PresentTemplateDeleted(ctx)
 }
+
+// Restore takes a template out of the trash.
+func (u *TemplateInteractor) Restore(ctx context.Context, input port.TemplateRestoreInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	trashed, err := u.repo.GetTrashed(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(input.OwnerID) == "" {
+		return domainerr.ErrTemplateOwnerRequired
+	}
+	if err := ensureMember(ctx, u.workspaces, trashed.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := authorize(ctx, u.shares, share.ResourceTemplate, input.ID, trashed.OwnerID, input.OwnerID, share.RoleOwner); err != nil {
+		return err
+	}
+	if err := trashed.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		restored, err := u.repo.Restore(txCtx, input.ID, trashed.Version)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.TemplateEvent(outbox.TemplateRestored, *restored, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	tpl, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTemplate(ctx, tpl)
+}
+
+// Import creates a template owned by the caller from a portable document.
+// The document's fields become new fields in the order they are listed.
+func (u *TemplateInteractor) Import(ctx context.Context, input port.TemplateImportInput) error {
+	if err := input.Document.CheckVersion(); err != nil {
+		return err
+	}
+	return u.Create(ctx, port.TemplateCreateInput{
+		Name:        input.Document.Name,
+		OwnerID:     input.OwnerID,
+		WorkspaceID: input.WorkspaceID,
+		Fields:      template.CloneFields(input.Document.Fields),
+	})
+}
+
+// Clone creates a template owned by the caller with the name and fields of another one.
+// The copy starts private and keeps no link to its source.
+func (u *TemplateInteractor) Clone(ctx context.Context, input port.TemplateCloneInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	src, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := u.ensureReadable(ctx, src.Template, input.OwnerID); err != nil {
+		return err
+	}
+	return u.Create(ctx, port.TemplateCreateInput{
+		Name:        src.Template.Name,
+		OwnerID:     input.OwnerID,
+		WorkspaceID: input.WorkspaceID,
+		Fields:      template.CloneFields(src.Template.Fields),
+	})
+}
+
+// SetVisibility lists a template in the gallery or takes it out.
+func (u *TemplateInteractor) SetVisibility(ctx context.Context, input port.TemplateVisibilityInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(input.OwnerID) == "" {
+		return domainerr.ErrTemplateOwnerRequired
+	}
+	if err := ensureMember(ctx, u.workspaces, current.Template.WorkspaceID, input.OwnerID); err != nil {
+		return err
+	}
+	// ルール: ギャラリーへの公開・非公開を切り替えられるのはオーナー権限を持つアカウントだけ
+	if err := authorize(ctx, u.shares, share.ResourceTemplate, input.ID, current.Template.OwnerID, input.OwnerID, share.RoleOwner); err != nil {
+		return err
+	}
+	if err := current.Template.CheckVersion(input.Version); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.repo.SetPublic(txCtx, input.ID, input.IsPublic, current.Template.Version)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.TemplateEvent(outbox.TemplateUpdated, *updated, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	tpl, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTemplate(ctx, tpl)
+}
+
+// ensureReadable lets members of the template's workspace read it.
+// ルール: ギャラリーに公開されたテンプレートはワークスペース外のアカウントも閲覧・複製できる
+func (u *TemplateInteractor) ensureReadable(ctx context.Context, tpl template.Template, actorID string) error {
+	if tpl.IsPublic {
+		return nil
+	}
+	return ensureMember(ctx, u.workspaces, tpl.WorkspaceID, actorID)
+}
+
+// workspaceFor resolves the workspace a template is created in; the owner must be a member of it.
+func (u *TemplateInteractor) workspaceFor(ctx context.Context, input port.TemplateCreateInput) (string, error) {
+	if input.WorkspaceID == "" {
+		personal, err := u.workspaces.EnsurePersonal(ctx, input.OwnerID)
+		if err != nil {
+			return "", err
+		}
+		return personal.ID, nil
+	}
+	if err := ensureMember(ctx, u.workspaces, input.WorkspaceID, input.OwnerID); err != nil {
+		return "", err
+	}
+	return input.WorkspaceID, nil
+}
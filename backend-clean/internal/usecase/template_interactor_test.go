@@ -7,8 +7,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/account"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/pagination"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
 	"immortal-architecture-clean/backend/internal/port"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
@@ -32,7 +36,7 @@ func TestTemplateInteractor_Create(t *testing.T) {
 					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
 				},
 			},
-			created: &template.Template{ID: "tpl-1", Name: "Template", OwnerID: "owner-1"},
+			created: &template.Template{ID: "tpl-1", Name: "Template", OwnerID: "owner-1", SchemaVersion: 1},
 			withFields: &template.WithUsage{
 				Template: template.Template{
 					ID:      "tpl-1",
@@ -49,7 +53,7 @@ func TestTemplateInteractor_Create(t *testing.T) {
 				OwnerID: "owner-1",
 				Fields:  []template.Field{{ID: "f1", Label: "Title", Order: 1}},
 			},
-			wantError: domainerr.ErrTemplateNameRequired,
+			wantError: violation("name", domainerr.CodeRequired, domainerr.ErrTemplateNameRequired),
 		},
 		{
 			name: "[Fail] repo create error",
@@ -85,12 +89,12 @@ func TestTemplateInteractor_Create(t *testing.T) {
 				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.created, tt.createErr)
 			}
 			if tt.created != nil && tt.createErr == nil {
-				repo.EXPECT().ReplaceFields(gomock.Any(), tt.created.ID, gomock.Any()).Return(nil)
+				repo.EXPECT().ReplaceFields(gomock.Any(), tt.created.ID, tt.created.SchemaVersion, gomock.Any()).Return(nil)
 				repo.EXPECT().Get(gomock.Any(), tt.created.ID).Return(tt.withFields, nil)
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.withFields).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, anyOutbox(ctrl), out)
 			err := interactor.Create(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -107,22 +111,41 @@ func TestTemplateInteractor_Create(t *testing.T) {
 
 func TestTemplateInteractor_List(t *testing.T) {
 	tests := []struct {
-		name      string
-		filters   template.Filters
-		result    []template.WithUsage
-		repoErr   error
-		wantError error
+		name        string
+		filters     template.Filters
+		repoLimit   int
+		result      []template.WithUsage
+		repoErr     error
+		wantHasMore bool
+		wantError   error
 	}{
 		{
-			name:    "[Success] list templates",
-			filters: template.Filters{OwnerID: strPtr("owner-1")},
+			name:      "[Success] list templates",
+			filters:   template.Filters{OwnerID: strPtr("owner-1")},
+			repoLimit: pagination.DefaultLimit + 1,
 			result: []template.WithUsage{
 				{Template: template.Template{ID: "tpl-1", Name: "tpl"}},
 			},
 		},
+		{
+			name:      "[Success] extra row sets hasMore",
+			filters:   template.Filters{Limit: 1},
+			repoLimit: 2,
+			result: []template.WithUsage{
+				{Template: template.Template{ID: "tpl-1"}},
+				{Template: template.Template{ID: "tpl-2"}},
+			},
+			wantHasMore: true,
+		},
+		{
+			name:      "[Fail] negative limit",
+			filters:   template.Filters{Limit: -1},
+			wantError: domainerr.ErrInvalidPageLimit,
+		},
 		{
 			name:      "[Fail] repo error",
 			filters:   template.Filters{},
+			repoLimit: pagination.DefaultLimit + 1,
 			repoErr:   errors.New("repo error"),
 			wantError: errors.New("repo error"),
 		},
@@ -137,18 +160,32 @@ func TestTemplateInteractor_List(t *testing.T) {
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
-			repo.EXPECT().List(gomock.Any(), tt.filters).Return(tt.result, tt.repoErr)
-			if tt.repoErr == nil {
-				out.EXPECT().PresentTemplateList(gomock.Any(), tt.result).Return(nil)
+			if tt.repoLimit > 0 {
+				want := tt.filters
+				want.Limit = tt.repoLimit
+				repo.EXPECT().List(gomock.Any(), want).Return(tt.result, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentTemplateList(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, got []template.WithUsage, info pagination.Info) error {
+						if info.HasMore != tt.wantHasMore || (info.NextCursor != nil) != tt.wantHasMore {
+							t.Fatalf("unexpected page info: %+v", info)
+						}
+						if tt.wantHasMore && len(got) != tt.filters.Limit {
+							t.Fatalf("want %d templates, got %d", tt.filters.Limit, len(got))
+						}
+						return nil
+					},
+				)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, anyOutbox(ctrl), out)
 			err := interactor.List(context.Background(), tt.filters)
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			if tt.wantError != nil && tt.wantError.Error() != err.Error() {
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
 				t.Fatalf("want %v, got %v", tt.wantError, err)
 			}
 		})
@@ -190,8 +227,8 @@ func TestTemplateInteractor_Get(t *testing.T) {
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
-			err := interactor.Get(context.Background(), tt.id)
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, anyOutbox(ctrl), out)
+			err := interactor.Get(context.Background(), tt.id, "owner")
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -204,6 +241,12 @@ func TestTemplateInteractor_Get(t *testing.T) {
 }
 
 func TestTemplateInteractor_Update(t *testing.T) {
+	withFields := func() *template.WithUsage {
+		return &template.WithUsage{Template: template.Template{
+			ID: "tpl-1", Name: "old", OwnerID: "owner-1", SchemaVersion: 2,
+			Fields: []template.Field{{ID: "f1", Key: "k1", Label: "Title", Order: 1, IsRequired: true, Type: template.FieldTypeText}},
+		}}
+	}
 	tests := []struct {
 		name        string
 		input       port.TemplateUpdateInput
@@ -211,6 +254,7 @@ func TestTemplateInteractor_Update(t *testing.T) {
 		getErr      error
 		updateErr   error
 		replaceErr  error
+		wantFields  []template.Field
 		wantError   error
 		expectTxRun bool
 	}{
@@ -221,14 +265,88 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				Name:    "updated",
 				OwnerID: "owner-1",
 				Fields: []template.Field{
-					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
+					{ID: "f1", Label: "Heading", Order: 1, IsRequired: true},
+					{Label: "Body", Order: 2},
 				},
 			},
-			current: &template.WithUsage{
-				Template: template.Template{ID: "tpl-1", Name: "old", OwnerID: "owner-1"},
+			current: withFields(),
+			wantFields: []template.Field{
+				{ID: "f1", Key: "k1", Label: "Heading", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+				{Label: "Body", Order: 2, Type: template.FieldTypeText},
+			},
+			expectTxRun: true,
+		},
+		{
+			name: "[Success] changing a field type bumps the schema version",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Fields: []template.Field{
+					{ID: "f1", Label: "Title", Order: 1, IsRequired: true, Type: template.FieldTypeSingleSelect, Options: []string{"a", "b"}},
+				},
+			},
+			current: withFields(),
+			wantFields: []template.Field{
+				{ID: "f1", Key: "k1", Label: "Title", Order: 1, IsRequired: true, Type: template.FieldTypeSingleSelect, Options: []string{"a", "b"}},
+			},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] select field without options",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Fields:  []template.Field{{ID: "f1", Label: "Title", Order: 1, Type: template.FieldTypeMultiSelect}},
+			},
+			current:   withFields(),
+			wantError: violation("fields[0].options", domainerr.CodeRequired, domainerr.ErrFieldOptionsInvalid),
+		},
+		{
+			name: "[Success] unchanged fields keep the schema version",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Fields:  []template.Field{{ID: "f1", Label: "Title", Order: 1, IsRequired: true}},
+			},
+			current:     withFields(),
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] field from another schema version",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Fields:  []template.Field{{ID: "f-old", Label: "Title", Order: 1}},
 			},
+			current:   withFields(),
+			wantError: domainerr.ErrInvalidTemplateField,
+		},
+		{
+			name: "[Success] matching If-Match version",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Version: 7,
+			},
+			current:     &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "old", OwnerID: "owner-1", Version: 7}},
 			expectTxRun: true,
 		},
+		{
+			name: "[Fail] stale If-Match version",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Version: 6,
+			},
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "old", OwnerID: "owner-1", Version: 7}},
+			wantError: domainerr.ErrVersionConflict,
+		},
 		{
 			name: "[Fail] owner required",
 			input: port.TemplateUpdateInput{
@@ -248,7 +366,7 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				Fields:  []template.Field{},
 			},
 			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
-			wantError: domainerr.ErrFieldRequired,
+			wantError: violation("fields", domainerr.CodeRequired, domainerr.ErrFieldRequired),
 		},
 		{
 			name: "[Fail] repo get error",
@@ -278,12 +396,13 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				Name:    "updated",
 				OwnerID: "owner-1",
 				Fields: []template.Field{
-					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
+					{Label: "Title", Order: 1, IsRequired: true},
 				},
 			},
-			current:     &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
+			current:     withFields(),
 			updateErr:   nil,
 			replaceErr:  errors.New("replace err"),
+			wantFields:  []template.Field{{Label: "Title", Order: 1, IsRequired: true, Type: template.FieldTypeText}},
 			wantError:   errors.New("replace err"),
 			expectTxRun: true,
 		},
@@ -307,9 +426,16 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				)
 			}
 			if tt.getErr == nil && tt.expectTxRun {
-				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&tt.current.Template, tt.updateErr)
-				if tt.updateErr == nil && tt.input.Fields != nil {
-					repo.EXPECT().ReplaceFields(gomock.Any(), tt.input.ID, tt.input.Fields).Return(tt.replaceErr)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, tpl template.Template) (*template.Template, error) {
+						if tpl.Version != tt.current.Template.Version {
+							t.Fatalf("update must be conditional on the read version: got %d, want %d", tpl.Version, tt.current.Template.Version)
+						}
+						return &tt.current.Template, tt.updateErr
+					},
+				)
+				if tt.updateErr == nil && tt.wantFields != nil {
+					repo.EXPECT().ReplaceFields(gomock.Any(), tt.input.ID, tt.current.Template.SchemaVersion+1, tt.wantFields).Return(tt.replaceErr)
 				}
 			}
 			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && tt.replaceErr == nil {
@@ -317,7 +443,7 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, anyOutbox(ctrl), out)
 			err := interactor.Update(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -335,6 +461,7 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 		name      string
 		id        string
 		ownerID   string
+		version   int
 		current   *template.WithUsage
 		getErr    error
 		deleteErr error
@@ -369,6 +496,14 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}, IsUsed: true},
 			wantError: domainerr.ErrTemplateInUse,
 		},
+		{
+			name:      "[Fail] stale If-Match version",
+			id:        "tpl-1",
+			ownerID:   "owner-1",
+			version:   1,
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Version: 2}},
+			wantError: domainerr.ErrVersionConflict,
+		},
 		{
 			name:      "[Fail] delete error",
 			id:        "tpl-1",
@@ -390,15 +525,18 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.current, tt.getErr)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
 			if tt.getErr == nil && tt.expectDel {
-				repo.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.deleteErr)
+				passThroughTx(tx)
+				repo.EXPECT().Delete(gomock.Any(), tt.id, tt.current.Template.Version).Return(tt.deleteErr)
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.deleteErr == nil {
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.TemplateDeleted, tt.id)).Return(nil)
 				out.EXPECT().PresentTemplateDeleted(gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
-			err := interactor.Delete(context.Background(), tt.id, tt.ownerID)
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, publisher, out)
+			err := interactor.Delete(context.Background(), port.TemplateDeleteInput{ID: tt.id, OwnerID: tt.ownerID, Version: tt.version})
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -409,3 +547,332 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateInteractor_Restore(t *testing.T) {
+	trashed := func() *template.Template {
+		return &template.Template{ID: "tpl-1", OwnerID: "owner-1", Version: 2}
+	}
+	tests := []struct {
+		name       string
+		ownerID    string
+		version    int
+		current    *template.Template
+		getErr     error
+		restoreErr error
+		wantError  error
+		expectRest bool
+	}{
+		{
+			name:       "[Success] restore",
+			ownerID:    "owner-1",
+			current:    trashed(),
+			expectRest: true,
+		},
+		{
+			name:      "[Fail] not in trash",
+			ownerID:   "owner-1",
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] owner mismatch",
+			ownerID:   "other",
+			current:   trashed(),
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] stale If-Match version",
+			ownerID:   "owner-1",
+			version:   1,
+			current:   trashed(),
+			wantError: domainerr.ErrVersionConflict,
+		},
+		{
+			name:       "[Fail] restore error",
+			ownerID:    "owner-1",
+			current:    trashed(),
+			restoreErr: errors.New("restore err"),
+			wantError:  errors.New("restore err"),
+			expectRest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			repo.EXPECT().GetTrashed(gomock.Any(), "tpl-1").Return(tt.current, tt.getErr)
+			if tt.expectRest {
+				passThroughTx(tx)
+				repo.EXPECT().Restore(gomock.Any(), "tpl-1", 2).Return(&template.Template{ID: "tpl-1", Version: 3}, tt.restoreErr)
+			}
+			if tt.wantError == nil {
+				restored := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Version: 3}}
+				repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(restored, nil)
+				out.EXPECT().PresentTemplate(gomock.Any(), restored).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, anyOutbox(ctrl), out)
+			err := interactor.Restore(context.Background(), port.TemplateRestoreInput{ID: "tpl-1", OwnerID: tt.ownerID, Version: tt.version})
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_InactiveActor(t *testing.T) {
+	fields := []template.Field{{Label: "Body", Order: 1}}
+	tests := []struct {
+		name string
+		call func(u *uc.TemplateInteractor) error
+	}{
+		{
+			name: "[Fail] create by inactive account",
+			call: func(u *uc.TemplateInteractor) error {
+				return u.Create(context.Background(), port.TemplateCreateInput{Name: "tpl", OwnerID: "owner", Fields: fields})
+			},
+		},
+		{
+			name: "[Fail] update by inactive account",
+			call: func(u *uc.TemplateInteractor) error {
+				return u.Update(context.Background(), port.TemplateUpdateInput{ID: "tpl-1", Name: "tpl", OwnerID: "owner"})
+			},
+		},
+		{
+			name: "[Fail] delete by inactive account",
+			call: func(u *uc.TemplateInteractor) error {
+				return u.Delete(context.Background(), port.TemplateDeleteInput{ID: "tpl-1", OwnerID: "owner"})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			accounts.EXPECT().GetByID(gomock.Any(), "owner").Return(&account.Account{ID: "owner", IsActive: false}, nil)
+
+			interactor := uc.NewTemplateInteractor(
+				mockusecase.NewMockTemplateRepository(ctrl),
+				accounts,
+				mockusecase.NewMockShareRepository(ctrl),
+				mockusecase.NewMockWorkspaceRepository(ctrl),
+				mockusecase.NewMockTxManager(ctrl),
+				mockusecase.NewMockEventPublisher(ctrl),
+				mockusecase.NewMockTemplateOutputPort(ctrl),
+			)
+			if err := tt.call(interactor); !errors.Is(err, domainerr.ErrAccountInactive) {
+				t.Fatalf("want %v, got %v", domainerr.ErrAccountInactive, err)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Clone(t *testing.T) {
+	source := func(isPublic bool) *template.WithUsage {
+		return &template.WithUsage{Template: template.Template{
+			ID:          "tpl-1",
+			Name:        "Retro",
+			OwnerID:     "owner-1",
+			WorkspaceID: "ws-1",
+			IsPublic:    isPublic,
+			Fields: []template.Field{
+				{ID: "f2", Key: "k2", Label: "Try", Order: 2},
+				{ID: "f1", Key: "k1", Label: "Keep", Order: 1, IsRequired: true},
+			},
+		}}
+	}
+	tests := []struct {
+		name      string
+		source    *template.WithUsage
+		getErr    error
+		memberErr error
+		wantCheck bool
+		wantError error
+	}{
+		{name: "[Success] clone public template from another workspace", source: source(true)},
+		{name: "[Success] clone private template as member", source: source(false), wantCheck: true},
+		{name: "[Fail] private template outside the workspace", source: source(false), wantCheck: true, memberErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] source not found", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tt.source, tt.getErr)
+			if tt.wantCheck {
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", "owner-2").Return(&workspace.Member{Role: workspace.RoleMember}, tt.memberErr)
+			}
+			var stored []template.Field
+			if tt.wantError == nil {
+				workspaces.EXPECT().EnsurePersonal(gomock.Any(), "owner-2").Return(&workspace.Workspace{ID: "ws-2"}, nil)
+				passThroughTx(tx)
+				repo.EXPECT().Create(gomock.Any(), template.Template{Name: "Retro", OwnerID: "owner-2", WorkspaceID: "ws-2"}).
+					Return(&template.Template{ID: "tpl-2", OwnerID: "owner-2", SchemaVersion: 1}, nil)
+				repo.EXPECT().ReplaceFields(gomock.Any(), "tpl-2", 1, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ int, fields []template.Field) error {
+						stored = fields
+						return nil
+					},
+				)
+				clone := &template.WithUsage{Template: template.Template{ID: "tpl-2"}}
+				repo.EXPECT().Get(gomock.Any(), "tpl-2").Return(clone, nil)
+				out.EXPECT().PresentTemplate(gomock.Any(), clone).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), workspaces, tx, anyOutbox(ctrl), out)
+			err := interactor.Clone(context.Background(), port.TemplateCloneInput{ID: "tpl-1", OwnerID: "owner-2"})
+
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantError != nil {
+				return
+			}
+			if len(stored) != 2 || stored[0].Label != "Keep" || !stored[0].IsRequired || stored[1].Label != "Try" {
+				t.Fatalf("fields = %+v", stored)
+			}
+			for _, f := range stored {
+				if f.ID != "" || f.Key != "" {
+					t.Fatalf("field kept its identity: %+v", f)
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Import(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc        template.Document
+		wantLabels []string
+		wantError  error
+	}{
+		{
+			name: "[Success] fields follow document order",
+			doc: template.Document{Version: template.DocumentVersion, Name: "Weekly", Fields: []template.Field{
+				{Label: "Summary", IsRequired: true},
+				{Label: "Blockers"},
+			}},
+			wantLabels: []string{"Summary", "Blockers"},
+		},
+		{
+			name:      "[Fail] other document version",
+			doc:       template.Document{Version: 2, Name: "Weekly", Fields: []template.Field{{Label: "Summary"}}},
+			wantError: domainerr.ErrTemplateDocumentVersionUnsupported,
+		},
+		{
+			name:      "[Fail] document without fields",
+			doc:       template.Document{Version: template.DocumentVersion, Name: "Weekly"},
+			wantError: domainerr.ErrFieldRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			var stored []template.Field
+			if tt.wantError == nil {
+				passThroughTx(tx)
+				repo.EXPECT().Create(gomock.Any(), template.Template{Name: "Weekly", OwnerID: "owner-1", WorkspaceID: "ws-owner-1"}).
+					Return(&template.Template{ID: "tpl-1", SchemaVersion: 1}, nil)
+				repo.EXPECT().ReplaceFields(gomock.Any(), "tpl-1", 1, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ int, fields []template.Field) error {
+						stored = fields
+						return nil
+					},
+				)
+				imported := &template.WithUsage{Template: template.Template{ID: "tpl-1"}}
+				repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(imported, nil)
+				out.EXPECT().PresentTemplate(gomock.Any(), imported).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, anyOutbox(ctrl), out)
+			err := interactor.Import(context.Background(), port.TemplateImportInput{Document: tt.doc, OwnerID: "owner-1"})
+
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			for i, label := range tt.wantLabels {
+				if stored[i].Label != label || stored[i].Order != i+1 {
+					t.Fatalf("field %d = %+v", i, stored[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_SetVisibility(t *testing.T) {
+	current := func() *template.WithUsage {
+		return &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", WorkspaceID: "ws-1", Version: 2}}
+	}
+	tests := []struct {
+		name      string
+		ownerID   string
+		version   int
+		setErr    error
+		expectSet bool
+		wantError error
+	}{
+		{name: "[Success] publish to the gallery", ownerID: "owner-1", expectSet: true},
+		{name: "[Fail] not the owner", ownerID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] stale If-Match version", ownerID: "owner-1", version: 1, wantError: domainerr.ErrVersionConflict},
+		{name: "[Fail] concurrent write", ownerID: "owner-1", setErr: domainerr.ErrVersionConflict, expectSet: true, wantError: domainerr.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(current(), nil)
+			if tt.expectSet {
+				passThroughTx(tx)
+				repo.EXPECT().SetPublic(gomock.Any(), "tpl-1", true, 2).Return(&template.Template{ID: "tpl-1", Version: 3, IsPublic: true}, tt.setErr)
+			}
+			if tt.wantError == nil {
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.TemplateUpdated, "tpl-1")).Return(nil)
+				published := &template.WithUsage{Template: template.Template{ID: "tpl-1", Version: 3, IsPublic: true}}
+				repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(published, nil)
+				out.EXPECT().PresentTemplate(gomock.Any(), published).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, activeAccounts(ctrl), noShares(ctrl), allMembers(ctrl), tx, publisher, out)
+			err := interactor.SetVisibility(context.Background(), port.TemplateVisibilityInput{ID: "tpl-1", IsPublic: true, OwnerID: tt.ownerID, Version: tt.version})
+
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
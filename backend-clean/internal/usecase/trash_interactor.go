@@ -0,0 +1,39 @@
+// Package usecase provides application use cases.
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TrashInteractor lists what an owner has moved to the trash.
+type TrashInteractor struct {
+	notes     port.NoteRepository
+	templates port.TemplateRepository
+	output    port.TrashOutputPort
+}
+
+var _ port.TrashInputPort = (*TrashInteractor)(nil)
+
+// NewTrashInteractor creates TrashInteractor.
+func NewTrashInteractor(notes port.NoteRepository, templates port.TemplateRepository, output port.TrashOutputPort) *TrashInteractor {
+	return &TrashInteractor{
+		notes:     notes,
+		templates: templates,
+		output:    output,
+	}
+}
+
+// List returns the owner's trashed notes and templates, most recently trashed first.
+func (u *TrashInteractor) List(ctx context.Context, ownerID string) error {
+	notes, err := u.notes.ListTrashed(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	templates, err := u.templates.ListTrashed(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTrash(ctx, notes, templates)
+}
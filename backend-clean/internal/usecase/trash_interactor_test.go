@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestTrashInteractor_List(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	trashedNotes := []note.WithMeta{{Note: note.Note{ID: "note-1", OwnerID: "owner-1", DeletedAt: &deletedAt}}}
+	trashedTemplates := []template.Template{{ID: "tpl-1", OwnerID: "owner-1", DeletedAt: &deletedAt}}
+
+	tests := []struct {
+		name         string
+		notesErr     error
+		templatesErr error
+		wantError    error
+	}{
+		{
+			name: "[Success] list notes and templates",
+		},
+		{
+			name:      "[Fail] notes error",
+			notesErr:  errors.New("notes err"),
+			wantError: errors.New("notes err"),
+		},
+		{
+			name:         "[Fail] templates error",
+			templatesErr: errors.New("templates err"),
+			wantError:    errors.New("templates err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			out := mockusecase.NewMockTrashOutputPort(ctrl)
+
+			notesRepo.EXPECT().ListTrashed(gomock.Any(), "owner-1").Return(trashedNotes, tt.notesErr)
+			if tt.notesErr == nil {
+				tplRepo.EXPECT().ListTrashed(gomock.Any(), "owner-1").Return(trashedTemplates, tt.templatesErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentTrash(gomock.Any(), trashedNotes, trashedTemplates).Return(nil)
+			}
+
+			err := uc.NewTrashInteractor(notesRepo, tplRepo, out).List(context.Background(), "owner-1")
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
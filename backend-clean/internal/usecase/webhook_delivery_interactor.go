@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookDeliveryInteractor posts pending webhook deliveries to their receivers.
+type WebhookDeliveryInteractor struct {
+	repo   port.WebhookDeliveryRepository
+	sender port.WebhookSender
+	output port.WebhookDeliveryOutputPort
+}
+
+var _ port.WebhookDeliveryInputPort = (*WebhookDeliveryInteractor)(nil)
+
+// NewWebhookDeliveryInteractor creates WebhookDeliveryInteractor.
+func NewWebhookDeliveryInteractor(repo port.WebhookDeliveryRepository, sender port.WebhookSender, output port.WebhookDeliveryOutputPort) *WebhookDeliveryInteractor {
+	return &WebhookDeliveryInteractor{
+		repo:   repo,
+		sender: sender,
+		output: output,
+	}
+}
+
+// Execute claims one batch of due deliveries, signs and sends each of them and records the outcome.
+// Like the outbox relay, the claim is a lease, so no transaction spans a request; a crash mid-batch
+// leaves deliveries to be claimed again once the lease runs out, so receivers may see a delivery
+// twice and should deduplicate on the event ID.
+func (u *WebhookDeliveryInteractor) Execute(ctx context.Context, batchSize int) error {
+	now := time.Now()
+	leaseUntil := now.Add(webhook.ClaimLease)
+	due, err := u.repo.ClaimDue(ctx, now, leaseUntil, batchSize)
+	if err != nil {
+		return err
+	}
+	var result outbox.RelayResult
+	for _, d := range due {
+		// The rest of the batch may already be claimed by another worker.
+		if !time.Now().Before(leaseUntil) {
+			break
+		}
+		if err := u.send(ctx, d, &result); err != nil {
+			return err
+		}
+	}
+	return u.output.PresentResult(ctx, result)
+}
+
+func (u *WebhookDeliveryInteractor) send(ctx context.Context, d webhook.DueDelivery, result *outbox.RelayResult) error {
+	status, sendErr := u.sender.Send(ctx, webhook.NewRequest(d, time.Now()))
+	now := time.Now()
+	attempts := d.Attempts + 1
+	if sendErr == nil {
+		result.Delivered++
+		return u.repo.MarkSucceeded(ctx, d.ID, attempts, status, now)
+	}
+	delay, retry := webhook.NextAttempt(attempts)
+	if !retry {
+		result.Failed++
+		return u.repo.MarkFailed(ctx, d.ID, attempts, status, now, sendErr.Error())
+	}
+	result.Retried++
+	return u.repo.MarkRetry(ctx, d.ID, attempts, status, now.Add(delay), sendErr.Error())
+}
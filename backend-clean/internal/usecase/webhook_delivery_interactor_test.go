@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookDeliveryInteractor_Execute(t *testing.T) {
+	due := func(id string, attempts int) webhook.DueDelivery {
+		return webhook.DueDelivery{
+			Delivery: webhook.Delivery{ID: id, EventType: outbox.NotePublished, Payload: []byte(`{}`), Attempts: attempts},
+			URL:      "https://example.com/hooks",
+			Secret:   "secret",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		due        []webhook.DueDelivery
+		claimErr   error
+		status     int
+		sendErr    error
+		settleErr  error
+		wantResult outbox.RelayResult
+		wantError  error
+	}{
+		{
+			name:       "[Success] send batch",
+			due:        []webhook.DueDelivery{due("d1", 0), due("d2", 0)},
+			status:     200,
+			wantResult: outbox.RelayResult{Delivered: 2},
+		},
+		{
+			name:       "[Success] schedule retry after rejected delivery",
+			due:        []webhook.DueDelivery{due("d1", 1)},
+			status:     500,
+			sendErr:    errors.New("receiver responded 500"),
+			wantResult: outbox.RelayResult{Retried: 1},
+		},
+		{
+			name:       "[Success] give up after the last attempt",
+			due:        []webhook.DueDelivery{due("d1", webhook.MaxAttempts-1)},
+			sendErr:    errors.New("connection refused"),
+			wantResult: outbox.RelayResult{Failed: 1},
+		},
+		{
+			name:      "[Fail] claim error",
+			claimErr:  errors.New("claim err"),
+			wantError: errors.New("claim err"),
+		},
+		{
+			name:      "[Fail] settle error",
+			due:       []webhook.DueDelivery{due("d1", 0)},
+			status:    204,
+			settleErr: errors.New("settle err"),
+			wantError: errors.New("settle err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookDeliveryRepository(ctrl)
+			sender := mockusecase.NewMockWebhookSender(ctrl)
+			out := mockusecase.NewMockWebhookDeliveryOutputPort(ctrl)
+
+			repo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), 20).DoAndReturn(
+				func(_ context.Context, now, leaseUntil time.Time, _ int) ([]webhook.DueDelivery, error) {
+					if leaseUntil.Sub(now) != webhook.ClaimLease {
+						t.Fatalf("lease until %v, want %v after %v", leaseUntil, webhook.ClaimLease, now)
+					}
+					return tt.due, tt.claimErr
+				},
+			)
+			for _, d := range tt.due {
+				sender.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req webhook.Request) (int, error) {
+						if req.URL != d.URL || req.Headers[webhook.HeaderDeliveryID] != d.ID || req.Headers[webhook.HeaderSignature] == "" {
+							t.Fatalf("unexpected request: %+v", req)
+						}
+						return tt.status, tt.sendErr
+					},
+				)
+				attempts := d.Attempts + 1
+				switch {
+				case tt.sendErr == nil:
+					repo.EXPECT().MarkSucceeded(gomock.Any(), d.ID, attempts, tt.status, gomock.Any()).Return(tt.settleErr)
+				case attempts >= webhook.MaxAttempts:
+					repo.EXPECT().MarkFailed(gomock.Any(), d.ID, attempts, tt.status, gomock.Any(), tt.sendErr.Error()).Return(tt.settleErr)
+				default:
+					repo.EXPECT().MarkRetry(gomock.Any(), d.ID, attempts, tt.status, gomock.Any(), tt.sendErr.Error()).Return(tt.settleErr)
+				}
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentResult(gomock.Any(), tt.wantResult).Return(nil)
+			}
+
+			err := uc.NewWebhookDeliveryInteractor(repo, sender, out).Execute(context.Background(), 20)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookDispatchInteractor turns relayed outbox messages into pending webhook deliveries.
+// It is an event sink the relay calls with no open transaction, and a message may be
+// relayed more than once. Each enqueue commits on its own; a repeated one is dropped by
+// EnqueueDelivery's ON CONFLICT (subscription_id, event_id) DO NOTHING, which is what
+// keeps this at-least-once relay from creating duplicate deliveries.
+type WebhookDispatchInteractor struct {
+	repo port.WebhookRepository
+}
+
+var _ port.EventSink = (*WebhookDispatchInteractor)(nil)
+
+// NewWebhookDispatchInteractor creates WebhookDispatchInteractor.
+func NewWebhookDispatchInteractor(repo port.WebhookRepository) *WebhookDispatchInteractor {
+	return &WebhookDispatchInteractor{repo: repo}
+}
+
+// Deliver enqueues a delivery of msg for every subscription of the note owner to its type.
+// Messages webhooks cannot subscribe to are ignored.
+func (u *WebhookDispatchInteractor) Deliver(ctx context.Context, msg outbox.Message) error {
+	if !webhook.IsSupportedEvent(msg.Type) {
+		return nil
+	}
+	ownerID, err := webhook.EventOwner(msg)
+	if err != nil {
+		return err
+	}
+	subs, err := u.repo.ListForEvent(ctx, ownerID, msg.Type)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, sub := range subs {
+		d, err := webhook.NewDelivery(sub, msg, now)
+		if err != nil {
+			return err
+		}
+		if err := u.repo.EnqueueDelivery(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookDispatchInteractor_Deliver(t *testing.T) {
+	published := outbox.Message{ID: "m1", Type: outbox.NotePublished, Payload: []byte(`{"id":"n1","owner_id":"owner-1"}`)}
+	subs := []webhook.Subscription{{ID: "hook-1"}, {ID: "hook-2"}}
+
+	tests := []struct {
+		name        string
+		msg         outbox.Message
+		wantList    bool
+		listErr     error
+		wantEnqueue int
+		wantError   error
+	}{
+		{
+			name:        "[Success] enqueue for every subscription",
+			msg:         published,
+			wantList:    true,
+			wantEnqueue: 2,
+		},
+		{
+			name: "[Success] ignore events webhooks cannot subscribe to",
+			msg:  outbox.Message{ID: "m2", Type: outbox.NoteCreated, Payload: published.Payload},
+		},
+		{
+			name:      "[Fail] list error",
+			msg:       published,
+			wantList:  true,
+			listErr:   errors.New("list err"),
+			wantError: errors.New("list err"),
+		},
+		{
+			name:      "[Fail] payload without owner",
+			msg:       outbox.Message{ID: "m3", Type: outbox.NotePublished, Payload: []byte(`{}`)},
+			wantError: errors.New("event m3 has no owner"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			if tt.wantList {
+				repo.EXPECT().ListForEvent(gomock.Any(), "owner-1", tt.msg.Type).Return(subs, tt.listErr)
+			}
+			repo.EXPECT().EnqueueDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, d webhook.Delivery) error {
+					if d.EventID != tt.msg.ID || d.Status != webhook.DeliveryPending {
+						t.Fatalf("unexpected delivery: %+v", d)
+					}
+					return nil
+				},
+			).Times(tt.wantEnqueue)
+
+			err := uc.NewWebhookDispatchInteractor(repo).Deliver(context.Background(), tt.msg)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
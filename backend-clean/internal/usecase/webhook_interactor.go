@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// webhookDeliveryListLimit is how many recent deliveries are listed per subscription.
+const webhookDeliveryListLimit = 50
+
+// WebhookInteractor handles webhook subscription use cases.
+type WebhookInteractor struct {
+	repo     port.WebhookRepository
+	accounts port.AccountRepository
+	output   port.WebhookOutputPort
+}
+
+var _ port.WebhookInputPort = (*WebhookInteractor)(nil)
+
+// NewWebhookInteractor creates WebhookInteractor.
+func NewWebhookInteractor(repo port.WebhookRepository, accounts port.AccountRepository, output port.WebhookOutputPort) *WebhookInteractor {
+	return &WebhookInteractor{repo: repo, accounts: accounts, output: output}
+}
+
+// List returns the owner's subscriptions.
+func (u *WebhookInteractor) List(ctx context.Context, ownerID string) error {
+	subs, err := u.repo.ListByOwner(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhookList(ctx, subs)
+}
+
+// Get returns one of the owner's subscriptions.
+func (u *WebhookInteractor) Get(ctx context.Context, id, ownerID string) error {
+	sub, err := u.owned(ctx, id, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, sub)
+}
+
+// Create subscribes a URL to events of the owner's notes.
+func (u *WebhookInteractor) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	sub := webhook.Subscription{
+		OwnerID:    input.OwnerID,
+		URL:        input.URL,
+		Secret:     input.Secret,
+		EventTypes: input.EventTypes,
+	}
+	if err := webhook.ValidateSubscription(sub); err != nil {
+		return err
+	}
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	created, err := u.repo.Create(ctx, sub)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, created)
+}
+
+// Update replaces the URL and event types of a subscription and optionally rotates its secret.
+func (u *WebhookInteractor) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	current, err := u.owned(ctx, input.ID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	sub := *current
+	sub.URL = input.URL
+	sub.EventTypes = input.EventTypes
+	if input.Secret != nil {
+		sub.Secret = *input.Secret
+	}
+	if err := webhook.ValidateSubscription(sub); err != nil {
+		return err
+	}
+	updated, err := u.repo.Update(ctx, sub)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, updated)
+}
+
+// Delete removes a subscription and its delivery log.
+func (u *WebhookInteractor) Delete(ctx context.Context, id, ownerID string) error {
+	if err := ensureActiveActor(ctx, u.accounts, ownerID); err != nil {
+		return err
+	}
+	if _, err := u.owned(ctx, id, ownerID); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return u.output.PresentWebhookDeleted(ctx)
+}
+
+// ListDeliveries returns the latest deliveries of one of the owner's subscriptions.
+func (u *WebhookInteractor) ListDeliveries(ctx context.Context, webhookID, ownerID string) error {
+	if _, err := u.owned(ctx, webhookID, ownerID); err != nil {
+		return err
+	}
+	deliveries, err := u.repo.ListDeliveries(ctx, webhookID, webhookDeliveryListLimit)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentDeliveryList(ctx, deliveries)
+}
+
+// Replay queues a new delivery with the payload of a past one, whatever its outcome was.
+func (u *WebhookInteractor) Replay(ctx context.Context, input port.WebhookReplayInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.OwnerID); err != nil {
+		return err
+	}
+	if _, err := u.owned(ctx, input.WebhookID, input.OwnerID); err != nil {
+		return err
+	}
+	past, err := u.repo.GetDelivery(ctx, input.DeliveryID)
+	if err != nil {
+		return err
+	}
+	// ルール: URL の Webhook に属さない配信は存在しないものとして扱う
+	if past.SubscriptionID != input.WebhookID {
+		return domainerr.ErrNotFound
+	}
+	replay, err := u.repo.CreateDelivery(ctx, past.Replay(time.Now()))
+	if err != nil {
+		return err
+	}
+	return u.output.PresentDelivery(ctx, replay)
+}
+
+// owned loads a subscription and checks that ownerID owns it.
+func (u *WebhookInteractor) owned(ctx context.Context, id, ownerID string) (*webhook.Subscription, error) {
+	sub, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := webhook.ValidateOwnership(sub.OwnerID, ownerID); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
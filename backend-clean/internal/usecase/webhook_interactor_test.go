@@ -0,0 +1,307 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+var webhookSecret = strings.Repeat("s", webhook.MinSecretLength)
+
+func TestWebhookInteractor_Create(t *testing.T) {
+	valid := port.WebhookCreateInput{
+		OwnerID:    "owner-1",
+		URL:        "https://example.com/hooks",
+		Secret:     webhookSecret,
+		EventTypes: []string{outbox.NotePublished},
+	}
+	tests := []struct {
+		name       string
+		input      port.WebhookCreateInput
+		createErr  error
+		wantCreate bool
+		wantError  error
+	}{
+		{
+			name:       "[Success] create subscription",
+			input:      valid,
+			wantCreate: true,
+		},
+		{
+			name: "[Fail] invalid url",
+			input: port.WebhookCreateInput{
+				OwnerID:    valid.OwnerID,
+				URL:        "not a url",
+				Secret:     valid.Secret,
+				EventTypes: valid.EventTypes,
+			},
+			wantError: violation("url", domainerr.CodeInvalid, domainerr.ErrInvalidWebhookURL),
+		},
+		{
+			name:       "[Fail] repository error",
+			input:      valid,
+			createErr:  errors.New("create err"),
+			wantCreate: true,
+			wantError:  errors.New("create err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			created := &webhook.Subscription{ID: "hook-1", OwnerID: tt.input.OwnerID}
+			if tt.wantCreate {
+				repo.EXPECT().Create(gomock.Any(), webhook.Subscription{
+					OwnerID:    tt.input.OwnerID,
+					URL:        tt.input.URL,
+					Secret:     tt.input.Secret,
+					EventTypes: tt.input.EventTypes,
+				}).Return(created, tt.createErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentWebhook(gomock.Any(), created).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, activeAccounts(ctrl), out).Create(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWebhookInteractor_Update(t *testing.T) {
+	current := &webhook.Subscription{
+		ID:         "hook-1",
+		OwnerID:    "owner-1",
+		URL:        "https://example.com/old",
+		Secret:     webhookSecret,
+		EventTypes: []string{outbox.NotePublished},
+	}
+	rotated := strings.Repeat("r", webhook.MinSecretLength)
+
+	tests := []struct {
+		name       string
+		input      port.WebhookUpdateInput
+		wantSecret string
+		wantUpdate bool
+		wantError  error
+	}{
+		{
+			name:       "[Success] keep secret when omitted",
+			input:      port.WebhookUpdateInput{ID: "hook-1", OwnerID: "owner-1", URL: "https://example.com/new", EventTypes: []string{outbox.NoteUnpublished}},
+			wantSecret: webhookSecret,
+			wantUpdate: true,
+		},
+		{
+			name:       "[Success] rotate secret",
+			input:      port.WebhookUpdateInput{ID: "hook-1", OwnerID: "owner-1", URL: "https://example.com/new", Secret: &rotated, EventTypes: []string{outbox.NotePublished}},
+			wantSecret: rotated,
+			wantUpdate: true,
+		},
+		{
+			name:      "[Fail] not the owner",
+			input:     port.WebhookUpdateInput{ID: "hook-1", OwnerID: "other", URL: "https://example.com/new", EventTypes: []string{outbox.NotePublished}},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] unsupported event",
+			input:     port.WebhookUpdateInput{ID: "hook-1", OwnerID: "owner-1", URL: "https://example.com/new", EventTypes: []string{outbox.NoteCreated}},
+			wantError: violation("eventTypes[0]", domainerr.CodeInvalid, domainerr.ErrUnsupportedWebhookEvent),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "hook-1").Return(current, nil)
+			if tt.wantUpdate {
+				want := *current
+				want.URL = tt.input.URL
+				want.Secret = tt.wantSecret
+				want.EventTypes = tt.input.EventTypes
+				repo.EXPECT().Update(gomock.Any(), want).Return(&want, nil)
+				out.EXPECT().PresentWebhook(gomock.Any(), &want).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, activeAccounts(ctrl), out).Update(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWebhookInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownerID   string
+		deleteErr error
+		wantError error
+	}{
+		{name: "[Success] delete subscription", ownerID: "owner-1"},
+		{name: "[Fail] not the owner", ownerID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] repository error", ownerID: "owner-1", deleteErr: errors.New("delete err"), wantError: errors.New("delete err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "hook-1").Return(&webhook.Subscription{ID: "hook-1", OwnerID: "owner-1"}, nil)
+			if tt.ownerID == "owner-1" {
+				repo.EXPECT().Delete(gomock.Any(), "hook-1").Return(tt.deleteErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentWebhookDeleted(gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, activeAccounts(ctrl), out).Delete(context.Background(), "hook-1", tt.ownerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWebhookInteractor_ListDeliveries(t *testing.T) {
+	deliveries := []webhook.Delivery{{ID: "d1", SubscriptionID: "hook-1"}}
+	tests := []struct {
+		name      string
+		ownerID   string
+		getErr    error
+		wantError error
+	}{
+		{name: "[Success] list deliveries", ownerID: "owner-1"},
+		{name: "[Fail] not the owner", ownerID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] unknown subscription", ownerID: "owner-1", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "hook-1").Return(&webhook.Subscription{ID: "hook-1", OwnerID: "owner-1"}, tt.getErr)
+			if tt.wantError == nil {
+				repo.EXPECT().ListDeliveries(gomock.Any(), "hook-1", 50).Return(deliveries, nil)
+				out.EXPECT().PresentDeliveryList(gomock.Any(), deliveries).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, activeAccounts(ctrl), out).ListDeliveries(context.Background(), "hook-1", tt.ownerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWebhookInteractor_Replay(t *testing.T) {
+	past := &webhook.Delivery{
+		ID:             "d1",
+		SubscriptionID: "hook-1",
+		EventID:        "m1",
+		EventType:      outbox.NotePublished,
+		Payload:        []byte(`{"id":"m1"}`),
+		Status:         webhook.DeliveryFailed,
+		Attempts:       webhook.MaxAttempts,
+	}
+	tests := []struct {
+		name       string
+		delivery   *webhook.Delivery
+		wantReplay bool
+		wantError  error
+	}{
+		{
+			name:       "[Success] replay failed delivery",
+			delivery:   past,
+			wantReplay: true,
+		},
+		{
+			name:      "[Fail] delivery of another subscription",
+			delivery:  &webhook.Delivery{ID: "d1", SubscriptionID: "hook-2"},
+			wantError: domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "hook-1").Return(&webhook.Subscription{ID: "hook-1", OwnerID: "owner-1"}, nil)
+			repo.EXPECT().GetDelivery(gomock.Any(), "d1").Return(tt.delivery, nil)
+			replayed := &webhook.Delivery{ID: "d2", ReplayOf: "d1", Status: webhook.DeliveryPending}
+			if tt.wantReplay {
+				repo.EXPECT().CreateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+						if d.ReplayOf != "d1" || d.Status != webhook.DeliveryPending || d.Attempts != 0 || string(d.Payload) != string(past.Payload) {
+							t.Fatalf("unexpected replay: %+v", d)
+						}
+						return replayed, nil
+					},
+				)
+				out.EXPECT().PresentDelivery(gomock.Any(), replayed).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, activeAccounts(ctrl), out).Replay(context.Background(), port.WebhookReplayInput{
+				WebhookID:  "hook-1",
+				DeliveryID: "d1",
+				OwnerID:    "owner-1",
+			})
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceInteractor handles workspaces, their members and invitations.
+type WorkspaceInteractor struct {
+	workspaces port.WorkspaceRepository
+	accounts   port.AccountRepository
+	tx         port.TxManager
+	publisher  port.EventPublisher
+	output     port.WorkspaceOutputPort
+}
+
+var _ port.WorkspaceInputPort = (*WorkspaceInteractor)(nil)
+
+// NewWorkspaceInteractor creates WorkspaceInteractor.
+// Membership changes are recorded to publisher within their transaction.
+func NewWorkspaceInteractor(workspaces port.WorkspaceRepository, accounts port.AccountRepository, tx port.TxManager, publisher port.EventPublisher, output port.WorkspaceOutputPort) *WorkspaceInteractor {
+	return &WorkspaceInteractor{
+		workspaces: workspaces,
+		accounts:   accounts,
+		tx:         tx,
+		publisher:  publisher,
+		output:     output,
+	}
+}
+
+// List returns the workspaces of the actor, personal workspace first.
+func (u *WorkspaceInteractor) List(ctx context.Context, actorID string) error {
+	if err := ensureActiveActor(ctx, u.accounts, actorID); err != nil {
+		return err
+	}
+	if _, err := u.workspaces.EnsurePersonal(ctx, actorID); err != nil {
+		return err
+	}
+	list, err := u.workspaces.ListByMember(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaces(ctx, list)
+}
+
+// Create creates a shared workspace owned by the actor.
+func (u *WorkspaceInteractor) Create(ctx context.Context, input port.WorkspaceCreateInput) error {
+	ws := workspace.Workspace{Name: strings.TrimSpace(input.Name), OwnerID: input.ActorID}
+	if err := workspace.ValidateWorkspace(ws); err != nil {
+		return err
+	}
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	var created *workspace.Workspace
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		created, err = u.workspaces.Create(txCtx, ws)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.WorkspaceEvent(outbox.WorkspaceCreated, *created, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspace(ctx, workspace.WithRole{Workspace: *created, Role: workspace.RoleOwner})
+}
+
+// ListMembers returns the members of a workspace to one of its members.
+func (u *WorkspaceInteractor) ListMembers(ctx context.Context, workspaceID, actorID string) error {
+	if err := ensureMember(ctx, u.workspaces, workspaceID, actorID); err != nil {
+		return err
+	}
+	members, err := u.workspaces.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentMembers(ctx, members)
+}
+
+// Invite invites an email address to a workspace; inviting a pending address again renews its invitation.
+func (u *WorkspaceInteractor) Invite(ctx context.Context, input port.WorkspaceInviteInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	ws, err := u.workspaces.Get(ctx, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	inviter, err := u.workspaces.GetMember(ctx, input.WorkspaceID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	inv := workspace.Invitation{
+		WorkspaceID: input.WorkspaceID,
+		Email:       strings.TrimSpace(input.Email),
+		Role:        input.Role,
+		InvitedBy:   input.ActorID,
+	}
+	if err := workspace.ValidateInvitation(*ws, *inviter, inv); err != nil {
+		return err
+	}
+	if err := u.ensureNotMember(ctx, input.WorkspaceID, inv.Email); err != nil {
+		return err
+	}
+	var saved *workspace.Invitation
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		saved, err = u.workspaces.UpsertInvitation(txCtx, inv)
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.InvitationEvent(outbox.WorkspaceMemberInvited, *saved, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentInvitation(ctx, saved)
+}
+
+// ListInvitations returns the pending invitations addressed to the actor's email.
+func (u *WorkspaceInteractor) ListInvitations(ctx context.Context, actorID string) error {
+	a, err := activeActor(ctx, u.accounts, actorID)
+	if err != nil {
+		return err
+	}
+	invs, err := u.workspaces.ListPendingInvitations(ctx, a.Email.String())
+	if err != nil {
+		return err
+	}
+	return u.output.PresentInvitations(ctx, invs)
+}
+
+// Accept makes the actor a member of the workspace an invitation addressed to them is for.
+func (u *WorkspaceInteractor) Accept(ctx context.Context, input port.WorkspaceAcceptInput) error {
+	a, err := activeActor(ctx, u.accounts, input.ActorID)
+	if err != nil {
+		return err
+	}
+	inv, err := u.workspaces.GetInvitation(ctx, input.InvitationID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.CanAccept(*inv, a.Email.String()); err != nil {
+		return err
+	}
+	var member *workspace.Member
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := u.workspaces.AcceptInvitation(txCtx, inv.ID); err != nil {
+			return err
+		}
+		member, err = u.workspaces.AddMember(txCtx, workspace.Member{WorkspaceID: inv.WorkspaceID, AccountID: a.ID, Role: inv.Role})
+		if err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.MemberEvent(outbox.WorkspaceMemberJoined, *member, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	ws, err := u.workspaces.Get(ctx, inv.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspace(ctx, workspace.WithRole{Workspace: *ws, Role: member.Role})
+}
+
+// RemoveMember removes a member from a workspace, or lets the actor leave it.
+func (u *WorkspaceInteractor) RemoveMember(ctx context.Context, input port.WorkspaceRemoveMemberInput) error {
+	if err := ensureActiveActor(ctx, u.accounts, input.ActorID); err != nil {
+		return err
+	}
+	actor, err := u.workspaces.GetMember(ctx, input.WorkspaceID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	target, err := u.workspaces.GetMember(ctx, input.WorkspaceID, input.AccountID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.CanRemoveMember(*actor, *target); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.workspaces.RemoveMember(txCtx, input.WorkspaceID, input.AccountID); err != nil {
+			return err
+		}
+		return u.publisher.Publish(txCtx, outbox.MemberEvent(outbox.WorkspaceMemberRemoved, *target, time.Now()))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentMemberRemoved(ctx)
+}
+
+// ensureNotMember reports inviting the email of an existing member as a violation.
+func (u *WorkspaceInteractor) ensureNotMember(ctx context.Context, workspaceID, email string) error {
+	a, err := u.accounts.GetByEmail(ctx, email)
+	if errors.Is(err, domainerr.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = u.workspaces.GetMember(ctx, workspaceID, a.ID)
+	if errors.Is(err, domainerr.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	verr := &domainerr.ValidationError{}
+	verr.Add("email", domainerr.CodeDuplicate, domainerr.ErrAlreadyWorkspaceMember)
+	return verr.Err()
+}
+
+// activeActor returns the account acting on a request; missing or deactivated accounts are rejected.
+func activeActor(ctx context.Context, repo port.AccountRepository, accountID string) (*account.Account, error) {
+	a, err := repo.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerr.ErrNotFound) {
+			return nil, domainerr.ErrUnauthorized
+		}
+		return nil, err
+	}
+	if err := account.EnsureActive(*a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ensureMember hides the resources of a workspace from accounts outside it as errors.ErrNotFound.
+// Every caller is authenticated, so an empty actorID is rejected rather than trusted.
+func ensureMember(ctx context.Context, workspaces port.WorkspaceRepository, workspaceID, actorID string) error {
+	if actorID == "" {
+		return domainerr.ErrUnauthorized
+	}
+	_, err := workspaces.GetMember(ctx, workspaceID, actorID)
+	return err
+}
@@ -0,0 +1,298 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/outbox"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWorkspaceInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+	out := mockusecase.NewMockWorkspaceOutputPort(ctrl)
+
+	list := []workspace.WithRole{{Workspace: workspace.Workspace{ID: "ws-personal", Personal: true}, Role: workspace.RoleOwner}}
+	gomock.InOrder(
+		workspaces.EXPECT().EnsurePersonal(gomock.Any(), "owner").Return(&list[0].Workspace, nil),
+		workspaces.EXPECT().ListByMember(gomock.Any(), "owner").Return(list, nil),
+	)
+	out.EXPECT().PresentWorkspaces(gomock.Any(), list).Return(nil)
+
+	interactor := uc.NewWorkspaceInteractor(workspaces, activeAccounts(ctrl), mockusecase.NewMockTxManager(ctrl), anyOutbox(ctrl), out)
+	if err := interactor.List(context.Background(), "owner"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWorkspaceInteractor_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.WorkspaceCreateInput
+		wantError error
+	}{
+		{name: "[Success] create workspace", input: port.WorkspaceCreateInput{Name: "  Team  ", ActorID: "owner"}},
+		{name: "[Fail] name required", input: port.WorkspaceCreateInput{Name: " ", ActorID: "owner"}, wantError: violation("name", domainerr.CodeRequired, domainerr.ErrWorkspaceNameRequired)},
+		{name: "[Fail] name too long", input: port.WorkspaceCreateInput{Name: strings.Repeat("a", workspace.MaxNameLength+1), ActorID: "owner"}, wantError: violation("name", domainerr.CodeTooLong, domainerr.ErrWorkspaceNameTooLong)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockWorkspaceOutputPort(ctrl)
+
+			if tt.wantError == nil {
+				created := &workspace.Workspace{ID: "ws-1", Name: "Team", OwnerID: "owner"}
+				passThroughTx(tx)
+				workspaces.EXPECT().Create(gomock.Any(), workspace.Workspace{Name: "Team", OwnerID: "owner"}).Return(created, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.WorkspaceCreated, "ws-1")).Return(nil)
+				out.EXPECT().PresentWorkspace(gomock.Any(), workspace.WithRole{Workspace: *created, Role: workspace.RoleOwner}).Return(nil)
+			}
+
+			interactor := uc.NewWorkspaceInteractor(workspaces, activeAccounts(ctrl), tx, publisher, out)
+			err := interactor.Create(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceInteractor_Invite(t *testing.T) {
+	team := &workspace.Workspace{ID: "ws-1", Name: "Team", OwnerID: "owner"}
+	tests := []struct {
+		name        string
+		ws          *workspace.Workspace
+		inviterRole workspace.Role
+		input       port.WorkspaceInviteInput
+		existing    *account.Account
+		wantError   error
+	}{
+		{
+			name:        "[Success] admin invites a new address",
+			ws:          team,
+			inviterRole: workspace.RoleAdmin,
+			input:       port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleMember, ActorID: "admin-1"},
+		},
+		{
+			name:        "[Fail] personal workspace",
+			ws:          &workspace.Workspace{ID: "ws-1", OwnerID: "owner", Personal: true},
+			inviterRole: workspace.RoleOwner,
+			input:       port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleMember, ActorID: "owner"},
+			wantError:   domainerr.ErrPersonalWorkspace,
+		},
+		{
+			name:        "[Fail] member cannot invite",
+			ws:          team,
+			inviterRole: workspace.RoleMember,
+			input:       port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleMember, ActorID: "member-1"},
+			wantError:   domainerr.ErrUnauthorized,
+		},
+		{
+			name:        "[Fail] owner role cannot be granted",
+			ws:          team,
+			inviterRole: workspace.RoleOwner,
+			input:       port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleOwner, ActorID: "owner"},
+			wantError:   violation("role", domainerr.CodeInvalid, domainerr.ErrInvalidWorkspaceRole),
+		},
+		{
+			name:        "[Fail] already a member",
+			ws:          team,
+			inviterRole: workspace.RoleOwner,
+			input:       port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "member@example.com", Role: workspace.RoleMember, ActorID: "owner"},
+			existing:    &account.Account{ID: "member-1", Email: "member@example.com", IsActive: true},
+			wantError:   violation("email", domainerr.CodeDuplicate, domainerr.ErrAlreadyWorkspaceMember),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockWorkspaceOutputPort(ctrl)
+
+			accounts.EXPECT().GetByID(gomock.Any(), tt.input.ActorID).Return(&account.Account{ID: tt.input.ActorID, IsActive: true}, nil)
+			workspaces.EXPECT().Get(gomock.Any(), "ws-1").Return(tt.ws, nil)
+			workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", tt.input.ActorID).Return(&workspace.Member{WorkspaceID: "ws-1", AccountID: tt.input.ActorID, Role: tt.inviterRole}, nil)
+			validated := !tt.ws.Personal && tt.inviterRole.ManagesMembers() && tt.input.Role != workspace.RoleOwner
+			if validated {
+				if tt.existing != nil {
+					accounts.EXPECT().GetByEmail(gomock.Any(), tt.input.Email).Return(tt.existing, nil)
+					workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", tt.existing.ID).Return(&workspace.Member{Role: workspace.RoleMember}, nil)
+				} else {
+					accounts.EXPECT().GetByEmail(gomock.Any(), tt.input.Email).Return(nil, domainerr.ErrNotFound)
+				}
+			}
+			if tt.wantError == nil {
+				inv := workspace.Invitation{WorkspaceID: "ws-1", Email: tt.input.Email, Role: tt.input.Role, InvitedBy: tt.input.ActorID}
+				saved := inv
+				saved.ID = "inv-1"
+				passThroughTx(tx)
+				workspaces.EXPECT().UpsertInvitation(gomock.Any(), inv).Return(&saved, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.WorkspaceMemberInvited, "ws-1")).Return(nil)
+				out.EXPECT().PresentInvitation(gomock.Any(), &saved).Return(nil)
+			}
+
+			interactor := uc.NewWorkspaceInteractor(workspaces, accounts, tx, publisher, out)
+			err := interactor.Invite(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceInteractor_Accept(t *testing.T) {
+	acceptedAt := time.Now()
+	tests := []struct {
+		name      string
+		inv       *workspace.Invitation
+		getErr    error
+		wantError error
+	}{
+		{
+			name: "[Success] invitee joins with the invited role",
+			inv:  &workspace.Invitation{ID: "inv-1", WorkspaceID: "ws-1", Email: "Invitee@Example.com", Role: workspace.RoleAdmin},
+		},
+		{
+			name:      "[Fail] already accepted",
+			inv:       &workspace.Invitation{ID: "inv-1", WorkspaceID: "ws-1", Email: "invitee@example.com", Role: workspace.RoleMember, AcceptedAt: &acceptedAt},
+			wantError: domainerr.ErrInvitationAccepted,
+		},
+		{
+			name:      "[Fail] addressed to someone else",
+			inv:       &workspace.Invitation{ID: "inv-1", WorkspaceID: "ws-1", Email: "other@example.com", Role: workspace.RoleMember},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] invitation not found",
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockWorkspaceOutputPort(ctrl)
+
+			accounts.EXPECT().GetByID(gomock.Any(), "invitee").Return(&account.Account{ID: "invitee", Email: "invitee@example.com", IsActive: true}, nil)
+			workspaces.EXPECT().GetInvitation(gomock.Any(), "inv-1").Return(tt.inv, tt.getErr)
+			if tt.wantError == nil {
+				member := &workspace.Member{WorkspaceID: "ws-1", AccountID: "invitee", Role: tt.inv.Role}
+				ws := &workspace.Workspace{ID: "ws-1", Name: "Team"}
+				passThroughTx(tx)
+				workspaces.EXPECT().AcceptInvitation(gomock.Any(), "inv-1").Return(tt.inv, nil)
+				workspaces.EXPECT().AddMember(gomock.Any(), workspace.Member{WorkspaceID: "ws-1", AccountID: "invitee", Role: tt.inv.Role}).Return(member, nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.WorkspaceMemberJoined, "ws-1")).Return(nil)
+				workspaces.EXPECT().Get(gomock.Any(), "ws-1").Return(ws, nil)
+				out.EXPECT().PresentWorkspace(gomock.Any(), workspace.WithRole{Workspace: *ws, Role: workspace.RoleAdmin}).Return(nil)
+			}
+
+			interactor := uc.NewWorkspaceInteractor(workspaces, accounts, tx, publisher, out)
+			err := interactor.Accept(context.Background(), port.WorkspaceAcceptInput{InvitationID: "inv-1", ActorID: "invitee"})
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceInteractor_RemoveMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		actor      workspace.Member
+		target     workspace.Member
+		wantRemove bool
+		wantError  error
+	}{
+		{
+			name:       "[Success] owner removes an admin",
+			actor:      workspace.Member{AccountID: "owner", Role: workspace.RoleOwner},
+			target:     workspace.Member{AccountID: "admin-1", Role: workspace.RoleAdmin},
+			wantRemove: true,
+		},
+		{
+			name:       "[Success] member leaves",
+			actor:      workspace.Member{AccountID: "member-1", Role: workspace.RoleMember},
+			target:     workspace.Member{AccountID: "member-1", Role: workspace.RoleMember},
+			wantRemove: true,
+		},
+		{
+			name:      "[Fail] admin cannot remove another admin",
+			actor:     workspace.Member{AccountID: "admin-1", Role: workspace.RoleAdmin},
+			target:    workspace.Member{AccountID: "admin-2", Role: workspace.RoleAdmin},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] owner cannot be removed",
+			actor:     workspace.Member{AccountID: "owner", Role: workspace.RoleOwner},
+			target:    workspace.Member{AccountID: "owner", Role: workspace.RoleOwner},
+			wantError: domainerr.ErrRemoveWorkspaceOwner,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			publisher := mockusecase.NewMockEventPublisher(ctrl)
+			out := mockusecase.NewMockWorkspaceOutputPort(ctrl)
+
+			actor, target := tt.actor, tt.target
+			actor.WorkspaceID, target.WorkspaceID = "ws-1", "ws-1"
+			workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", actor.AccountID).Return(&actor, nil)
+			workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", target.AccountID).Return(&target, nil)
+			if tt.wantRemove {
+				passThroughTx(tx)
+				workspaces.EXPECT().RemoveMember(gomock.Any(), "ws-1", target.AccountID).Return(nil)
+				publisher.EXPECT().Publish(gomock.Any(), outboxOf(outbox.WorkspaceMemberRemoved, "ws-1")).Return(nil)
+				out.EXPECT().PresentMemberRemoved(gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewWorkspaceInteractor(workspaces, activeAccounts(ctrl), tx, publisher, out)
+			err := interactor.RemoveMember(context.Background(), port.WorkspaceRemoveMemberInput{WorkspaceID: "ws-1", AccountID: target.AccountID, ActorID: actor.AccountID})
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
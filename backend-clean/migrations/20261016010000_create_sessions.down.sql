@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_sessions_account_id;
+
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_reactivations_account_id;
+
+DROP TABLE IF EXISTS account_reactivations;
@@ -0,0 +1,9 @@
+-- Audit trail of deactivated accounts that were explicitly reactivated on login
+CREATE TABLE account_reactivations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    previous_last_login_at TIMESTAMPTZ,
+    reactivated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_account_reactivations_account_id ON account_reactivations(account_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_templates_updated_at_id;
+DROP INDEX IF EXISTS idx_notes_updated_at_id;
@@ -0,0 +1,3 @@
+-- Keyset pagination walks lists in (updated_at DESC, id DESC) order
+CREATE INDEX idx_notes_updated_at_id ON notes(updated_at DESC, id DESC);
+CREATE INDEX idx_templates_updated_at_id ON templates(updated_at DESC, id DESC);
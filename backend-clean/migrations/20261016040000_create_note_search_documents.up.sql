@@ -0,0 +1,17 @@
+-- Full-text search document per note: title (weight A) plus all section content (weight B).
+-- The 'simple' configuration avoids language-specific stemming so mixed Japanese/English text is indexed as-is.
+CREATE TABLE note_search_documents (
+    note_id UUID PRIMARY KEY REFERENCES notes(id) ON DELETE CASCADE,
+    document TSVECTOR NOT NULL
+);
+
+CREATE INDEX idx_note_search_documents_document ON note_search_documents USING GIN (document);
+
+INSERT INTO note_search_documents (note_id, document)
+SELECT
+    n.id,
+    setweight(to_tsvector('simple', n.title), 'A')
+        || setweight(to_tsvector('simple', COALESCE(string_agg(s.content, ' '), '')), 'B')
+FROM notes n
+LEFT JOIN sections s ON s.note_id = n.id
+GROUP BY n.id, n.title;
@@ -0,0 +1,28 @@
+-- Immutable history of note title and section content; one row per change.
+-- sections holds [{"fieldId": "...", "content": "..."}] so a revision survives later template edits.
+CREATE TABLE note_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    revision INT NOT NULL CHECK (revision > 0),
+    title TEXT NOT NULL,
+    sections JSONB NOT NULL,
+    actor_id UUID NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT note_revisions_unique_revision UNIQUE (note_id, revision)
+);
+
+-- Seed revision 1 from the current state so existing notes have a baseline to diff against.
+INSERT INTO note_revisions (note_id, revision, title, sections, actor_id, created_at)
+SELECT
+    n.id,
+    1,
+    n.title,
+    COALESCE(
+        jsonb_agg(jsonb_build_object('fieldId', s.field_id, 'content', s.content)) FILTER (WHERE s.id IS NOT NULL),
+        '[]'::jsonb
+    ),
+    n.owner_id,
+    n.updated_at
+FROM notes n
+LEFT JOIN sections s ON s.note_id = n.id
+GROUP BY n.id, n.title, n.owner_id, n.updated_at;
@@ -0,0 +1,3 @@
+-- Optimistic concurrency: every write bumps version and is conditional on the version the writer read.
+ALTER TABLE notes ADD COLUMN version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0);
+ALTER TABLE templates ADD COLUMN version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0);
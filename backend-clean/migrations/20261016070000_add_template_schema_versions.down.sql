@@ -0,0 +1,10 @@
+-- Only reversible while every template is still on schema version 1.
+ALTER TABLE notes DROP COLUMN IF EXISTS schema_version;
+
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fields_unique_key;
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fields_unique_order;
+ALTER TABLE fields ADD CONSTRAINT fields_unique_order UNIQUE (template_id, "order");
+ALTER TABLE fields DROP COLUMN IF EXISTS key;
+ALTER TABLE fields DROP COLUMN IF EXISTS schema_version;
+
+ALTER TABLE templates DROP COLUMN IF EXISTS schema_version;
@@ -0,0 +1,17 @@
+-- Template field sets are versioned. Editing fields writes a new schema version next to the old one
+-- instead of replacing rows, so sections keep referencing the fields they were written against.
+ALTER TABLE templates ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 1 CHECK (schema_version > 0);
+
+-- key is the identity of a field across schema versions; existing fields start with their own id.
+ALTER TABLE fields ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 1 CHECK (schema_version > 0);
+ALTER TABLE fields ADD COLUMN key UUID;
+UPDATE fields SET key = id;
+ALTER TABLE fields ALTER COLUMN key SET NOT NULL;
+ALTER TABLE fields ALTER COLUMN key SET DEFAULT gen_random_uuid();
+
+ALTER TABLE fields DROP CONSTRAINT fields_unique_order;
+ALTER TABLE fields ADD CONSTRAINT fields_unique_order UNIQUE (template_id, schema_version, "order");
+ALTER TABLE fields ADD CONSTRAINT fields_unique_key UNIQUE (template_id, schema_version, key);
+
+-- The schema version of its template a note is pinned to.
+ALTER TABLE notes ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 1 CHECK (schema_version > 0);
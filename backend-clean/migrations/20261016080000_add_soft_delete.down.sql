@@ -0,0 +1,10 @@
+-- Trashed rows would reappear as live ones, so they are removed first.
+DELETE FROM notes WHERE deleted_at IS NOT NULL;
+DELETE FROM templates t
+WHERE t.deleted_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM notes n WHERE n.template_id = t.id);
+
+DROP INDEX IF EXISTS idx_templates_deleted_at;
+DROP INDEX IF EXISTS idx_notes_deleted_at;
+ALTER TABLE templates DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE notes DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,7 @@
+-- Deleting a note or template moves it to the trash; the purge job removes it for good after the retention period.
+ALTER TABLE notes ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE templates ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- Trash listing and purging only look at trashed rows.
+CREATE INDEX idx_notes_deleted_at ON notes(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_templates_deleted_at ON templates(deleted_at) WHERE deleted_at IS NOT NULL;
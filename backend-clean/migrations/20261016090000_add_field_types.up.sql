@@ -0,0 +1,7 @@
+-- Fields are typed. Section content stays TEXT; the type decides how it is validated:
+-- number and date are parsed, select values must be one of options, multi_select holds a JSON array.
+ALTER TABLE fields ADD COLUMN type TEXT NOT NULL DEFAULT 'text'
+    CHECK (type IN ('text', 'markdown', 'number', 'date', 'single_select', 'multi_select', 'url', 'checkbox'));
+
+-- Choices for single_select / multi_select fields; empty for every other type.
+ALTER TABLE fields ADD COLUMN options TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,5 @@
+ALTER TABLE fields DROP COLUMN IF EXISTS default_content;
+ALTER TABLE fields DROP COLUMN IF EXISTS placeholder;
+ALTER TABLE fields DROP COLUMN IF EXISTS pattern;
+ALTER TABLE fields DROP COLUMN IF EXISTS max_length;
+ALTER TABLE fields DROP COLUMN IF EXISTS min_length;
@@ -0,0 +1,9 @@
+-- Per-field constraints on section content. 0 / '' means "no constraint";
+-- lengths count characters, pattern must match the whole content.
+ALTER TABLE fields ADD COLUMN min_length INTEGER NOT NULL DEFAULT 0 CHECK (min_length >= 0);
+ALTER TABLE fields ADD COLUMN max_length INTEGER NOT NULL DEFAULT 0 CHECK (max_length >= 0);
+ALTER TABLE fields ADD COLUMN pattern TEXT NOT NULL DEFAULT '';
+
+-- Editor hints: placeholder is shown in an empty section, default_content fills a section the client omits.
+ALTER TABLE fields ADD COLUMN placeholder TEXT NOT NULL DEFAULT '';
+ALTER TABLE fields ADD COLUMN default_content TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription;
+DROP INDEX IF EXISTS idx_webhook_deliveries_pending;
+DROP INDEX IF EXISTS uq_webhook_deliveries_event;
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP INDEX IF EXISTS idx_webhook_subscriptions_owner;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,10 @@
+-- Scheduled notes fall back to Draft since the old constraint does not know the status.
+UPDATE notes SET status = 'Draft' WHERE status = 'Scheduled';
+
+DROP INDEX IF EXISTS idx_notes_unpublish_at;
+DROP INDEX IF EXISTS idx_notes_publish_at;
+ALTER TABLE notes DROP CONSTRAINT IF EXISTS notes_schedule_check;
+ALTER TABLE notes DROP COLUMN IF EXISTS unpublish_at;
+ALTER TABLE notes DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE notes DROP CONSTRAINT notes_status_check;
+ALTER TABLE notes ADD CONSTRAINT notes_status_check CHECK (status IN ('Draft', 'Publish'));
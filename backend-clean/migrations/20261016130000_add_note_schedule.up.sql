@@ -0,0 +1,14 @@
+-- Scheduled notes are published by the publish-scheduled-notes job once publish_at has passed;
+-- unpublish_at optionally moves a published note back to Draft.
+ALTER TABLE notes DROP CONSTRAINT notes_status_check;
+ALTER TABLE notes ADD CONSTRAINT notes_status_check CHECK (status IN ('Draft', 'Publish', 'Scheduled'));
+ALTER TABLE notes ADD COLUMN publish_at TIMESTAMPTZ;
+ALTER TABLE notes ADD COLUMN unpublish_at TIMESTAMPTZ;
+ALTER TABLE notes ADD CONSTRAINT notes_schedule_check CHECK (
+    (status = 'Scheduled') = (publish_at IS NOT NULL)
+    AND (unpublish_at IS NULL OR publish_at IS NULL OR unpublish_at > publish_at)
+);
+
+-- The job only looks at live notes that are due.
+CREATE INDEX idx_notes_publish_at ON notes(publish_at) WHERE status = 'Scheduled' AND deleted_at IS NULL;
+CREATE INDEX idx_notes_unpublish_at ON notes(unpublish_at) WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-- Notes in review fall back to Draft since the old constraint does not know the statuses.
+UPDATE notes SET status = 'Draft' WHERE status IN ('InReview', 'Approved');
+
+DROP INDEX IF EXISTS idx_note_reviews_note;
+DROP TABLE IF EXISTS note_reviews;
+
+DROP INDEX IF EXISTS idx_note_reviewers_reviewer;
+DROP TABLE IF EXISTS note_reviewers;
+
+ALTER TABLE notes DROP CONSTRAINT notes_status_check;
+ALTER TABLE notes ADD CONSTRAINT notes_status_check CHECK (status IN ('Draft', 'Publish', 'Scheduled'));
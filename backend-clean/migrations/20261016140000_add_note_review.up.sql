@@ -0,0 +1,24 @@
+-- Notes may go through review before publishing: Draft -> InReview -> Approved -> Publish.
+-- Reviewers are assigned by the owner; every approve/reject decision is kept with its comment.
+ALTER TABLE notes DROP CONSTRAINT notes_status_check;
+ALTER TABLE notes ADD CONSTRAINT notes_status_check CHECK (status IN ('Draft', 'Publish', 'Scheduled', 'InReview', 'Approved'));
+
+CREATE TABLE note_reviewers (
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (note_id, reviewer_id)
+);
+
+CREATE INDEX idx_note_reviewers_reviewer ON note_reviewers(reviewer_id);
+
+CREATE TABLE note_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    decision TEXT NOT NULL CHECK (decision IN ('Approved', 'Rejected')),
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_note_reviews_note ON note_reviews(note_id, created_at DESC);
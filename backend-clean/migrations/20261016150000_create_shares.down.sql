@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS template_shares;
+DROP TABLE IF EXISTS note_shares;
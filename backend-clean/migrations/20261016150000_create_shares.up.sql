@@ -0,0 +1,26 @@
+-- Notes and templates can be shared with other accounts as viewer, editor or owner.
+-- The owner recorded on the resource itself is never listed here.
+CREATE TABLE note_shares (
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'owner')),
+    granted_by UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (note_id, account_id)
+);
+
+-- "Shared with me" lists notes by grantee.
+CREATE INDEX idx_note_shares_account ON note_shares(account_id);
+
+CREATE TABLE template_shares (
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'owner')),
+    granted_by UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (template_id, account_id)
+);
+
+CREATE INDEX idx_template_shares_account ON template_shares(account_id);
@@ -0,0 +1,94 @@
+-- Workspaces are the tenancy boundary: every template and note belongs to one,
+-- and only its members can see them. Each account has a personal workspace;
+-- teams create shared workspaces and invite members by email.
+CREATE TABLE workspaces (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    owner_id UUID NOT NULL REFERENCES accounts(id),
+    personal BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- At most one personal workspace per account; it is created on first use.
+CREATE UNIQUE INDEX idx_workspaces_personal_owner ON workspaces(owner_id) WHERE personal;
+
+CREATE TABLE workspace_members (
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, account_id)
+);
+
+-- Listing scopes by the workspaces an account belongs to.
+CREATE INDEX idx_workspace_members_account ON workspace_members(account_id);
+
+CREATE TABLE workspace_invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'member')),
+    invited_by UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMPTZ
+);
+
+-- One pending invitation per address and workspace; inviting again changes its role.
+CREATE UNIQUE INDEX idx_workspace_invitations_pending ON workspace_invitations(workspace_id, lower(email)) WHERE accepted_at IS NULL;
+CREATE INDEX idx_workspace_invitations_email ON workspace_invitations(lower(email)) WHERE accepted_at IS NULL;
+
+-- Existing templates move to their owner's personal workspace and notes follow their template.
+INSERT INTO workspaces (name, owner_id, personal)
+SELECT 'Personal', id, TRUE FROM accounts;
+
+ALTER TABLE templates ADD COLUMN workspace_id UUID REFERENCES workspaces(id);
+UPDATE templates t SET workspace_id = w.id FROM workspaces w WHERE w.owner_id = t.owner_id AND w.personal;
+
+-- Personal workspaces stay owner-only, so templates other accounts already work with
+-- (shared templates, templates others wrote notes from and templates of shared notes)
+-- move instead to one shared workspace per owner.
+WITH collaborative AS (
+    SELECT t.id, t.owner_id FROM templates t
+    WHERE EXISTS (SELECT 1 FROM template_shares s WHERE s.template_id = t.id)
+       OR EXISTS (SELECT 1 FROM notes n WHERE n.template_id = t.id AND n.owner_id <> t.owner_id)
+       OR EXISTS (SELECT 1 FROM notes n JOIN note_shares s ON s.note_id = n.id WHERE n.template_id = t.id)
+), created AS (
+    INSERT INTO workspaces (name, owner_id, personal)
+    SELECT DISTINCT 'Shared', owner_id, FALSE FROM collaborative
+    RETURNING id, owner_id
+)
+UPDATE templates t SET workspace_id = w.id
+FROM collaborative c JOIN created w ON w.owner_id = c.owner_id
+WHERE t.id = c.id;
+
+ALTER TABLE templates ALTER COLUMN workspace_id SET NOT NULL;
+
+ALTER TABLE notes ADD COLUMN workspace_id UUID REFERENCES workspaces(id);
+UPDATE notes n SET workspace_id = t.workspace_id FROM templates t WHERE t.id = n.template_id;
+ALTER TABLE notes ALTER COLUMN workspace_id SET NOT NULL;
+
+INSERT INTO workspace_members (workspace_id, account_id, role)
+SELECT id, owner_id, 'owner' FROM workspaces;
+
+-- Note authors and share grantees keep their access as members of the shared workspace;
+-- their existing share grants are kept as they are.
+INSERT INTO workspace_members (workspace_id, account_id, role)
+SELECT DISTINCT n.workspace_id, n.owner_id, 'member' FROM notes n
+JOIN workspaces w ON w.id = n.workspace_id AND NOT w.personal
+ON CONFLICT DO NOTHING;
+
+INSERT INTO workspace_members (workspace_id, account_id, role)
+SELECT DISTINCT n.workspace_id, s.account_id, 'member' FROM note_shares s
+JOIN notes n ON n.id = s.note_id
+JOIN workspaces w ON w.id = n.workspace_id AND NOT w.personal
+ON CONFLICT DO NOTHING;
+
+INSERT INTO workspace_members (workspace_id, account_id, role)
+SELECT DISTINCT t.workspace_id, s.account_id, 'member' FROM template_shares s
+JOIN templates t ON t.id = s.template_id
+JOIN workspaces w ON w.id = t.workspace_id AND NOT w.personal
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX idx_templates_workspace ON templates(workspace_id);
+CREATE INDEX idx_notes_workspace ON notes(workspace_id);
@@ -0,0 +1,13 @@
+-- Public read-only links to published notes. Only the hash of the token is stored;
+-- the raw token is shown once when the link is created.
+CREATE TABLE note_share_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_by UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_note_share_links_note_id ON note_share_links(note_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_templates_gallery;
+ALTER TABLE templates DROP COLUMN IF EXISTS is_public;
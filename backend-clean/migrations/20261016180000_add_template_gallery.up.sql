@@ -0,0 +1,4 @@
+-- Public templates are listed in the gallery, where any account can read and clone them.
+ALTER TABLE templates ADD COLUMN is_public BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_templates_gallery ON templates(updated_at DESC, id DESC) WHERE is_public AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_outbox_note_feed;
+ALTER TABLE outbox DROP COLUMN IF EXISTS seq;
+ALTER TABLE outbox DROP COLUMN IF EXISTS tx_id;
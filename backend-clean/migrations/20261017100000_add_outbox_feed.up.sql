@@ -0,0 +1,7 @@
+-- Note watchers follow the outbox as a feed shared by every process. tx_id is the transaction
+-- that recorded an event and seq orders the events within it. Watchers only read events of
+-- transactions older than every running one, so an event committed late is never skipped.
+ALTER TABLE outbox ADD COLUMN tx_id BIGINT NOT NULL DEFAULT pg_current_xact_id()::text::bigint;
+ALTER TABLE outbox ADD COLUMN seq BIGSERIAL;
+
+CREATE INDEX idx_outbox_note_feed ON outbox(tx_id, seq) WHERE aggregate_type = 'note';
@@ -53,10 +53,9 @@ func TestDeactivateJob_E2E(t *testing.T) {
 	runDeactivateJob := func(t *testing.T) int {
 		t.Helper()
 		txMgr := driverdb.NewTxManager(pool)
-		_ = txMgr // not used in this job, but showing the pattern
 
 		accountRepoFactory := factory.NewAccountRepoFactory(pool)
-		deactivateInputFactory := factory.NewDeactivateJobInputFactory()
+		deactivateInputFactory := factory.NewDeactivateJobInputFactory(factory.NewTxFactory(txMgr), factory.NewEventPublisherFactory(pool))
 		deactivateOutputFactory := jobfactory.NewDeactivateOutputFactory()
 
 		controller := jobctrl.NewDeactivateController(
@@ -28,6 +28,7 @@ func TestNoteAPI_CRUD(t *testing.T) {
 
 	// Create test data
 	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
 
 	var createdNoteID string
 
@@ -35,7 +36,6 @@ func TestNoteAPI_CRUD(t *testing.T) {
 		reqBody := map[string]interface{}{
 			"title":      "E2E Test Note",
 			"templateId": data.Template.ID,
-			"ownerId":    data.Account.ID,
 			"sections": []map[string]interface{}{
 				{"fieldId": data.Template.Fields[0].ID, "content": "Background content"},
 				{"fieldId": data.Template.Fields[1].ID, "content": "Solution content"},
@@ -44,7 +44,7 @@ func TestNoteAPI_CRUD(t *testing.T) {
 		}
 		body, _ := json.Marshal(reqBody)
 
-		resp, err := http.Post(
+		resp, err := client.Post(
 			server.URL+"/api/notes",
 			"application/json",
 			bytes.NewReader(body),
@@ -69,7 +69,7 @@ func TestNoteAPI_CRUD(t *testing.T) {
 	})
 
 	t.Run("GET /api/notes/:id - Get note", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/notes/" + createdNoteID)
+		resp, err := client.Get(server.URL + "/api/notes/" + createdNoteID)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -85,22 +85,39 @@ func TestNoteAPI_CRUD(t *testing.T) {
 	})
 
 	t.Run("GET /api/notes - List notes", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/notes")
+		resp, err := client.Get(server.URL + "/api/notes")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 1)
 	})
 
+	t.Run("GET /api/notes?q= - Search section content", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/notes?q=Solution")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		require.NoError(t, err)
+
+		require.NotEmpty(t, page.Items)
+		assert.Equal(t, createdNoteID, page.Items[0]["id"])
+		assert.Contains(t, page.Items[0]["snippet"], "<mark>Solution</mark>")
+	})
+
 	t.Run("PUT /api/notes/:id - Update note", func(t *testing.T) {
 		// First, get the note to retrieve section IDs
-		getResp, err := http.Get(server.URL + "/api/notes/" + createdNoteID)
+		getResp, err := client.Get(server.URL + "/api/notes/" + createdNoteID)
 		require.NoError(t, err)
 		var noteData map[string]interface{}
 		err = json.NewDecoder(getResp.Body).Decode(&noteData)
@@ -125,13 +142,13 @@ func TestNoteAPI_CRUD(t *testing.T) {
 
 		req, err := http.NewRequest(
 			http.MethodPut,
-			server.URL+"/api/notes/"+createdNoteID+"?ownerId="+data.Account.ID,
+			server.URL+"/api/notes/"+createdNoteID,
 			bytes.NewReader(body),
 		)
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -146,19 +163,113 @@ func TestNoteAPI_CRUD(t *testing.T) {
 		assert.Equal(t, "Updated E2E Note", result["title"])
 	})
 
+	t.Run("GET /api/notes/:id/revisions - List revisions", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/notes/" + createdNoteID + "/revisions")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		require.NoError(t, err)
+
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, float64(2), result.Items[0]["revision"])
+		assert.Equal(t, "Updated E2E Note", result.Items[0]["title"])
+		assert.Equal(t, data.Account.ID, result.Items[0]["actorId"])
+	})
+
+	t.Run("GET /api/notes/:id/revisions/diff - Diff revisions", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/notes/" + createdNoteID + "/revisions/diff?from=1&to=2")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		require.NoError(t, err)
+
+		title := result["title"].(map[string]interface{})
+		assert.Equal(t, "E2E Test Note", title["before"])
+		assert.Equal(t, "Updated E2E Note", title["after"])
+		sections := result["sections"].([]interface{})
+		require.Len(t, sections, 3)
+		first := sections[0].(map[string]interface{})
+		assert.Equal(t, data.Template.Fields[0].Label, first["fieldLabel"])
+		assert.Equal(t, true, first["changed"])
+	})
+
+	t.Run("POST /api/notes/:id/revisions/:revision/restore - Restore revision", func(t *testing.T) {
+		resp, err := client.Post(server.URL+"/api/notes/"+createdNoteID+"/revisions/1/restore", "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		require.NoError(t, err)
+		assert.Equal(t, "E2E Test Note", result["title"])
+
+		listResp, err := client.Get(server.URL + "/api/notes/" + createdNoteID + "/revisions")
+		require.NoError(t, err)
+		defer listResp.Body.Close()
+		var list struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		require.NoError(t, json.NewDecoder(listResp.Body).Decode(&list))
+		assert.Len(t, list.Items, 3)
+	})
+
+	t.Run("GET /api/notes/:id/revisions/diff - Unknown revision", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/notes/" + createdNoteID + "/revisions/diff?from=1&to=99")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("POST /api/notes/:id/publish - Stale If-Match", func(t *testing.T) {
+		req, err := http.NewRequest(
+			http.MethodPost,
+			server.URL+"/api/notes/"+createdNoteID+"/publish",
+			nil,
+		)
+		require.NoError(t, err)
+		req.Header.Set("If-Match", `"1"`)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
 	t.Run("POST /api/notes/:id/publish - Publish note", func(t *testing.T) {
+		getResp, err := client.Get(server.URL + "/api/notes/" + createdNoteID)
+		require.NoError(t, err)
+		getResp.Body.Close()
+		etag := getResp.Header.Get("ETag")
+		require.NotEmpty(t, etag)
+
 		req, err := http.NewRequest(
 			http.MethodPost,
-			server.URL+"/api/notes/"+createdNoteID+"/publish?ownerId="+data.Account.ID,
+			server.URL+"/api/notes/"+createdNoteID+"/publish",
 			nil,
 		)
 		require.NoError(t, err)
+		req.Header.Set("If-Match", etag)
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
 
 		var result map[string]interface{}
 		err = json.NewDecoder(resp.Body).Decode(&result)
@@ -169,12 +280,12 @@ func TestNoteAPI_CRUD(t *testing.T) {
 	t.Run("POST /api/notes/:id/unpublish - Unpublish note", func(t *testing.T) {
 		req, err := http.NewRequest(
 			http.MethodPost,
-			server.URL+"/api/notes/"+createdNoteID+"/unpublish?ownerId="+data.Account.ID,
+			server.URL+"/api/notes/"+createdNoteID+"/unpublish",
 			nil,
 		)
 		require.NoError(t, err)
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -189,19 +300,19 @@ func TestNoteAPI_CRUD(t *testing.T) {
 	t.Run("DELETE /api/notes/:id - Delete note", func(t *testing.T) {
 		req, err := http.NewRequest(
 			http.MethodDelete,
-			server.URL+"/api/notes/"+createdNoteID+"?ownerId="+data.Account.ID,
+			server.URL+"/api/notes/"+createdNoteID,
 			nil,
 		)
 		require.NoError(t, err)
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 		// Verify deletion
-		getResp, err := http.Get(server.URL + "/api/notes/" + createdNoteID)
+		getResp, err := client.Get(server.URL + "/api/notes/" + createdNoteID)
 		require.NoError(t, err)
 		defer getResp.Body.Close()
 
@@ -217,9 +328,10 @@ func TestNoteAPI_Errors(t *testing.T) {
 	pg := basetestutil.SetupPostgres(t)
 	server := testutil.StartTestServer(t, pg.ConnectionString)
 	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
 
 	t.Run("GET /api/notes/:id - Not found", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/notes/00000000-0000-0000-0000-000000000000")
+		resp, err := client.Get(server.URL + "/api/notes/00000000-0000-0000-0000-000000000000")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -227,7 +339,7 @@ func TestNoteAPI_Errors(t *testing.T) {
 	})
 
 	t.Run("POST /api/notes - Invalid body", func(t *testing.T) {
-		resp, err := http.Post(
+		resp, err := client.Post(
 			server.URL+"/api/notes",
 			"application/json",
 			bytes.NewReader([]byte("invalid-json")),
@@ -238,7 +350,7 @@ func TestNoteAPI_Errors(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 
-	t.Run("PUT /api/notes/:id - Missing ownerId", func(t *testing.T) {
+	t.Run("PUT /api/notes/:id - Missing bearer token", func(t *testing.T) {
 		reqBody := map[string]interface{}{
 			"title":    "Test",
 			"sections": []map[string]interface{}{},
@@ -247,7 +359,7 @@ func TestNoteAPI_Errors(t *testing.T) {
 
 		req, err := http.NewRequest(
 			http.MethodPut,
-			server.URL+"/api/notes/"+data.Note.ID, // No ownerId query param
+			server.URL+"/api/notes/"+data.Note.ID,
 			bytes.NewReader(body),
 		)
 		require.NoError(t, err)
@@ -257,8 +369,7 @@ func TestNoteAPI_Errors(t *testing.T) {
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
-		// API returns 400 Bad Request when ownerId is missing (required param)
-		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 	})
 
 	t.Run("DELETE /api/notes/:id - Wrong owner", func(t *testing.T) {
@@ -269,12 +380,12 @@ func TestNoteAPI_Errors(t *testing.T) {
 
 		req, err := http.NewRequest(
 			http.MethodDelete,
-			server.URL+"/api/notes/"+data.Note.ID+"?ownerId="+otherAccount.ID,
+			server.URL+"/api/notes/"+data.Note.ID,
 			nil,
 		)
 		require.NoError(t, err)
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := server.AuthClient(t, otherAccount.ID).Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -290,6 +401,7 @@ func TestNoteAPI_Filters(t *testing.T) {
 	pg := basetestutil.SetupPostgres(t)
 	server := testutil.StartTestServer(t, pg.ConnectionString)
 	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
 
 	// Create additional notes
 	basetestutil.CreateTestNote(t, server.Pool(), basetestutil.TestNote{
@@ -300,15 +412,16 @@ func TestNoteAPI_Filters(t *testing.T) {
 	})
 
 	t.Run("GET /api/notes?status=Draft", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/notes?status=Draft")
+		resp, err := client.Get(server.URL + "/api/notes?status=Draft")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		for _, note := range result {
 			assert.Equal(t, "Draft", note["status"])
@@ -316,15 +429,16 @@ func TestNoteAPI_Filters(t *testing.T) {
 	})
 
 	t.Run("GET /api/notes?status=Publish", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/notes?status=Publish")
+		resp, err := client.Get(server.URL + "/api/notes?status=Publish")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 1)
 		for _, note := range result {
@@ -333,30 +447,335 @@ func TestNoteAPI_Filters(t *testing.T) {
 	})
 
 	t.Run("GET /api/notes?ownerId=:id", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/notes?ownerId=" + data.Account.ID)
+		resp, err := client.Get(server.URL + "/api/notes?ownerId=" + data.Account.ID)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 2)
 	})
 
+	t.Run("GET /api/notes?limit=1 - Page through with cursor", func(t *testing.T) {
+		seen := map[string]bool{}
+		url := server.URL + "/api/notes?limit=1&ownerId=" + data.Account.ID
+		for {
+			resp, err := client.Get(url)
+			require.NoError(t, err)
+
+			var page testutil.ListPage
+			err = json.NewDecoder(resp.Body).Decode(&page)
+			resp.Body.Close()
+			require.NoError(t, err)
+			require.Len(t, page.Items, 1)
+
+			id := page.Items[0]["id"].(string)
+			assert.False(t, seen[id], "note %s returned twice", id)
+			seen[id] = true
+			if !page.HasMore {
+				break
+			}
+			require.NotEmpty(t, page.NextCursor)
+			url = server.URL + "/api/notes?limit=1&ownerId=" + data.Account.ID + "&cursor=" + page.NextCursor
+		}
+		assert.GreaterOrEqual(t, len(seen), 2)
+	})
+
+	t.Run("GET /api/notes?cursor=garbage", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/notes?cursor=garbage")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("GET /api/notes?q=Published", func(t *testing.T) {
-		resp, err := http.Get(fmt.Sprintf("%s/api/notes?q=%s", server.URL, "Published"))
+		resp, err := client.Get(fmt.Sprintf("%s/api/notes?q=%s", server.URL, "Published"))
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 1)
 	})
 }
+
+func TestNoteAPI_Upgrade(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e test")
+	}
+
+	pg := basetestutil.SetupPostgres(t)
+	server := testutil.StartTestServer(t, pg.ConnectionString)
+	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
+
+	var decisionFieldID string
+
+	t.Run("PUT /api/templates/:id - Editing fields writes a new schema version", func(t *testing.T) {
+		reqBody := map[string]interface{}{
+			"id":   data.Template.ID,
+			"name": data.Template.Name,
+			"fields": []map[string]interface{}{
+				{"id": data.Template.Fields[0].ID, "label": "Context", "order": 1, "isRequired": true},
+				{"id": data.Template.Fields[1].ID, "label": "Solution", "order": 2, "isRequired": true},
+				{"label": "Decision", "order": 3, "isRequired": true},
+			},
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/api/templates/"+data.Template.ID, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(2), result["schemaVersion"])
+		fields := result["fields"].([]interface{})
+		require.Len(t, fields, 3)
+		decisionFieldID = fields[2].(map[string]interface{})["id"].(string)
+	})
+
+	t.Run("GET /api/notes/:id - Note stays on its schema version", func(t *testing.T) {
+		resp, err := client.Get(server.URL + "/api/notes/" + data.Note.ID)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(1), result["templateSchemaVersion"])
+		assert.Equal(t, float64(2), result["latestTemplateSchemaVersion"])
+		sections := result["sections"].([]interface{})
+		assert.Len(t, sections, 3)
+		assert.Equal(t, "Background", sections[0].(map[string]interface{})["fieldLabel"])
+	})
+
+	t.Run("POST /api/notes/:id/upgrade - Move note to the latest schema version", func(t *testing.T) {
+		reqBody := map[string]interface{}{
+			"sections": []map[string]interface{}{
+				{"fieldId": decisionFieldID, "content": "Decision content"},
+			},
+		}
+		body, _ := json.Marshal(reqBody)
+
+		resp, err := client.Post(server.URL+"/api/notes/"+data.Note.ID+"/upgrade", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var result map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		require.NoError(t, err)
+
+		if resp.StatusCode != http.StatusOK {
+			t.Logf("Response body: %+v", result)
+		}
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+		assert.Equal(t, float64(2), result["templateSchemaVersion"])
+
+		sections := result["sections"].([]interface{})
+		require.Len(t, sections, 3)
+		first := sections[0].(map[string]interface{})
+		assert.Equal(t, "Context", first["fieldLabel"])
+		assert.Equal(t, "Background content", first["content"])
+		assert.Equal(t, "Decision content", sections[2].(map[string]interface{})["content"])
+	})
+}
+
+func TestNoteAPI_TypedFields(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e test")
+	}
+
+	pg := basetestutil.SetupPostgres(t)
+	server := testutil.StartTestServer(t, pg.ConnectionString)
+	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"name": "Typed Template",
+		"fields": []map[string]interface{}{
+			{"label": "Estimate", "order": 1, "isRequired": true, "type": "number"},
+			{"label": "Priority", "order": 2, "isRequired": false, "type": "single_select", "options": []string{"low", "high"}},
+		},
+	})
+	resp, err := client.Post(server.URL+"/api/templates", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	var tpl struct {
+		ID     string `json:"id"`
+		Fields []struct {
+			ID      string   `json:"id"`
+			Type    string   `json:"type"`
+			Options []string `json:"options"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tpl))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, tpl.Fields, 2)
+	assert.Equal(t, "number", tpl.Fields[0].Type)
+	assert.Equal(t, []string{"low", "high"}, tpl.Fields[1].Options)
+
+	createNote := func(estimate, priority string) *http.Response {
+		body, _ := json.Marshal(map[string]interface{}{
+			"title":      "Typed Note",
+			"templateId": tpl.ID,
+			"sections": []map[string]interface{}{
+				{"fieldId": tpl.Fields[0].ID, "content": estimate},
+				{"fieldId": tpl.Fields[1].ID, "content": priority},
+			},
+		})
+		resp, err := client.Post(server.URL+"/api/notes", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("POST /api/notes - Content matching field types", func(t *testing.T) {
+		resp := createNote("3.5", "high")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Sections []struct {
+				FieldType    string   `json:"fieldType"`
+				FieldOptions []string `json:"fieldOptions"`
+			} `json:"sections"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		require.Len(t, result.Sections, 2)
+		assert.Equal(t, "number", result.Sections[0].FieldType)
+		assert.Equal(t, "single_select", result.Sections[1].FieldType)
+		assert.Equal(t, []string{"low", "high"}, result.Sections[1].FieldOptions)
+	})
+
+	t.Run("POST /api/notes - Not a number", func(t *testing.T) {
+		resp := createNote("soon", "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("POST /api/notes - Option not offered", func(t *testing.T) {
+		resp := createNote("1", "urgent")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("POST /api/templates - Select field without options", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"name":   "Broken",
+			"fields": []map[string]interface{}{{"label": "Pick", "order": 1, "isRequired": false, "type": "multi_select"}},
+		})
+		resp, err := client.Post(server.URL+"/api/templates", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestNoteAPI_FieldConstraints(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e test")
+	}
+
+	pg := basetestutil.SetupPostgres(t)
+	server := testutil.StartTestServer(t, pg.ConnectionString)
+	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"name": "Ticket",
+		"fields": []map[string]interface{}{
+			{"label": "Code", "order": 1, "isRequired": true, "pattern": `[A-Z]+-\d+`, "placeholder": "ABC-1"},
+			{"label": "Status", "order": 2, "isRequired": true, "defaultContent": "open", "maxLength": 10},
+		},
+	})
+	resp, err := client.Post(server.URL+"/api/templates", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	var tpl struct {
+		ID     string `json:"id"`
+		Fields []struct {
+			ID          string  `json:"id"`
+			Placeholder *string `json:"placeholder"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tpl))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, tpl.Fields, 2)
+	require.NotNil(t, tpl.Fields[0].Placeholder)
+	assert.Equal(t, "ABC-1", *tpl.Fields[0].Placeholder)
+
+	createNote := func(sections []map[string]interface{}) *http.Response {
+		body, _ := json.Marshal(map[string]interface{}{"title": "Ticket note", "templateId": tpl.ID, "sections": sections})
+		resp, err := client.Post(server.URL+"/api/notes", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("POST /api/notes - Omitted section takes the default", func(t *testing.T) {
+		resp := createNote([]map[string]interface{}{{"fieldId": tpl.Fields[0].ID, "content": "OPS-12"}})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Sections []struct {
+				FieldID string `json:"fieldId"`
+				Content string `json:"content"`
+			} `json:"sections"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		require.Len(t, result.Sections, 2)
+		assert.Equal(t, tpl.Fields[1].ID, result.Sections[1].FieldID)
+		assert.Equal(t, "open", result.Sections[1].Content)
+	})
+
+	t.Run("POST /api/notes - Pattern mismatch names the field", func(t *testing.T) {
+		resp := createNote([]map[string]interface{}{{"fieldId": tpl.Fields[0].ID, "content": "ops12"}})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var result struct {
+			Message string `json:"message"`
+			Details []struct {
+				Field string `json:"field"`
+				Code  string `json:"code"`
+			} `json:"details"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Contains(t, result.Message, tpl.Fields[0].ID)
+		require.Len(t, result.Details, 1)
+		assert.Equal(t, "sections["+tpl.Fields[0].ID+"].content", result.Details[0].Field)
+		assert.Equal(t, "PATTERN_MISMATCH", result.Details[0].Code)
+	})
+
+	t.Run("POST /api/templates - Default breaking its own constraint", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"name":   "Broken",
+			"fields": []map[string]interface{}{{"label": "Code", "order": 1, "isRequired": false, "maxLength": 2, "defaultContent": "long"}},
+		})
+		resp, err := client.Post(server.URL+"/api/templates", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
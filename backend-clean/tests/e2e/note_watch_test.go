@@ -0,0 +1,90 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	driverevent "immortal-architecture-clean/backend/internal/driver/event"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	"immortal-architecture-clean/backend/internal/port"
+	"immortal-architecture-clean/backend/tests/e2e/testutil"
+	basetestutil "immortal-architecture-clean/backend/tests/testutil"
+)
+
+// watchOutput hands presented events to the test.
+type watchOutput struct {
+	events chan note.Event
+}
+
+func (o *watchOutput) PresentNoteEvent(ctx context.Context, e note.Event) error {
+	select {
+	case o.events <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TestNoteWatch_HTTPWrites watches the way the gRPC server does, with its own pool,
+// while the note is written through the HTTP API.
+func TestNoteWatch_HTTPWrites(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e test")
+	}
+
+	pg := basetestutil.SetupPostgres(t)
+	server := testutil.StartTestServer(t, pg.ConnectionString)
+	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
+
+	watcherPool := pg.NewPool(t)
+	feed := driverevent.NewNoteFeed(factory.NewNoteFeedReaderFactory(watcherPool)(), 50*time.Millisecond)
+	watch := factory.NewNoteWatchInputFactory(feed, factory.NewWorkspaceRepoFactory(watcherPool))
+
+	ctx, cancel := context.WithTimeout(basetestutil.TestContext(t), 30*time.Second)
+	defer cancel()
+	output := &watchOutput{events: make(chan note.Event)}
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- watch(output).Watch(ctx, port.NoteWatchInput{ActorID: data.Account.ID})
+	}()
+	// Let the watcher take its starting position before the write.
+	time.Sleep(200 * time.Millisecond)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"title":      "Watched Note",
+		"templateId": data.Template.ID,
+		"sections": []map[string]interface{}{
+			{"fieldId": data.Template.Fields[0].ID, "content": "Background content"},
+			{"fieldId": data.Template.Fields[1].ID, "content": "Solution content"},
+		},
+	})
+	resp, err := client.Post(server.URL+"/api/notes", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var created map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	select {
+	case e := <-output.events:
+		assert.Equal(t, note.EventCreated, e.Type)
+		assert.Equal(t, created["id"], e.NoteID)
+		assert.Equal(t, data.Account.ID, e.OwnerID)
+		assert.NotEmpty(t, e.Cursor)
+	case err := <-watchErr:
+		t.Fatalf("watch ended early: %v", err)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the note event")
+	}
+}
@@ -0,0 +1,92 @@
+//go:build e2e
+
+// Package e2e contains end-to-end tests.
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jobctrl "immortal-architecture-clean/backend/internal/adapter/job/controller"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	jobfactory "immortal-architecture-clean/backend/internal/driver/factory/job"
+	basetestutil "immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestPurgeJob_E2E(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e test")
+	}
+
+	pg := basetestutil.SetupPostgres(t)
+	pool := pg.NewPool(t)
+	ctx := basetestutil.TestContext(t)
+	data := basetestutil.CreateDefaultTestData(t, pool)
+
+	// Helper to move a row to the trash at a given time
+	trashAt := func(t *testing.T, table, id string, deletedAt time.Time) {
+		t.Helper()
+		_, err := pool.Exec(context.Background(), `UPDATE `+table+` SET deleted_at = $2 WHERE id = $1`, id, deletedAt)
+		require.NoError(t, err)
+	}
+
+	// Helper to check whether a row still exists
+	exists := func(t *testing.T, table, id string) bool {
+		t.Helper()
+		var found bool
+		err := pool.QueryRow(context.Background(), `SELECT EXISTS (SELECT 1 FROM `+table+` WHERE id = $1)`, id).Scan(&found)
+		require.NoError(t, err)
+		return found
+	}
+
+	runPurgeJob := func(t *testing.T, retention time.Duration) (int, int) {
+		t.Helper()
+		controller := jobctrl.NewPurgeController(
+			factory.NewPurgeJobInputFactory(),
+			jobfactory.NewPurgeOutputFactory(),
+			factory.NewNoteRepoFactory(pool),
+			factory.NewTemplateRepoFactory(pool),
+		)
+		notes, templates, err := controller.Run(ctx, retention)
+		require.NoError(t, err)
+		return notes, templates
+	}
+
+	t.Run("保持期間を過ぎたノートとテンプレートを完全削除する", func(t *testing.T) {
+		// テストデータ:
+		// - 既定データのノート: 40日前に削除 -> 完全削除される
+		// - 既定データのテンプレート: 40日前に削除、参照するノートも削除される -> 完全削除される
+		// - ノートB: 5日前に削除 -> 残る
+		// - ノートC: ゴミ箱に入っていない -> 残る
+		other := basetestutil.CreateTestTemplate(t, pool, basetestutil.TestTemplate{Name: "Other", OwnerID: data.Account.ID})
+		noteB := basetestutil.CreateTestNote(t, pool, basetestutil.TestNote{Title: "B", TemplateID: other.ID, OwnerID: data.Account.ID})
+		noteC := basetestutil.CreateTestNote(t, pool, basetestutil.TestNote{Title: "C", TemplateID: other.ID, OwnerID: data.Account.ID})
+
+		trashAt(t, "notes", data.Note.ID, time.Now().AddDate(0, 0, -40))
+		trashAt(t, "templates", data.Template.ID, time.Now().AddDate(0, 0, -40))
+		trashAt(t, "notes", noteB.ID, time.Now().AddDate(0, 0, -5))
+
+		notes, templates := runPurgeJob(t, 0)
+		assert.Equal(t, 1, notes)
+		assert.Equal(t, 1, templates)
+
+		assert.False(t, exists(t, "notes", data.Note.ID))
+		assert.False(t, exists(t, "templates", data.Template.ID))
+		assert.True(t, exists(t, "notes", noteB.ID))
+		assert.True(t, exists(t, "notes", noteC.ID))
+
+		// 2回目は対象なし（冪等）
+		notes, templates = runPurgeJob(t, 0)
+		assert.Equal(t, 0, notes)
+		assert.Equal(t, 0, templates)
+	})
+
+	t.Run("保持期間を短くすると新しい削除も対象になる", func(t *testing.T) {
+		notes, _ := runPurgeJob(t, 24*time.Hour)
+		assert.Equal(t, 1, notes)
+	})
+}
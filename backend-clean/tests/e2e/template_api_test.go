@@ -29,13 +29,13 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 		FirstName: "Template",
 		LastName:  "Owner",
 	})
+	client := server.AuthClient(t, account.ID)
 
 	var createdTemplateID string
 
 	t.Run("POST /api/templates - Create template", func(t *testing.T) {
 		reqBody := map[string]interface{}{
-			"name":    "E2E Test Template",
-			"ownerId": account.ID,
+			"name": "E2E Test Template",
 			"fields": []map[string]interface{}{
 				{"label": "Background", "order": 1, "isRequired": true},
 				{"label": "Solution", "order": 2, "isRequired": true},
@@ -44,7 +44,7 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 		}
 		body, _ := json.Marshal(reqBody)
 
-		resp, err := http.Post(
+		resp, err := client.Post(
 			server.URL+"/api/templates",
 			"application/json",
 			bytes.NewReader(body),
@@ -67,7 +67,7 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 	})
 
 	t.Run("GET /api/templates/:id - Get template", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/templates/" + createdTemplateID)
+		resp, err := client.Get(server.URL + "/api/templates/" + createdTemplateID)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -88,15 +88,16 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 	})
 
 	t.Run("GET /api/templates - List templates", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/templates")
+		resp, err := client.Get(server.URL + "/api/templates")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 1)
 	})
@@ -113,13 +114,13 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 
 		req, err := http.NewRequest(
 			http.MethodPut,
-			server.URL+"/api/templates/"+createdTemplateID+"?ownerId="+account.ID,
+			server.URL+"/api/templates/"+createdTemplateID,
 			bytes.NewReader(body),
 		)
 		require.NoError(t, err)
 		req.Header.Set("Content-Type", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -137,15 +138,14 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 	t.Run("DELETE /api/templates/:id - Delete template", func(t *testing.T) {
 		// Create a new template to delete
 		reqBody := map[string]interface{}{
-			"name":    "Template to Delete",
-			"ownerId": account.ID,
+			"name": "Template to Delete",
 			"fields": []map[string]interface{}{
 				{"label": "Field1", "order": 1, "isRequired": false},
 			},
 		}
 		body, _ := json.Marshal(reqBody)
 
-		createResp, err := http.Post(
+		createResp, err := client.Post(
 			server.URL+"/api/templates",
 			"application/json",
 			bytes.NewReader(body),
@@ -161,19 +161,19 @@ func TestTemplateAPI_CRUD(t *testing.T) {
 		// Delete it
 		req, err := http.NewRequest(
 			http.MethodDelete,
-			server.URL+"/api/templates/"+templateToDelete+"?ownerId="+account.ID,
+			server.URL+"/api/templates/"+templateToDelete,
 			nil,
 		)
 		require.NoError(t, err)
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 		// Verify deletion
-		getResp, err := http.Get(server.URL + "/api/templates/" + templateToDelete)
+		getResp, err := client.Get(server.URL + "/api/templates/" + templateToDelete)
 		require.NoError(t, err)
 		defer getResp.Body.Close()
 
@@ -189,9 +189,10 @@ func TestTemplateAPI_Errors(t *testing.T) {
 	pg := basetestutil.SetupPostgres(t)
 	server := testutil.StartTestServer(t, pg.ConnectionString)
 	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
 
 	t.Run("GET /api/templates/:id - Not found", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/templates/00000000-0000-0000-0000-000000000000")
+		resp, err := client.Get(server.URL + "/api/templates/00000000-0000-0000-0000-000000000000")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -199,7 +200,7 @@ func TestTemplateAPI_Errors(t *testing.T) {
 	})
 
 	t.Run("POST /api/templates - Invalid body", func(t *testing.T) {
-		resp, err := http.Post(
+		resp, err := client.Post(
 			server.URL+"/api/templates",
 			"application/json",
 			bytes.NewReader([]byte("invalid-json")),
@@ -210,7 +211,7 @@ func TestTemplateAPI_Errors(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 
-	t.Run("PUT /api/templates/:id - Missing ownerId", func(t *testing.T) {
+	t.Run("PUT /api/templates/:id - Missing bearer token", func(t *testing.T) {
 		reqBody := map[string]interface{}{
 			"name":   "Test",
 			"fields": []map[string]interface{}{},
@@ -219,7 +220,7 @@ func TestTemplateAPI_Errors(t *testing.T) {
 
 		req, err := http.NewRequest(
 			http.MethodPut,
-			server.URL+"/api/templates/"+data.Template.ID, // No ownerId query param
+			server.URL+"/api/templates/"+data.Template.ID,
 			bytes.NewReader(body),
 		)
 		require.NoError(t, err)
@@ -229,8 +230,7 @@ func TestTemplateAPI_Errors(t *testing.T) {
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
-		// API returns 400 Bad Request when ownerId is missing (required param)
-		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 	})
 
 	t.Run("DELETE /api/templates/:id - Wrong owner", func(t *testing.T) {
@@ -241,12 +241,12 @@ func TestTemplateAPI_Errors(t *testing.T) {
 
 		req, err := http.NewRequest(
 			http.MethodDelete,
-			server.URL+"/api/templates/"+data.Template.ID+"?ownerId="+otherAccount.ID,
+			server.URL+"/api/templates/"+data.Template.ID,
 			nil,
 		)
 		require.NoError(t, err)
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := server.AuthClient(t, otherAccount.ID).Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -265,6 +265,7 @@ func TestTemplateAPI_Filters(t *testing.T) {
 	// Create accounts
 	account1 := basetestutil.CreateTestAccount(t, server.Pool(), basetestutil.TestAccount{Email: "filter1@example.com"})
 	account2 := basetestutil.CreateTestAccount(t, server.Pool(), basetestutil.TestAccount{Email: "filter2@example.com"})
+	client := server.AuthClient(t, account1.ID)
 
 	// Create templates for different owners
 	basetestutil.CreateTestTemplate(t, server.Pool(), basetestutil.TestTemplate{
@@ -281,15 +282,16 @@ func TestTemplateAPI_Filters(t *testing.T) {
 	})
 
 	t.Run("GET /api/templates?ownerId=:id", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/templates?ownerId=" + account1.ID)
+		resp, err := client.Get(server.URL + "/api/templates?ownerId=" + account1.ID)
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 2)
 		for _, tpl := range result {
@@ -298,15 +300,16 @@ func TestTemplateAPI_Filters(t *testing.T) {
 	})
 
 	t.Run("GET /api/templates?q=Design", func(t *testing.T) {
-		resp, err := http.Get(server.URL + "/api/templates?q=Design")
+		resp, err := client.Get(server.URL + "/api/templates?q=Design")
 		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var result []map[string]interface{}
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		var page testutil.ListPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
 		require.NoError(t, err)
+		result := page.Items
 
 		assert.GreaterOrEqual(t, len(result), 1)
 	})
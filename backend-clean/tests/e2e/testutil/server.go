@@ -4,20 +4,40 @@
 package testutil
 
 import (
+	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 
 	httpcontroller "immortal-architecture-clean/backend/internal/adapter/http/controller"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	httpmiddleware "immortal-architecture-clean/backend/internal/adapter/http/middleware"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/driver/auth"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	httpfactory "immortal-architecture-clean/backend/internal/driver/factory/http"
+	apiinitializer "immortal-architecture-clean/backend/internal/driver/initializer/api"
 	basetestutil "immortal-architecture-clean/backend/tests/testutil"
 )
 
+// TestAuthSecret is the HS256 key trusted by the test server.
+// It stands in for the identity provider's signing key.
+const TestAuthSecret = "e2e-test-secret"
+
+// TestLoginSecret is the login secret the test server shares with its callers.
+const TestLoginSecret = "e2e-login-secret"
+
+// ListPage is the envelope returned by the cursor-paginated list endpoints.
+type ListPage struct {
+	Items      []map[string]interface{} `json:"items"`
+	NextCursor string                   `json:"nextCursor"`
+	HasMore    bool                     `json:"hasMore"`
+}
+
 // TestServer wraps httptest.Server with helper methods.
 type TestServer struct {
 	*httptest.Server
@@ -59,29 +79,107 @@ func (s *TestServer) Pool() *pgxpool.Pool {
 	return s.pool
 }
 
+// Token mints a bearer token for accountID signed with TestAuthSecret.
+func Token(t *testing.T, accountID string) string {
+	t.Helper()
+	now := time.Now()
+	token, err := auth.NewHS256Signer(TestAuthSecret).Sign(auth.Claims{
+		Subject:   accountID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+// AuthClient returns an HTTP client that authenticates every request as accountID.
+func (s *TestServer) AuthClient(t *testing.T, accountID string) *http.Client {
+	t.Helper()
+	return &http.Client{Transport: &bearerTransport{token: Token(t, accountID), base: s.Client().Transport}}
+}
+
+type bearerTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (b *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+b.token)
+	return b.base.RoundTrip(r)
+}
+
 // buildEchoServer builds an Echo server with all dependencies wired.
 func buildEchoServer(pool *pgxpool.Pool) *echo.Echo {
+	verifier, err := auth.NewVerifier(auth.Options{Algorithm: auth.AlgHS256, Secret: TestAuthSecret})
+	if err != nil {
+		panic(err)
+	}
+	issuer, err := auth.NewIssuer(auth.IssuerOptions{Algorithm: auth.AlgHS256, Secret: TestAuthSecret})
+	if err != nil {
+		panic(err)
+	}
+
 	txMgr := driverdb.NewTxManager(pool)
 
 	accountRepoFactory := factory.NewAccountRepoFactory(pool)
 	templateRepoFactory := factory.NewTemplateRepoFactory(pool)
 	noteRepoFactory := factory.NewNoteRepoFactory(pool)
+	sessionRepoFactory := factory.NewSessionRepoFactory(pool)
+	webhookRepoFactory := factory.NewWebhookRepoFactory(pool)
+	reviewRepoFactory := factory.NewNoteReviewRepoFactory(pool)
+	shareRepoFactory := factory.NewShareRepoFactory(pool)
+	workspaceRepoFactory := factory.NewWorkspaceRepoFactory(pool)
+	shareLinkRepoFactory := factory.NewShareLinkRepoFactory(pool)
 	txFactory := factory.NewTxFactory(txMgr)
+	tokenFactory := factory.NewTokenIssuerFactory(issuer)
+	linkTokenFactory := factory.NewLinkTokenFactory(auth.NewLinkTokens())
+	eventPublisherFactory := factory.NewEventPublisherFactory(pool)
 
 	accountOutputFactory := httpfactory.NewAccountOutputFactory()
 	templateOutputFactory := httpfactory.NewTemplateOutputFactory()
 	noteOutputFactory := httpfactory.NewNoteOutputFactory()
-
-	accountInputFactory := factory.NewAccountInputFactory()
-	templateInputFactory := factory.NewTemplateInputFactory()
-	noteInputFactory := factory.NewNoteInputFactory()
+	noteExportOutputFactory := httpfactory.NewNoteExportOutputFactory()
+	templateExportOutputFactory := httpfactory.NewTemplateExportOutputFactory()
+	sessionOutputFactory := httpfactory.NewSessionOutputFactory()
+	trashOutputFactory := httpfactory.NewTrashOutputFactory()
+	webhookOutputFactory := httpfactory.NewWebhookOutputFactory()
+	reviewOutputFactory := httpfactory.NewNoteReviewOutputFactory()
+	shareOutputFactory := httpfactory.NewShareOutputFactory()
+	workspaceOutputFactory := httpfactory.NewWorkspaceOutputFactory()
+	shareLinkOutputFactory := httpfactory.NewShareLinkOutputFactory()
+	archiveOutputFactory := httpfactory.NewArchiveOutputFactory()
+
+	accountInputFactory := factory.NewAccountInputFactory(txFactory, eventPublisherFactory)
+	templateInputFactory := factory.NewTemplateInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory)
+	noteInputFactory := factory.NewNoteInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory, shareLinkRepoFactory, note.Workflow{})
+	sessionInputFactory := factory.NewSessionInputFactory(eventPublisherFactory)
+	trashInputFactory := factory.NewTrashInputFactory()
+	webhookInputFactory := factory.NewWebhookInputFactory()
+	reviewInputFactory := factory.NewNoteReviewInputFactory(eventPublisherFactory)
+	shareInputFactory := factory.NewShareInputFactory(eventPublisherFactory, workspaceRepoFactory)
+	workspaceInputFactory := factory.NewWorkspaceInputFactory(eventPublisherFactory)
+	shareLinkInputFactory := factory.NewShareLinkInputFactory(eventPublisherFactory, shareRepoFactory, workspaceRepoFactory)
+	archiveInputFactory := factory.NewArchiveInputFactory(eventPublisherFactory, workspaceRepoFactory)
 
 	e := echo.New()
+	e.Use(httpmiddleware.Auth(verifier, apiinitializer.PublicPaths...))
+	e.Use(httpmiddleware.LoginSecret(TestLoginSecret, apiinitializer.LoginPaths...))
 
 	ac := httpcontroller.NewAccountController(accountInputFactory, accountOutputFactory, accountRepoFactory)
-	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
-	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateRepoFactory, txFactory)
-	server := httpcontroller.NewServer(ac, nc, tc)
+	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteExportOutputFactory, noteRepoFactory, templateRepoFactory, accountRepoFactory, txFactory)
+	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateExportOutputFactory, templateRepoFactory, accountRepoFactory, txFactory)
+	sc := httpcontroller.NewSessionController(sessionInputFactory, sessionOutputFactory, accountRepoFactory, sessionRepoFactory, tokenFactory, txFactory)
+	trc := httpcontroller.NewTrashController(trashInputFactory, trashOutputFactory, noteRepoFactory, templateRepoFactory)
+	wc := httpcontroller.NewWebhookController(webhookInputFactory, webhookOutputFactory, webhookRepoFactory, accountRepoFactory)
+	rc := httpcontroller.NewNoteReviewController(reviewInputFactory, reviewOutputFactory, noteRepoFactory, reviewRepoFactory, accountRepoFactory, txFactory)
+	shc := httpcontroller.NewShareController(shareInputFactory, shareOutputFactory, shareRepoFactory, noteRepoFactory, templateRepoFactory, accountRepoFactory, txFactory)
+	wsc := httpcontroller.NewWorkspaceController(workspaceInputFactory, workspaceOutputFactory, workspaceRepoFactory, accountRepoFactory, txFactory)
+	slc := httpcontroller.NewShareLinkController(shareLinkInputFactory, shareLinkOutputFactory, shareLinkRepoFactory, noteRepoFactory, accountRepoFactory, linkTokenFactory, txFactory)
+	arc := httpcontroller.NewArchiveController(archiveInputFactory, archiveOutputFactory, templateRepoFactory, noteRepoFactory, accountRepoFactory, txFactory, 0)
+	server := httpcontroller.NewServer(ac, nc, tc, sc, trc, wc, rc, shc, wsc, slc, arc)
 	openapi.RegisterHandlers(e, server)
 
 	return e
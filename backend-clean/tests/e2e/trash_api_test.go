@@ -0,0 +1,124 @@
+//go:build e2e
+
+// Package e2e contains end-to-end API tests.
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"immortal-architecture-clean/backend/tests/e2e/testutil"
+	basetestutil "immortal-architecture-clean/backend/tests/testutil"
+)
+
+func TestTrashAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e test")
+	}
+
+	pg := basetestutil.SetupPostgres(t)
+	server := testutil.StartTestServer(t, pg.ConnectionString)
+	data := basetestutil.CreateDefaultTestData(t, server.Pool())
+	client := server.AuthClient(t, data.Account.ID)
+
+	do := func(t *testing.T, method, path, ifMatch string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		require.NoError(t, err)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	type trash struct {
+		Notes []struct {
+			ID      string `json:"id"`
+			Version int    `json:"version"`
+		} `json:"notes"`
+		Templates []struct {
+			ID string `json:"id"`
+		} `json:"templates"`
+	}
+	listTrash := func(t *testing.T) trash {
+		t.Helper()
+		resp := do(t, http.MethodGet, "/api/trash", "")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var got trash
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+		return got
+	}
+
+	t.Run("DELETE /api/notes/:id - Move note to the trash", func(t *testing.T) {
+		resp := do(t, http.MethodDelete, "/api/notes/"+data.Note.ID, "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		getResp := do(t, http.MethodGet, "/api/notes/"+data.Note.ID, "")
+		defer getResp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, getResp.StatusCode)
+	})
+
+	t.Run("DELETE /api/templates/:id - Template used only by trashed notes can be deleted", func(t *testing.T) {
+		resp := do(t, http.MethodDelete, "/api/templates/"+data.Template.ID, "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("GET /api/trash - List trashed items", func(t *testing.T) {
+		got := listTrash(t)
+		require.Len(t, got.Notes, 1)
+		require.Len(t, got.Templates, 1)
+		assert.Equal(t, data.Note.ID, got.Notes[0].ID)
+		assert.Equal(t, data.Template.ID, got.Templates[0].ID)
+	})
+
+	t.Run("POST /api/notes/:id/restore - Template still in the trash", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/api/notes/"+data.Note.ID+"/restore", "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("POST /api/templates/:id/restore - Restore template", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/api/templates/"+data.Template.ID+"/restore", "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+	})
+
+	t.Run("POST /api/notes/:id/restore - Stale If-Match", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/api/notes/"+data.Note.ID+"/restore", `"1"`)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("POST /api/notes/:id/restore - Restore note", func(t *testing.T) {
+		version := listTrash(t).Notes[0].Version
+		resp := do(t, http.MethodPost, "/api/notes/"+data.Note.ID+"/restore", fmt.Sprintf("%q", fmt.Sprint(version)))
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, data.Note.ID, result["id"])
+		assert.NotEmpty(t, result["sections"])
+
+		got := listTrash(t)
+		assert.Empty(t, got.Notes)
+		assert.Empty(t, got.Templates)
+	})
+
+	t.Run("POST /api/notes/:id/restore - Note not in the trash", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/api/notes/"+data.Note.ID+"/restore", "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
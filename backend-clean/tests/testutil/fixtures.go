@@ -23,7 +23,9 @@ type TestTemplate struct {
 	ID      string
 	Name    string
 	OwnerID string
-	Fields  []TestField
+	// WorkspaceID defaults to the owner's personal workspace.
+	WorkspaceID string
+	Fields      []TestField
 }
 
 // TestField represents test field data.
@@ -80,6 +82,35 @@ func CreateTestAccount(t *testing.T, pool *pgxpool.Pool, account TestAccount) Te
 	return account
 }
 
+// PersonalWorkspaceID returns the personal workspace of an account, creating it on first use.
+func PersonalWorkspaceID(t *testing.T, pool *pgxpool.Pool, accountID string) string {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := pool.Exec(ctx, `
+		INSERT INTO workspaces (name, owner_id, personal)
+		VALUES ('Personal', $1, TRUE)
+		ON CONFLICT (owner_id) WHERE personal DO NOTHING
+	`, accountID)
+	if err != nil {
+		t.Fatalf("failed to create personal workspace: %v", err)
+	}
+	var id string
+	err = pool.QueryRow(ctx, `SELECT id::text FROM workspaces WHERE owner_id = $1 AND personal`, accountID).Scan(&id)
+	if err != nil {
+		t.Fatalf("failed to get personal workspace: %v", err)
+	}
+	_, err = pool.Exec(ctx, `
+		INSERT INTO workspace_members (workspace_id, account_id, role)
+		VALUES ($1, $2, 'owner')
+		ON CONFLICT DO NOTHING
+	`, id, accountID)
+	if err != nil {
+		t.Fatalf("failed to add personal workspace owner: %v", err)
+	}
+	return id
+}
+
 // CreateTestTemplate creates a test template with fields in the database.
 func CreateTestTemplate(t *testing.T, pool *pgxpool.Pool, template TestTemplate) TestTemplate {
 	t.Helper()
@@ -91,11 +122,14 @@ func CreateTestTemplate(t *testing.T, pool *pgxpool.Pool, template TestTemplate)
 	if template.Name == "" {
 		template.Name = "Test Template"
 	}
+	if template.WorkspaceID == "" {
+		template.WorkspaceID = PersonalWorkspaceID(t, pool, template.OwnerID)
+	}
 
 	_, err := pool.Exec(ctx, `
-		INSERT INTO templates (id, name, owner_id)
-		VALUES ($1, $2, $3)
-	`, template.ID, template.Name, template.OwnerID)
+		INSERT INTO templates (id, name, owner_id, workspace_id)
+		VALUES ($1, $2, $3, $4)
+	`, template.ID, template.Name, template.OwnerID, template.WorkspaceID)
 	if err != nil {
 		t.Fatalf("failed to create test template: %v", err)
 	}
@@ -140,8 +174,8 @@ func CreateTestNote(t *testing.T, pool *pgxpool.Pool, note TestNote) TestNote {
 	}
 
 	_, err := pool.Exec(ctx, `
-		INSERT INTO notes (id, title, template_id, owner_id, status)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO notes (id, title, template_id, owner_id, status, workspace_id)
+		SELECT $1, $2, $3, $4, $5, t.workspace_id FROM templates t WHERE t.id = $3
 	`, note.ID, note.Title, note.TemplateID, note.OwnerID, note.Status)
 	if err != nil {
 		t.Fatalf("failed to create test note: %v", err)
@@ -162,6 +196,21 @@ func CreateTestNote(t *testing.T, pool *pgxpool.Pool, note TestNote) TestNote {
 		}
 	}
 
+	// Index the note for full-text search the same way NoteRepository does.
+	_, err = pool.Exec(ctx, `
+		INSERT INTO note_search_documents (note_id, document)
+		SELECT n.id,
+		       setweight(to_tsvector('simple', n.title), 'A')
+		           || setweight(to_tsvector('simple', COALESCE(string_agg(s.content, ' '), '')), 'B')
+		FROM notes n
+		LEFT JOIN sections s ON s.note_id = n.id
+		WHERE n.id = $1
+		GROUP BY n.id, n.title
+	`, note.ID)
+	if err != nil {
+		t.Fatalf("failed to index test note: %v", err)
+	}
+
 	return note
 }
 
@@ -140,7 +140,7 @@ func (c *PostgresContainer) CleanupTables(t *testing.T, pool *pgxpool.Pool) {
 	ctx := context.Background()
 
 	// Truncate in order respecting foreign keys
-	tables := []string{"sections", "notes", "fields", "templates", "accounts"}
+	tables := []string{"webhook_deliveries", "webhook_subscriptions", "outbox", "note_share_links", "note_shares", "template_shares", "note_reviews", "note_reviewers", "sections", "notes", "fields", "templates", "workspace_invitations", "workspace_members", "workspaces", "accounts"}
 	for _, table := range tables {
 		_, err := pool.Exec(ctx, fmt.Sprintf("TRUNCATE TABLE %s CASCADE", table))
 		if err != nil {